@@ -19,7 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Balances_FullMethodName = "/evmos.vesting.v1.Query/Balances"
+	Query_Balances_FullMethodName                = "/evmos.vesting.v1.Query/Balances"
+	Query_VestingAccount_FullMethodName          = "/evmos.vesting.v1.Query/VestingAccount"
+	Query_VestingAccountsByFunder_FullMethodName = "/evmos.vesting.v1.Query/VestingAccountsByFunder"
+	Query_SimulateClawback_FullMethodName        = "/evmos.vesting.v1.Query/SimulateClawback"
 )
 
 // QueryClient is the client API for Query service.
@@ -28,6 +31,15 @@ const (
 type QueryClient interface {
 	// Balances retrieves the unvested, vested and locked tokens for a vesting account
 	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
+	// VestingAccount retrieves the funder and the lockup and vesting schedules of
+	// a clawback vesting account
+	VestingAccount(ctx context.Context, in *QueryVestingAccountRequest, opts ...grpc.CallOption) (*QueryVestingAccountResponse, error)
+	// VestingAccountsByFunder retrieves the clawback vesting accounts funded by an
+	// address
+	VestingAccountsByFunder(ctx context.Context, in *QueryVestingAccountsByFunderRequest, opts ...grpc.CallOption) (*QueryVestingAccountsByFunderResponse, error)
+	// SimulateClawback retrieves the tokens a clawback of a clawback vesting
+	// account would leave vested and return to the funder at a given time
+	SimulateClawback(ctx context.Context, in *QuerySimulateClawbackRequest, opts ...grpc.CallOption) (*QuerySimulateClawbackResponse, error)
 }
 
 type queryClient struct {
@@ -47,12 +59,48 @@ func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, op
 	return out, nil
 }
 
+func (c *queryClient) VestingAccount(ctx context.Context, in *QueryVestingAccountRequest, opts ...grpc.CallOption) (*QueryVestingAccountResponse, error) {
+	out := new(QueryVestingAccountResponse)
+	err := c.cc.Invoke(ctx, Query_VestingAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingAccountsByFunder(ctx context.Context, in *QueryVestingAccountsByFunderRequest, opts ...grpc.CallOption) (*QueryVestingAccountsByFunderResponse, error) {
+	out := new(QueryVestingAccountsByFunderResponse)
+	err := c.cc.Invoke(ctx, Query_VestingAccountsByFunder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateClawback(ctx context.Context, in *QuerySimulateClawbackRequest, opts ...grpc.CallOption) (*QuerySimulateClawbackResponse, error) {
+	out := new(QuerySimulateClawbackResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateClawback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Balances retrieves the unvested, vested and locked tokens for a vesting account
 	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+	// VestingAccount retrieves the funder and the lockup and vesting schedules of
+	// a clawback vesting account
+	VestingAccount(context.Context, *QueryVestingAccountRequest) (*QueryVestingAccountResponse, error)
+	// VestingAccountsByFunder retrieves the clawback vesting accounts funded by an
+	// address
+	VestingAccountsByFunder(context.Context, *QueryVestingAccountsByFunderRequest) (*QueryVestingAccountsByFunderResponse, error)
+	// SimulateClawback retrieves the tokens a clawback of a clawback vesting
+	// account would leave vested and return to the funder at a given time
+	SimulateClawback(context.Context, *QuerySimulateClawbackRequest) (*QuerySimulateClawbackResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -63,6 +111,15 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
 }
+func (UnimplementedQueryServer) VestingAccount(context.Context, *QueryVestingAccountRequest) (*QueryVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingAccount not implemented")
+}
+func (UnimplementedQueryServer) VestingAccountsByFunder(context.Context, *QueryVestingAccountsByFunderRequest) (*QueryVestingAccountsByFunderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingAccountsByFunder not implemented")
+}
+func (UnimplementedQueryServer) SimulateClawback(context.Context, *QuerySimulateClawbackRequest) (*QuerySimulateClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateClawback not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +151,60 @@ func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VestingAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingAccount(ctx, req.(*QueryVestingAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingAccountsByFunder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingAccountsByFunderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingAccountsByFunder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VestingAccountsByFunder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingAccountsByFunder(ctx, req.(*QueryVestingAccountsByFunderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateClawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateClawbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateClawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateClawback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateClawback(ctx, req.(*QuerySimulateClawbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +216,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Balances",
 			Handler:    _Query_Balances_Handler,
 		},
+		{
+			MethodName: "VestingAccount",
+			Handler:    _Query_VestingAccount_Handler,
+		},
+		{
+			MethodName: "VestingAccountsByFunder",
+			Handler:    _Query_VestingAccountsByFunder_Handler,
+		},
+		{
+			MethodName: "SimulateClawback",
+			Handler:    _Query_SimulateClawback_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v1/query.proto",
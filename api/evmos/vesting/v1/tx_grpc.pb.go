@@ -23,6 +23,8 @@ const (
 	Msg_Clawback_FullMethodName                     = "/evmos.vesting.v1.Msg/Clawback"
 	Msg_UpdateVestingFunder_FullMethodName          = "/evmos.vesting.v1.Msg/UpdateVestingFunder"
 	Msg_ConvertVestingAccount_FullMethodName        = "/evmos.vesting.v1.Msg/ConvertVestingAccount"
+	Msg_AllocateToSubnet_FullMethodName             = "/evmos.vesting.v1.Msg/AllocateToSubnet"
+	Msg_DeallocateFromSubnet_FullMethodName         = "/evmos.vesting.v1.Msg/DeallocateFromSubnet"
 )
 
 // MsgClient is the client API for Msg service.
@@ -39,6 +41,12 @@ type MsgClient interface {
 	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to a Eth account
 	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
+	// AllocateToSubnet allocates locked tokens of a ClawbackVestingAccount to
+	// its stake on a subnet.
+	AllocateToSubnet(ctx context.Context, in *MsgAllocateToSubnet, opts ...grpc.CallOption) (*MsgAllocateToSubnetResponse, error)
+	// DeallocateFromSubnet returns tokens allocated to the stake of a vesting
+	// account on a subnet to the account.
+	DeallocateFromSubnet(ctx context.Context, in *MsgDeallocateFromSubnet, opts ...grpc.CallOption) (*MsgDeallocateFromSubnetResponse, error)
 }
 
 type msgClient struct {
@@ -85,6 +93,24 @@ func (c *msgClient) ConvertVestingAccount(ctx context.Context, in *MsgConvertVes
 	return out, nil
 }
 
+func (c *msgClient) AllocateToSubnet(ctx context.Context, in *MsgAllocateToSubnet, opts ...grpc.CallOption) (*MsgAllocateToSubnetResponse, error) {
+	out := new(MsgAllocateToSubnetResponse)
+	err := c.cc.Invoke(ctx, Msg_AllocateToSubnet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeallocateFromSubnet(ctx context.Context, in *MsgDeallocateFromSubnet, opts ...grpc.CallOption) (*MsgDeallocateFromSubnetResponse, error) {
+	out := new(MsgDeallocateFromSubnetResponse)
+	err := c.cc.Invoke(ctx, Msg_DeallocateFromSubnet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -99,6 +125,12 @@ type MsgServer interface {
 	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to a Eth account
 	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
+	// AllocateToSubnet allocates locked tokens of a ClawbackVestingAccount to
+	// its stake on a subnet.
+	AllocateToSubnet(context.Context, *MsgAllocateToSubnet) (*MsgAllocateToSubnetResponse, error)
+	// DeallocateFromSubnet returns tokens allocated to the stake of a vesting
+	// account on a subnet to the account.
+	DeallocateFromSubnet(context.Context, *MsgDeallocateFromSubnet) (*MsgDeallocateFromSubnetResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -118,6 +150,12 @@ func (UnimplementedMsgServer) UpdateVestingFunder(context.Context, *MsgUpdateVes
 func (UnimplementedMsgServer) ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestingAccount not implemented")
 }
+func (UnimplementedMsgServer) AllocateToSubnet(context.Context, *MsgAllocateToSubnet) (*MsgAllocateToSubnetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocateToSubnet not implemented")
+}
+func (UnimplementedMsgServer) DeallocateFromSubnet(context.Context, *MsgDeallocateFromSubnet) (*MsgDeallocateFromSubnetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeallocateFromSubnet not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -203,6 +241,42 @@ func _Msg_ConvertVestingAccount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AllocateToSubnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAllocateToSubnet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AllocateToSubnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AllocateToSubnet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AllocateToSubnet(ctx, req.(*MsgAllocateToSubnet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeallocateFromSubnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeallocateFromSubnet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeallocateFromSubnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeallocateFromSubnet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeallocateFromSubnet(ctx, req.(*MsgDeallocateFromSubnet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -226,6 +300,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertVestingAccount",
 			Handler:    _Msg_ConvertVestingAccount_Handler,
 		},
+		{
+			MethodName: "AllocateToSubnet",
+			Handler:    _Msg_AllocateToSubnet_Handler,
+		},
+		{
+			MethodName: "DeallocateFromSubnet",
+			Handler:    _Msg_DeallocateFromSubnet_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v1/tx.proto",
@@ -14,12 +14,116 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*SubnetEmissionSuspension
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SubnetEmissionSuspension)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SubnetEmissionSuspension)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(SubnetEmissionSuspension)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(SubnetEmissionSuspension)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*SubnetCosts
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SubnetCosts)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SubnetCosts)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(SubnetCosts)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(SubnetCosts)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                        protoreflect.MessageDescriptor
 	fd_GenesisState_params                 protoreflect.FieldDescriptor
 	fd_GenesisState_total_issuance         protoreflect.FieldDescriptor
 	fd_GenesisState_total_burned           protoreflect.FieldDescriptor
 	fd_GenesisState_pending_subnet_rewards protoreflect.FieldDescriptor
+	fd_GenesisState_suspended_subnets      protoreflect.FieldDescriptor
+	fd_GenesisState_subnet_costs           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -29,6 +133,8 @@ func init() {
 	fd_GenesisState_total_issuance = md_GenesisState.Fields().ByName("total_issuance")
 	fd_GenesisState_total_burned = md_GenesisState.Fields().ByName("total_burned")
 	fd_GenesisState_pending_subnet_rewards = md_GenesisState.Fields().ByName("pending_subnet_rewards")
+	fd_GenesisState_suspended_subnets = md_GenesisState.Fields().ByName("suspended_subnets")
+	fd_GenesisState_subnet_costs = md_GenesisState.Fields().ByName("subnet_costs")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -120,6 +226,18 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.SuspendedSubnets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.SuspendedSubnets})
+		if !f(fd_GenesisState_suspended_subnets, value) {
+			return
+		}
+	}
+	if len(x.SubnetCosts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.SubnetCosts})
+		if !f(fd_GenesisState_subnet_costs, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -143,6 +261,10 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.TotalBurned != nil
 	case "hetu.blockinflation.v1.GenesisState.pending_subnet_rewards":
 		return x.PendingSubnetRewards != nil
+	case "hetu.blockinflation.v1.GenesisState.suspended_subnets":
+		return len(x.SuspendedSubnets) != 0
+	case "hetu.blockinflation.v1.GenesisState.subnet_costs":
+		return len(x.SubnetCosts) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.GenesisState"))
@@ -167,6 +289,10 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.TotalBurned = nil
 	case "hetu.blockinflation.v1.GenesisState.pending_subnet_rewards":
 		x.PendingSubnetRewards = nil
+	case "hetu.blockinflation.v1.GenesisState.suspended_subnets":
+		x.SuspendedSubnets = nil
+	case "hetu.blockinflation.v1.GenesisState.subnet_costs":
+		x.SubnetCosts = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.GenesisState"))
@@ -195,6 +321,18 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "hetu.blockinflation.v1.GenesisState.pending_subnet_rewards":
 		value := x.PendingSubnetRewards
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "hetu.blockinflation.v1.GenesisState.suspended_subnets":
+		if len(x.SuspendedSubnets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.SuspendedSubnets}
+		return protoreflect.ValueOfList(listValue)
+	case "hetu.blockinflation.v1.GenesisState.subnet_costs":
+		if len(x.SubnetCosts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.SubnetCosts}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.GenesisState"))
@@ -223,6 +361,14 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.TotalBurned = value.Message().Interface().(*v1beta1.Coin)
 	case "hetu.blockinflation.v1.GenesisState.pending_subnet_rewards":
 		x.PendingSubnetRewards = value.Message().Interface().(*v1beta1.Coin)
+	case "hetu.blockinflation.v1.GenesisState.suspended_subnets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.SuspendedSubnets = *clv.list
+	case "hetu.blockinflation.v1.GenesisState.subnet_costs":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.SubnetCosts = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.GenesisState"))
@@ -263,6 +409,18 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.PendingSubnetRewards = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.PendingSubnetRewards.ProtoReflect())
+	case "hetu.blockinflation.v1.GenesisState.suspended_subnets":
+		if x.SuspendedSubnets == nil {
+			x.SuspendedSubnets = []*SubnetEmissionSuspension{}
+		}
+		value := &_GenesisState_5_list{list: &x.SuspendedSubnets}
+		return protoreflect.ValueOfList(value)
+	case "hetu.blockinflation.v1.GenesisState.subnet_costs":
+		if x.SubnetCosts == nil {
+			x.SubnetCosts = []*SubnetCosts{}
+		}
+		value := &_GenesisState_6_list{list: &x.SubnetCosts}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.GenesisState"))
@@ -288,6 +446,12 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "hetu.blockinflation.v1.GenesisState.pending_subnet_rewards":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "hetu.blockinflation.v1.GenesisState.suspended_subnets":
+		list := []*SubnetEmissionSuspension{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "hetu.blockinflation.v1.GenesisState.subnet_costs":
+		list := []*SubnetCosts{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.GenesisState"))
@@ -373,6 +537,18 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.PendingSubnetRewards)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.SuspendedSubnets) > 0 {
+			for _, e := range x.SuspendedSubnets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SubnetCosts) > 0 {
+			for _, e := range x.SubnetCosts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -402,6 +578,38 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.SubnetCosts) > 0 {
+			for iNdEx := len(x.SubnetCosts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SubnetCosts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.SuspendedSubnets) > 0 {
+			for iNdEx := len(x.SuspendedSubnets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SuspendedSubnets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
 		if x.PendingSubnetRewards != nil {
 			encoded, err := options.Marshal(x.PendingSubnetRewards)
 			if err != nil {
@@ -651,6 +859,74 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SuspendedSubnets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SuspendedSubnets = append(x.SuspendedSubnets, &SubnetEmissionSuspension{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SuspendedSubnets[len(x.SuspendedSubnets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubnetCosts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubnetCosts = append(x.SubnetCosts, &SubnetCosts{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubnetCosts[len(x.SubnetCosts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -686,130 +962,1410 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: hetu/blockinflation/v1/genesis.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_SubnetEmissionSuspension                  protoreflect.MessageDescriptor
+	fd_SubnetEmissionSuspension_netuid           protoreflect.FieldDescriptor
+	fd_SubnetEmissionSuspension_reason           protoreflect.FieldDescriptor
+	fd_SubnetEmissionSuspension_suspended_height protoreflect.FieldDescriptor
 )
 
-// GenesisState defines the blockinflation module's genesis state.
-type GenesisState struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_hetu_blockinflation_v1_genesis_proto_init()
+	md_SubnetEmissionSuspension = File_hetu_blockinflation_v1_genesis_proto.Messages().ByName("SubnetEmissionSuspension")
+	fd_SubnetEmissionSuspension_netuid = md_SubnetEmissionSuspension.Fields().ByName("netuid")
+	fd_SubnetEmissionSuspension_reason = md_SubnetEmissionSuspension.Fields().ByName("reason")
+	fd_SubnetEmissionSuspension_suspended_height = md_SubnetEmissionSuspension.Fields().ByName("suspended_height")
+}
 
-	Params               *Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	TotalIssuance        *v1beta1.Coin `protobuf:"bytes,2,opt,name=total_issuance,json=totalIssuance,proto3" json:"total_issuance,omitempty"`
-	TotalBurned          *v1beta1.Coin `protobuf:"bytes,3,opt,name=total_burned,json=totalBurned,proto3" json:"total_burned,omitempty"`
-	PendingSubnetRewards *v1beta1.Coin `protobuf:"bytes,4,opt,name=pending_subnet_rewards,json=pendingSubnetRewards,proto3" json:"pending_subnet_rewards,omitempty"`
+var _ protoreflect.Message = (*fastReflection_SubnetEmissionSuspension)(nil)
+
+type fastReflection_SubnetEmissionSuspension SubnetEmissionSuspension
+
+func (x *SubnetEmissionSuspension) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SubnetEmissionSuspension)(x)
 }
 
-func (x *GenesisState) Reset() {
-	*x = GenesisState{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hetu_blockinflation_v1_genesis_proto_msgTypes[0]
+func (x *SubnetEmissionSuspension) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *GenesisState) String() string {
-	return protoimpl.X.MessageStringOf(x)
+var _fastReflection_SubnetEmissionSuspension_messageType fastReflection_SubnetEmissionSuspension_messageType
+var _ protoreflect.MessageType = fastReflection_SubnetEmissionSuspension_messageType{}
+
+type fastReflection_SubnetEmissionSuspension_messageType struct{}
+
+func (x fastReflection_SubnetEmissionSuspension_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SubnetEmissionSuspension)(nil)
+}
+func (x fastReflection_SubnetEmissionSuspension_messageType) New() protoreflect.Message {
+	return new(fastReflection_SubnetEmissionSuspension)
+}
+func (x fastReflection_SubnetEmissionSuspension_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubnetEmissionSuspension
 }
 
-func (*GenesisState) ProtoMessage() {}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SubnetEmissionSuspension) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubnetEmissionSuspension
+}
 
-// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return file_hetu_blockinflation_v1_genesis_proto_rawDescGZIP(), []int{0}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SubnetEmissionSuspension) Type() protoreflect.MessageType {
+	return _fastReflection_SubnetEmissionSuspension_messageType
 }
 
-func (x *GenesisState) GetParams() *Params {
-	if x != nil {
-		return x.Params
-	}
-	return nil
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SubnetEmissionSuspension) New() protoreflect.Message {
+	return new(fastReflection_SubnetEmissionSuspension)
 }
 
-func (x *GenesisState) GetTotalIssuance() *v1beta1.Coin {
-	if x != nil {
-		return x.TotalIssuance
-	}
-	return nil
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SubnetEmissionSuspension) Interface() protoreflect.ProtoMessage {
+	return (*SubnetEmissionSuspension)(x)
 }
 
-func (x *GenesisState) GetTotalBurned() *v1beta1.Coin {
-	if x != nil {
-		return x.TotalBurned
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SubnetEmissionSuspension) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Netuid != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Netuid)
+		if !f(fd_SubnetEmissionSuspension_netuid, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_SubnetEmissionSuspension_reason, value) {
+			return
+		}
+	}
+	if x.SuspendedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SuspendedHeight)
+		if !f(fd_SubnetEmissionSuspension_suspended_height, value) {
+			return
+		}
 	}
-	return nil
 }
 
-func (x *GenesisState) GetPendingSubnetRewards() *v1beta1.Coin {
-	if x != nil {
-		return x.PendingSubnetRewards
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SubnetEmissionSuspension) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.netuid":
+		return x.Netuid != uint32(0)
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.reason":
+		return x.Reason != ""
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.suspended_height":
+		return x.SuspendedHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.SubnetEmissionSuspension"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.SubnetEmissionSuspension does not contain field %s", fd.FullName()))
 	}
-	return nil
 }
 
-var File_hetu_blockinflation_v1_genesis_proto protoreflect.FileDescriptor
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetEmissionSuspension) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.netuid":
+		x.Netuid = uint32(0)
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.reason":
+		x.Reason = ""
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.suspended_height":
+		x.SuspendedHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.SubnetEmissionSuspension"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.SubnetEmissionSuspension does not contain field %s", fd.FullName()))
+	}
+}
 
-var file_hetu_blockinflation_v1_genesis_proto_rawDesc = []byte{
-	0x0a, 0x24, 0x68, 0x65, 0x74, 0x75, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x14,
-	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
-	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x68, 0x65, 0x74, 0x75, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x65, 0x74, 0x75,
-	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x42, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x75, 0x72,
-	0x6e, 0x65, 0x64, 0x12, 0x55, 0x0a, 0x16, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73,
-	0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62,
-	0x6e, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0xde, 0x01, 0x0a, 0x1a, 0x63,
-	0x6f, 0x6d, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x74, 0x75,
-	0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x76, 0x31, 0x3b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x42, 0x58, 0xaa, 0x02, 0x16, 0x48, 0x65, 0x74, 0x75,
-	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x16, 0x48, 0x65, 0x74, 0x75, 0x5c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69,
-	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x48, 0x65,
-	0x74, 0x75, 0x5c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x18, 0x48, 0x65, 0x74, 0x75, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e,
-	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SubnetEmissionSuspension) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.netuid":
+		value := x.Netuid
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.suspended_height":
+		value := x.SuspendedHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.SubnetEmissionSuspension"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.SubnetEmissionSuspension does not contain field %s", descriptor.FullName()))
+	}
 }
 
-var (
-	file_hetu_blockinflation_v1_genesis_proto_rawDescOnce sync.Once
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetEmissionSuspension) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.netuid":
+		x.Netuid = uint32(value.Uint())
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.reason":
+		x.Reason = value.Interface().(string)
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.suspended_height":
+		x.SuspendedHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.SubnetEmissionSuspension"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.SubnetEmissionSuspension does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetEmissionSuspension) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.netuid":
+		panic(fmt.Errorf("field netuid of message hetu.blockinflation.v1.SubnetEmissionSuspension is not mutable"))
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.reason":
+		panic(fmt.Errorf("field reason of message hetu.blockinflation.v1.SubnetEmissionSuspension is not mutable"))
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.suspended_height":
+		panic(fmt.Errorf("field suspended_height of message hetu.blockinflation.v1.SubnetEmissionSuspension is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.SubnetEmissionSuspension"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.SubnetEmissionSuspension does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SubnetEmissionSuspension) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.netuid":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.reason":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.SubnetEmissionSuspension.suspended_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.SubnetEmissionSuspension"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.SubnetEmissionSuspension does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SubnetEmissionSuspension) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.blockinflation.v1.SubnetEmissionSuspension", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SubnetEmissionSuspension) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetEmissionSuspension) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SubnetEmissionSuspension) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SubnetEmissionSuspension) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SubnetEmissionSuspension)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Netuid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Netuid))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SuspendedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SuspendedHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SubnetEmissionSuspension)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SuspendedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SuspendedHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Netuid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SubnetEmissionSuspension)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubnetEmissionSuspension: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubnetEmissionSuspension: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				}
+				x.Netuid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Netuid |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SuspendedHeight", wireType)
+				}
+				x.SuspendedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SuspendedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SubnetCosts                protoreflect.MessageDescriptor
+	fd_SubnetCosts_netuid         protoreflect.FieldDescriptor
+	fd_SubnetCosts_burned         protoreflect.FieldDescriptor
+	fd_SubnetCosts_recycled       protoreflect.FieldDescriptor
+	fd_SubnetCosts_community_pool protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_blockinflation_v1_genesis_proto_init()
+	md_SubnetCosts = File_hetu_blockinflation_v1_genesis_proto.Messages().ByName("SubnetCosts")
+	fd_SubnetCosts_netuid = md_SubnetCosts.Fields().ByName("netuid")
+	fd_SubnetCosts_burned = md_SubnetCosts.Fields().ByName("burned")
+	fd_SubnetCosts_recycled = md_SubnetCosts.Fields().ByName("recycled")
+	fd_SubnetCosts_community_pool = md_SubnetCosts.Fields().ByName("community_pool")
+}
+
+var _ protoreflect.Message = (*fastReflection_SubnetCosts)(nil)
+
+type fastReflection_SubnetCosts SubnetCosts
+
+func (x *SubnetCosts) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SubnetCosts)(x)
+}
+
+func (x *SubnetCosts) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SubnetCosts_messageType fastReflection_SubnetCosts_messageType
+var _ protoreflect.MessageType = fastReflection_SubnetCosts_messageType{}
+
+type fastReflection_SubnetCosts_messageType struct{}
+
+func (x fastReflection_SubnetCosts_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SubnetCosts)(nil)
+}
+func (x fastReflection_SubnetCosts_messageType) New() protoreflect.Message {
+	return new(fastReflection_SubnetCosts)
+}
+func (x fastReflection_SubnetCosts_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubnetCosts
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SubnetCosts) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubnetCosts
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SubnetCosts) Type() protoreflect.MessageType {
+	return _fastReflection_SubnetCosts_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SubnetCosts) New() protoreflect.Message {
+	return new(fastReflection_SubnetCosts)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SubnetCosts) Interface() protoreflect.ProtoMessage {
+	return (*SubnetCosts)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SubnetCosts) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Netuid != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Netuid)
+		if !f(fd_SubnetCosts_netuid, value) {
+			return
+		}
+	}
+	if x.Burned != "" {
+		value := protoreflect.ValueOfString(x.Burned)
+		if !f(fd_SubnetCosts_burned, value) {
+			return
+		}
+	}
+	if x.Recycled != "" {
+		value := protoreflect.ValueOfString(x.Recycled)
+		if !f(fd_SubnetCosts_recycled, value) {
+			return
+		}
+	}
+	if x.CommunityPool != "" {
+		value := protoreflect.ValueOfString(x.CommunityPool)
+		if !f(fd_SubnetCosts_community_pool, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SubnetCosts) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.blockinflation.v1.SubnetCosts.netuid":
+		return x.Netuid != uint32(0)
+	case "hetu.blockinflation.v1.SubnetCosts.burned":
+		return x.Burned != ""
+	case "hetu.blockinflation.v1.SubnetCosts.recycled":
+		return x.Recycled != ""
+	case "hetu.blockinflation.v1.SubnetCosts.community_pool":
+		return x.CommunityPool != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.SubnetCosts"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.SubnetCosts does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetCosts) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.blockinflation.v1.SubnetCosts.netuid":
+		x.Netuid = uint32(0)
+	case "hetu.blockinflation.v1.SubnetCosts.burned":
+		x.Burned = ""
+	case "hetu.blockinflation.v1.SubnetCosts.recycled":
+		x.Recycled = ""
+	case "hetu.blockinflation.v1.SubnetCosts.community_pool":
+		x.CommunityPool = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.SubnetCosts"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.SubnetCosts does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SubnetCosts) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.blockinflation.v1.SubnetCosts.netuid":
+		value := x.Netuid
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.blockinflation.v1.SubnetCosts.burned":
+		value := x.Burned
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.SubnetCosts.recycled":
+		value := x.Recycled
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.SubnetCosts.community_pool":
+		value := x.CommunityPool
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.SubnetCosts"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.SubnetCosts does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetCosts) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.blockinflation.v1.SubnetCosts.netuid":
+		x.Netuid = uint32(value.Uint())
+	case "hetu.blockinflation.v1.SubnetCosts.burned":
+		x.Burned = value.Interface().(string)
+	case "hetu.blockinflation.v1.SubnetCosts.recycled":
+		x.Recycled = value.Interface().(string)
+	case "hetu.blockinflation.v1.SubnetCosts.community_pool":
+		x.CommunityPool = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.SubnetCosts"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.SubnetCosts does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetCosts) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.blockinflation.v1.SubnetCosts.netuid":
+		panic(fmt.Errorf("field netuid of message hetu.blockinflation.v1.SubnetCosts is not mutable"))
+	case "hetu.blockinflation.v1.SubnetCosts.burned":
+		panic(fmt.Errorf("field burned of message hetu.blockinflation.v1.SubnetCosts is not mutable"))
+	case "hetu.blockinflation.v1.SubnetCosts.recycled":
+		panic(fmt.Errorf("field recycled of message hetu.blockinflation.v1.SubnetCosts is not mutable"))
+	case "hetu.blockinflation.v1.SubnetCosts.community_pool":
+		panic(fmt.Errorf("field community_pool of message hetu.blockinflation.v1.SubnetCosts is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.SubnetCosts"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.SubnetCosts does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SubnetCosts) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.blockinflation.v1.SubnetCosts.netuid":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.blockinflation.v1.SubnetCosts.burned":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.SubnetCosts.recycled":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.SubnetCosts.community_pool":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.SubnetCosts"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.SubnetCosts does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SubnetCosts) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.blockinflation.v1.SubnetCosts", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SubnetCosts) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetCosts) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SubnetCosts) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SubnetCosts) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SubnetCosts)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Netuid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Netuid))
+		}
+		l = len(x.Burned)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Recycled)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CommunityPool)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SubnetCosts)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CommunityPool) > 0 {
+			i -= len(x.CommunityPool)
+			copy(dAtA[i:], x.CommunityPool)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CommunityPool)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Recycled) > 0 {
+			i -= len(x.Recycled)
+			copy(dAtA[i:], x.Recycled)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recycled)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Burned) > 0 {
+			i -= len(x.Burned)
+			copy(dAtA[i:], x.Burned)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Burned)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Netuid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SubnetCosts)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubnetCosts: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubnetCosts: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				}
+				x.Netuid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Netuid |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Burned = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recycled", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recycled = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CommunityPool = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: hetu/blockinflation/v1/genesis.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GenesisState defines the blockinflation module's genesis state.
+type GenesisState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params               *Params                     `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	TotalIssuance        *v1beta1.Coin               `protobuf:"bytes,2,opt,name=total_issuance,json=totalIssuance,proto3" json:"total_issuance,omitempty"`
+	TotalBurned          *v1beta1.Coin               `protobuf:"bytes,3,opt,name=total_burned,json=totalBurned,proto3" json:"total_burned,omitempty"`
+	PendingSubnetRewards *v1beta1.Coin               `protobuf:"bytes,4,opt,name=pending_subnet_rewards,json=pendingSubnetRewards,proto3" json:"pending_subnet_rewards,omitempty"`
+	SuspendedSubnets     []*SubnetEmissionSuspension `protobuf:"bytes,5,rep,name=suspended_subnets,json=suspendedSubnets,proto3" json:"suspended_subnets,omitempty"`
+	SubnetCosts          []*SubnetCosts              `protobuf:"bytes,6,rep,name=subnet_costs,json=subnetCosts,proto3" json:"subnet_costs,omitempty"`
+}
+
+func (x *GenesisState) Reset() {
+	*x = GenesisState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_blockinflation_v1_genesis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisState) ProtoMessage() {}
+
+// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTotalIssuance() *v1beta1.Coin {
+	if x != nil {
+		return x.TotalIssuance
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTotalBurned() *v1beta1.Coin {
+	if x != nil {
+		return x.TotalBurned
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingSubnetRewards() *v1beta1.Coin {
+	if x != nil {
+		return x.PendingSubnetRewards
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSuspendedSubnets() []*SubnetEmissionSuspension {
+	if x != nil {
+		return x.SuspendedSubnets
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSubnetCosts() []*SubnetCosts {
+	if x != nil {
+		return x.SubnetCosts
+	}
+	return nil
+}
+
+// SubnetEmissionSuspension records why the emission of a subnet was suspended.
+type SubnetEmissionSuspension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// netuid is the subnet whose emission is suspended.
+	Netuid uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	// reason is the error that rolled back the coinbase of the subnet.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// suspended_height is the block height at which the emission was suspended.
+	SuspendedHeight int64 `protobuf:"varint,3,opt,name=suspended_height,json=suspendedHeight,proto3" json:"suspended_height,omitempty"`
+}
+
+func (x *SubnetEmissionSuspension) Reset() {
+	*x = SubnetEmissionSuspension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_blockinflation_v1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubnetEmissionSuspension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubnetEmissionSuspension) ProtoMessage() {}
+
+// Deprecated: Use SubnetEmissionSuspension.ProtoReflect.Descriptor instead.
+func (*SubnetEmissionSuspension) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubnetEmissionSuspension) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+func (x *SubnetEmissionSuspension) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SubnetEmissionSuspension) GetSuspendedHeight() int64 {
+	if x != nil {
+		return x.SuspendedHeight
+	}
+	return 0
+}
+
+// SubnetCosts are the registration and lock costs paid for a subnet, split by
+// where the cost policy sent them. All amounts are in the mint denom.
+type SubnetCosts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// netuid is the subnet the costs were paid for.
+	Netuid uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	// burned is the cost burned and removed from the total issuance.
+	Burned string `protobuf:"bytes,2,opt,name=burned,proto3" json:"burned,omitempty"`
+	// recycled is the cost recycled into the pending subnet rewards.
+	Recycled string `protobuf:"bytes,3,opt,name=recycled,proto3" json:"recycled,omitempty"`
+	// community_pool is the cost sent to the community pool.
+	CommunityPool string `protobuf:"bytes,4,opt,name=community_pool,json=communityPool,proto3" json:"community_pool,omitempty"`
+}
+
+func (x *SubnetCosts) Reset() {
+	*x = SubnetCosts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_blockinflation_v1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubnetCosts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubnetCosts) ProtoMessage() {}
+
+// Deprecated: Use SubnetCosts.ProtoReflect.Descriptor instead.
+func (*SubnetCosts) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubnetCosts) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+func (x *SubnetCosts) GetBurned() string {
+	if x != nil {
+		return x.Burned
+	}
+	return ""
+}
+
+func (x *SubnetCosts) GetRecycled() string {
+	if x != nil {
+		return x.Recycled
+	}
+	return ""
+}
+
+func (x *SubnetCosts) GetCommunityPool() string {
+	if x != nil {
+		return x.CommunityPool
+	}
+	return ""
+}
+
+var File_hetu_blockinflation_v1_genesis_proto protoreflect.FileDescriptor
+
+var file_hetu_blockinflation_v1_genesis_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x68, 0x65, 0x74, 0x75, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x14,
+	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x68, 0x65, 0x74, 0x75, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x03, 0x0a, 0x0c, 0x47, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x65, 0x74,
+	0x75, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x42, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x12, 0x55, 0x0a, 0x16, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x63, 0x0a, 0x11, 0x73,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75,
+	0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10,
+	0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73,
+	0x12, 0x4c, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x75,
+	0x0a, 0x18, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65,
+	0x74, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x65, 0x74, 0x75,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x43, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a,
+	0x06, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x62, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x64, 0x12,
+	0x44, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0xde, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x65,
+	0x74, 0x75, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x74, 0x75, 0x2f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x48, 0x42, 0x58, 0xaa, 0x02, 0x16, 0x48, 0x65, 0x74, 0x75, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16,
+	0x48, 0x65, 0x74, 0x75, 0x5c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x48, 0x65, 0x74, 0x75, 0x5c, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x48, 0x65,
+	0x74, 0x75, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_hetu_blockinflation_v1_genesis_proto_rawDescOnce sync.Once
 	file_hetu_blockinflation_v1_genesis_proto_rawDescData = file_hetu_blockinflation_v1_genesis_proto_rawDesc
 )
 
@@ -820,22 +2376,26 @@ func file_hetu_blockinflation_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_hetu_blockinflation_v1_genesis_proto_rawDescData
 }
 
-var file_hetu_blockinflation_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_hetu_blockinflation_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_hetu_blockinflation_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: hetu.blockinflation.v1.GenesisState
-	(*Params)(nil),       // 1: hetu.blockinflation.v1.Params
-	(*v1beta1.Coin)(nil), // 2: cosmos.base.v1beta1.Coin
+	(*GenesisState)(nil),             // 0: hetu.blockinflation.v1.GenesisState
+	(*SubnetEmissionSuspension)(nil), // 1: hetu.blockinflation.v1.SubnetEmissionSuspension
+	(*SubnetCosts)(nil),              // 2: hetu.blockinflation.v1.SubnetCosts
+	(*Params)(nil),                   // 3: hetu.blockinflation.v1.Params
+	(*v1beta1.Coin)(nil),             // 4: cosmos.base.v1beta1.Coin
 }
 var file_hetu_blockinflation_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: hetu.blockinflation.v1.GenesisState.params:type_name -> hetu.blockinflation.v1.Params
-	2, // 1: hetu.blockinflation.v1.GenesisState.total_issuance:type_name -> cosmos.base.v1beta1.Coin
-	2, // 2: hetu.blockinflation.v1.GenesisState.total_burned:type_name -> cosmos.base.v1beta1.Coin
-	2, // 3: hetu.blockinflation.v1.GenesisState.pending_subnet_rewards:type_name -> cosmos.base.v1beta1.Coin
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	3, // 0: hetu.blockinflation.v1.GenesisState.params:type_name -> hetu.blockinflation.v1.Params
+	4, // 1: hetu.blockinflation.v1.GenesisState.total_issuance:type_name -> cosmos.base.v1beta1.Coin
+	4, // 2: hetu.blockinflation.v1.GenesisState.total_burned:type_name -> cosmos.base.v1beta1.Coin
+	4, // 3: hetu.blockinflation.v1.GenesisState.pending_subnet_rewards:type_name -> cosmos.base.v1beta1.Coin
+	1, // 4: hetu.blockinflation.v1.GenesisState.suspended_subnets:type_name -> hetu.blockinflation.v1.SubnetEmissionSuspension
+	2, // 5: hetu.blockinflation.v1.GenesisState.subnet_costs:type_name -> hetu.blockinflation.v1.SubnetCosts
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_hetu_blockinflation_v1_genesis_proto_init() }
@@ -843,7 +2403,7 @@ func file_hetu_blockinflation_v1_genesis_proto_init() {
 	if File_hetu_blockinflation_v1_genesis_proto != nil {
 		return
 	}
-	file_hetu_blockinflation_v1_query_proto_init()
+	file_hetu_blockinflation_v1_params_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_hetu_blockinflation_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -857,6 +2417,30 @@ func file_hetu_blockinflation_v1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_hetu_blockinflation_v1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubnetEmissionSuspension); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_blockinflation_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubnetCosts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -864,7 +2448,7 @@ func file_hetu_blockinflation_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_hetu_blockinflation_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
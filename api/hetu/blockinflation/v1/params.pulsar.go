@@ -0,0 +1,1840 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package blockinflationv1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_Params                            protoreflect.MessageDescriptor
+	fd_Params_enable_block_inflation     protoreflect.FieldDescriptor
+	fd_Params_mint_denom                 protoreflect.FieldDescriptor
+	fd_Params_total_supply               protoreflect.FieldDescriptor
+	fd_Params_default_block_emission     protoreflect.FieldDescriptor
+	fd_Params_subnet_reward_base         protoreflect.FieldDescriptor
+	fd_Params_subnet_reward_k            protoreflect.FieldDescriptor
+	fd_Params_subnet_reward_max_ratio    protoreflect.FieldDescriptor
+	fd_Params_subnet_moving_alpha        protoreflect.FieldDescriptor
+	fd_Params_subnet_owner_cut           protoreflect.FieldDescriptor
+	fd_Params_price_twap_window          protoreflect.FieldDescriptor
+	fd_Params_max_price_change_per_block protoreflect.FieldDescriptor
+	fd_Params_subnet_emission_mode       protoreflect.FieldDescriptor
+	fd_Params_root_weight_ratio          protoreflect.FieldDescriptor
+	fd_Params_root_dividend_ratio        protoreflect.FieldDescriptor
+	fd_Params_root_tempo                 protoreflect.FieldDescriptor
+	fd_Params_active_minter              protoreflect.FieldDescriptor
+	fd_Params_lock_cost_policy           protoreflect.FieldDescriptor
+	fd_Params_registration_cost_policy   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_blockinflation_v1_params_proto_init()
+	md_Params = File_hetu_blockinflation_v1_params_proto.Messages().ByName("Params")
+	fd_Params_enable_block_inflation = md_Params.Fields().ByName("enable_block_inflation")
+	fd_Params_mint_denom = md_Params.Fields().ByName("mint_denom")
+	fd_Params_total_supply = md_Params.Fields().ByName("total_supply")
+	fd_Params_default_block_emission = md_Params.Fields().ByName("default_block_emission")
+	fd_Params_subnet_reward_base = md_Params.Fields().ByName("subnet_reward_base")
+	fd_Params_subnet_reward_k = md_Params.Fields().ByName("subnet_reward_k")
+	fd_Params_subnet_reward_max_ratio = md_Params.Fields().ByName("subnet_reward_max_ratio")
+	fd_Params_subnet_moving_alpha = md_Params.Fields().ByName("subnet_moving_alpha")
+	fd_Params_subnet_owner_cut = md_Params.Fields().ByName("subnet_owner_cut")
+	fd_Params_price_twap_window = md_Params.Fields().ByName("price_twap_window")
+	fd_Params_max_price_change_per_block = md_Params.Fields().ByName("max_price_change_per_block")
+	fd_Params_subnet_emission_mode = md_Params.Fields().ByName("subnet_emission_mode")
+	fd_Params_root_weight_ratio = md_Params.Fields().ByName("root_weight_ratio")
+	fd_Params_root_dividend_ratio = md_Params.Fields().ByName("root_dividend_ratio")
+	fd_Params_root_tempo = md_Params.Fields().ByName("root_tempo")
+	fd_Params_active_minter = md_Params.Fields().ByName("active_minter")
+	fd_Params_lock_cost_policy = md_Params.Fields().ByName("lock_cost_policy")
+	fd_Params_registration_cost_policy = md_Params.Fields().ByName("registration_cost_policy")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EnableBlockInflation != false {
+		value := protoreflect.ValueOfBool(x.EnableBlockInflation)
+		if !f(fd_Params_enable_block_inflation, value) {
+			return
+		}
+	}
+	if x.MintDenom != "" {
+		value := protoreflect.ValueOfString(x.MintDenom)
+		if !f(fd_Params_mint_denom, value) {
+			return
+		}
+	}
+	if x.TotalSupply != "" {
+		value := protoreflect.ValueOfString(x.TotalSupply)
+		if !f(fd_Params_total_supply, value) {
+			return
+		}
+	}
+	if x.DefaultBlockEmission != "" {
+		value := protoreflect.ValueOfString(x.DefaultBlockEmission)
+		if !f(fd_Params_default_block_emission, value) {
+			return
+		}
+	}
+	if x.SubnetRewardBase != "" {
+		value := protoreflect.ValueOfString(x.SubnetRewardBase)
+		if !f(fd_Params_subnet_reward_base, value) {
+			return
+		}
+	}
+	if x.SubnetRewardK != "" {
+		value := protoreflect.ValueOfString(x.SubnetRewardK)
+		if !f(fd_Params_subnet_reward_k, value) {
+			return
+		}
+	}
+	if x.SubnetRewardMaxRatio != "" {
+		value := protoreflect.ValueOfString(x.SubnetRewardMaxRatio)
+		if !f(fd_Params_subnet_reward_max_ratio, value) {
+			return
+		}
+	}
+	if x.SubnetMovingAlpha != "" {
+		value := protoreflect.ValueOfString(x.SubnetMovingAlpha)
+		if !f(fd_Params_subnet_moving_alpha, value) {
+			return
+		}
+	}
+	if x.SubnetOwnerCut != "" {
+		value := protoreflect.ValueOfString(x.SubnetOwnerCut)
+		if !f(fd_Params_subnet_owner_cut, value) {
+			return
+		}
+	}
+	if x.PriceTwapWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PriceTwapWindow)
+		if !f(fd_Params_price_twap_window, value) {
+			return
+		}
+	}
+	if x.MaxPriceChangePerBlock != "" {
+		value := protoreflect.ValueOfString(x.MaxPriceChangePerBlock)
+		if !f(fd_Params_max_price_change_per_block, value) {
+			return
+		}
+	}
+	if x.SubnetEmissionMode != "" {
+		value := protoreflect.ValueOfString(x.SubnetEmissionMode)
+		if !f(fd_Params_subnet_emission_mode, value) {
+			return
+		}
+	}
+	if x.RootWeightRatio != "" {
+		value := protoreflect.ValueOfString(x.RootWeightRatio)
+		if !f(fd_Params_root_weight_ratio, value) {
+			return
+		}
+	}
+	if x.RootDividendRatio != "" {
+		value := protoreflect.ValueOfString(x.RootDividendRatio)
+		if !f(fd_Params_root_dividend_ratio, value) {
+			return
+		}
+	}
+	if x.RootTempo != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RootTempo)
+		if !f(fd_Params_root_tempo, value) {
+			return
+		}
+	}
+	if x.ActiveMinter != "" {
+		value := protoreflect.ValueOfString(x.ActiveMinter)
+		if !f(fd_Params_active_minter, value) {
+			return
+		}
+	}
+	if x.LockCostPolicy != "" {
+		value := protoreflect.ValueOfString(x.LockCostPolicy)
+		if !f(fd_Params_lock_cost_policy, value) {
+			return
+		}
+	}
+	if x.RegistrationCostPolicy != "" {
+		value := protoreflect.ValueOfString(x.RegistrationCostPolicy)
+		if !f(fd_Params_registration_cost_policy, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.blockinflation.v1.Params.enable_block_inflation":
+		return x.EnableBlockInflation != false
+	case "hetu.blockinflation.v1.Params.mint_denom":
+		return x.MintDenom != ""
+	case "hetu.blockinflation.v1.Params.total_supply":
+		return x.TotalSupply != ""
+	case "hetu.blockinflation.v1.Params.default_block_emission":
+		return x.DefaultBlockEmission != ""
+	case "hetu.blockinflation.v1.Params.subnet_reward_base":
+		return x.SubnetRewardBase != ""
+	case "hetu.blockinflation.v1.Params.subnet_reward_k":
+		return x.SubnetRewardK != ""
+	case "hetu.blockinflation.v1.Params.subnet_reward_max_ratio":
+		return x.SubnetRewardMaxRatio != ""
+	case "hetu.blockinflation.v1.Params.subnet_moving_alpha":
+		return x.SubnetMovingAlpha != ""
+	case "hetu.blockinflation.v1.Params.subnet_owner_cut":
+		return x.SubnetOwnerCut != ""
+	case "hetu.blockinflation.v1.Params.price_twap_window":
+		return x.PriceTwapWindow != uint64(0)
+	case "hetu.blockinflation.v1.Params.max_price_change_per_block":
+		return x.MaxPriceChangePerBlock != ""
+	case "hetu.blockinflation.v1.Params.subnet_emission_mode":
+		return x.SubnetEmissionMode != ""
+	case "hetu.blockinflation.v1.Params.root_weight_ratio":
+		return x.RootWeightRatio != ""
+	case "hetu.blockinflation.v1.Params.root_dividend_ratio":
+		return x.RootDividendRatio != ""
+	case "hetu.blockinflation.v1.Params.root_tempo":
+		return x.RootTempo != uint64(0)
+	case "hetu.blockinflation.v1.Params.active_minter":
+		return x.ActiveMinter != ""
+	case "hetu.blockinflation.v1.Params.lock_cost_policy":
+		return x.LockCostPolicy != ""
+	case "hetu.blockinflation.v1.Params.registration_cost_policy":
+		return x.RegistrationCostPolicy != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.Params"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.blockinflation.v1.Params.enable_block_inflation":
+		x.EnableBlockInflation = false
+	case "hetu.blockinflation.v1.Params.mint_denom":
+		x.MintDenom = ""
+	case "hetu.blockinflation.v1.Params.total_supply":
+		x.TotalSupply = ""
+	case "hetu.blockinflation.v1.Params.default_block_emission":
+		x.DefaultBlockEmission = ""
+	case "hetu.blockinflation.v1.Params.subnet_reward_base":
+		x.SubnetRewardBase = ""
+	case "hetu.blockinflation.v1.Params.subnet_reward_k":
+		x.SubnetRewardK = ""
+	case "hetu.blockinflation.v1.Params.subnet_reward_max_ratio":
+		x.SubnetRewardMaxRatio = ""
+	case "hetu.blockinflation.v1.Params.subnet_moving_alpha":
+		x.SubnetMovingAlpha = ""
+	case "hetu.blockinflation.v1.Params.subnet_owner_cut":
+		x.SubnetOwnerCut = ""
+	case "hetu.blockinflation.v1.Params.price_twap_window":
+		x.PriceTwapWindow = uint64(0)
+	case "hetu.blockinflation.v1.Params.max_price_change_per_block":
+		x.MaxPriceChangePerBlock = ""
+	case "hetu.blockinflation.v1.Params.subnet_emission_mode":
+		x.SubnetEmissionMode = ""
+	case "hetu.blockinflation.v1.Params.root_weight_ratio":
+		x.RootWeightRatio = ""
+	case "hetu.blockinflation.v1.Params.root_dividend_ratio":
+		x.RootDividendRatio = ""
+	case "hetu.blockinflation.v1.Params.root_tempo":
+		x.RootTempo = uint64(0)
+	case "hetu.blockinflation.v1.Params.active_minter":
+		x.ActiveMinter = ""
+	case "hetu.blockinflation.v1.Params.lock_cost_policy":
+		x.LockCostPolicy = ""
+	case "hetu.blockinflation.v1.Params.registration_cost_policy":
+		x.RegistrationCostPolicy = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.Params"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.blockinflation.v1.Params.enable_block_inflation":
+		value := x.EnableBlockInflation
+		return protoreflect.ValueOfBool(value)
+	case "hetu.blockinflation.v1.Params.mint_denom":
+		value := x.MintDenom
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.Params.total_supply":
+		value := x.TotalSupply
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.Params.default_block_emission":
+		value := x.DefaultBlockEmission
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.Params.subnet_reward_base":
+		value := x.SubnetRewardBase
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.Params.subnet_reward_k":
+		value := x.SubnetRewardK
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.Params.subnet_reward_max_ratio":
+		value := x.SubnetRewardMaxRatio
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.Params.subnet_moving_alpha":
+		value := x.SubnetMovingAlpha
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.Params.subnet_owner_cut":
+		value := x.SubnetOwnerCut
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.Params.price_twap_window":
+		value := x.PriceTwapWindow
+		return protoreflect.ValueOfUint64(value)
+	case "hetu.blockinflation.v1.Params.max_price_change_per_block":
+		value := x.MaxPriceChangePerBlock
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.Params.subnet_emission_mode":
+		value := x.SubnetEmissionMode
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.Params.root_weight_ratio":
+		value := x.RootWeightRatio
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.Params.root_dividend_ratio":
+		value := x.RootDividendRatio
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.Params.root_tempo":
+		value := x.RootTempo
+		return protoreflect.ValueOfUint64(value)
+	case "hetu.blockinflation.v1.Params.active_minter":
+		value := x.ActiveMinter
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.Params.lock_cost_policy":
+		value := x.LockCostPolicy
+		return protoreflect.ValueOfString(value)
+	case "hetu.blockinflation.v1.Params.registration_cost_policy":
+		value := x.RegistrationCostPolicy
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.Params"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.blockinflation.v1.Params.enable_block_inflation":
+		x.EnableBlockInflation = value.Bool()
+	case "hetu.blockinflation.v1.Params.mint_denom":
+		x.MintDenom = value.Interface().(string)
+	case "hetu.blockinflation.v1.Params.total_supply":
+		x.TotalSupply = value.Interface().(string)
+	case "hetu.blockinflation.v1.Params.default_block_emission":
+		x.DefaultBlockEmission = value.Interface().(string)
+	case "hetu.blockinflation.v1.Params.subnet_reward_base":
+		x.SubnetRewardBase = value.Interface().(string)
+	case "hetu.blockinflation.v1.Params.subnet_reward_k":
+		x.SubnetRewardK = value.Interface().(string)
+	case "hetu.blockinflation.v1.Params.subnet_reward_max_ratio":
+		x.SubnetRewardMaxRatio = value.Interface().(string)
+	case "hetu.blockinflation.v1.Params.subnet_moving_alpha":
+		x.SubnetMovingAlpha = value.Interface().(string)
+	case "hetu.blockinflation.v1.Params.subnet_owner_cut":
+		x.SubnetOwnerCut = value.Interface().(string)
+	case "hetu.blockinflation.v1.Params.price_twap_window":
+		x.PriceTwapWindow = value.Uint()
+	case "hetu.blockinflation.v1.Params.max_price_change_per_block":
+		x.MaxPriceChangePerBlock = value.Interface().(string)
+	case "hetu.blockinflation.v1.Params.subnet_emission_mode":
+		x.SubnetEmissionMode = value.Interface().(string)
+	case "hetu.blockinflation.v1.Params.root_weight_ratio":
+		x.RootWeightRatio = value.Interface().(string)
+	case "hetu.blockinflation.v1.Params.root_dividend_ratio":
+		x.RootDividendRatio = value.Interface().(string)
+	case "hetu.blockinflation.v1.Params.root_tempo":
+		x.RootTempo = value.Uint()
+	case "hetu.blockinflation.v1.Params.active_minter":
+		x.ActiveMinter = value.Interface().(string)
+	case "hetu.blockinflation.v1.Params.lock_cost_policy":
+		x.LockCostPolicy = value.Interface().(string)
+	case "hetu.blockinflation.v1.Params.registration_cost_policy":
+		x.RegistrationCostPolicy = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.Params"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.blockinflation.v1.Params.enable_block_inflation":
+		panic(fmt.Errorf("field enable_block_inflation of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.mint_denom":
+		panic(fmt.Errorf("field mint_denom of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.total_supply":
+		panic(fmt.Errorf("field total_supply of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.default_block_emission":
+		panic(fmt.Errorf("field default_block_emission of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.subnet_reward_base":
+		panic(fmt.Errorf("field subnet_reward_base of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.subnet_reward_k":
+		panic(fmt.Errorf("field subnet_reward_k of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.subnet_reward_max_ratio":
+		panic(fmt.Errorf("field subnet_reward_max_ratio of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.subnet_moving_alpha":
+		panic(fmt.Errorf("field subnet_moving_alpha of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.subnet_owner_cut":
+		panic(fmt.Errorf("field subnet_owner_cut of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.price_twap_window":
+		panic(fmt.Errorf("field price_twap_window of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.max_price_change_per_block":
+		panic(fmt.Errorf("field max_price_change_per_block of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.subnet_emission_mode":
+		panic(fmt.Errorf("field subnet_emission_mode of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.root_weight_ratio":
+		panic(fmt.Errorf("field root_weight_ratio of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.root_dividend_ratio":
+		panic(fmt.Errorf("field root_dividend_ratio of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.root_tempo":
+		panic(fmt.Errorf("field root_tempo of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.active_minter":
+		panic(fmt.Errorf("field active_minter of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.lock_cost_policy":
+		panic(fmt.Errorf("field lock_cost_policy of message hetu.blockinflation.v1.Params is not mutable"))
+	case "hetu.blockinflation.v1.Params.registration_cost_policy":
+		panic(fmt.Errorf("field registration_cost_policy of message hetu.blockinflation.v1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.Params"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.blockinflation.v1.Params.enable_block_inflation":
+		return protoreflect.ValueOfBool(false)
+	case "hetu.blockinflation.v1.Params.mint_denom":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.Params.total_supply":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.Params.default_block_emission":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.Params.subnet_reward_base":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.Params.subnet_reward_k":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.Params.subnet_reward_max_ratio":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.Params.subnet_moving_alpha":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.Params.subnet_owner_cut":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.Params.price_twap_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "hetu.blockinflation.v1.Params.max_price_change_per_block":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.Params.subnet_emission_mode":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.Params.root_weight_ratio":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.Params.root_dividend_ratio":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.Params.root_tempo":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "hetu.blockinflation.v1.Params.active_minter":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.Params.lock_cost_policy":
+		return protoreflect.ValueOfString("")
+	case "hetu.blockinflation.v1.Params.registration_cost_policy":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.blockinflation.v1.Params"))
+		}
+		panic(fmt.Errorf("message hetu.blockinflation.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.blockinflation.v1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EnableBlockInflation {
+			n += 2
+		}
+		l = len(x.MintDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TotalSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DefaultBlockEmission)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SubnetRewardBase)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SubnetRewardK)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SubnetRewardMaxRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SubnetMovingAlpha)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SubnetOwnerCut)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PriceTwapWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.PriceTwapWindow))
+		}
+		l = len(x.MaxPriceChangePerBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SubnetEmissionMode)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RootWeightRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RootDividendRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RootTempo != 0 {
+			n += 1 + runtime.Sov(uint64(x.RootTempo))
+		}
+		l = len(x.ActiveMinter)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LockCostPolicy)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RegistrationCostPolicy)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RegistrationCostPolicy) > 0 {
+			i -= len(x.RegistrationCostPolicy)
+			copy(dAtA[i:], x.RegistrationCostPolicy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RegistrationCostPolicy)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.LockCostPolicy) > 0 {
+			i -= len(x.LockCostPolicy)
+			copy(dAtA[i:], x.LockCostPolicy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LockCostPolicy)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.ActiveMinter) > 0 {
+			i -= len(x.ActiveMinter)
+			copy(dAtA[i:], x.ActiveMinter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ActiveMinter)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.RootTempo != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RootTempo))
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.RootDividendRatio) > 0 {
+			i -= len(x.RootDividendRatio)
+			copy(dAtA[i:], x.RootDividendRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RootDividendRatio)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.RootWeightRatio) > 0 {
+			i -= len(x.RootWeightRatio)
+			copy(dAtA[i:], x.RootWeightRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RootWeightRatio)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.SubnetEmissionMode) > 0 {
+			i -= len(x.SubnetEmissionMode)
+			copy(dAtA[i:], x.SubnetEmissionMode)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SubnetEmissionMode)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.MaxPriceChangePerBlock) > 0 {
+			i -= len(x.MaxPriceChangePerBlock)
+			copy(dAtA[i:], x.MaxPriceChangePerBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPriceChangePerBlock)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.PriceTwapWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PriceTwapWindow))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.SubnetOwnerCut) > 0 {
+			i -= len(x.SubnetOwnerCut)
+			copy(dAtA[i:], x.SubnetOwnerCut)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SubnetOwnerCut)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.SubnetMovingAlpha) > 0 {
+			i -= len(x.SubnetMovingAlpha)
+			copy(dAtA[i:], x.SubnetMovingAlpha)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SubnetMovingAlpha)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.SubnetRewardMaxRatio) > 0 {
+			i -= len(x.SubnetRewardMaxRatio)
+			copy(dAtA[i:], x.SubnetRewardMaxRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SubnetRewardMaxRatio)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.SubnetRewardK) > 0 {
+			i -= len(x.SubnetRewardK)
+			copy(dAtA[i:], x.SubnetRewardK)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SubnetRewardK)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.SubnetRewardBase) > 0 {
+			i -= len(x.SubnetRewardBase)
+			copy(dAtA[i:], x.SubnetRewardBase)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SubnetRewardBase)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.DefaultBlockEmission) > 0 {
+			i -= len(x.DefaultBlockEmission)
+			copy(dAtA[i:], x.DefaultBlockEmission)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DefaultBlockEmission)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.TotalSupply) > 0 {
+			i -= len(x.TotalSupply)
+			copy(dAtA[i:], x.TotalSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalSupply)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MintDenom) > 0 {
+			i -= len(x.MintDenom)
+			copy(dAtA[i:], x.MintDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.EnableBlockInflation {
+			i--
+			if x.EnableBlockInflation {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableBlockInflation", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EnableBlockInflation = bool(v != 0)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultBlockEmission", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DefaultBlockEmission = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubnetRewardBase", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubnetRewardBase = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubnetRewardK", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubnetRewardK = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubnetRewardMaxRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubnetRewardMaxRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubnetMovingAlpha", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubnetMovingAlpha = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubnetOwnerCut", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubnetOwnerCut = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceTwapWindow", wireType)
+				}
+				x.PriceTwapWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PriceTwapWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChangePerBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPriceChangePerBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubnetEmissionMode", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubnetEmissionMode = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RootWeightRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RootWeightRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RootDividendRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RootDividendRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RootTempo", wireType)
+				}
+				x.RootTempo = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RootTempo |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveMinter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ActiveMinter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockCostPolicy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockCostPolicy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistrationCostPolicy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RegistrationCostPolicy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: hetu/blockinflation/v1/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the parameters for the blockinflation module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnableBlockInflation   bool   `protobuf:"varint,1,opt,name=enable_block_inflation,json=enableBlockInflation,proto3" json:"enable_block_inflation,omitempty"`
+	MintDenom              string `protobuf:"bytes,2,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
+	TotalSupply            string `protobuf:"bytes,3,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	DefaultBlockEmission   string `protobuf:"bytes,4,opt,name=default_block_emission,json=defaultBlockEmission,proto3" json:"default_block_emission,omitempty"`
+	SubnetRewardBase       string `protobuf:"bytes,5,opt,name=subnet_reward_base,json=subnetRewardBase,proto3" json:"subnet_reward_base,omitempty"`
+	SubnetRewardK          string `protobuf:"bytes,6,opt,name=subnet_reward_k,json=subnetRewardK,proto3" json:"subnet_reward_k,omitempty"`
+	SubnetRewardMaxRatio   string `protobuf:"bytes,7,opt,name=subnet_reward_max_ratio,json=subnetRewardMaxRatio,proto3" json:"subnet_reward_max_ratio,omitempty"`
+	SubnetMovingAlpha      string `protobuf:"bytes,8,opt,name=subnet_moving_alpha,json=subnetMovingAlpha,proto3" json:"subnet_moving_alpha,omitempty"`
+	SubnetOwnerCut         string `protobuf:"bytes,9,opt,name=subnet_owner_cut,json=subnetOwnerCut,proto3" json:"subnet_owner_cut,omitempty"`
+	PriceTwapWindow        uint64 `protobuf:"varint,10,opt,name=price_twap_window,json=priceTwapWindow,proto3" json:"price_twap_window,omitempty"`
+	MaxPriceChangePerBlock string `protobuf:"bytes,11,opt,name=max_price_change_per_block,json=maxPriceChangePerBlock,proto3" json:"max_price_change_per_block,omitempty"`
+	SubnetEmissionMode     string `protobuf:"bytes,12,opt,name=subnet_emission_mode,json=subnetEmissionMode,proto3" json:"subnet_emission_mode,omitempty"`
+	RootWeightRatio        string `protobuf:"bytes,13,opt,name=root_weight_ratio,json=rootWeightRatio,proto3" json:"root_weight_ratio,omitempty"`
+	RootDividendRatio      string `protobuf:"bytes,14,opt,name=root_dividend_ratio,json=rootDividendRatio,proto3" json:"root_dividend_ratio,omitempty"`
+	RootTempo              uint64 `protobuf:"varint,15,opt,name=root_tempo,json=rootTempo,proto3" json:"root_tempo,omitempty"`
+	// active_minter is the module allowed to mint new supply of the mint denom,
+	// either blockinflation or inflation
+	ActiveMinter string `protobuf:"bytes,16,opt,name=active_minter,json=activeMinter,proto3" json:"active_minter,omitempty"`
+	// lock_cost_policy is where the part of the network lock cost burned at subnet
+	// registration ends up: burn, recycle or community_pool
+	LockCostPolicy string `protobuf:"bytes,17,opt,name=lock_cost_policy,json=lockCostPolicy,proto3" json:"lock_cost_policy,omitempty"`
+	// registration_cost_policy is where the neuron registration costs paid to the
+	// GlobalStaking treasury end up: burn, recycle or community_pool
+	RegistrationCostPolicy string `protobuf:"bytes,18,opt,name=registration_cost_policy,json=registrationCostPolicy,proto3" json:"registration_cost_policy,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_blockinflation_v1_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetEnableBlockInflation() bool {
+	if x != nil {
+		return x.EnableBlockInflation
+	}
+	return false
+}
+
+func (x *Params) GetMintDenom() string {
+	if x != nil {
+		return x.MintDenom
+	}
+	return ""
+}
+
+func (x *Params) GetTotalSupply() string {
+	if x != nil {
+		return x.TotalSupply
+	}
+	return ""
+}
+
+func (x *Params) GetDefaultBlockEmission() string {
+	if x != nil {
+		return x.DefaultBlockEmission
+	}
+	return ""
+}
+
+func (x *Params) GetSubnetRewardBase() string {
+	if x != nil {
+		return x.SubnetRewardBase
+	}
+	return ""
+}
+
+func (x *Params) GetSubnetRewardK() string {
+	if x != nil {
+		return x.SubnetRewardK
+	}
+	return ""
+}
+
+func (x *Params) GetSubnetRewardMaxRatio() string {
+	if x != nil {
+		return x.SubnetRewardMaxRatio
+	}
+	return ""
+}
+
+func (x *Params) GetSubnetMovingAlpha() string {
+	if x != nil {
+		return x.SubnetMovingAlpha
+	}
+	return ""
+}
+
+func (x *Params) GetSubnetOwnerCut() string {
+	if x != nil {
+		return x.SubnetOwnerCut
+	}
+	return ""
+}
+
+func (x *Params) GetPriceTwapWindow() uint64 {
+	if x != nil {
+		return x.PriceTwapWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMaxPriceChangePerBlock() string {
+	if x != nil {
+		return x.MaxPriceChangePerBlock
+	}
+	return ""
+}
+
+func (x *Params) GetSubnetEmissionMode() string {
+	if x != nil {
+		return x.SubnetEmissionMode
+	}
+	return ""
+}
+
+func (x *Params) GetRootWeightRatio() string {
+	if x != nil {
+		return x.RootWeightRatio
+	}
+	return ""
+}
+
+func (x *Params) GetRootDividendRatio() string {
+	if x != nil {
+		return x.RootDividendRatio
+	}
+	return ""
+}
+
+func (x *Params) GetRootTempo() uint64 {
+	if x != nil {
+		return x.RootTempo
+	}
+	return 0
+}
+
+func (x *Params) GetActiveMinter() string {
+	if x != nil {
+		return x.ActiveMinter
+	}
+	return ""
+}
+
+func (x *Params) GetLockCostPolicy() string {
+	if x != nil {
+		return x.LockCostPolicy
+	}
+	return ""
+}
+
+func (x *Params) GetRegistrationCostPolicy() string {
+	if x != nil {
+		return x.RegistrationCostPolicy
+	}
+	return ""
+}
+
+var File_hetu_blockinflation_v1_params_proto protoreflect.FileDescriptor
+
+var file_hetu_blockinflation_v1_params_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x68, 0x65, 0x74, 0x75, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67,
+	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x09, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x34,
+	0x0a, 0x16, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x74, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x53, 0x0a, 0x16, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0x52, 0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x61, 0x73, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x10, 0x73, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x73, 0x65, 0x12, 0x4b, 0x0a,
+	0x0f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6b,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x73, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4b, 0x12, 0x5a, 0x0a, 0x17, 0x73, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0x52, 0x14, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x61,
+	0x78, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x53, 0x0a, 0x13, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x11, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x4d, 0x0a, 0x10, 0x73,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x75, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x43, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x74, 0x77, 0x61, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x77, 0x61, 0x70,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x5f, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52,
+	0x16, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
+	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x75, 0x62, 0x6e, 0x65,
+	0x74, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x45, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x72, 0x6f, 0x6f,
+	0x74, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0f, 0x72, 0x6f, 0x6f, 0x74, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x53, 0x0a, 0x13, 0x72, 0x6f,
+	0x6f, 0x74, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x11, 0x72, 0x6f,
+	0x6f, 0x74, 0x44, 0x69, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x73, 0x74,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x38, 0x0a,
+	0x18, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x16, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x73,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x3a, 0x04, 0x98, 0xa0, 0x1f, 0x00, 0x42, 0xdd, 0x01,
+	0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65,
+	0x74, 0x75, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x42, 0x58, 0xaa, 0x02, 0x16, 0x48, 0x65,
+	0x74, 0x75, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x48, 0x65, 0x74, 0x75, 0x5c, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22,
+	0x48, 0x65, 0x74, 0x75, 0x5c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x18, 0x48, 0x65, 0x74, 0x75, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_hetu_blockinflation_v1_params_proto_rawDescOnce sync.Once
+	file_hetu_blockinflation_v1_params_proto_rawDescData = file_hetu_blockinflation_v1_params_proto_rawDesc
+)
+
+func file_hetu_blockinflation_v1_params_proto_rawDescGZIP() []byte {
+	file_hetu_blockinflation_v1_params_proto_rawDescOnce.Do(func() {
+		file_hetu_blockinflation_v1_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_hetu_blockinflation_v1_params_proto_rawDescData)
+	})
+	return file_hetu_blockinflation_v1_params_proto_rawDescData
+}
+
+var file_hetu_blockinflation_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_hetu_blockinflation_v1_params_proto_goTypes = []interface{}{
+	(*Params)(nil), // 0: hetu.blockinflation.v1.Params
+}
+var file_hetu_blockinflation_v1_params_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hetu_blockinflation_v1_params_proto_init() }
+func file_hetu_blockinflation_v1_params_proto_init() {
+	if File_hetu_blockinflation_v1_params_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_hetu_blockinflation_v1_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_hetu_blockinflation_v1_params_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hetu_blockinflation_v1_params_proto_goTypes,
+		DependencyIndexes: file_hetu_blockinflation_v1_params_proto_depIdxs,
+		MessageInfos:      file_hetu_blockinflation_v1_params_proto_msgTypes,
+	}.Build()
+	File_hetu_blockinflation_v1_params_proto = out.File
+	file_hetu_blockinflation_v1_params_proto_rawDesc = nil
+	file_hetu_blockinflation_v1_params_proto_goTypes = nil
+	file_hetu_blockinflation_v1_params_proto_depIdxs = nil
+}
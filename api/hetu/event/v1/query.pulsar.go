@@ -4854,49 +4854,25 @@ func (x *fastReflection_QueryValidatorWeightsResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_SubnetInfo                  protoreflect.MessageDescriptor
-	fd_SubnetInfo_netuid           protoreflect.FieldDescriptor
-	fd_SubnetInfo_owner            protoreflect.FieldDescriptor
-	fd_SubnetInfo_alpha_token      protoreflect.FieldDescriptor
-	fd_SubnetInfo_amm_pool         protoreflect.FieldDescriptor
-	fd_SubnetInfo_locked_amount    protoreflect.FieldDescriptor
-	fd_SubnetInfo_pool_initial_tao protoreflect.FieldDescriptor
-	fd_SubnetInfo_burned_amount    protoreflect.FieldDescriptor
-	fd_SubnetInfo_created_at       protoreflect.FieldDescriptor
-	fd_SubnetInfo_is_active        protoreflect.FieldDescriptor
-	fd_SubnetInfo_name             protoreflect.FieldDescriptor
-	fd_SubnetInfo_description      protoreflect.FieldDescriptor
-	fd_SubnetInfo_activated_at     protoreflect.FieldDescriptor
-	fd_SubnetInfo_activated_block  protoreflect.FieldDescriptor
+	md_QueryStakePositionRequest         protoreflect.MessageDescriptor
+	fd_QueryStakePositionRequest_account protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_hetu_event_v1_query_proto_init()
-	md_SubnetInfo = File_hetu_event_v1_query_proto.Messages().ByName("SubnetInfo")
-	fd_SubnetInfo_netuid = md_SubnetInfo.Fields().ByName("netuid")
-	fd_SubnetInfo_owner = md_SubnetInfo.Fields().ByName("owner")
-	fd_SubnetInfo_alpha_token = md_SubnetInfo.Fields().ByName("alpha_token")
-	fd_SubnetInfo_amm_pool = md_SubnetInfo.Fields().ByName("amm_pool")
-	fd_SubnetInfo_locked_amount = md_SubnetInfo.Fields().ByName("locked_amount")
-	fd_SubnetInfo_pool_initial_tao = md_SubnetInfo.Fields().ByName("pool_initial_tao")
-	fd_SubnetInfo_burned_amount = md_SubnetInfo.Fields().ByName("burned_amount")
-	fd_SubnetInfo_created_at = md_SubnetInfo.Fields().ByName("created_at")
-	fd_SubnetInfo_is_active = md_SubnetInfo.Fields().ByName("is_active")
-	fd_SubnetInfo_name = md_SubnetInfo.Fields().ByName("name")
-	fd_SubnetInfo_description = md_SubnetInfo.Fields().ByName("description")
-	fd_SubnetInfo_activated_at = md_SubnetInfo.Fields().ByName("activated_at")
-	fd_SubnetInfo_activated_block = md_SubnetInfo.Fields().ByName("activated_block")
+	md_QueryStakePositionRequest = File_hetu_event_v1_query_proto.Messages().ByName("QueryStakePositionRequest")
+	fd_QueryStakePositionRequest_account = md_QueryStakePositionRequest.Fields().ByName("account")
 }
 
-var _ protoreflect.Message = (*fastReflection_SubnetInfo)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryStakePositionRequest)(nil)
 
-type fastReflection_SubnetInfo SubnetInfo
+type fastReflection_QueryStakePositionRequest QueryStakePositionRequest
 
-func (x *SubnetInfo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SubnetInfo)(x)
+func (x *QueryStakePositionRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryStakePositionRequest)(x)
 }
 
-func (x *SubnetInfo) slowProtoReflect() protoreflect.Message {
+func (x *QueryStakePositionRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_hetu_event_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4908,43 +4884,43 @@ func (x *SubnetInfo) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SubnetInfo_messageType fastReflection_SubnetInfo_messageType
-var _ protoreflect.MessageType = fastReflection_SubnetInfo_messageType{}
+var _fastReflection_QueryStakePositionRequest_messageType fastReflection_QueryStakePositionRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryStakePositionRequest_messageType{}
 
-type fastReflection_SubnetInfo_messageType struct{}
+type fastReflection_QueryStakePositionRequest_messageType struct{}
 
-func (x fastReflection_SubnetInfo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SubnetInfo)(nil)
+func (x fastReflection_QueryStakePositionRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryStakePositionRequest)(nil)
 }
-func (x fastReflection_SubnetInfo_messageType) New() protoreflect.Message {
-	return new(fastReflection_SubnetInfo)
+func (x fastReflection_QueryStakePositionRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryStakePositionRequest)
 }
-func (x fastReflection_SubnetInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SubnetInfo
+func (x fastReflection_QueryStakePositionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStakePositionRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SubnetInfo) Descriptor() protoreflect.MessageDescriptor {
-	return md_SubnetInfo
+func (x *fastReflection_QueryStakePositionRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStakePositionRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SubnetInfo) Type() protoreflect.MessageType {
-	return _fastReflection_SubnetInfo_messageType
+func (x *fastReflection_QueryStakePositionRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryStakePositionRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SubnetInfo) New() protoreflect.Message {
-	return new(fastReflection_SubnetInfo)
+func (x *fastReflection_QueryStakePositionRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryStakePositionRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SubnetInfo) Interface() protoreflect.ProtoMessage {
-	return (*SubnetInfo)(x)
+func (x *fastReflection_QueryStakePositionRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryStakePositionRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4952,82 +4928,10 @@ func (x *fastReflection_SubnetInfo) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SubnetInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Netuid != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Netuid)
-		if !f(fd_SubnetInfo_netuid, value) {
-			return
-		}
-	}
-	if x.Owner != "" {
-		value := protoreflect.ValueOfString(x.Owner)
-		if !f(fd_SubnetInfo_owner, value) {
-			return
-		}
-	}
-	if x.AlphaToken != "" {
-		value := protoreflect.ValueOfString(x.AlphaToken)
-		if !f(fd_SubnetInfo_alpha_token, value) {
-			return
-		}
-	}
-	if x.AmmPool != "" {
-		value := protoreflect.ValueOfString(x.AmmPool)
-		if !f(fd_SubnetInfo_amm_pool, value) {
-			return
-		}
-	}
-	if x.LockedAmount != "" {
-		value := protoreflect.ValueOfString(x.LockedAmount)
-		if !f(fd_SubnetInfo_locked_amount, value) {
-			return
-		}
-	}
-	if x.PoolInitialTao != "" {
-		value := protoreflect.ValueOfString(x.PoolInitialTao)
-		if !f(fd_SubnetInfo_pool_initial_tao, value) {
-			return
-		}
-	}
-	if x.BurnedAmount != "" {
-		value := protoreflect.ValueOfString(x.BurnedAmount)
-		if !f(fd_SubnetInfo_burned_amount, value) {
-			return
-		}
-	}
-	if x.CreatedAt != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.CreatedAt)
-		if !f(fd_SubnetInfo_created_at, value) {
-			return
-		}
-	}
-	if x.IsActive != false {
-		value := protoreflect.ValueOfBool(x.IsActive)
-		if !f(fd_SubnetInfo_is_active, value) {
-			return
-		}
-	}
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_SubnetInfo_name, value) {
-			return
-		}
-	}
-	if x.Description != "" {
-		value := protoreflect.ValueOfString(x.Description)
-		if !f(fd_SubnetInfo_description, value) {
-			return
-		}
-	}
-	if x.ActivatedAt != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ActivatedAt)
-		if !f(fd_SubnetInfo_activated_at, value) {
-			return
-		}
-	}
-	if x.ActivatedBlock != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ActivatedBlock)
-		if !f(fd_SubnetInfo_activated_block, value) {
+func (x *fastReflection_QueryStakePositionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Account != "" {
+		value := protoreflect.ValueOfString(x.Account)
+		if !f(fd_QueryStakePositionRequest_account, value) {
 			return
 		}
 	}
@@ -5044,39 +4948,15 @@ func (x *fastReflection_SubnetInfo) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SubnetInfo) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryStakePositionRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "hetu.event.v1.SubnetInfo.netuid":
-		return x.Netuid != uint32(0)
-	case "hetu.event.v1.SubnetInfo.owner":
-		return x.Owner != ""
-	case "hetu.event.v1.SubnetInfo.alpha_token":
-		return x.AlphaToken != ""
-	case "hetu.event.v1.SubnetInfo.amm_pool":
-		return x.AmmPool != ""
-	case "hetu.event.v1.SubnetInfo.locked_amount":
-		return x.LockedAmount != ""
-	case "hetu.event.v1.SubnetInfo.pool_initial_tao":
-		return x.PoolInitialTao != ""
-	case "hetu.event.v1.SubnetInfo.burned_amount":
-		return x.BurnedAmount != ""
-	case "hetu.event.v1.SubnetInfo.created_at":
-		return x.CreatedAt != uint64(0)
-	case "hetu.event.v1.SubnetInfo.is_active":
-		return x.IsActive != false
-	case "hetu.event.v1.SubnetInfo.name":
-		return x.Name != ""
-	case "hetu.event.v1.SubnetInfo.description":
-		return x.Description != ""
-	case "hetu.event.v1.SubnetInfo.activated_at":
-		return x.ActivatedAt != uint64(0)
-	case "hetu.event.v1.SubnetInfo.activated_block":
-		return x.ActivatedBlock != uint64(0)
+	case "hetu.event.v1.QueryStakePositionRequest.account":
+		return x.Account != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryStakePositionRequest"))
 		}
-		panic(fmt.Errorf("message hetu.event.v1.SubnetInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message hetu.event.v1.QueryStakePositionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5086,39 +4966,15 @@ func (x *fastReflection_SubnetInfo) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SubnetInfo) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryStakePositionRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "hetu.event.v1.SubnetInfo.netuid":
-		x.Netuid = uint32(0)
-	case "hetu.event.v1.SubnetInfo.owner":
-		x.Owner = ""
-	case "hetu.event.v1.SubnetInfo.alpha_token":
-		x.AlphaToken = ""
-	case "hetu.event.v1.SubnetInfo.amm_pool":
-		x.AmmPool = ""
-	case "hetu.event.v1.SubnetInfo.locked_amount":
-		x.LockedAmount = ""
-	case "hetu.event.v1.SubnetInfo.pool_initial_tao":
-		x.PoolInitialTao = ""
-	case "hetu.event.v1.SubnetInfo.burned_amount":
-		x.BurnedAmount = ""
-	case "hetu.event.v1.SubnetInfo.created_at":
-		x.CreatedAt = uint64(0)
-	case "hetu.event.v1.SubnetInfo.is_active":
-		x.IsActive = false
-	case "hetu.event.v1.SubnetInfo.name":
-		x.Name = ""
-	case "hetu.event.v1.SubnetInfo.description":
-		x.Description = ""
-	case "hetu.event.v1.SubnetInfo.activated_at":
-		x.ActivatedAt = uint64(0)
-	case "hetu.event.v1.SubnetInfo.activated_block":
-		x.ActivatedBlock = uint64(0)
+	case "hetu.event.v1.QueryStakePositionRequest.account":
+		x.Account = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryStakePositionRequest"))
 		}
-		panic(fmt.Errorf("message hetu.event.v1.SubnetInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message hetu.event.v1.QueryStakePositionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5128,52 +4984,16 @@ func (x *fastReflection_SubnetInfo) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SubnetInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStakePositionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "hetu.event.v1.SubnetInfo.netuid":
-		value := x.Netuid
-		return protoreflect.ValueOfUint32(value)
-	case "hetu.event.v1.SubnetInfo.owner":
-		value := x.Owner
-		return protoreflect.ValueOfString(value)
-	case "hetu.event.v1.SubnetInfo.alpha_token":
-		value := x.AlphaToken
-		return protoreflect.ValueOfString(value)
-	case "hetu.event.v1.SubnetInfo.amm_pool":
-		value := x.AmmPool
-		return protoreflect.ValueOfString(value)
-	case "hetu.event.v1.SubnetInfo.locked_amount":
-		value := x.LockedAmount
-		return protoreflect.ValueOfString(value)
-	case "hetu.event.v1.SubnetInfo.pool_initial_tao":
-		value := x.PoolInitialTao
-		return protoreflect.ValueOfString(value)
-	case "hetu.event.v1.SubnetInfo.burned_amount":
-		value := x.BurnedAmount
-		return protoreflect.ValueOfString(value)
-	case "hetu.event.v1.SubnetInfo.created_at":
-		value := x.CreatedAt
-		return protoreflect.ValueOfUint64(value)
-	case "hetu.event.v1.SubnetInfo.is_active":
-		value := x.IsActive
-		return protoreflect.ValueOfBool(value)
-	case "hetu.event.v1.SubnetInfo.name":
-		value := x.Name
-		return protoreflect.ValueOfString(value)
-	case "hetu.event.v1.SubnetInfo.description":
-		value := x.Description
+	case "hetu.event.v1.QueryStakePositionRequest.account":
+		value := x.Account
 		return protoreflect.ValueOfString(value)
-	case "hetu.event.v1.SubnetInfo.activated_at":
-		value := x.ActivatedAt
-		return protoreflect.ValueOfUint64(value)
-	case "hetu.event.v1.SubnetInfo.activated_block":
-		value := x.ActivatedBlock
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryStakePositionRequest"))
 		}
-		panic(fmt.Errorf("message hetu.event.v1.SubnetInfo does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message hetu.event.v1.QueryStakePositionRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5187,39 +5007,15 @@ func (x *fastReflection_SubnetInfo) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SubnetInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryStakePositionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "hetu.event.v1.SubnetInfo.netuid":
-		x.Netuid = uint32(value.Uint())
-	case "hetu.event.v1.SubnetInfo.owner":
-		x.Owner = value.Interface().(string)
-	case "hetu.event.v1.SubnetInfo.alpha_token":
-		x.AlphaToken = value.Interface().(string)
-	case "hetu.event.v1.SubnetInfo.amm_pool":
-		x.AmmPool = value.Interface().(string)
-	case "hetu.event.v1.SubnetInfo.locked_amount":
-		x.LockedAmount = value.Interface().(string)
-	case "hetu.event.v1.SubnetInfo.pool_initial_tao":
-		x.PoolInitialTao = value.Interface().(string)
-	case "hetu.event.v1.SubnetInfo.burned_amount":
-		x.BurnedAmount = value.Interface().(string)
-	case "hetu.event.v1.SubnetInfo.created_at":
-		x.CreatedAt = value.Uint()
-	case "hetu.event.v1.SubnetInfo.is_active":
-		x.IsActive = value.Bool()
-	case "hetu.event.v1.SubnetInfo.name":
-		x.Name = value.Interface().(string)
-	case "hetu.event.v1.SubnetInfo.description":
-		x.Description = value.Interface().(string)
-	case "hetu.event.v1.SubnetInfo.activated_at":
-		x.ActivatedAt = value.Uint()
-	case "hetu.event.v1.SubnetInfo.activated_block":
-		x.ActivatedBlock = value.Uint()
+	case "hetu.event.v1.QueryStakePositionRequest.account":
+		x.Account = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryStakePositionRequest"))
 		}
-		panic(fmt.Errorf("message hetu.event.v1.SubnetInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message hetu.event.v1.QueryStakePositionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5233,88 +5029,40 @@ func (x *fastReflection_SubnetInfo) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SubnetInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStakePositionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "hetu.event.v1.SubnetInfo.netuid":
-		panic(fmt.Errorf("field netuid of message hetu.event.v1.SubnetInfo is not mutable"))
-	case "hetu.event.v1.SubnetInfo.owner":
-		panic(fmt.Errorf("field owner of message hetu.event.v1.SubnetInfo is not mutable"))
-	case "hetu.event.v1.SubnetInfo.alpha_token":
-		panic(fmt.Errorf("field alpha_token of message hetu.event.v1.SubnetInfo is not mutable"))
-	case "hetu.event.v1.SubnetInfo.amm_pool":
-		panic(fmt.Errorf("field amm_pool of message hetu.event.v1.SubnetInfo is not mutable"))
-	case "hetu.event.v1.SubnetInfo.locked_amount":
-		panic(fmt.Errorf("field locked_amount of message hetu.event.v1.SubnetInfo is not mutable"))
-	case "hetu.event.v1.SubnetInfo.pool_initial_tao":
-		panic(fmt.Errorf("field pool_initial_tao of message hetu.event.v1.SubnetInfo is not mutable"))
-	case "hetu.event.v1.SubnetInfo.burned_amount":
-		panic(fmt.Errorf("field burned_amount of message hetu.event.v1.SubnetInfo is not mutable"))
-	case "hetu.event.v1.SubnetInfo.created_at":
-		panic(fmt.Errorf("field created_at of message hetu.event.v1.SubnetInfo is not mutable"))
-	case "hetu.event.v1.SubnetInfo.is_active":
-		panic(fmt.Errorf("field is_active of message hetu.event.v1.SubnetInfo is not mutable"))
-	case "hetu.event.v1.SubnetInfo.name":
-		panic(fmt.Errorf("field name of message hetu.event.v1.SubnetInfo is not mutable"))
-	case "hetu.event.v1.SubnetInfo.description":
-		panic(fmt.Errorf("field description of message hetu.event.v1.SubnetInfo is not mutable"))
-	case "hetu.event.v1.SubnetInfo.activated_at":
-		panic(fmt.Errorf("field activated_at of message hetu.event.v1.SubnetInfo is not mutable"))
-	case "hetu.event.v1.SubnetInfo.activated_block":
-		panic(fmt.Errorf("field activated_block of message hetu.event.v1.SubnetInfo is not mutable"))
+	case "hetu.event.v1.QueryStakePositionRequest.account":
+		panic(fmt.Errorf("field account of message hetu.event.v1.QueryStakePositionRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryStakePositionRequest"))
 		}
-		panic(fmt.Errorf("message hetu.event.v1.SubnetInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message hetu.event.v1.QueryStakePositionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SubnetInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStakePositionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "hetu.event.v1.SubnetInfo.netuid":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "hetu.event.v1.SubnetInfo.owner":
-		return protoreflect.ValueOfString("")
-	case "hetu.event.v1.SubnetInfo.alpha_token":
-		return protoreflect.ValueOfString("")
-	case "hetu.event.v1.SubnetInfo.amm_pool":
-		return protoreflect.ValueOfString("")
-	case "hetu.event.v1.SubnetInfo.locked_amount":
-		return protoreflect.ValueOfString("")
-	case "hetu.event.v1.SubnetInfo.pool_initial_tao":
-		return protoreflect.ValueOfString("")
-	case "hetu.event.v1.SubnetInfo.burned_amount":
-		return protoreflect.ValueOfString("")
-	case "hetu.event.v1.SubnetInfo.created_at":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "hetu.event.v1.SubnetInfo.is_active":
-		return protoreflect.ValueOfBool(false)
-	case "hetu.event.v1.SubnetInfo.name":
-		return protoreflect.ValueOfString("")
-	case "hetu.event.v1.SubnetInfo.description":
+	case "hetu.event.v1.QueryStakePositionRequest.account":
 		return protoreflect.ValueOfString("")
-	case "hetu.event.v1.SubnetInfo.activated_at":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "hetu.event.v1.SubnetInfo.activated_block":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryStakePositionRequest"))
 		}
-		panic(fmt.Errorf("message hetu.event.v1.SubnetInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message hetu.event.v1.QueryStakePositionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SubnetInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryStakePositionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.SubnetInfo", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryStakePositionRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5322,7 +5070,7 @@ func (x *fastReflection_SubnetInfo) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SubnetInfo) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryStakePositionRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5333,7 +5081,7 @@ func (x *fastReflection_SubnetInfo) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SubnetInfo) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryStakePositionRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5345,7 +5093,7 @@ func (x *fastReflection_SubnetInfo) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SubnetInfo) IsValid() bool {
+func (x *fastReflection_QueryStakePositionRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5355,9 +5103,9 @@ func (x *fastReflection_SubnetInfo) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SubnetInfo) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryStakePositionRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SubnetInfo)
+		x := input.Message.Interface().(*QueryStakePositionRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5369,53 +5117,10 @@ func (x *fastReflection_SubnetInfo) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Netuid != 0 {
-			n += 1 + runtime.Sov(uint64(x.Netuid))
-		}
-		l = len(x.Owner)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.AlphaToken)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.AmmPool)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.LockedAmount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.PoolInitialTao)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.BurnedAmount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.CreatedAt != 0 {
-			n += 1 + runtime.Sov(uint64(x.CreatedAt))
-		}
-		if x.IsActive {
-			n += 2
-		}
-		l = len(x.Name)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Description)
+		l = len(x.Account)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ActivatedAt != 0 {
-			n += 1 + runtime.Sov(uint64(x.ActivatedAt))
-		}
-		if x.ActivatedBlock != 0 {
-			n += 1 + runtime.Sov(uint64(x.ActivatedBlock))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5426,7 +5131,7 @@ func (x *fastReflection_SubnetInfo) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SubnetInfo)
+		x := input.Message.Interface().(*QueryStakePositionRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5445,91 +5150,12 @@ func (x *fastReflection_SubnetInfo) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ActivatedBlock != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActivatedBlock))
-			i--
-			dAtA[i] = 0x68
-		}
-		if x.ActivatedAt != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActivatedAt))
-			i--
-			dAtA[i] = 0x60
-		}
-		if len(x.Description) > 0 {
-			i -= len(x.Description)
-			copy(dAtA[i:], x.Description)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
-			i--
-			dAtA[i] = 0x5a
-		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
-			i--
-			dAtA[i] = 0x52
-		}
-		if x.IsActive {
-			i--
-			if x.IsActive {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x48
-		}
-		if x.CreatedAt != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
-			i--
-			dAtA[i] = 0x40
-		}
-		if len(x.BurnedAmount) > 0 {
-			i -= len(x.BurnedAmount)
-			copy(dAtA[i:], x.BurnedAmount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BurnedAmount)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.PoolInitialTao) > 0 {
-			i -= len(x.PoolInitialTao)
-			copy(dAtA[i:], x.PoolInitialTao)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PoolInitialTao)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.LockedAmount) > 0 {
-			i -= len(x.LockedAmount)
-			copy(dAtA[i:], x.LockedAmount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LockedAmount)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.AmmPool) > 0 {
-			i -= len(x.AmmPool)
-			copy(dAtA[i:], x.AmmPool)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmmPool)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.AlphaToken) > 0 {
-			i -= len(x.AlphaToken)
-			copy(dAtA[i:], x.AlphaToken)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AlphaToken)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Owner) > 0 {
-			i -= len(x.Owner)
-			copy(dAtA[i:], x.Owner)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Netuid != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+		if len(x.Account) > 0 {
+			i -= len(x.Account)
+			copy(dAtA[i:], x.Account)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Account)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5542,7 +5168,7 @@ func (x *fastReflection_SubnetInfo) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SubnetInfo)
+		x := input.Message.Interface().(*QueryStakePositionRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5574,34 +5200,15 @@ func (x *fastReflection_SubnetInfo) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubnetInfo: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStakePositionRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubnetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStakePositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
-				}
-				x.Netuid = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Netuid |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5629,323 +5236,22 @@ func (x *fastReflection_SubnetInfo) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Owner = string(dAtA[iNdEx:postIndex])
+				x.Account = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AlphaToken", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.AlphaToken = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmmPool", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.AmmPool = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedAmount", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.LockedAmount = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolInitialTao", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.PoolInitialTao = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnedAmount", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.BurnedAmount = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-				}
-				x.CreatedAt = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.CreatedAt |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 9:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.IsActive = bool(v != 0)
-			case 10:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Name = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 11:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Description = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 12:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivatedAt", wireType)
-				}
-				x.ActivatedAt = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ActivatedAt |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 13:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivatedBlock", wireType)
-				}
-				x.ActivatedBlock = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ActivatedBlock |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -5967,48 +5273,87 @@ func (x *fastReflection_SubnetInfo) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_QueryStakePositionResponse_6_list)(nil)
+
+type _QueryStakePositionResponse_6_list struct {
+	list *[]*SubnetAllocationInfo
+}
+
+func (x *_QueryStakePositionResponse_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryStakePositionResponse_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryStakePositionResponse_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SubnetAllocationInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryStakePositionResponse_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SubnetAllocationInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryStakePositionResponse_6_list) AppendMutable() protoreflect.Value {
+	v := new(SubnetAllocationInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryStakePositionResponse_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryStakePositionResponse_6_list) NewElement() protoreflect.Value {
+	v := new(SubnetAllocationInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryStakePositionResponse_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_NeuronInfo                          protoreflect.MessageDescriptor
-	fd_NeuronInfo_account                  protoreflect.FieldDescriptor
-	fd_NeuronInfo_netuid                   protoreflect.FieldDescriptor
-	fd_NeuronInfo_is_active                protoreflect.FieldDescriptor
-	fd_NeuronInfo_is_validator             protoreflect.FieldDescriptor
-	fd_NeuronInfo_requested_validator_role protoreflect.FieldDescriptor
-	fd_NeuronInfo_stake                    protoreflect.FieldDescriptor
-	fd_NeuronInfo_registration_block       protoreflect.FieldDescriptor
-	fd_NeuronInfo_last_update              protoreflect.FieldDescriptor
-	fd_NeuronInfo_axon_endpoint            protoreflect.FieldDescriptor
-	fd_NeuronInfo_axon_port                protoreflect.FieldDescriptor
-	fd_NeuronInfo_prometheus_endpoint      protoreflect.FieldDescriptor
-	fd_NeuronInfo_prometheus_port          protoreflect.FieldDescriptor
+	md_QueryStakePositionResponse                   protoreflect.MessageDescriptor
+	fd_QueryStakePositionResponse_account           protoreflect.FieldDescriptor
+	fd_QueryStakePositionResponse_total_staked      protoreflect.FieldDescriptor
+	fd_QueryStakePositionResponse_allocated         protoreflect.FieldDescriptor
+	fd_QueryStakePositionResponse_registration_cost protoreflect.FieldDescriptor
+	fd_QueryStakePositionResponse_available         protoreflect.FieldDescriptor
+	fd_QueryStakePositionResponse_allocations       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_hetu_event_v1_query_proto_init()
-	md_NeuronInfo = File_hetu_event_v1_query_proto.Messages().ByName("NeuronInfo")
-	fd_NeuronInfo_account = md_NeuronInfo.Fields().ByName("account")
-	fd_NeuronInfo_netuid = md_NeuronInfo.Fields().ByName("netuid")
-	fd_NeuronInfo_is_active = md_NeuronInfo.Fields().ByName("is_active")
-	fd_NeuronInfo_is_validator = md_NeuronInfo.Fields().ByName("is_validator")
-	fd_NeuronInfo_requested_validator_role = md_NeuronInfo.Fields().ByName("requested_validator_role")
-	fd_NeuronInfo_stake = md_NeuronInfo.Fields().ByName("stake")
-	fd_NeuronInfo_registration_block = md_NeuronInfo.Fields().ByName("registration_block")
-	fd_NeuronInfo_last_update = md_NeuronInfo.Fields().ByName("last_update")
-	fd_NeuronInfo_axon_endpoint = md_NeuronInfo.Fields().ByName("axon_endpoint")
-	fd_NeuronInfo_axon_port = md_NeuronInfo.Fields().ByName("axon_port")
-	fd_NeuronInfo_prometheus_endpoint = md_NeuronInfo.Fields().ByName("prometheus_endpoint")
-	fd_NeuronInfo_prometheus_port = md_NeuronInfo.Fields().ByName("prometheus_port")
+	md_QueryStakePositionResponse = File_hetu_event_v1_query_proto.Messages().ByName("QueryStakePositionResponse")
+	fd_QueryStakePositionResponse_account = md_QueryStakePositionResponse.Fields().ByName("account")
+	fd_QueryStakePositionResponse_total_staked = md_QueryStakePositionResponse.Fields().ByName("total_staked")
+	fd_QueryStakePositionResponse_allocated = md_QueryStakePositionResponse.Fields().ByName("allocated")
+	fd_QueryStakePositionResponse_registration_cost = md_QueryStakePositionResponse.Fields().ByName("registration_cost")
+	fd_QueryStakePositionResponse_available = md_QueryStakePositionResponse.Fields().ByName("available")
+	fd_QueryStakePositionResponse_allocations = md_QueryStakePositionResponse.Fields().ByName("allocations")
 }
 
-var _ protoreflect.Message = (*fastReflection_NeuronInfo)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryStakePositionResponse)(nil)
 
-type fastReflection_NeuronInfo NeuronInfo
+type fastReflection_QueryStakePositionResponse QueryStakePositionResponse
 
-func (x *NeuronInfo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_NeuronInfo)(x)
+func (x *QueryStakePositionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryStakePositionResponse)(x)
 }
 
-func (x *NeuronInfo) slowProtoReflect() protoreflect.Message {
+func (x *QueryStakePositionResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_hetu_event_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6020,43 +5365,43 @@ func (x *NeuronInfo) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_NeuronInfo_messageType fastReflection_NeuronInfo_messageType
-var _ protoreflect.MessageType = fastReflection_NeuronInfo_messageType{}
+var _fastReflection_QueryStakePositionResponse_messageType fastReflection_QueryStakePositionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryStakePositionResponse_messageType{}
 
-type fastReflection_NeuronInfo_messageType struct{}
+type fastReflection_QueryStakePositionResponse_messageType struct{}
 
-func (x fastReflection_NeuronInfo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_NeuronInfo)(nil)
+func (x fastReflection_QueryStakePositionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryStakePositionResponse)(nil)
 }
-func (x fastReflection_NeuronInfo_messageType) New() protoreflect.Message {
-	return new(fastReflection_NeuronInfo)
+func (x fastReflection_QueryStakePositionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryStakePositionResponse)
 }
-func (x fastReflection_NeuronInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_NeuronInfo
+func (x fastReflection_QueryStakePositionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStakePositionResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_NeuronInfo) Descriptor() protoreflect.MessageDescriptor {
-	return md_NeuronInfo
+func (x *fastReflection_QueryStakePositionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStakePositionResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_NeuronInfo) Type() protoreflect.MessageType {
-	return _fastReflection_NeuronInfo_messageType
+func (x *fastReflection_QueryStakePositionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryStakePositionResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_NeuronInfo) New() protoreflect.Message {
-	return new(fastReflection_NeuronInfo)
+func (x *fastReflection_QueryStakePositionResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryStakePositionResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_NeuronInfo) Interface() protoreflect.ProtoMessage {
-	return (*NeuronInfo)(x)
+func (x *fastReflection_QueryStakePositionResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryStakePositionResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6064,76 +5409,40 @@ func (x *fastReflection_NeuronInfo) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_NeuronInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryStakePositionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Account != "" {
 		value := protoreflect.ValueOfString(x.Account)
-		if !f(fd_NeuronInfo_account, value) {
-			return
-		}
-	}
-	if x.Netuid != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Netuid)
-		if !f(fd_NeuronInfo_netuid, value) {
+		if !f(fd_QueryStakePositionResponse_account, value) {
 			return
 		}
 	}
-	if x.IsActive != false {
-		value := protoreflect.ValueOfBool(x.IsActive)
-		if !f(fd_NeuronInfo_is_active, value) {
-			return
-		}
-	}
-	if x.IsValidator != false {
-		value := protoreflect.ValueOfBool(x.IsValidator)
-		if !f(fd_NeuronInfo_is_validator, value) {
-			return
-		}
-	}
-	if x.RequestedValidatorRole != false {
-		value := protoreflect.ValueOfBool(x.RequestedValidatorRole)
-		if !f(fd_NeuronInfo_requested_validator_role, value) {
-			return
-		}
-	}
-	if x.Stake != "" {
-		value := protoreflect.ValueOfString(x.Stake)
-		if !f(fd_NeuronInfo_stake, value) {
-			return
-		}
-	}
-	if x.RegistrationBlock != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RegistrationBlock)
-		if !f(fd_NeuronInfo_registration_block, value) {
-			return
-		}
-	}
-	if x.LastUpdate != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.LastUpdate)
-		if !f(fd_NeuronInfo_last_update, value) {
+	if x.TotalStaked != "" {
+		value := protoreflect.ValueOfString(x.TotalStaked)
+		if !f(fd_QueryStakePositionResponse_total_staked, value) {
 			return
 		}
 	}
-	if x.AxonEndpoint != "" {
-		value := protoreflect.ValueOfString(x.AxonEndpoint)
-		if !f(fd_NeuronInfo_axon_endpoint, value) {
+	if x.Allocated != "" {
+		value := protoreflect.ValueOfString(x.Allocated)
+		if !f(fd_QueryStakePositionResponse_allocated, value) {
 			return
 		}
 	}
-	if x.AxonPort != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.AxonPort)
-		if !f(fd_NeuronInfo_axon_port, value) {
+	if x.RegistrationCost != "" {
+		value := protoreflect.ValueOfString(x.RegistrationCost)
+		if !f(fd_QueryStakePositionResponse_registration_cost, value) {
 			return
 		}
 	}
-	if x.PrometheusEndpoint != "" {
-		value := protoreflect.ValueOfString(x.PrometheusEndpoint)
-		if !f(fd_NeuronInfo_prometheus_endpoint, value) {
+	if x.Available != "" {
+		value := protoreflect.ValueOfString(x.Available)
+		if !f(fd_QueryStakePositionResponse_available, value) {
 			return
 		}
 	}
-	if x.PrometheusPort != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.PrometheusPort)
-		if !f(fd_NeuronInfo_prometheus_port, value) {
+	if len(x.Allocations) != 0 {
+		value := protoreflect.ValueOfList(&_QueryStakePositionResponse_6_list{list: &x.Allocations})
+		if !f(fd_QueryStakePositionResponse_allocations, value) {
 			return
 		}
 	}
@@ -6150,37 +5459,25 @@ func (x *fastReflection_NeuronInfo) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_NeuronInfo) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryStakePositionResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "hetu.event.v1.NeuronInfo.account":
+	case "hetu.event.v1.QueryStakePositionResponse.account":
 		return x.Account != ""
-	case "hetu.event.v1.NeuronInfo.netuid":
-		return x.Netuid != uint32(0)
-	case "hetu.event.v1.NeuronInfo.is_active":
-		return x.IsActive != false
-	case "hetu.event.v1.NeuronInfo.is_validator":
-		return x.IsValidator != false
-	case "hetu.event.v1.NeuronInfo.requested_validator_role":
-		return x.RequestedValidatorRole != false
-	case "hetu.event.v1.NeuronInfo.stake":
-		return x.Stake != ""
-	case "hetu.event.v1.NeuronInfo.registration_block":
-		return x.RegistrationBlock != uint64(0)
-	case "hetu.event.v1.NeuronInfo.last_update":
-		return x.LastUpdate != uint64(0)
-	case "hetu.event.v1.NeuronInfo.axon_endpoint":
-		return x.AxonEndpoint != ""
-	case "hetu.event.v1.NeuronInfo.axon_port":
-		return x.AxonPort != uint32(0)
-	case "hetu.event.v1.NeuronInfo.prometheus_endpoint":
-		return x.PrometheusEndpoint != ""
-	case "hetu.event.v1.NeuronInfo.prometheus_port":
-		return x.PrometheusPort != uint32(0)
+	case "hetu.event.v1.QueryStakePositionResponse.total_staked":
+		return x.TotalStaked != ""
+	case "hetu.event.v1.QueryStakePositionResponse.allocated":
+		return x.Allocated != ""
+	case "hetu.event.v1.QueryStakePositionResponse.registration_cost":
+		return x.RegistrationCost != ""
+	case "hetu.event.v1.QueryStakePositionResponse.available":
+		return x.Available != ""
+	case "hetu.event.v1.QueryStakePositionResponse.allocations":
+		return len(x.Allocations) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NeuronInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryStakePositionResponse"))
 		}
-		panic(fmt.Errorf("message hetu.event.v1.NeuronInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message hetu.event.v1.QueryStakePositionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6190,37 +5487,25 @@ func (x *fastReflection_NeuronInfo) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NeuronInfo) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryStakePositionResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "hetu.event.v1.NeuronInfo.account":
+	case "hetu.event.v1.QueryStakePositionResponse.account":
 		x.Account = ""
-	case "hetu.event.v1.NeuronInfo.netuid":
-		x.Netuid = uint32(0)
-	case "hetu.event.v1.NeuronInfo.is_active":
-		x.IsActive = false
-	case "hetu.event.v1.NeuronInfo.is_validator":
-		x.IsValidator = false
-	case "hetu.event.v1.NeuronInfo.requested_validator_role":
-		x.RequestedValidatorRole = false
-	case "hetu.event.v1.NeuronInfo.stake":
-		x.Stake = ""
-	case "hetu.event.v1.NeuronInfo.registration_block":
-		x.RegistrationBlock = uint64(0)
-	case "hetu.event.v1.NeuronInfo.last_update":
-		x.LastUpdate = uint64(0)
-	case "hetu.event.v1.NeuronInfo.axon_endpoint":
-		x.AxonEndpoint = ""
-	case "hetu.event.v1.NeuronInfo.axon_port":
-		x.AxonPort = uint32(0)
-	case "hetu.event.v1.NeuronInfo.prometheus_endpoint":
-		x.PrometheusEndpoint = ""
-	case "hetu.event.v1.NeuronInfo.prometheus_port":
-		x.PrometheusPort = uint32(0)
+	case "hetu.event.v1.QueryStakePositionResponse.total_staked":
+		x.TotalStaked = ""
+	case "hetu.event.v1.QueryStakePositionResponse.allocated":
+		x.Allocated = ""
+	case "hetu.event.v1.QueryStakePositionResponse.registration_cost":
+		x.RegistrationCost = ""
+	case "hetu.event.v1.QueryStakePositionResponse.available":
+		x.Available = ""
+	case "hetu.event.v1.QueryStakePositionResponse.allocations":
+		x.Allocations = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NeuronInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryStakePositionResponse"))
 		}
-		panic(fmt.Errorf("message hetu.event.v1.NeuronInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message hetu.event.v1.QueryStakePositionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6230,49 +5515,34 @@ func (x *fastReflection_NeuronInfo) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_NeuronInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStakePositionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "hetu.event.v1.NeuronInfo.account":
+	case "hetu.event.v1.QueryStakePositionResponse.account":
 		value := x.Account
 		return protoreflect.ValueOfString(value)
-	case "hetu.event.v1.NeuronInfo.netuid":
-		value := x.Netuid
-		return protoreflect.ValueOfUint32(value)
-	case "hetu.event.v1.NeuronInfo.is_active":
-		value := x.IsActive
-		return protoreflect.ValueOfBool(value)
-	case "hetu.event.v1.NeuronInfo.is_validator":
-		value := x.IsValidator
-		return protoreflect.ValueOfBool(value)
-	case "hetu.event.v1.NeuronInfo.requested_validator_role":
-		value := x.RequestedValidatorRole
-		return protoreflect.ValueOfBool(value)
-	case "hetu.event.v1.NeuronInfo.stake":
-		value := x.Stake
+	case "hetu.event.v1.QueryStakePositionResponse.total_staked":
+		value := x.TotalStaked
 		return protoreflect.ValueOfString(value)
-	case "hetu.event.v1.NeuronInfo.registration_block":
-		value := x.RegistrationBlock
-		return protoreflect.ValueOfUint64(value)
-	case "hetu.event.v1.NeuronInfo.last_update":
-		value := x.LastUpdate
-		return protoreflect.ValueOfUint64(value)
-	case "hetu.event.v1.NeuronInfo.axon_endpoint":
-		value := x.AxonEndpoint
+	case "hetu.event.v1.QueryStakePositionResponse.allocated":
+		value := x.Allocated
 		return protoreflect.ValueOfString(value)
-	case "hetu.event.v1.NeuronInfo.axon_port":
-		value := x.AxonPort
-		return protoreflect.ValueOfUint32(value)
-	case "hetu.event.v1.NeuronInfo.prometheus_endpoint":
-		value := x.PrometheusEndpoint
+	case "hetu.event.v1.QueryStakePositionResponse.registration_cost":
+		value := x.RegistrationCost
 		return protoreflect.ValueOfString(value)
-	case "hetu.event.v1.NeuronInfo.prometheus_port":
-		value := x.PrometheusPort
-		return protoreflect.ValueOfUint32(value)
+	case "hetu.event.v1.QueryStakePositionResponse.available":
+		value := x.Available
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.QueryStakePositionResponse.allocations":
+		if len(x.Allocations) == 0 {
+			return protoreflect.ValueOfList(&_QueryStakePositionResponse_6_list{})
+		}
+		listValue := &_QueryStakePositionResponse_6_list{list: &x.Allocations}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NeuronInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryStakePositionResponse"))
 		}
-		panic(fmt.Errorf("message hetu.event.v1.NeuronInfo does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message hetu.event.v1.QueryStakePositionResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6286,37 +5556,27 @@ func (x *fastReflection_NeuronInfo) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NeuronInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryStakePositionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "hetu.event.v1.NeuronInfo.account":
+	case "hetu.event.v1.QueryStakePositionResponse.account":
 		x.Account = value.Interface().(string)
-	case "hetu.event.v1.NeuronInfo.netuid":
-		x.Netuid = uint32(value.Uint())
-	case "hetu.event.v1.NeuronInfo.is_active":
-		x.IsActive = value.Bool()
-	case "hetu.event.v1.NeuronInfo.is_validator":
-		x.IsValidator = value.Bool()
-	case "hetu.event.v1.NeuronInfo.requested_validator_role":
-		x.RequestedValidatorRole = value.Bool()
-	case "hetu.event.v1.NeuronInfo.stake":
-		x.Stake = value.Interface().(string)
-	case "hetu.event.v1.NeuronInfo.registration_block":
-		x.RegistrationBlock = value.Uint()
-	case "hetu.event.v1.NeuronInfo.last_update":
-		x.LastUpdate = value.Uint()
-	case "hetu.event.v1.NeuronInfo.axon_endpoint":
-		x.AxonEndpoint = value.Interface().(string)
-	case "hetu.event.v1.NeuronInfo.axon_port":
-		x.AxonPort = uint32(value.Uint())
-	case "hetu.event.v1.NeuronInfo.prometheus_endpoint":
-		x.PrometheusEndpoint = value.Interface().(string)
-	case "hetu.event.v1.NeuronInfo.prometheus_port":
-		x.PrometheusPort = uint32(value.Uint())
+	case "hetu.event.v1.QueryStakePositionResponse.total_staked":
+		x.TotalStaked = value.Interface().(string)
+	case "hetu.event.v1.QueryStakePositionResponse.allocated":
+		x.Allocated = value.Interface().(string)
+	case "hetu.event.v1.QueryStakePositionResponse.registration_cost":
+		x.RegistrationCost = value.Interface().(string)
+	case "hetu.event.v1.QueryStakePositionResponse.available":
+		x.Available = value.Interface().(string)
+	case "hetu.event.v1.QueryStakePositionResponse.allocations":
+		lv := value.List()
+		clv := lv.(*_QueryStakePositionResponse_6_list)
+		x.Allocations = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NeuronInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryStakePositionResponse"))
 		}
-		panic(fmt.Errorf("message hetu.event.v1.NeuronInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message hetu.event.v1.QueryStakePositionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6330,84 +5590,65 @@ func (x *fastReflection_NeuronInfo) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NeuronInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStakePositionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "hetu.event.v1.NeuronInfo.account":
-		panic(fmt.Errorf("field account of message hetu.event.v1.NeuronInfo is not mutable"))
-	case "hetu.event.v1.NeuronInfo.netuid":
-		panic(fmt.Errorf("field netuid of message hetu.event.v1.NeuronInfo is not mutable"))
-	case "hetu.event.v1.NeuronInfo.is_active":
-		panic(fmt.Errorf("field is_active of message hetu.event.v1.NeuronInfo is not mutable"))
-	case "hetu.event.v1.NeuronInfo.is_validator":
-		panic(fmt.Errorf("field is_validator of message hetu.event.v1.NeuronInfo is not mutable"))
-	case "hetu.event.v1.NeuronInfo.requested_validator_role":
-		panic(fmt.Errorf("field requested_validator_role of message hetu.event.v1.NeuronInfo is not mutable"))
-	case "hetu.event.v1.NeuronInfo.stake":
-		panic(fmt.Errorf("field stake of message hetu.event.v1.NeuronInfo is not mutable"))
-	case "hetu.event.v1.NeuronInfo.registration_block":
-		panic(fmt.Errorf("field registration_block of message hetu.event.v1.NeuronInfo is not mutable"))
-	case "hetu.event.v1.NeuronInfo.last_update":
-		panic(fmt.Errorf("field last_update of message hetu.event.v1.NeuronInfo is not mutable"))
-	case "hetu.event.v1.NeuronInfo.axon_endpoint":
-		panic(fmt.Errorf("field axon_endpoint of message hetu.event.v1.NeuronInfo is not mutable"))
-	case "hetu.event.v1.NeuronInfo.axon_port":
-		panic(fmt.Errorf("field axon_port of message hetu.event.v1.NeuronInfo is not mutable"))
-	case "hetu.event.v1.NeuronInfo.prometheus_endpoint":
-		panic(fmt.Errorf("field prometheus_endpoint of message hetu.event.v1.NeuronInfo is not mutable"))
-	case "hetu.event.v1.NeuronInfo.prometheus_port":
-		panic(fmt.Errorf("field prometheus_port of message hetu.event.v1.NeuronInfo is not mutable"))
+	case "hetu.event.v1.QueryStakePositionResponse.allocations":
+		if x.Allocations == nil {
+			x.Allocations = []*SubnetAllocationInfo{}
+		}
+		value := &_QueryStakePositionResponse_6_list{list: &x.Allocations}
+		return protoreflect.ValueOfList(value)
+	case "hetu.event.v1.QueryStakePositionResponse.account":
+		panic(fmt.Errorf("field account of message hetu.event.v1.QueryStakePositionResponse is not mutable"))
+	case "hetu.event.v1.QueryStakePositionResponse.total_staked":
+		panic(fmt.Errorf("field total_staked of message hetu.event.v1.QueryStakePositionResponse is not mutable"))
+	case "hetu.event.v1.QueryStakePositionResponse.allocated":
+		panic(fmt.Errorf("field allocated of message hetu.event.v1.QueryStakePositionResponse is not mutable"))
+	case "hetu.event.v1.QueryStakePositionResponse.registration_cost":
+		panic(fmt.Errorf("field registration_cost of message hetu.event.v1.QueryStakePositionResponse is not mutable"))
+	case "hetu.event.v1.QueryStakePositionResponse.available":
+		panic(fmt.Errorf("field available of message hetu.event.v1.QueryStakePositionResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NeuronInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryStakePositionResponse"))
 		}
-		panic(fmt.Errorf("message hetu.event.v1.NeuronInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message hetu.event.v1.QueryStakePositionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_NeuronInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStakePositionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "hetu.event.v1.NeuronInfo.account":
+	case "hetu.event.v1.QueryStakePositionResponse.account":
 		return protoreflect.ValueOfString("")
-	case "hetu.event.v1.NeuronInfo.netuid":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "hetu.event.v1.NeuronInfo.is_active":
-		return protoreflect.ValueOfBool(false)
-	case "hetu.event.v1.NeuronInfo.is_validator":
-		return protoreflect.ValueOfBool(false)
-	case "hetu.event.v1.NeuronInfo.requested_validator_role":
-		return protoreflect.ValueOfBool(false)
-	case "hetu.event.v1.NeuronInfo.stake":
+	case "hetu.event.v1.QueryStakePositionResponse.total_staked":
 		return protoreflect.ValueOfString("")
-	case "hetu.event.v1.NeuronInfo.registration_block":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "hetu.event.v1.NeuronInfo.last_update":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "hetu.event.v1.NeuronInfo.axon_endpoint":
+	case "hetu.event.v1.QueryStakePositionResponse.allocated":
 		return protoreflect.ValueOfString("")
-	case "hetu.event.v1.NeuronInfo.axon_port":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "hetu.event.v1.NeuronInfo.prometheus_endpoint":
+	case "hetu.event.v1.QueryStakePositionResponse.registration_cost":
 		return protoreflect.ValueOfString("")
-	case "hetu.event.v1.NeuronInfo.prometheus_port":
-		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.event.v1.QueryStakePositionResponse.available":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.QueryStakePositionResponse.allocations":
+		list := []*SubnetAllocationInfo{}
+		return protoreflect.ValueOfList(&_QueryStakePositionResponse_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NeuronInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryStakePositionResponse"))
 		}
-		panic(fmt.Errorf("message hetu.event.v1.NeuronInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message hetu.event.v1.QueryStakePositionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_NeuronInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryStakePositionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.NeuronInfo", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryStakePositionResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6415,7 +5656,7 @@ func (x *fastReflection_NeuronInfo) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_NeuronInfo) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryStakePositionResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6426,7 +5667,7 @@ func (x *fastReflection_NeuronInfo) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NeuronInfo) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryStakePositionResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6438,7 +5679,7 @@ func (x *fastReflection_NeuronInfo) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_NeuronInfo) IsValid() bool {
+func (x *fastReflection_QueryStakePositionResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6448,9 +5689,9 @@ func (x *fastReflection_NeuronInfo) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_NeuronInfo) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryStakePositionResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*NeuronInfo)
+		x := input.Message.Interface().(*QueryStakePositionResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6466,41 +5707,27 @@ func (x *fastReflection_NeuronInfo) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Netuid != 0 {
-			n += 1 + runtime.Sov(uint64(x.Netuid))
-		}
-		if x.IsActive {
-			n += 2
-		}
-		if x.IsValidator {
-			n += 2
-		}
-		if x.RequestedValidatorRole {
-			n += 2
-		}
-		l = len(x.Stake)
+		l = len(x.TotalStaked)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.RegistrationBlock != 0 {
-			n += 1 + runtime.Sov(uint64(x.RegistrationBlock))
-		}
-		if x.LastUpdate != 0 {
-			n += 1 + runtime.Sov(uint64(x.LastUpdate))
-		}
-		l = len(x.AxonEndpoint)
+		l = len(x.Allocated)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.AxonPort != 0 {
-			n += 1 + runtime.Sov(uint64(x.AxonPort))
+		l = len(x.RegistrationCost)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.PrometheusEndpoint)
+		l = len(x.Available)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.PrometheusPort != 0 {
-			n += 1 + runtime.Sov(uint64(x.PrometheusPort))
+		if len(x.Allocations) > 0 {
+			for _, e := range x.Allocations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6512,7 +5739,7 @@ func (x *fastReflection_NeuronInfo) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*NeuronInfo)
+		x := input.Message.Interface().(*QueryStakePositionResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6531,81 +5758,49 @@ func (x *fastReflection_NeuronInfo) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.PrometheusPort != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrometheusPort))
-			i--
-			dAtA[i] = 0x60
-		}
-		if len(x.PrometheusEndpoint) > 0 {
-			i -= len(x.PrometheusEndpoint)
-			copy(dAtA[i:], x.PrometheusEndpoint)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PrometheusEndpoint)))
-			i--
-			dAtA[i] = 0x5a
-		}
-		if x.AxonPort != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AxonPort))
-			i--
-			dAtA[i] = 0x50
-		}
-		if len(x.AxonEndpoint) > 0 {
-			i -= len(x.AxonEndpoint)
-			copy(dAtA[i:], x.AxonEndpoint)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AxonEndpoint)))
-			i--
-			dAtA[i] = 0x4a
-		}
-		if x.LastUpdate != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdate))
-			i--
-			dAtA[i] = 0x40
-		}
-		if x.RegistrationBlock != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RegistrationBlock))
-			i--
-			dAtA[i] = 0x38
-		}
-		if len(x.Stake) > 0 {
-			i -= len(x.Stake)
-			copy(dAtA[i:], x.Stake)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Stake)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if x.RequestedValidatorRole {
-			i--
-			if x.RequestedValidatorRole {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if len(x.Allocations) > 0 {
+			for iNdEx := len(x.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Allocations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
 			}
-			i--
-			dAtA[i] = 0x28
 		}
-		if x.IsValidator {
+		if len(x.Available) > 0 {
+			i -= len(x.Available)
+			copy(dAtA[i:], x.Available)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Available)))
 			i--
-			if x.IsValidator {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x2a
 		}
-		if x.IsActive {
+		if len(x.RegistrationCost) > 0 {
+			i -= len(x.RegistrationCost)
+			copy(dAtA[i:], x.RegistrationCost)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RegistrationCost)))
 			i--
-			if x.IsActive {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+			dAtA[i] = 0x22
+		}
+		if len(x.Allocated) > 0 {
+			i -= len(x.Allocated)
+			copy(dAtA[i:], x.Allocated)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Allocated)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
-		if x.Netuid != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+		if len(x.TotalStaked) > 0 {
+			i -= len(x.TotalStaked)
+			copy(dAtA[i:], x.TotalStaked)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalStaked)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
 		if len(x.Account) > 0 {
 			i -= len(x.Account)
@@ -6625,7 +5820,7 @@ func (x *fastReflection_NeuronInfo) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*NeuronInfo)
+		x := input.Message.Interface().(*QueryStakePositionResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6657,10 +5852,10 @@ func (x *fastReflection_NeuronInfo) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NeuronInfo: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStakePositionResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NeuronInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStakePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6696,10 +5891,10 @@ func (x *fastReflection_NeuronInfo) ProtoMethods() *protoiface.Methods {
 				x.Account = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalStaked", wireType)
 				}
-				x.Netuid = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6709,16 +5904,29 @@ func (x *fastReflection_NeuronInfo) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Netuid |= uint32(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalStaked = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
 				}
-				var v int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6728,17 +5936,29 @@ func (x *fastReflection_NeuronInfo) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.IsActive = bool(v != 0)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Allocated = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsValidator", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistrationCost", wireType)
 				}
-				var v int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6748,47 +5968,7 @@ func (x *fastReflection_NeuronInfo) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.IsValidator = bool(v != 0)
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestedValidatorRole", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.RequestedValidatorRole = bool(v != 0)
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -6804,49 +5984,11 @@ func (x *fastReflection_NeuronInfo) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Stake = string(dAtA[iNdEx:postIndex])
+				x.RegistrationCost = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistrationBlock", wireType)
-				}
-				x.RegistrationBlock = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RegistrationBlock |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
-				}
-				x.LastUpdate = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.LastUpdate |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 9:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AxonEndpoint", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6874,32 +6016,13 @@ func (x *fastReflection_NeuronInfo) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AxonEndpoint = string(dAtA[iNdEx:postIndex])
+				x.Available = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 10:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AxonPort", wireType)
-				}
-				x.AxonPort = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AxonPort |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 11:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrometheusEndpoint", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6909,43 +6032,26 @@ func (x *fastReflection_NeuronInfo) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PrometheusEndpoint = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 12:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrometheusPort", wireType)
-				}
-				x.PrometheusPort = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.PrometheusPort |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				x.Allocations = append(x.Allocations, &SubnetAllocationInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Allocations[len(x.Allocations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6965,364 +6071,17338 @@ func (x *fastReflection_NeuronInfo) ProtoMethods() *protoiface.Methods {
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryRegistrationCostsRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_QueryRegistrationCostsRequest = File_hetu_event_v1_query_proto.Messages().ByName("QueryRegistrationCostsRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRegistrationCostsRequest)(nil)
+
+type fastReflection_QueryRegistrationCostsRequest QueryRegistrationCostsRequest
+
+func (x *QueryRegistrationCostsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRegistrationCostsRequest)(x)
+}
+
+func (x *QueryRegistrationCostsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRegistrationCostsRequest_messageType fastReflection_QueryRegistrationCostsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRegistrationCostsRequest_messageType{}
+
+type fastReflection_QueryRegistrationCostsRequest_messageType struct{}
+
+func (x fastReflection_QueryRegistrationCostsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRegistrationCostsRequest)(nil)
+}
+func (x fastReflection_QueryRegistrationCostsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRegistrationCostsRequest)
+}
+func (x fastReflection_QueryRegistrationCostsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRegistrationCostsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRegistrationCostsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRegistrationCostsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRegistrationCostsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRegistrationCostsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRegistrationCostsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryRegistrationCostsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRegistrationCostsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryRegistrationCostsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRegistrationCostsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRegistrationCostsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryRegistrationCostsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryRegistrationCostsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRegistrationCostsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryRegistrationCostsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryRegistrationCostsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRegistrationCostsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryRegistrationCostsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryRegistrationCostsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRegistrationCostsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryRegistrationCostsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryRegistrationCostsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRegistrationCostsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryRegistrationCostsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryRegistrationCostsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRegistrationCostsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryRegistrationCostsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryRegistrationCostsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRegistrationCostsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryRegistrationCostsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRegistrationCostsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRegistrationCostsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRegistrationCostsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRegistrationCostsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRegistrationCostsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRegistrationCostsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRegistrationCostsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRegistrationCostsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRegistrationCostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryRegistrationCostsResponse_2_list)(nil)
+
+type _QueryRegistrationCostsResponse_2_list struct {
+	list *[]*SubnetRegistrationCost
+}
+
+func (x *_QueryRegistrationCostsResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryRegistrationCostsResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryRegistrationCostsResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SubnetRegistrationCost)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryRegistrationCostsResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SubnetRegistrationCost)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryRegistrationCostsResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(SubnetRegistrationCost)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRegistrationCostsResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryRegistrationCostsResponse_2_list) NewElement() protoreflect.Value {
+	v := new(SubnetRegistrationCost)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRegistrationCostsResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryRegistrationCostsResponse         protoreflect.MessageDescriptor
+	fd_QueryRegistrationCostsResponse_total   protoreflect.FieldDescriptor
+	fd_QueryRegistrationCostsResponse_subnets protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_QueryRegistrationCostsResponse = File_hetu_event_v1_query_proto.Messages().ByName("QueryRegistrationCostsResponse")
+	fd_QueryRegistrationCostsResponse_total = md_QueryRegistrationCostsResponse.Fields().ByName("total")
+	fd_QueryRegistrationCostsResponse_subnets = md_QueryRegistrationCostsResponse.Fields().ByName("subnets")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRegistrationCostsResponse)(nil)
+
+type fastReflection_QueryRegistrationCostsResponse QueryRegistrationCostsResponse
+
+func (x *QueryRegistrationCostsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRegistrationCostsResponse)(x)
+}
+
+func (x *QueryRegistrationCostsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRegistrationCostsResponse_messageType fastReflection_QueryRegistrationCostsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRegistrationCostsResponse_messageType{}
+
+type fastReflection_QueryRegistrationCostsResponse_messageType struct{}
+
+func (x fastReflection_QueryRegistrationCostsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRegistrationCostsResponse)(nil)
+}
+func (x fastReflection_QueryRegistrationCostsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRegistrationCostsResponse)
+}
+func (x fastReflection_QueryRegistrationCostsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRegistrationCostsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRegistrationCostsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRegistrationCostsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRegistrationCostsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRegistrationCostsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRegistrationCostsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryRegistrationCostsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRegistrationCostsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryRegistrationCostsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRegistrationCostsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Total != "" {
+		value := protoreflect.ValueOfString(x.Total)
+		if !f(fd_QueryRegistrationCostsResponse_total, value) {
+			return
+		}
+	}
+	if len(x.Subnets) != 0 {
+		value := protoreflect.ValueOfList(&_QueryRegistrationCostsResponse_2_list{list: &x.Subnets})
+		if !f(fd_QueryRegistrationCostsResponse_subnets, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRegistrationCostsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryRegistrationCostsResponse.total":
+		return x.Total != ""
+	case "hetu.event.v1.QueryRegistrationCostsResponse.subnets":
+		return len(x.Subnets) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryRegistrationCostsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryRegistrationCostsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRegistrationCostsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryRegistrationCostsResponse.total":
+		x.Total = ""
+	case "hetu.event.v1.QueryRegistrationCostsResponse.subnets":
+		x.Subnets = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryRegistrationCostsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryRegistrationCostsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRegistrationCostsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.QueryRegistrationCostsResponse.total":
+		value := x.Total
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.QueryRegistrationCostsResponse.subnets":
+		if len(x.Subnets) == 0 {
+			return protoreflect.ValueOfList(&_QueryRegistrationCostsResponse_2_list{})
+		}
+		listValue := &_QueryRegistrationCostsResponse_2_list{list: &x.Subnets}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryRegistrationCostsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryRegistrationCostsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRegistrationCostsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryRegistrationCostsResponse.total":
+		x.Total = value.Interface().(string)
+	case "hetu.event.v1.QueryRegistrationCostsResponse.subnets":
+		lv := value.List()
+		clv := lv.(*_QueryRegistrationCostsResponse_2_list)
+		x.Subnets = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryRegistrationCostsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryRegistrationCostsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRegistrationCostsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryRegistrationCostsResponse.subnets":
+		if x.Subnets == nil {
+			x.Subnets = []*SubnetRegistrationCost{}
+		}
+		value := &_QueryRegistrationCostsResponse_2_list{list: &x.Subnets}
+		return protoreflect.ValueOfList(value)
+	case "hetu.event.v1.QueryRegistrationCostsResponse.total":
+		panic(fmt.Errorf("field total of message hetu.event.v1.QueryRegistrationCostsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryRegistrationCostsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryRegistrationCostsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRegistrationCostsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryRegistrationCostsResponse.total":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.QueryRegistrationCostsResponse.subnets":
+		list := []*SubnetRegistrationCost{}
+		return protoreflect.ValueOfList(&_QueryRegistrationCostsResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryRegistrationCostsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryRegistrationCostsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRegistrationCostsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryRegistrationCostsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRegistrationCostsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRegistrationCostsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRegistrationCostsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRegistrationCostsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRegistrationCostsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Total)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Subnets) > 0 {
+			for _, e := range x.Subnets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRegistrationCostsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Subnets) > 0 {
+			for iNdEx := len(x.Subnets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Subnets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Total) > 0 {
+			i -= len(x.Total)
+			copy(dAtA[i:], x.Total)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Total)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRegistrationCostsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRegistrationCostsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRegistrationCostsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Total = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Subnets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Subnets = append(x.Subnets, &SubnetRegistrationCost{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Subnets[len(x.Subnets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryParamsRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_QueryParamsRequest = File_hetu_event_v1_query_proto.Messages().ByName("QueryParamsRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
+
+type fastReflection_QueryParamsRequest QueryParamsRequest
+
+func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(x)
+}
+
+func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
+
+type fastReflection_QueryParamsRequest_messageType struct{}
+
+func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(nil)
+}
+func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
+}
+func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryParamsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryParamsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryParamsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParamsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParamsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryParamsResponse                           protoreflect.MessageDescriptor
+	fd_QueryParamsResponse_price_history_retention   protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_child_key_cooldown        protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_max_child_key_take        protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_min_validator_take        protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_max_validator_take        protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_default_validator_take    protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_validator_take_cooldown   protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_native_stake_enabled      protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_alpha_token_pairs_enabled protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_price_candle_retention    protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_global_staking_address    protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_subnet_manager_address    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_QueryParamsResponse = File_hetu_event_v1_query_proto.Messages().ByName("QueryParamsResponse")
+	fd_QueryParamsResponse_price_history_retention = md_QueryParamsResponse.Fields().ByName("price_history_retention")
+	fd_QueryParamsResponse_child_key_cooldown = md_QueryParamsResponse.Fields().ByName("child_key_cooldown")
+	fd_QueryParamsResponse_max_child_key_take = md_QueryParamsResponse.Fields().ByName("max_child_key_take")
+	fd_QueryParamsResponse_min_validator_take = md_QueryParamsResponse.Fields().ByName("min_validator_take")
+	fd_QueryParamsResponse_max_validator_take = md_QueryParamsResponse.Fields().ByName("max_validator_take")
+	fd_QueryParamsResponse_default_validator_take = md_QueryParamsResponse.Fields().ByName("default_validator_take")
+	fd_QueryParamsResponse_validator_take_cooldown = md_QueryParamsResponse.Fields().ByName("validator_take_cooldown")
+	fd_QueryParamsResponse_native_stake_enabled = md_QueryParamsResponse.Fields().ByName("native_stake_enabled")
+	fd_QueryParamsResponse_alpha_token_pairs_enabled = md_QueryParamsResponse.Fields().ByName("alpha_token_pairs_enabled")
+	fd_QueryParamsResponse_price_candle_retention = md_QueryParamsResponse.Fields().ByName("price_candle_retention")
+	fd_QueryParamsResponse_global_staking_address = md_QueryParamsResponse.Fields().ByName("global_staking_address")
+	fd_QueryParamsResponse_subnet_manager_address = md_QueryParamsResponse.Fields().ByName("subnet_manager_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
+
+type fastReflection_QueryParamsResponse QueryParamsResponse
+
+func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(x)
+}
+
+func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
+
+type fastReflection_QueryParamsResponse_messageType struct{}
+
+func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(nil)
+}
+func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
+}
+func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PriceHistoryRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PriceHistoryRetention)
+		if !f(fd_QueryParamsResponse_price_history_retention, value) {
+			return
+		}
+	}
+	if x.ChildKeyCooldown != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ChildKeyCooldown)
+		if !f(fd_QueryParamsResponse_child_key_cooldown, value) {
+			return
+		}
+	}
+	if x.MaxChildKeyTake != "" {
+		value := protoreflect.ValueOfString(x.MaxChildKeyTake)
+		if !f(fd_QueryParamsResponse_max_child_key_take, value) {
+			return
+		}
+	}
+	if x.MinValidatorTake != "" {
+		value := protoreflect.ValueOfString(x.MinValidatorTake)
+		if !f(fd_QueryParamsResponse_min_validator_take, value) {
+			return
+		}
+	}
+	if x.MaxValidatorTake != "" {
+		value := protoreflect.ValueOfString(x.MaxValidatorTake)
+		if !f(fd_QueryParamsResponse_max_validator_take, value) {
+			return
+		}
+	}
+	if x.DefaultValidatorTake != "" {
+		value := protoreflect.ValueOfString(x.DefaultValidatorTake)
+		if !f(fd_QueryParamsResponse_default_validator_take, value) {
+			return
+		}
+	}
+	if x.ValidatorTakeCooldown != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidatorTakeCooldown)
+		if !f(fd_QueryParamsResponse_validator_take_cooldown, value) {
+			return
+		}
+	}
+	if x.NativeStakeEnabled != false {
+		value := protoreflect.ValueOfBool(x.NativeStakeEnabled)
+		if !f(fd_QueryParamsResponse_native_stake_enabled, value) {
+			return
+		}
+	}
+	if x.AlphaTokenPairsEnabled != false {
+		value := protoreflect.ValueOfBool(x.AlphaTokenPairsEnabled)
+		if !f(fd_QueryParamsResponse_alpha_token_pairs_enabled, value) {
+			return
+		}
+	}
+	if x.PriceCandleRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PriceCandleRetention)
+		if !f(fd_QueryParamsResponse_price_candle_retention, value) {
+			return
+		}
+	}
+	if x.GlobalStakingAddress != "" {
+		value := protoreflect.ValueOfString(x.GlobalStakingAddress)
+		if !f(fd_QueryParamsResponse_global_staking_address, value) {
+			return
+		}
+	}
+	if x.SubnetManagerAddress != "" {
+		value := protoreflect.ValueOfString(x.SubnetManagerAddress)
+		if !f(fd_QueryParamsResponse_subnet_manager_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryParamsResponse.price_history_retention":
+		return x.PriceHistoryRetention != uint64(0)
+	case "hetu.event.v1.QueryParamsResponse.child_key_cooldown":
+		return x.ChildKeyCooldown != uint64(0)
+	case "hetu.event.v1.QueryParamsResponse.max_child_key_take":
+		return x.MaxChildKeyTake != ""
+	case "hetu.event.v1.QueryParamsResponse.min_validator_take":
+		return x.MinValidatorTake != ""
+	case "hetu.event.v1.QueryParamsResponse.max_validator_take":
+		return x.MaxValidatorTake != ""
+	case "hetu.event.v1.QueryParamsResponse.default_validator_take":
+		return x.DefaultValidatorTake != ""
+	case "hetu.event.v1.QueryParamsResponse.validator_take_cooldown":
+		return x.ValidatorTakeCooldown != uint64(0)
+	case "hetu.event.v1.QueryParamsResponse.native_stake_enabled":
+		return x.NativeStakeEnabled != false
+	case "hetu.event.v1.QueryParamsResponse.alpha_token_pairs_enabled":
+		return x.AlphaTokenPairsEnabled != false
+	case "hetu.event.v1.QueryParamsResponse.price_candle_retention":
+		return x.PriceCandleRetention != uint64(0)
+	case "hetu.event.v1.QueryParamsResponse.global_staking_address":
+		return x.GlobalStakingAddress != ""
+	case "hetu.event.v1.QueryParamsResponse.subnet_manager_address":
+		return x.SubnetManagerAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryParamsResponse.price_history_retention":
+		x.PriceHistoryRetention = uint64(0)
+	case "hetu.event.v1.QueryParamsResponse.child_key_cooldown":
+		x.ChildKeyCooldown = uint64(0)
+	case "hetu.event.v1.QueryParamsResponse.max_child_key_take":
+		x.MaxChildKeyTake = ""
+	case "hetu.event.v1.QueryParamsResponse.min_validator_take":
+		x.MinValidatorTake = ""
+	case "hetu.event.v1.QueryParamsResponse.max_validator_take":
+		x.MaxValidatorTake = ""
+	case "hetu.event.v1.QueryParamsResponse.default_validator_take":
+		x.DefaultValidatorTake = ""
+	case "hetu.event.v1.QueryParamsResponse.validator_take_cooldown":
+		x.ValidatorTakeCooldown = uint64(0)
+	case "hetu.event.v1.QueryParamsResponse.native_stake_enabled":
+		x.NativeStakeEnabled = false
+	case "hetu.event.v1.QueryParamsResponse.alpha_token_pairs_enabled":
+		x.AlphaTokenPairsEnabled = false
+	case "hetu.event.v1.QueryParamsResponse.price_candle_retention":
+		x.PriceCandleRetention = uint64(0)
+	case "hetu.event.v1.QueryParamsResponse.global_staking_address":
+		x.GlobalStakingAddress = ""
+	case "hetu.event.v1.QueryParamsResponse.subnet_manager_address":
+		x.SubnetManagerAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.QueryParamsResponse.price_history_retention":
+		value := x.PriceHistoryRetention
+		return protoreflect.ValueOfUint64(value)
+	case "hetu.event.v1.QueryParamsResponse.child_key_cooldown":
+		value := x.ChildKeyCooldown
+		return protoreflect.ValueOfUint64(value)
+	case "hetu.event.v1.QueryParamsResponse.max_child_key_take":
+		value := x.MaxChildKeyTake
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.QueryParamsResponse.min_validator_take":
+		value := x.MinValidatorTake
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.QueryParamsResponse.max_validator_take":
+		value := x.MaxValidatorTake
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.QueryParamsResponse.default_validator_take":
+		value := x.DefaultValidatorTake
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.QueryParamsResponse.validator_take_cooldown":
+		value := x.ValidatorTakeCooldown
+		return protoreflect.ValueOfUint64(value)
+	case "hetu.event.v1.QueryParamsResponse.native_stake_enabled":
+		value := x.NativeStakeEnabled
+		return protoreflect.ValueOfBool(value)
+	case "hetu.event.v1.QueryParamsResponse.alpha_token_pairs_enabled":
+		value := x.AlphaTokenPairsEnabled
+		return protoreflect.ValueOfBool(value)
+	case "hetu.event.v1.QueryParamsResponse.price_candle_retention":
+		value := x.PriceCandleRetention
+		return protoreflect.ValueOfUint64(value)
+	case "hetu.event.v1.QueryParamsResponse.global_staking_address":
+		value := x.GlobalStakingAddress
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.QueryParamsResponse.subnet_manager_address":
+		value := x.SubnetManagerAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryParamsResponse.price_history_retention":
+		x.PriceHistoryRetention = value.Uint()
+	case "hetu.event.v1.QueryParamsResponse.child_key_cooldown":
+		x.ChildKeyCooldown = value.Uint()
+	case "hetu.event.v1.QueryParamsResponse.max_child_key_take":
+		x.MaxChildKeyTake = value.Interface().(string)
+	case "hetu.event.v1.QueryParamsResponse.min_validator_take":
+		x.MinValidatorTake = value.Interface().(string)
+	case "hetu.event.v1.QueryParamsResponse.max_validator_take":
+		x.MaxValidatorTake = value.Interface().(string)
+	case "hetu.event.v1.QueryParamsResponse.default_validator_take":
+		x.DefaultValidatorTake = value.Interface().(string)
+	case "hetu.event.v1.QueryParamsResponse.validator_take_cooldown":
+		x.ValidatorTakeCooldown = value.Uint()
+	case "hetu.event.v1.QueryParamsResponse.native_stake_enabled":
+		x.NativeStakeEnabled = value.Bool()
+	case "hetu.event.v1.QueryParamsResponse.alpha_token_pairs_enabled":
+		x.AlphaTokenPairsEnabled = value.Bool()
+	case "hetu.event.v1.QueryParamsResponse.price_candle_retention":
+		x.PriceCandleRetention = value.Uint()
+	case "hetu.event.v1.QueryParamsResponse.global_staking_address":
+		x.GlobalStakingAddress = value.Interface().(string)
+	case "hetu.event.v1.QueryParamsResponse.subnet_manager_address":
+		x.SubnetManagerAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryParamsResponse.price_history_retention":
+		panic(fmt.Errorf("field price_history_retention of message hetu.event.v1.QueryParamsResponse is not mutable"))
+	case "hetu.event.v1.QueryParamsResponse.child_key_cooldown":
+		panic(fmt.Errorf("field child_key_cooldown of message hetu.event.v1.QueryParamsResponse is not mutable"))
+	case "hetu.event.v1.QueryParamsResponse.max_child_key_take":
+		panic(fmt.Errorf("field max_child_key_take of message hetu.event.v1.QueryParamsResponse is not mutable"))
+	case "hetu.event.v1.QueryParamsResponse.min_validator_take":
+		panic(fmt.Errorf("field min_validator_take of message hetu.event.v1.QueryParamsResponse is not mutable"))
+	case "hetu.event.v1.QueryParamsResponse.max_validator_take":
+		panic(fmt.Errorf("field max_validator_take of message hetu.event.v1.QueryParamsResponse is not mutable"))
+	case "hetu.event.v1.QueryParamsResponse.default_validator_take":
+		panic(fmt.Errorf("field default_validator_take of message hetu.event.v1.QueryParamsResponse is not mutable"))
+	case "hetu.event.v1.QueryParamsResponse.validator_take_cooldown":
+		panic(fmt.Errorf("field validator_take_cooldown of message hetu.event.v1.QueryParamsResponse is not mutable"))
+	case "hetu.event.v1.QueryParamsResponse.native_stake_enabled":
+		panic(fmt.Errorf("field native_stake_enabled of message hetu.event.v1.QueryParamsResponse is not mutable"))
+	case "hetu.event.v1.QueryParamsResponse.alpha_token_pairs_enabled":
+		panic(fmt.Errorf("field alpha_token_pairs_enabled of message hetu.event.v1.QueryParamsResponse is not mutable"))
+	case "hetu.event.v1.QueryParamsResponse.price_candle_retention":
+		panic(fmt.Errorf("field price_candle_retention of message hetu.event.v1.QueryParamsResponse is not mutable"))
+	case "hetu.event.v1.QueryParamsResponse.global_staking_address":
+		panic(fmt.Errorf("field global_staking_address of message hetu.event.v1.QueryParamsResponse is not mutable"))
+	case "hetu.event.v1.QueryParamsResponse.subnet_manager_address":
+		panic(fmt.Errorf("field subnet_manager_address of message hetu.event.v1.QueryParamsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryParamsResponse.price_history_retention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "hetu.event.v1.QueryParamsResponse.child_key_cooldown":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "hetu.event.v1.QueryParamsResponse.max_child_key_take":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.QueryParamsResponse.min_validator_take":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.QueryParamsResponse.max_validator_take":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.QueryParamsResponse.default_validator_take":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.QueryParamsResponse.validator_take_cooldown":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "hetu.event.v1.QueryParamsResponse.native_stake_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "hetu.event.v1.QueryParamsResponse.alpha_token_pairs_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "hetu.event.v1.QueryParamsResponse.price_candle_retention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "hetu.event.v1.QueryParamsResponse.global_staking_address":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.QueryParamsResponse.subnet_manager_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryParamsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryParamsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryParamsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PriceHistoryRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.PriceHistoryRetention))
+		}
+		if x.ChildKeyCooldown != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChildKeyCooldown))
+		}
+		l = len(x.MaxChildKeyTake)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinValidatorTake)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxValidatorTake)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DefaultValidatorTake)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ValidatorTakeCooldown != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorTakeCooldown))
+		}
+		if x.NativeStakeEnabled {
+			n += 2
+		}
+		if x.AlphaTokenPairsEnabled {
+			n += 2
+		}
+		if x.PriceCandleRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.PriceCandleRetention))
+		}
+		l = len(x.GlobalStakingAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SubnetManagerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParamsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SubnetManagerAddress) > 0 {
+			i -= len(x.SubnetManagerAddress)
+			copy(dAtA[i:], x.SubnetManagerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SubnetManagerAddress)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.GlobalStakingAddress) > 0 {
+			i -= len(x.GlobalStakingAddress)
+			copy(dAtA[i:], x.GlobalStakingAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GlobalStakingAddress)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.PriceCandleRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PriceCandleRetention))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.AlphaTokenPairsEnabled {
+			i--
+			if x.AlphaTokenPairsEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.NativeStakeEnabled {
+			i--
+			if x.NativeStakeEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.ValidatorTakeCooldown != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorTakeCooldown))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.DefaultValidatorTake) > 0 {
+			i -= len(x.DefaultValidatorTake)
+			copy(dAtA[i:], x.DefaultValidatorTake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DefaultValidatorTake)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.MaxValidatorTake) > 0 {
+			i -= len(x.MaxValidatorTake)
+			copy(dAtA[i:], x.MaxValidatorTake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxValidatorTake)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.MinValidatorTake) > 0 {
+			i -= len(x.MinValidatorTake)
+			copy(dAtA[i:], x.MinValidatorTake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinValidatorTake)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.MaxChildKeyTake) > 0 {
+			i -= len(x.MaxChildKeyTake)
+			copy(dAtA[i:], x.MaxChildKeyTake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxChildKeyTake)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ChildKeyCooldown != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChildKeyCooldown))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.PriceHistoryRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PriceHistoryRetention))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParamsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryRetention", wireType)
+				}
+				x.PriceHistoryRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PriceHistoryRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChildKeyCooldown", wireType)
+				}
+				x.ChildKeyCooldown = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChildKeyCooldown |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxChildKeyTake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxChildKeyTake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinValidatorTake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinValidatorTake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorTake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxValidatorTake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultValidatorTake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DefaultValidatorTake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorTakeCooldown", wireType)
+				}
+				x.ValidatorTakeCooldown = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorTakeCooldown |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NativeStakeEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.NativeStakeEnabled = bool(v != 0)
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AlphaTokenPairsEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AlphaTokenPairsEnabled = bool(v != 0)
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceCandleRetention", wireType)
+				}
+				x.PriceCandleRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PriceCandleRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GlobalStakingAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GlobalStakingAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubnetManagerAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubnetManagerAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryPriceHistoryRequest              protoreflect.MessageDescriptor
+	fd_QueryPriceHistoryRequest_netuid       protoreflect.FieldDescriptor
+	fd_QueryPriceHistoryRequest_start_height protoreflect.FieldDescriptor
+	fd_QueryPriceHistoryRequest_end_height   protoreflect.FieldDescriptor
+	fd_QueryPriceHistoryRequest_limit        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_QueryPriceHistoryRequest = File_hetu_event_v1_query_proto.Messages().ByName("QueryPriceHistoryRequest")
+	fd_QueryPriceHistoryRequest_netuid = md_QueryPriceHistoryRequest.Fields().ByName("netuid")
+	fd_QueryPriceHistoryRequest_start_height = md_QueryPriceHistoryRequest.Fields().ByName("start_height")
+	fd_QueryPriceHistoryRequest_end_height = md_QueryPriceHistoryRequest.Fields().ByName("end_height")
+	fd_QueryPriceHistoryRequest_limit = md_QueryPriceHistoryRequest.Fields().ByName("limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPriceHistoryRequest)(nil)
+
+type fastReflection_QueryPriceHistoryRequest QueryPriceHistoryRequest
+
+func (x *QueryPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPriceHistoryRequest)(x)
+}
+
+func (x *QueryPriceHistoryRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPriceHistoryRequest_messageType fastReflection_QueryPriceHistoryRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPriceHistoryRequest_messageType{}
+
+type fastReflection_QueryPriceHistoryRequest_messageType struct{}
+
+func (x fastReflection_QueryPriceHistoryRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPriceHistoryRequest)(nil)
+}
+func (x fastReflection_QueryPriceHistoryRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceHistoryRequest)
+}
+func (x fastReflection_QueryPriceHistoryRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceHistoryRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPriceHistoryRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceHistoryRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPriceHistoryRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPriceHistoryRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPriceHistoryRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceHistoryRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPriceHistoryRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPriceHistoryRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPriceHistoryRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Netuid != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Netuid)
+		if !f(fd_QueryPriceHistoryRequest_netuid, value) {
+			return
+		}
+	}
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_QueryPriceHistoryRequest_start_height, value) {
+			return
+		}
+	}
+	if x.EndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EndHeight)
+		if !f(fd_QueryPriceHistoryRequest_end_height, value) {
+			return
+		}
+	}
+	if x.Limit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Limit)
+		if !f(fd_QueryPriceHistoryRequest_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPriceHistoryRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceHistoryRequest.netuid":
+		return x.Netuid != uint32(0)
+	case "hetu.event.v1.QueryPriceHistoryRequest.start_height":
+		return x.StartHeight != int64(0)
+	case "hetu.event.v1.QueryPriceHistoryRequest.end_height":
+		return x.EndHeight != int64(0)
+	case "hetu.event.v1.QueryPriceHistoryRequest.limit":
+		return x.Limit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceHistoryRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPriceHistoryRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceHistoryRequest.netuid":
+		x.Netuid = uint32(0)
+	case "hetu.event.v1.QueryPriceHistoryRequest.start_height":
+		x.StartHeight = int64(0)
+	case "hetu.event.v1.QueryPriceHistoryRequest.end_height":
+		x.EndHeight = int64(0)
+	case "hetu.event.v1.QueryPriceHistoryRequest.limit":
+		x.Limit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceHistoryRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPriceHistoryRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.QueryPriceHistoryRequest.netuid":
+		value := x.Netuid
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.event.v1.QueryPriceHistoryRequest.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "hetu.event.v1.QueryPriceHistoryRequest.end_height":
+		value := x.EndHeight
+		return protoreflect.ValueOfInt64(value)
+	case "hetu.event.v1.QueryPriceHistoryRequest.limit":
+		value := x.Limit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceHistoryRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceHistoryRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPriceHistoryRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceHistoryRequest.netuid":
+		x.Netuid = uint32(value.Uint())
+	case "hetu.event.v1.QueryPriceHistoryRequest.start_height":
+		x.StartHeight = value.Int()
+	case "hetu.event.v1.QueryPriceHistoryRequest.end_height":
+		x.EndHeight = value.Int()
+	case "hetu.event.v1.QueryPriceHistoryRequest.limit":
+		x.Limit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceHistoryRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPriceHistoryRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceHistoryRequest.netuid":
+		panic(fmt.Errorf("field netuid of message hetu.event.v1.QueryPriceHistoryRequest is not mutable"))
+	case "hetu.event.v1.QueryPriceHistoryRequest.start_height":
+		panic(fmt.Errorf("field start_height of message hetu.event.v1.QueryPriceHistoryRequest is not mutable"))
+	case "hetu.event.v1.QueryPriceHistoryRequest.end_height":
+		panic(fmt.Errorf("field end_height of message hetu.event.v1.QueryPriceHistoryRequest is not mutable"))
+	case "hetu.event.v1.QueryPriceHistoryRequest.limit":
+		panic(fmt.Errorf("field limit of message hetu.event.v1.QueryPriceHistoryRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceHistoryRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPriceHistoryRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceHistoryRequest.netuid":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.event.v1.QueryPriceHistoryRequest.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "hetu.event.v1.QueryPriceHistoryRequest.end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "hetu.event.v1.QueryPriceHistoryRequest.limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceHistoryRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPriceHistoryRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryPriceHistoryRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPriceHistoryRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPriceHistoryRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPriceHistoryRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPriceHistoryRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPriceHistoryRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Netuid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Netuid))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.EndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndHeight))
+		}
+		if x.Limit != 0 {
+			n += 1 + runtime.Sov(uint64(x.Limit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPriceHistoryRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Limit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.EndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Netuid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPriceHistoryRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceHistoryRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				}
+				x.Netuid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Netuid |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+				}
+				x.EndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				}
+				x.Limit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Limit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryPriceHistoryResponse_1_list)(nil)
+
+type _QueryPriceHistoryResponse_1_list struct {
+	list *[]*PriceSnapshot
+}
+
+func (x *_QueryPriceHistoryResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryPriceHistoryResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryPriceHistoryResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceSnapshot)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryPriceHistoryResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceSnapshot)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryPriceHistoryResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(PriceSnapshot)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPriceHistoryResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryPriceHistoryResponse_1_list) NewElement() protoreflect.Value {
+	v := new(PriceSnapshot)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPriceHistoryResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryPriceHistoryResponse             protoreflect.MessageDescriptor
+	fd_QueryPriceHistoryResponse_snapshots   protoreflect.FieldDescriptor
+	fd_QueryPriceHistoryResponse_next_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_QueryPriceHistoryResponse = File_hetu_event_v1_query_proto.Messages().ByName("QueryPriceHistoryResponse")
+	fd_QueryPriceHistoryResponse_snapshots = md_QueryPriceHistoryResponse.Fields().ByName("snapshots")
+	fd_QueryPriceHistoryResponse_next_height = md_QueryPriceHistoryResponse.Fields().ByName("next_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPriceHistoryResponse)(nil)
+
+type fastReflection_QueryPriceHistoryResponse QueryPriceHistoryResponse
+
+func (x *QueryPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPriceHistoryResponse)(x)
+}
+
+func (x *QueryPriceHistoryResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPriceHistoryResponse_messageType fastReflection_QueryPriceHistoryResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPriceHistoryResponse_messageType{}
+
+type fastReflection_QueryPriceHistoryResponse_messageType struct{}
+
+func (x fastReflection_QueryPriceHistoryResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPriceHistoryResponse)(nil)
+}
+func (x fastReflection_QueryPriceHistoryResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceHistoryResponse)
+}
+func (x fastReflection_QueryPriceHistoryResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceHistoryResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPriceHistoryResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceHistoryResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPriceHistoryResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPriceHistoryResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPriceHistoryResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceHistoryResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPriceHistoryResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPriceHistoryResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPriceHistoryResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Snapshots) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPriceHistoryResponse_1_list{list: &x.Snapshots})
+		if !f(fd_QueryPriceHistoryResponse_snapshots, value) {
+			return
+		}
+	}
+	if x.NextHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextHeight)
+		if !f(fd_QueryPriceHistoryResponse_next_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPriceHistoryResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceHistoryResponse.snapshots":
+		return len(x.Snapshots) != 0
+	case "hetu.event.v1.QueryPriceHistoryResponse.next_height":
+		return x.NextHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceHistoryResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPriceHistoryResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceHistoryResponse.snapshots":
+		x.Snapshots = nil
+	case "hetu.event.v1.QueryPriceHistoryResponse.next_height":
+		x.NextHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceHistoryResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPriceHistoryResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.QueryPriceHistoryResponse.snapshots":
+		if len(x.Snapshots) == 0 {
+			return protoreflect.ValueOfList(&_QueryPriceHistoryResponse_1_list{})
+		}
+		listValue := &_QueryPriceHistoryResponse_1_list{list: &x.Snapshots}
+		return protoreflect.ValueOfList(listValue)
+	case "hetu.event.v1.QueryPriceHistoryResponse.next_height":
+		value := x.NextHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceHistoryResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceHistoryResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPriceHistoryResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceHistoryResponse.snapshots":
+		lv := value.List()
+		clv := lv.(*_QueryPriceHistoryResponse_1_list)
+		x.Snapshots = *clv.list
+	case "hetu.event.v1.QueryPriceHistoryResponse.next_height":
+		x.NextHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceHistoryResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPriceHistoryResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceHistoryResponse.snapshots":
+		if x.Snapshots == nil {
+			x.Snapshots = []*PriceSnapshot{}
+		}
+		value := &_QueryPriceHistoryResponse_1_list{list: &x.Snapshots}
+		return protoreflect.ValueOfList(value)
+	case "hetu.event.v1.QueryPriceHistoryResponse.next_height":
+		panic(fmt.Errorf("field next_height of message hetu.event.v1.QueryPriceHistoryResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceHistoryResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPriceHistoryResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceHistoryResponse.snapshots":
+		list := []*PriceSnapshot{}
+		return protoreflect.ValueOfList(&_QueryPriceHistoryResponse_1_list{list: &list})
+	case "hetu.event.v1.QueryPriceHistoryResponse.next_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceHistoryResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPriceHistoryResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryPriceHistoryResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPriceHistoryResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPriceHistoryResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPriceHistoryResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPriceHistoryResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPriceHistoryResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Snapshots) > 0 {
+			for _, e := range x.Snapshots {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPriceHistoryResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NextHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Snapshots) > 0 {
+			for iNdEx := len(x.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Snapshots[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPriceHistoryResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceHistoryResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Snapshots = append(x.Snapshots, &PriceSnapshot{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Snapshots[len(x.Snapshots)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+				}
+				x.NextHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryPriceCandlesRequest              protoreflect.MessageDescriptor
+	fd_QueryPriceCandlesRequest_netuid       protoreflect.FieldDescriptor
+	fd_QueryPriceCandlesRequest_start_height protoreflect.FieldDescriptor
+	fd_QueryPriceCandlesRequest_end_height   protoreflect.FieldDescriptor
+	fd_QueryPriceCandlesRequest_limit        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_QueryPriceCandlesRequest = File_hetu_event_v1_query_proto.Messages().ByName("QueryPriceCandlesRequest")
+	fd_QueryPriceCandlesRequest_netuid = md_QueryPriceCandlesRequest.Fields().ByName("netuid")
+	fd_QueryPriceCandlesRequest_start_height = md_QueryPriceCandlesRequest.Fields().ByName("start_height")
+	fd_QueryPriceCandlesRequest_end_height = md_QueryPriceCandlesRequest.Fields().ByName("end_height")
+	fd_QueryPriceCandlesRequest_limit = md_QueryPriceCandlesRequest.Fields().ByName("limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPriceCandlesRequest)(nil)
+
+type fastReflection_QueryPriceCandlesRequest QueryPriceCandlesRequest
+
+func (x *QueryPriceCandlesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPriceCandlesRequest)(x)
+}
+
+func (x *QueryPriceCandlesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPriceCandlesRequest_messageType fastReflection_QueryPriceCandlesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPriceCandlesRequest_messageType{}
+
+type fastReflection_QueryPriceCandlesRequest_messageType struct{}
+
+func (x fastReflection_QueryPriceCandlesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPriceCandlesRequest)(nil)
+}
+func (x fastReflection_QueryPriceCandlesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceCandlesRequest)
+}
+func (x fastReflection_QueryPriceCandlesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceCandlesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPriceCandlesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceCandlesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPriceCandlesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPriceCandlesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPriceCandlesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceCandlesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPriceCandlesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPriceCandlesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPriceCandlesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Netuid != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Netuid)
+		if !f(fd_QueryPriceCandlesRequest_netuid, value) {
+			return
+		}
+	}
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_QueryPriceCandlesRequest_start_height, value) {
+			return
+		}
+	}
+	if x.EndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EndHeight)
+		if !f(fd_QueryPriceCandlesRequest_end_height, value) {
+			return
+		}
+	}
+	if x.Limit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Limit)
+		if !f(fd_QueryPriceCandlesRequest_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPriceCandlesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceCandlesRequest.netuid":
+		return x.Netuid != uint32(0)
+	case "hetu.event.v1.QueryPriceCandlesRequest.start_height":
+		return x.StartHeight != int64(0)
+	case "hetu.event.v1.QueryPriceCandlesRequest.end_height":
+		return x.EndHeight != int64(0)
+	case "hetu.event.v1.QueryPriceCandlesRequest.limit":
+		return x.Limit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceCandlesRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceCandlesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPriceCandlesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceCandlesRequest.netuid":
+		x.Netuid = uint32(0)
+	case "hetu.event.v1.QueryPriceCandlesRequest.start_height":
+		x.StartHeight = int64(0)
+	case "hetu.event.v1.QueryPriceCandlesRequest.end_height":
+		x.EndHeight = int64(0)
+	case "hetu.event.v1.QueryPriceCandlesRequest.limit":
+		x.Limit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceCandlesRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceCandlesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPriceCandlesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.QueryPriceCandlesRequest.netuid":
+		value := x.Netuid
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.event.v1.QueryPriceCandlesRequest.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "hetu.event.v1.QueryPriceCandlesRequest.end_height":
+		value := x.EndHeight
+		return protoreflect.ValueOfInt64(value)
+	case "hetu.event.v1.QueryPriceCandlesRequest.limit":
+		value := x.Limit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceCandlesRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceCandlesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPriceCandlesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceCandlesRequest.netuid":
+		x.Netuid = uint32(value.Uint())
+	case "hetu.event.v1.QueryPriceCandlesRequest.start_height":
+		x.StartHeight = value.Int()
+	case "hetu.event.v1.QueryPriceCandlesRequest.end_height":
+		x.EndHeight = value.Int()
+	case "hetu.event.v1.QueryPriceCandlesRequest.limit":
+		x.Limit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceCandlesRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceCandlesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPriceCandlesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceCandlesRequest.netuid":
+		panic(fmt.Errorf("field netuid of message hetu.event.v1.QueryPriceCandlesRequest is not mutable"))
+	case "hetu.event.v1.QueryPriceCandlesRequest.start_height":
+		panic(fmt.Errorf("field start_height of message hetu.event.v1.QueryPriceCandlesRequest is not mutable"))
+	case "hetu.event.v1.QueryPriceCandlesRequest.end_height":
+		panic(fmt.Errorf("field end_height of message hetu.event.v1.QueryPriceCandlesRequest is not mutable"))
+	case "hetu.event.v1.QueryPriceCandlesRequest.limit":
+		panic(fmt.Errorf("field limit of message hetu.event.v1.QueryPriceCandlesRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceCandlesRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceCandlesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPriceCandlesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceCandlesRequest.netuid":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.event.v1.QueryPriceCandlesRequest.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "hetu.event.v1.QueryPriceCandlesRequest.end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "hetu.event.v1.QueryPriceCandlesRequest.limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceCandlesRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceCandlesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPriceCandlesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryPriceCandlesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPriceCandlesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPriceCandlesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPriceCandlesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPriceCandlesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPriceCandlesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Netuid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Netuid))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.EndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndHeight))
+		}
+		if x.Limit != 0 {
+			n += 1 + runtime.Sov(uint64(x.Limit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPriceCandlesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Limit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.EndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Netuid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPriceCandlesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceCandlesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceCandlesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				}
+				x.Netuid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Netuid |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+				}
+				x.EndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				}
+				x.Limit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Limit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryPriceCandlesResponse_1_list)(nil)
+
+type _QueryPriceCandlesResponse_1_list struct {
+	list *[]*PriceCandle
+}
+
+func (x *_QueryPriceCandlesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryPriceCandlesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryPriceCandlesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceCandle)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryPriceCandlesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceCandle)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryPriceCandlesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(PriceCandle)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPriceCandlesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryPriceCandlesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(PriceCandle)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPriceCandlesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryPriceCandlesResponse             protoreflect.MessageDescriptor
+	fd_QueryPriceCandlesResponse_candles     protoreflect.FieldDescriptor
+	fd_QueryPriceCandlesResponse_next_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_QueryPriceCandlesResponse = File_hetu_event_v1_query_proto.Messages().ByName("QueryPriceCandlesResponse")
+	fd_QueryPriceCandlesResponse_candles = md_QueryPriceCandlesResponse.Fields().ByName("candles")
+	fd_QueryPriceCandlesResponse_next_height = md_QueryPriceCandlesResponse.Fields().ByName("next_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPriceCandlesResponse)(nil)
+
+type fastReflection_QueryPriceCandlesResponse QueryPriceCandlesResponse
+
+func (x *QueryPriceCandlesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPriceCandlesResponse)(x)
+}
+
+func (x *QueryPriceCandlesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPriceCandlesResponse_messageType fastReflection_QueryPriceCandlesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPriceCandlesResponse_messageType{}
+
+type fastReflection_QueryPriceCandlesResponse_messageType struct{}
+
+func (x fastReflection_QueryPriceCandlesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPriceCandlesResponse)(nil)
+}
+func (x fastReflection_QueryPriceCandlesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceCandlesResponse)
+}
+func (x fastReflection_QueryPriceCandlesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceCandlesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPriceCandlesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceCandlesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPriceCandlesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPriceCandlesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPriceCandlesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceCandlesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPriceCandlesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPriceCandlesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPriceCandlesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Candles) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPriceCandlesResponse_1_list{list: &x.Candles})
+		if !f(fd_QueryPriceCandlesResponse_candles, value) {
+			return
+		}
+	}
+	if x.NextHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextHeight)
+		if !f(fd_QueryPriceCandlesResponse_next_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPriceCandlesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceCandlesResponse.candles":
+		return len(x.Candles) != 0
+	case "hetu.event.v1.QueryPriceCandlesResponse.next_height":
+		return x.NextHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceCandlesResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceCandlesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPriceCandlesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceCandlesResponse.candles":
+		x.Candles = nil
+	case "hetu.event.v1.QueryPriceCandlesResponse.next_height":
+		x.NextHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceCandlesResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceCandlesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPriceCandlesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.QueryPriceCandlesResponse.candles":
+		if len(x.Candles) == 0 {
+			return protoreflect.ValueOfList(&_QueryPriceCandlesResponse_1_list{})
+		}
+		listValue := &_QueryPriceCandlesResponse_1_list{list: &x.Candles}
+		return protoreflect.ValueOfList(listValue)
+	case "hetu.event.v1.QueryPriceCandlesResponse.next_height":
+		value := x.NextHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceCandlesResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceCandlesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPriceCandlesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceCandlesResponse.candles":
+		lv := value.List()
+		clv := lv.(*_QueryPriceCandlesResponse_1_list)
+		x.Candles = *clv.list
+	case "hetu.event.v1.QueryPriceCandlesResponse.next_height":
+		x.NextHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceCandlesResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceCandlesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPriceCandlesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceCandlesResponse.candles":
+		if x.Candles == nil {
+			x.Candles = []*PriceCandle{}
+		}
+		value := &_QueryPriceCandlesResponse_1_list{list: &x.Candles}
+		return protoreflect.ValueOfList(value)
+	case "hetu.event.v1.QueryPriceCandlesResponse.next_height":
+		panic(fmt.Errorf("field next_height of message hetu.event.v1.QueryPriceCandlesResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceCandlesResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceCandlesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPriceCandlesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryPriceCandlesResponse.candles":
+		list := []*PriceCandle{}
+		return protoreflect.ValueOfList(&_QueryPriceCandlesResponse_1_list{list: &list})
+	case "hetu.event.v1.QueryPriceCandlesResponse.next_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryPriceCandlesResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryPriceCandlesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPriceCandlesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryPriceCandlesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPriceCandlesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPriceCandlesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPriceCandlesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPriceCandlesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPriceCandlesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Candles) > 0 {
+			for _, e := range x.Candles {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPriceCandlesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NextHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Candles) > 0 {
+			for iNdEx := len(x.Candles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Candles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPriceCandlesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceCandlesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceCandlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Candles = append(x.Candles, &PriceCandle{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Candles[len(x.Candles)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+				}
+				x.NextHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryChildKeysRequest        protoreflect.MessageDescriptor
+	fd_QueryChildKeysRequest_netuid protoreflect.FieldDescriptor
+	fd_QueryChildKeysRequest_hotkey protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_QueryChildKeysRequest = File_hetu_event_v1_query_proto.Messages().ByName("QueryChildKeysRequest")
+	fd_QueryChildKeysRequest_netuid = md_QueryChildKeysRequest.Fields().ByName("netuid")
+	fd_QueryChildKeysRequest_hotkey = md_QueryChildKeysRequest.Fields().ByName("hotkey")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryChildKeysRequest)(nil)
+
+type fastReflection_QueryChildKeysRequest QueryChildKeysRequest
+
+func (x *QueryChildKeysRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryChildKeysRequest)(x)
+}
+
+func (x *QueryChildKeysRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryChildKeysRequest_messageType fastReflection_QueryChildKeysRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryChildKeysRequest_messageType{}
+
+type fastReflection_QueryChildKeysRequest_messageType struct{}
+
+func (x fastReflection_QueryChildKeysRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryChildKeysRequest)(nil)
+}
+func (x fastReflection_QueryChildKeysRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryChildKeysRequest)
+}
+func (x fastReflection_QueryChildKeysRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryChildKeysRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryChildKeysRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryChildKeysRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryChildKeysRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryChildKeysRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryChildKeysRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryChildKeysRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryChildKeysRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryChildKeysRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryChildKeysRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Netuid != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Netuid)
+		if !f(fd_QueryChildKeysRequest_netuid, value) {
+			return
+		}
+	}
+	if x.Hotkey != "" {
+		value := protoreflect.ValueOfString(x.Hotkey)
+		if !f(fd_QueryChildKeysRequest_hotkey, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryChildKeysRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryChildKeysRequest.netuid":
+		return x.Netuid != uint32(0)
+	case "hetu.event.v1.QueryChildKeysRequest.hotkey":
+		return x.Hotkey != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryChildKeysRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryChildKeysRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryChildKeysRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryChildKeysRequest.netuid":
+		x.Netuid = uint32(0)
+	case "hetu.event.v1.QueryChildKeysRequest.hotkey":
+		x.Hotkey = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryChildKeysRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryChildKeysRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryChildKeysRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.QueryChildKeysRequest.netuid":
+		value := x.Netuid
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.event.v1.QueryChildKeysRequest.hotkey":
+		value := x.Hotkey
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryChildKeysRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryChildKeysRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryChildKeysRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryChildKeysRequest.netuid":
+		x.Netuid = uint32(value.Uint())
+	case "hetu.event.v1.QueryChildKeysRequest.hotkey":
+		x.Hotkey = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryChildKeysRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryChildKeysRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryChildKeysRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryChildKeysRequest.netuid":
+		panic(fmt.Errorf("field netuid of message hetu.event.v1.QueryChildKeysRequest is not mutable"))
+	case "hetu.event.v1.QueryChildKeysRequest.hotkey":
+		panic(fmt.Errorf("field hotkey of message hetu.event.v1.QueryChildKeysRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryChildKeysRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryChildKeysRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryChildKeysRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryChildKeysRequest.netuid":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.event.v1.QueryChildKeysRequest.hotkey":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryChildKeysRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryChildKeysRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryChildKeysRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryChildKeysRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryChildKeysRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryChildKeysRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryChildKeysRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryChildKeysRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryChildKeysRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Netuid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Netuid))
+		}
+		l = len(x.Hotkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryChildKeysRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Hotkey) > 0 {
+			i -= len(x.Hotkey)
+			copy(dAtA[i:], x.Hotkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hotkey)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Netuid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryChildKeysRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryChildKeysRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryChildKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				}
+				x.Netuid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Netuid |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hotkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hotkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryChildKeysResponse_3_list)(nil)
+
+type _QueryChildKeysResponse_3_list struct {
+	list *[]*ChildKeyInfo
+}
+
+func (x *_QueryChildKeysResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryChildKeysResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryChildKeysResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChildKeyInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryChildKeysResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChildKeyInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryChildKeysResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(ChildKeyInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryChildKeysResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryChildKeysResponse_3_list) NewElement() protoreflect.Value {
+	v := new(ChildKeyInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryChildKeysResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryChildKeysResponse_4_list)(nil)
+
+type _QueryChildKeysResponse_4_list struct {
+	list *[]*ChildKeyInfo
+}
+
+func (x *_QueryChildKeysResponse_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryChildKeysResponse_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryChildKeysResponse_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChildKeyInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryChildKeysResponse_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChildKeyInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryChildKeysResponse_4_list) AppendMutable() protoreflect.Value {
+	v := new(ChildKeyInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryChildKeysResponse_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryChildKeysResponse_4_list) NewElement() protoreflect.Value {
+	v := new(ChildKeyInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryChildKeysResponse_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryChildKeysResponse_6_list)(nil)
+
+type _QueryChildKeysResponse_6_list struct {
+	list *[]*ChildKeyInfo
+}
+
+func (x *_QueryChildKeysResponse_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryChildKeysResponse_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryChildKeysResponse_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChildKeyInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryChildKeysResponse_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChildKeyInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryChildKeysResponse_6_list) AppendMutable() protoreflect.Value {
+	v := new(ChildKeyInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryChildKeysResponse_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryChildKeysResponse_6_list) NewElement() protoreflect.Value {
+	v := new(ChildKeyInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryChildKeysResponse_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryChildKeysResponse                  protoreflect.MessageDescriptor
+	fd_QueryChildKeysResponse_netuid           protoreflect.FieldDescriptor
+	fd_QueryChildKeysResponse_hotkey           protoreflect.FieldDescriptor
+	fd_QueryChildKeysResponse_children         protoreflect.FieldDescriptor
+	fd_QueryChildKeysResponse_pending_children protoreflect.FieldDescriptor
+	fd_QueryChildKeysResponse_cooldown_block   protoreflect.FieldDescriptor
+	fd_QueryChildKeysResponse_parents          protoreflect.FieldDescriptor
+	fd_QueryChildKeysResponse_take             protoreflect.FieldDescriptor
+	fd_QueryChildKeysResponse_stake            protoreflect.FieldDescriptor
+	fd_QueryChildKeysResponse_effective_stake  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_QueryChildKeysResponse = File_hetu_event_v1_query_proto.Messages().ByName("QueryChildKeysResponse")
+	fd_QueryChildKeysResponse_netuid = md_QueryChildKeysResponse.Fields().ByName("netuid")
+	fd_QueryChildKeysResponse_hotkey = md_QueryChildKeysResponse.Fields().ByName("hotkey")
+	fd_QueryChildKeysResponse_children = md_QueryChildKeysResponse.Fields().ByName("children")
+	fd_QueryChildKeysResponse_pending_children = md_QueryChildKeysResponse.Fields().ByName("pending_children")
+	fd_QueryChildKeysResponse_cooldown_block = md_QueryChildKeysResponse.Fields().ByName("cooldown_block")
+	fd_QueryChildKeysResponse_parents = md_QueryChildKeysResponse.Fields().ByName("parents")
+	fd_QueryChildKeysResponse_take = md_QueryChildKeysResponse.Fields().ByName("take")
+	fd_QueryChildKeysResponse_stake = md_QueryChildKeysResponse.Fields().ByName("stake")
+	fd_QueryChildKeysResponse_effective_stake = md_QueryChildKeysResponse.Fields().ByName("effective_stake")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryChildKeysResponse)(nil)
+
+type fastReflection_QueryChildKeysResponse QueryChildKeysResponse
+
+func (x *QueryChildKeysResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryChildKeysResponse)(x)
+}
+
+func (x *QueryChildKeysResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryChildKeysResponse_messageType fastReflection_QueryChildKeysResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryChildKeysResponse_messageType{}
+
+type fastReflection_QueryChildKeysResponse_messageType struct{}
+
+func (x fastReflection_QueryChildKeysResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryChildKeysResponse)(nil)
+}
+func (x fastReflection_QueryChildKeysResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryChildKeysResponse)
+}
+func (x fastReflection_QueryChildKeysResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryChildKeysResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryChildKeysResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryChildKeysResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryChildKeysResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryChildKeysResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryChildKeysResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryChildKeysResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryChildKeysResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryChildKeysResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryChildKeysResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Netuid != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Netuid)
+		if !f(fd_QueryChildKeysResponse_netuid, value) {
+			return
+		}
+	}
+	if x.Hotkey != "" {
+		value := protoreflect.ValueOfString(x.Hotkey)
+		if !f(fd_QueryChildKeysResponse_hotkey, value) {
+			return
+		}
+	}
+	if len(x.Children) != 0 {
+		value := protoreflect.ValueOfList(&_QueryChildKeysResponse_3_list{list: &x.Children})
+		if !f(fd_QueryChildKeysResponse_children, value) {
+			return
+		}
+	}
+	if len(x.PendingChildren) != 0 {
+		value := protoreflect.ValueOfList(&_QueryChildKeysResponse_4_list{list: &x.PendingChildren})
+		if !f(fd_QueryChildKeysResponse_pending_children, value) {
+			return
+		}
+	}
+	if x.CooldownBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CooldownBlock)
+		if !f(fd_QueryChildKeysResponse_cooldown_block, value) {
+			return
+		}
+	}
+	if len(x.Parents) != 0 {
+		value := protoreflect.ValueOfList(&_QueryChildKeysResponse_6_list{list: &x.Parents})
+		if !f(fd_QueryChildKeysResponse_parents, value) {
+			return
+		}
+	}
+	if x.Take != "" {
+		value := protoreflect.ValueOfString(x.Take)
+		if !f(fd_QueryChildKeysResponse_take, value) {
+			return
+		}
+	}
+	if x.Stake != "" {
+		value := protoreflect.ValueOfString(x.Stake)
+		if !f(fd_QueryChildKeysResponse_stake, value) {
+			return
+		}
+	}
+	if x.EffectiveStake != "" {
+		value := protoreflect.ValueOfString(x.EffectiveStake)
+		if !f(fd_QueryChildKeysResponse_effective_stake, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryChildKeysResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryChildKeysResponse.netuid":
+		return x.Netuid != uint32(0)
+	case "hetu.event.v1.QueryChildKeysResponse.hotkey":
+		return x.Hotkey != ""
+	case "hetu.event.v1.QueryChildKeysResponse.children":
+		return len(x.Children) != 0
+	case "hetu.event.v1.QueryChildKeysResponse.pending_children":
+		return len(x.PendingChildren) != 0
+	case "hetu.event.v1.QueryChildKeysResponse.cooldown_block":
+		return x.CooldownBlock != uint64(0)
+	case "hetu.event.v1.QueryChildKeysResponse.parents":
+		return len(x.Parents) != 0
+	case "hetu.event.v1.QueryChildKeysResponse.take":
+		return x.Take != ""
+	case "hetu.event.v1.QueryChildKeysResponse.stake":
+		return x.Stake != ""
+	case "hetu.event.v1.QueryChildKeysResponse.effective_stake":
+		return x.EffectiveStake != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryChildKeysResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryChildKeysResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryChildKeysResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryChildKeysResponse.netuid":
+		x.Netuid = uint32(0)
+	case "hetu.event.v1.QueryChildKeysResponse.hotkey":
+		x.Hotkey = ""
+	case "hetu.event.v1.QueryChildKeysResponse.children":
+		x.Children = nil
+	case "hetu.event.v1.QueryChildKeysResponse.pending_children":
+		x.PendingChildren = nil
+	case "hetu.event.v1.QueryChildKeysResponse.cooldown_block":
+		x.CooldownBlock = uint64(0)
+	case "hetu.event.v1.QueryChildKeysResponse.parents":
+		x.Parents = nil
+	case "hetu.event.v1.QueryChildKeysResponse.take":
+		x.Take = ""
+	case "hetu.event.v1.QueryChildKeysResponse.stake":
+		x.Stake = ""
+	case "hetu.event.v1.QueryChildKeysResponse.effective_stake":
+		x.EffectiveStake = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryChildKeysResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryChildKeysResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryChildKeysResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.QueryChildKeysResponse.netuid":
+		value := x.Netuid
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.event.v1.QueryChildKeysResponse.hotkey":
+		value := x.Hotkey
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.QueryChildKeysResponse.children":
+		if len(x.Children) == 0 {
+			return protoreflect.ValueOfList(&_QueryChildKeysResponse_3_list{})
+		}
+		listValue := &_QueryChildKeysResponse_3_list{list: &x.Children}
+		return protoreflect.ValueOfList(listValue)
+	case "hetu.event.v1.QueryChildKeysResponse.pending_children":
+		if len(x.PendingChildren) == 0 {
+			return protoreflect.ValueOfList(&_QueryChildKeysResponse_4_list{})
+		}
+		listValue := &_QueryChildKeysResponse_4_list{list: &x.PendingChildren}
+		return protoreflect.ValueOfList(listValue)
+	case "hetu.event.v1.QueryChildKeysResponse.cooldown_block":
+		value := x.CooldownBlock
+		return protoreflect.ValueOfUint64(value)
+	case "hetu.event.v1.QueryChildKeysResponse.parents":
+		if len(x.Parents) == 0 {
+			return protoreflect.ValueOfList(&_QueryChildKeysResponse_6_list{})
+		}
+		listValue := &_QueryChildKeysResponse_6_list{list: &x.Parents}
+		return protoreflect.ValueOfList(listValue)
+	case "hetu.event.v1.QueryChildKeysResponse.take":
+		value := x.Take
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.QueryChildKeysResponse.stake":
+		value := x.Stake
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.QueryChildKeysResponse.effective_stake":
+		value := x.EffectiveStake
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryChildKeysResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryChildKeysResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryChildKeysResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryChildKeysResponse.netuid":
+		x.Netuid = uint32(value.Uint())
+	case "hetu.event.v1.QueryChildKeysResponse.hotkey":
+		x.Hotkey = value.Interface().(string)
+	case "hetu.event.v1.QueryChildKeysResponse.children":
+		lv := value.List()
+		clv := lv.(*_QueryChildKeysResponse_3_list)
+		x.Children = *clv.list
+	case "hetu.event.v1.QueryChildKeysResponse.pending_children":
+		lv := value.List()
+		clv := lv.(*_QueryChildKeysResponse_4_list)
+		x.PendingChildren = *clv.list
+	case "hetu.event.v1.QueryChildKeysResponse.cooldown_block":
+		x.CooldownBlock = value.Uint()
+	case "hetu.event.v1.QueryChildKeysResponse.parents":
+		lv := value.List()
+		clv := lv.(*_QueryChildKeysResponse_6_list)
+		x.Parents = *clv.list
+	case "hetu.event.v1.QueryChildKeysResponse.take":
+		x.Take = value.Interface().(string)
+	case "hetu.event.v1.QueryChildKeysResponse.stake":
+		x.Stake = value.Interface().(string)
+	case "hetu.event.v1.QueryChildKeysResponse.effective_stake":
+		x.EffectiveStake = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryChildKeysResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryChildKeysResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryChildKeysResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryChildKeysResponse.children":
+		if x.Children == nil {
+			x.Children = []*ChildKeyInfo{}
+		}
+		value := &_QueryChildKeysResponse_3_list{list: &x.Children}
+		return protoreflect.ValueOfList(value)
+	case "hetu.event.v1.QueryChildKeysResponse.pending_children":
+		if x.PendingChildren == nil {
+			x.PendingChildren = []*ChildKeyInfo{}
+		}
+		value := &_QueryChildKeysResponse_4_list{list: &x.PendingChildren}
+		return protoreflect.ValueOfList(value)
+	case "hetu.event.v1.QueryChildKeysResponse.parents":
+		if x.Parents == nil {
+			x.Parents = []*ChildKeyInfo{}
+		}
+		value := &_QueryChildKeysResponse_6_list{list: &x.Parents}
+		return protoreflect.ValueOfList(value)
+	case "hetu.event.v1.QueryChildKeysResponse.netuid":
+		panic(fmt.Errorf("field netuid of message hetu.event.v1.QueryChildKeysResponse is not mutable"))
+	case "hetu.event.v1.QueryChildKeysResponse.hotkey":
+		panic(fmt.Errorf("field hotkey of message hetu.event.v1.QueryChildKeysResponse is not mutable"))
+	case "hetu.event.v1.QueryChildKeysResponse.cooldown_block":
+		panic(fmt.Errorf("field cooldown_block of message hetu.event.v1.QueryChildKeysResponse is not mutable"))
+	case "hetu.event.v1.QueryChildKeysResponse.take":
+		panic(fmt.Errorf("field take of message hetu.event.v1.QueryChildKeysResponse is not mutable"))
+	case "hetu.event.v1.QueryChildKeysResponse.stake":
+		panic(fmt.Errorf("field stake of message hetu.event.v1.QueryChildKeysResponse is not mutable"))
+	case "hetu.event.v1.QueryChildKeysResponse.effective_stake":
+		panic(fmt.Errorf("field effective_stake of message hetu.event.v1.QueryChildKeysResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryChildKeysResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryChildKeysResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryChildKeysResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryChildKeysResponse.netuid":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.event.v1.QueryChildKeysResponse.hotkey":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.QueryChildKeysResponse.children":
+		list := []*ChildKeyInfo{}
+		return protoreflect.ValueOfList(&_QueryChildKeysResponse_3_list{list: &list})
+	case "hetu.event.v1.QueryChildKeysResponse.pending_children":
+		list := []*ChildKeyInfo{}
+		return protoreflect.ValueOfList(&_QueryChildKeysResponse_4_list{list: &list})
+	case "hetu.event.v1.QueryChildKeysResponse.cooldown_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "hetu.event.v1.QueryChildKeysResponse.parents":
+		list := []*ChildKeyInfo{}
+		return protoreflect.ValueOfList(&_QueryChildKeysResponse_6_list{list: &list})
+	case "hetu.event.v1.QueryChildKeysResponse.take":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.QueryChildKeysResponse.stake":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.QueryChildKeysResponse.effective_stake":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryChildKeysResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryChildKeysResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryChildKeysResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryChildKeysResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryChildKeysResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryChildKeysResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryChildKeysResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryChildKeysResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryChildKeysResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Netuid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Netuid))
+		}
+		l = len(x.Hotkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Children) > 0 {
+			for _, e := range x.Children {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PendingChildren) > 0 {
+			for _, e := range x.PendingChildren {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CooldownBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.CooldownBlock))
+		}
+		if len(x.Parents) > 0 {
+			for _, e := range x.Parents {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Take)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Stake)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EffectiveStake)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryChildKeysResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EffectiveStake) > 0 {
+			i -= len(x.EffectiveStake)
+			copy(dAtA[i:], x.EffectiveStake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EffectiveStake)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Stake) > 0 {
+			i -= len(x.Stake)
+			copy(dAtA[i:], x.Stake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Stake)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Take) > 0 {
+			i -= len(x.Take)
+			copy(dAtA[i:], x.Take)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Take)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Parents) > 0 {
+			for iNdEx := len(x.Parents) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Parents[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.CooldownBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CooldownBlock))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.PendingChildren) > 0 {
+			for iNdEx := len(x.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingChildren[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Children) > 0 {
+			for iNdEx := len(x.Children) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Children[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Hotkey) > 0 {
+			i -= len(x.Hotkey)
+			copy(dAtA[i:], x.Hotkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hotkey)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Netuid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryChildKeysResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryChildKeysResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryChildKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				}
+				x.Netuid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Netuid |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hotkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hotkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Children = append(x.Children, &ChildKeyInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Children[len(x.Children)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingChildren = append(x.PendingChildren, &ChildKeyInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingChildren[len(x.PendingChildren)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CooldownBlock", wireType)
+				}
+				x.CooldownBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CooldownBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Parents", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Parents = append(x.Parents, &ChildKeyInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Parents[len(x.Parents)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Take", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Take = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Stake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveStake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EffectiveStake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ChildKeyInfo            protoreflect.MessageDescriptor
+	fd_ChildKeyInfo_hotkey     protoreflect.FieldDescriptor
+	fd_ChildKeyInfo_proportion protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_ChildKeyInfo = File_hetu_event_v1_query_proto.Messages().ByName("ChildKeyInfo")
+	fd_ChildKeyInfo_hotkey = md_ChildKeyInfo.Fields().ByName("hotkey")
+	fd_ChildKeyInfo_proportion = md_ChildKeyInfo.Fields().ByName("proportion")
+}
+
+var _ protoreflect.Message = (*fastReflection_ChildKeyInfo)(nil)
+
+type fastReflection_ChildKeyInfo ChildKeyInfo
+
+func (x *ChildKeyInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ChildKeyInfo)(x)
+}
+
+func (x *ChildKeyInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ChildKeyInfo_messageType fastReflection_ChildKeyInfo_messageType
+var _ protoreflect.MessageType = fastReflection_ChildKeyInfo_messageType{}
+
+type fastReflection_ChildKeyInfo_messageType struct{}
+
+func (x fastReflection_ChildKeyInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ChildKeyInfo)(nil)
+}
+func (x fastReflection_ChildKeyInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_ChildKeyInfo)
+}
+func (x fastReflection_ChildKeyInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChildKeyInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ChildKeyInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChildKeyInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ChildKeyInfo) Type() protoreflect.MessageType {
+	return _fastReflection_ChildKeyInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ChildKeyInfo) New() protoreflect.Message {
+	return new(fastReflection_ChildKeyInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ChildKeyInfo) Interface() protoreflect.ProtoMessage {
+	return (*ChildKeyInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ChildKeyInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hotkey != "" {
+		value := protoreflect.ValueOfString(x.Hotkey)
+		if !f(fd_ChildKeyInfo_hotkey, value) {
+			return
+		}
+	}
+	if x.Proportion != "" {
+		value := protoreflect.ValueOfString(x.Proportion)
+		if !f(fd_ChildKeyInfo_proportion, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ChildKeyInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.ChildKeyInfo.hotkey":
+		return x.Hotkey != ""
+	case "hetu.event.v1.ChildKeyInfo.proportion":
+		return x.Proportion != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.ChildKeyInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.ChildKeyInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChildKeyInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.ChildKeyInfo.hotkey":
+		x.Hotkey = ""
+	case "hetu.event.v1.ChildKeyInfo.proportion":
+		x.Proportion = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.ChildKeyInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.ChildKeyInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ChildKeyInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.ChildKeyInfo.hotkey":
+		value := x.Hotkey
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.ChildKeyInfo.proportion":
+		value := x.Proportion
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.ChildKeyInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.ChildKeyInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChildKeyInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.ChildKeyInfo.hotkey":
+		x.Hotkey = value.Interface().(string)
+	case "hetu.event.v1.ChildKeyInfo.proportion":
+		x.Proportion = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.ChildKeyInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.ChildKeyInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChildKeyInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.ChildKeyInfo.hotkey":
+		panic(fmt.Errorf("field hotkey of message hetu.event.v1.ChildKeyInfo is not mutable"))
+	case "hetu.event.v1.ChildKeyInfo.proportion":
+		panic(fmt.Errorf("field proportion of message hetu.event.v1.ChildKeyInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.ChildKeyInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.ChildKeyInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ChildKeyInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.ChildKeyInfo.hotkey":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.ChildKeyInfo.proportion":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.ChildKeyInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.ChildKeyInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ChildKeyInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.ChildKeyInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ChildKeyInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChildKeyInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ChildKeyInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ChildKeyInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ChildKeyInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Hotkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Proportion)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ChildKeyInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Proportion) > 0 {
+			i -= len(x.Proportion)
+			copy(dAtA[i:], x.Proportion)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proportion)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Hotkey) > 0 {
+			i -= len(x.Hotkey)
+			copy(dAtA[i:], x.Hotkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hotkey)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ChildKeyInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChildKeyInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChildKeyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hotkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hotkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proportion", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proportion = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PriceSnapshot                  protoreflect.MessageDescriptor
+	fd_PriceSnapshot_netuid           protoreflect.FieldDescriptor
+	fd_PriceSnapshot_height           protoreflect.FieldDescriptor
+	fd_PriceSnapshot_price            protoreflect.FieldDescriptor
+	fd_PriceSnapshot_moving_price     protoreflect.FieldDescriptor
+	fd_PriceSnapshot_tao_in           protoreflect.FieldDescriptor
+	fd_PriceSnapshot_alpha_in         protoreflect.FieldDescriptor
+	fd_PriceSnapshot_alpha_out        protoreflect.FieldDescriptor
+	fd_PriceSnapshot_pending_emission protoreflect.FieldDescriptor
+	fd_PriceSnapshot_owner_cut        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_PriceSnapshot = File_hetu_event_v1_query_proto.Messages().ByName("PriceSnapshot")
+	fd_PriceSnapshot_netuid = md_PriceSnapshot.Fields().ByName("netuid")
+	fd_PriceSnapshot_height = md_PriceSnapshot.Fields().ByName("height")
+	fd_PriceSnapshot_price = md_PriceSnapshot.Fields().ByName("price")
+	fd_PriceSnapshot_moving_price = md_PriceSnapshot.Fields().ByName("moving_price")
+	fd_PriceSnapshot_tao_in = md_PriceSnapshot.Fields().ByName("tao_in")
+	fd_PriceSnapshot_alpha_in = md_PriceSnapshot.Fields().ByName("alpha_in")
+	fd_PriceSnapshot_alpha_out = md_PriceSnapshot.Fields().ByName("alpha_out")
+	fd_PriceSnapshot_pending_emission = md_PriceSnapshot.Fields().ByName("pending_emission")
+	fd_PriceSnapshot_owner_cut = md_PriceSnapshot.Fields().ByName("owner_cut")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceSnapshot)(nil)
+
+type fastReflection_PriceSnapshot PriceSnapshot
+
+func (x *PriceSnapshot) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceSnapshot)(x)
+}
+
+func (x *PriceSnapshot) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceSnapshot_messageType fastReflection_PriceSnapshot_messageType
+var _ protoreflect.MessageType = fastReflection_PriceSnapshot_messageType{}
+
+type fastReflection_PriceSnapshot_messageType struct{}
+
+func (x fastReflection_PriceSnapshot_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceSnapshot)(nil)
+}
+func (x fastReflection_PriceSnapshot_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceSnapshot)
+}
+func (x fastReflection_PriceSnapshot_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceSnapshot
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceSnapshot) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceSnapshot
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceSnapshot) Type() protoreflect.MessageType {
+	return _fastReflection_PriceSnapshot_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceSnapshot) New() protoreflect.Message {
+	return new(fastReflection_PriceSnapshot)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceSnapshot) Interface() protoreflect.ProtoMessage {
+	return (*PriceSnapshot)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceSnapshot) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Netuid != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Netuid)
+		if !f(fd_PriceSnapshot_netuid, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_PriceSnapshot_height, value) {
+			return
+		}
+	}
+	if x.Price != "" {
+		value := protoreflect.ValueOfString(x.Price)
+		if !f(fd_PriceSnapshot_price, value) {
+			return
+		}
+	}
+	if x.MovingPrice != "" {
+		value := protoreflect.ValueOfString(x.MovingPrice)
+		if !f(fd_PriceSnapshot_moving_price, value) {
+			return
+		}
+	}
+	if x.TaoIn != "" {
+		value := protoreflect.ValueOfString(x.TaoIn)
+		if !f(fd_PriceSnapshot_tao_in, value) {
+			return
+		}
+	}
+	if x.AlphaIn != "" {
+		value := protoreflect.ValueOfString(x.AlphaIn)
+		if !f(fd_PriceSnapshot_alpha_in, value) {
+			return
+		}
+	}
+	if x.AlphaOut != "" {
+		value := protoreflect.ValueOfString(x.AlphaOut)
+		if !f(fd_PriceSnapshot_alpha_out, value) {
+			return
+		}
+	}
+	if x.PendingEmission != "" {
+		value := protoreflect.ValueOfString(x.PendingEmission)
+		if !f(fd_PriceSnapshot_pending_emission, value) {
+			return
+		}
+	}
+	if x.OwnerCut != "" {
+		value := protoreflect.ValueOfString(x.OwnerCut)
+		if !f(fd_PriceSnapshot_owner_cut, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceSnapshot) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.PriceSnapshot.netuid":
+		return x.Netuid != uint32(0)
+	case "hetu.event.v1.PriceSnapshot.height":
+		return x.Height != int64(0)
+	case "hetu.event.v1.PriceSnapshot.price":
+		return x.Price != ""
+	case "hetu.event.v1.PriceSnapshot.moving_price":
+		return x.MovingPrice != ""
+	case "hetu.event.v1.PriceSnapshot.tao_in":
+		return x.TaoIn != ""
+	case "hetu.event.v1.PriceSnapshot.alpha_in":
+		return x.AlphaIn != ""
+	case "hetu.event.v1.PriceSnapshot.alpha_out":
+		return x.AlphaOut != ""
+	case "hetu.event.v1.PriceSnapshot.pending_emission":
+		return x.PendingEmission != ""
+	case "hetu.event.v1.PriceSnapshot.owner_cut":
+		return x.OwnerCut != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.PriceSnapshot"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.PriceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceSnapshot) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.PriceSnapshot.netuid":
+		x.Netuid = uint32(0)
+	case "hetu.event.v1.PriceSnapshot.height":
+		x.Height = int64(0)
+	case "hetu.event.v1.PriceSnapshot.price":
+		x.Price = ""
+	case "hetu.event.v1.PriceSnapshot.moving_price":
+		x.MovingPrice = ""
+	case "hetu.event.v1.PriceSnapshot.tao_in":
+		x.TaoIn = ""
+	case "hetu.event.v1.PriceSnapshot.alpha_in":
+		x.AlphaIn = ""
+	case "hetu.event.v1.PriceSnapshot.alpha_out":
+		x.AlphaOut = ""
+	case "hetu.event.v1.PriceSnapshot.pending_emission":
+		x.PendingEmission = ""
+	case "hetu.event.v1.PriceSnapshot.owner_cut":
+		x.OwnerCut = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.PriceSnapshot"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.PriceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceSnapshot) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.PriceSnapshot.netuid":
+		value := x.Netuid
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.event.v1.PriceSnapshot.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "hetu.event.v1.PriceSnapshot.price":
+		value := x.Price
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.PriceSnapshot.moving_price":
+		value := x.MovingPrice
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.PriceSnapshot.tao_in":
+		value := x.TaoIn
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.PriceSnapshot.alpha_in":
+		value := x.AlphaIn
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.PriceSnapshot.alpha_out":
+		value := x.AlphaOut
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.PriceSnapshot.pending_emission":
+		value := x.PendingEmission
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.PriceSnapshot.owner_cut":
+		value := x.OwnerCut
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.PriceSnapshot"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.PriceSnapshot does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceSnapshot) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.PriceSnapshot.netuid":
+		x.Netuid = uint32(value.Uint())
+	case "hetu.event.v1.PriceSnapshot.height":
+		x.Height = value.Int()
+	case "hetu.event.v1.PriceSnapshot.price":
+		x.Price = value.Interface().(string)
+	case "hetu.event.v1.PriceSnapshot.moving_price":
+		x.MovingPrice = value.Interface().(string)
+	case "hetu.event.v1.PriceSnapshot.tao_in":
+		x.TaoIn = value.Interface().(string)
+	case "hetu.event.v1.PriceSnapshot.alpha_in":
+		x.AlphaIn = value.Interface().(string)
+	case "hetu.event.v1.PriceSnapshot.alpha_out":
+		x.AlphaOut = value.Interface().(string)
+	case "hetu.event.v1.PriceSnapshot.pending_emission":
+		x.PendingEmission = value.Interface().(string)
+	case "hetu.event.v1.PriceSnapshot.owner_cut":
+		x.OwnerCut = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.PriceSnapshot"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.PriceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceSnapshot) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.PriceSnapshot.netuid":
+		panic(fmt.Errorf("field netuid of message hetu.event.v1.PriceSnapshot is not mutable"))
+	case "hetu.event.v1.PriceSnapshot.height":
+		panic(fmt.Errorf("field height of message hetu.event.v1.PriceSnapshot is not mutable"))
+	case "hetu.event.v1.PriceSnapshot.price":
+		panic(fmt.Errorf("field price of message hetu.event.v1.PriceSnapshot is not mutable"))
+	case "hetu.event.v1.PriceSnapshot.moving_price":
+		panic(fmt.Errorf("field moving_price of message hetu.event.v1.PriceSnapshot is not mutable"))
+	case "hetu.event.v1.PriceSnapshot.tao_in":
+		panic(fmt.Errorf("field tao_in of message hetu.event.v1.PriceSnapshot is not mutable"))
+	case "hetu.event.v1.PriceSnapshot.alpha_in":
+		panic(fmt.Errorf("field alpha_in of message hetu.event.v1.PriceSnapshot is not mutable"))
+	case "hetu.event.v1.PriceSnapshot.alpha_out":
+		panic(fmt.Errorf("field alpha_out of message hetu.event.v1.PriceSnapshot is not mutable"))
+	case "hetu.event.v1.PriceSnapshot.pending_emission":
+		panic(fmt.Errorf("field pending_emission of message hetu.event.v1.PriceSnapshot is not mutable"))
+	case "hetu.event.v1.PriceSnapshot.owner_cut":
+		panic(fmt.Errorf("field owner_cut of message hetu.event.v1.PriceSnapshot is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.PriceSnapshot"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.PriceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceSnapshot) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.PriceSnapshot.netuid":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.event.v1.PriceSnapshot.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "hetu.event.v1.PriceSnapshot.price":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.PriceSnapshot.moving_price":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.PriceSnapshot.tao_in":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.PriceSnapshot.alpha_in":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.PriceSnapshot.alpha_out":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.PriceSnapshot.pending_emission":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.PriceSnapshot.owner_cut":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.PriceSnapshot"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.PriceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceSnapshot) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.PriceSnapshot", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceSnapshot) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceSnapshot) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceSnapshot) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceSnapshot) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceSnapshot)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Netuid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Netuid))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Price)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MovingPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaoIn)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AlphaIn)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AlphaOut)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PendingEmission)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OwnerCut)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceSnapshot)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.OwnerCut) > 0 {
+			i -= len(x.OwnerCut)
+			copy(dAtA[i:], x.OwnerCut)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerCut)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.PendingEmission) > 0 {
+			i -= len(x.PendingEmission)
+			copy(dAtA[i:], x.PendingEmission)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingEmission)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.AlphaOut) > 0 {
+			i -= len(x.AlphaOut)
+			copy(dAtA[i:], x.AlphaOut)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AlphaOut)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.AlphaIn) > 0 {
+			i -= len(x.AlphaIn)
+			copy(dAtA[i:], x.AlphaIn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AlphaIn)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.TaoIn) > 0 {
+			i -= len(x.TaoIn)
+			copy(dAtA[i:], x.TaoIn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaoIn)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.MovingPrice) > 0 {
+			i -= len(x.MovingPrice)
+			copy(dAtA[i:], x.MovingPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MovingPrice)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Price) > 0 {
+			i -= len(x.Price)
+			copy(dAtA[i:], x.Price)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Price)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Netuid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceSnapshot)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				}
+				x.Netuid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Netuid |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Price = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MovingPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MovingPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaoIn", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaoIn = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AlphaIn", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AlphaIn = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AlphaOut", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AlphaOut = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingEmission", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingEmission = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerCut", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnerCut = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PriceCandle        protoreflect.MessageDescriptor
+	fd_PriceCandle_netuid protoreflect.FieldDescriptor
+	fd_PriceCandle_height protoreflect.FieldDescriptor
+	fd_PriceCandle_open   protoreflect.FieldDescriptor
+	fd_PriceCandle_high   protoreflect.FieldDescriptor
+	fd_PriceCandle_low    protoreflect.FieldDescriptor
+	fd_PriceCandle_close  protoreflect.FieldDescriptor
+	fd_PriceCandle_volume protoreflect.FieldDescriptor
+	fd_PriceCandle_trades protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_PriceCandle = File_hetu_event_v1_query_proto.Messages().ByName("PriceCandle")
+	fd_PriceCandle_netuid = md_PriceCandle.Fields().ByName("netuid")
+	fd_PriceCandle_height = md_PriceCandle.Fields().ByName("height")
+	fd_PriceCandle_open = md_PriceCandle.Fields().ByName("open")
+	fd_PriceCandle_high = md_PriceCandle.Fields().ByName("high")
+	fd_PriceCandle_low = md_PriceCandle.Fields().ByName("low")
+	fd_PriceCandle_close = md_PriceCandle.Fields().ByName("close")
+	fd_PriceCandle_volume = md_PriceCandle.Fields().ByName("volume")
+	fd_PriceCandle_trades = md_PriceCandle.Fields().ByName("trades")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceCandle)(nil)
+
+type fastReflection_PriceCandle PriceCandle
+
+func (x *PriceCandle) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceCandle)(x)
+}
+
+func (x *PriceCandle) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceCandle_messageType fastReflection_PriceCandle_messageType
+var _ protoreflect.MessageType = fastReflection_PriceCandle_messageType{}
+
+type fastReflection_PriceCandle_messageType struct{}
+
+func (x fastReflection_PriceCandle_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceCandle)(nil)
+}
+func (x fastReflection_PriceCandle_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceCandle)
+}
+func (x fastReflection_PriceCandle_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceCandle
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceCandle) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceCandle
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceCandle) Type() protoreflect.MessageType {
+	return _fastReflection_PriceCandle_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceCandle) New() protoreflect.Message {
+	return new(fastReflection_PriceCandle)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceCandle) Interface() protoreflect.ProtoMessage {
+	return (*PriceCandle)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceCandle) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Netuid != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Netuid)
+		if !f(fd_PriceCandle_netuid, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_PriceCandle_height, value) {
+			return
+		}
+	}
+	if x.Open != "" {
+		value := protoreflect.ValueOfString(x.Open)
+		if !f(fd_PriceCandle_open, value) {
+			return
+		}
+	}
+	if x.High != "" {
+		value := protoreflect.ValueOfString(x.High)
+		if !f(fd_PriceCandle_high, value) {
+			return
+		}
+	}
+	if x.Low != "" {
+		value := protoreflect.ValueOfString(x.Low)
+		if !f(fd_PriceCandle_low, value) {
+			return
+		}
+	}
+	if x.Close != "" {
+		value := protoreflect.ValueOfString(x.Close)
+		if !f(fd_PriceCandle_close, value) {
+			return
+		}
+	}
+	if x.Volume != "" {
+		value := protoreflect.ValueOfString(x.Volume)
+		if !f(fd_PriceCandle_volume, value) {
+			return
+		}
+	}
+	if x.Trades != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Trades)
+		if !f(fd_PriceCandle_trades, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceCandle) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.PriceCandle.netuid":
+		return x.Netuid != uint32(0)
+	case "hetu.event.v1.PriceCandle.height":
+		return x.Height != int64(0)
+	case "hetu.event.v1.PriceCandle.open":
+		return x.Open != ""
+	case "hetu.event.v1.PriceCandle.high":
+		return x.High != ""
+	case "hetu.event.v1.PriceCandle.low":
+		return x.Low != ""
+	case "hetu.event.v1.PriceCandle.close":
+		return x.Close != ""
+	case "hetu.event.v1.PriceCandle.volume":
+		return x.Volume != ""
+	case "hetu.event.v1.PriceCandle.trades":
+		return x.Trades != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.PriceCandle"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.PriceCandle does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceCandle) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.PriceCandle.netuid":
+		x.Netuid = uint32(0)
+	case "hetu.event.v1.PriceCandle.height":
+		x.Height = int64(0)
+	case "hetu.event.v1.PriceCandle.open":
+		x.Open = ""
+	case "hetu.event.v1.PriceCandle.high":
+		x.High = ""
+	case "hetu.event.v1.PriceCandle.low":
+		x.Low = ""
+	case "hetu.event.v1.PriceCandle.close":
+		x.Close = ""
+	case "hetu.event.v1.PriceCandle.volume":
+		x.Volume = ""
+	case "hetu.event.v1.PriceCandle.trades":
+		x.Trades = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.PriceCandle"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.PriceCandle does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceCandle) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.PriceCandle.netuid":
+		value := x.Netuid
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.event.v1.PriceCandle.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "hetu.event.v1.PriceCandle.open":
+		value := x.Open
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.PriceCandle.high":
+		value := x.High
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.PriceCandle.low":
+		value := x.Low
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.PriceCandle.close":
+		value := x.Close
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.PriceCandle.volume":
+		value := x.Volume
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.PriceCandle.trades":
+		value := x.Trades
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.PriceCandle"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.PriceCandle does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceCandle) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.PriceCandle.netuid":
+		x.Netuid = uint32(value.Uint())
+	case "hetu.event.v1.PriceCandle.height":
+		x.Height = value.Int()
+	case "hetu.event.v1.PriceCandle.open":
+		x.Open = value.Interface().(string)
+	case "hetu.event.v1.PriceCandle.high":
+		x.High = value.Interface().(string)
+	case "hetu.event.v1.PriceCandle.low":
+		x.Low = value.Interface().(string)
+	case "hetu.event.v1.PriceCandle.close":
+		x.Close = value.Interface().(string)
+	case "hetu.event.v1.PriceCandle.volume":
+		x.Volume = value.Interface().(string)
+	case "hetu.event.v1.PriceCandle.trades":
+		x.Trades = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.PriceCandle"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.PriceCandle does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceCandle) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.PriceCandle.netuid":
+		panic(fmt.Errorf("field netuid of message hetu.event.v1.PriceCandle is not mutable"))
+	case "hetu.event.v1.PriceCandle.height":
+		panic(fmt.Errorf("field height of message hetu.event.v1.PriceCandle is not mutable"))
+	case "hetu.event.v1.PriceCandle.open":
+		panic(fmt.Errorf("field open of message hetu.event.v1.PriceCandle is not mutable"))
+	case "hetu.event.v1.PriceCandle.high":
+		panic(fmt.Errorf("field high of message hetu.event.v1.PriceCandle is not mutable"))
+	case "hetu.event.v1.PriceCandle.low":
+		panic(fmt.Errorf("field low of message hetu.event.v1.PriceCandle is not mutable"))
+	case "hetu.event.v1.PriceCandle.close":
+		panic(fmt.Errorf("field close of message hetu.event.v1.PriceCandle is not mutable"))
+	case "hetu.event.v1.PriceCandle.volume":
+		panic(fmt.Errorf("field volume of message hetu.event.v1.PriceCandle is not mutable"))
+	case "hetu.event.v1.PriceCandle.trades":
+		panic(fmt.Errorf("field trades of message hetu.event.v1.PriceCandle is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.PriceCandle"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.PriceCandle does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceCandle) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.PriceCandle.netuid":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.event.v1.PriceCandle.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "hetu.event.v1.PriceCandle.open":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.PriceCandle.high":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.PriceCandle.low":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.PriceCandle.close":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.PriceCandle.volume":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.PriceCandle.trades":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.PriceCandle"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.PriceCandle does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceCandle) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.PriceCandle", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceCandle) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceCandle) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceCandle) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceCandle) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceCandle)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Netuid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Netuid))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Open)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.High)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Low)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Close)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Volume)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Trades != 0 {
+			n += 1 + runtime.Sov(uint64(x.Trades))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceCandle)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Trades != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Trades))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.Volume) > 0 {
+			i -= len(x.Volume)
+			copy(dAtA[i:], x.Volume)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Volume)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Close) > 0 {
+			i -= len(x.Close)
+			copy(dAtA[i:], x.Close)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Close)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Low) > 0 {
+			i -= len(x.Low)
+			copy(dAtA[i:], x.Low)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Low)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.High) > 0 {
+			i -= len(x.High)
+			copy(dAtA[i:], x.High)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.High)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Open) > 0 {
+			i -= len(x.Open)
+			copy(dAtA[i:], x.Open)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Open)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Netuid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceCandle)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceCandle: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceCandle: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				}
+				x.Netuid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Netuid |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Open = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.High = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Low = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Close = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Volume = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+				}
+				x.Trades = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Trades |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SubnetAllocationInfo                   protoreflect.MessageDescriptor
+	fd_SubnetAllocationInfo_netuid            protoreflect.FieldDescriptor
+	fd_SubnetAllocationInfo_amount            protoreflect.FieldDescriptor
+	fd_SubnetAllocationInfo_registration_cost protoreflect.FieldDescriptor
+	fd_SubnetAllocationInfo_last_update_block protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_SubnetAllocationInfo = File_hetu_event_v1_query_proto.Messages().ByName("SubnetAllocationInfo")
+	fd_SubnetAllocationInfo_netuid = md_SubnetAllocationInfo.Fields().ByName("netuid")
+	fd_SubnetAllocationInfo_amount = md_SubnetAllocationInfo.Fields().ByName("amount")
+	fd_SubnetAllocationInfo_registration_cost = md_SubnetAllocationInfo.Fields().ByName("registration_cost")
+	fd_SubnetAllocationInfo_last_update_block = md_SubnetAllocationInfo.Fields().ByName("last_update_block")
+}
+
+var _ protoreflect.Message = (*fastReflection_SubnetAllocationInfo)(nil)
+
+type fastReflection_SubnetAllocationInfo SubnetAllocationInfo
+
+func (x *SubnetAllocationInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SubnetAllocationInfo)(x)
+}
+
+func (x *SubnetAllocationInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SubnetAllocationInfo_messageType fastReflection_SubnetAllocationInfo_messageType
+var _ protoreflect.MessageType = fastReflection_SubnetAllocationInfo_messageType{}
+
+type fastReflection_SubnetAllocationInfo_messageType struct{}
+
+func (x fastReflection_SubnetAllocationInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SubnetAllocationInfo)(nil)
+}
+func (x fastReflection_SubnetAllocationInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_SubnetAllocationInfo)
+}
+func (x fastReflection_SubnetAllocationInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubnetAllocationInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SubnetAllocationInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubnetAllocationInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SubnetAllocationInfo) Type() protoreflect.MessageType {
+	return _fastReflection_SubnetAllocationInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SubnetAllocationInfo) New() protoreflect.Message {
+	return new(fastReflection_SubnetAllocationInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SubnetAllocationInfo) Interface() protoreflect.ProtoMessage {
+	return (*SubnetAllocationInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SubnetAllocationInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Netuid != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Netuid)
+		if !f(fd_SubnetAllocationInfo_netuid, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_SubnetAllocationInfo_amount, value) {
+			return
+		}
+	}
+	if x.RegistrationCost != "" {
+		value := protoreflect.ValueOfString(x.RegistrationCost)
+		if !f(fd_SubnetAllocationInfo_registration_cost, value) {
+			return
+		}
+	}
+	if x.LastUpdateBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastUpdateBlock)
+		if !f(fd_SubnetAllocationInfo_last_update_block, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SubnetAllocationInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.SubnetAllocationInfo.netuid":
+		return x.Netuid != uint32(0)
+	case "hetu.event.v1.SubnetAllocationInfo.amount":
+		return x.Amount != ""
+	case "hetu.event.v1.SubnetAllocationInfo.registration_cost":
+		return x.RegistrationCost != ""
+	case "hetu.event.v1.SubnetAllocationInfo.last_update_block":
+		return x.LastUpdateBlock != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetAllocationInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetAllocationInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetAllocationInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.SubnetAllocationInfo.netuid":
+		x.Netuid = uint32(0)
+	case "hetu.event.v1.SubnetAllocationInfo.amount":
+		x.Amount = ""
+	case "hetu.event.v1.SubnetAllocationInfo.registration_cost":
+		x.RegistrationCost = ""
+	case "hetu.event.v1.SubnetAllocationInfo.last_update_block":
+		x.LastUpdateBlock = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetAllocationInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetAllocationInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SubnetAllocationInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.SubnetAllocationInfo.netuid":
+		value := x.Netuid
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.event.v1.SubnetAllocationInfo.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.SubnetAllocationInfo.registration_cost":
+		value := x.RegistrationCost
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.SubnetAllocationInfo.last_update_block":
+		value := x.LastUpdateBlock
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetAllocationInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetAllocationInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetAllocationInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.SubnetAllocationInfo.netuid":
+		x.Netuid = uint32(value.Uint())
+	case "hetu.event.v1.SubnetAllocationInfo.amount":
+		x.Amount = value.Interface().(string)
+	case "hetu.event.v1.SubnetAllocationInfo.registration_cost":
+		x.RegistrationCost = value.Interface().(string)
+	case "hetu.event.v1.SubnetAllocationInfo.last_update_block":
+		x.LastUpdateBlock = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetAllocationInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetAllocationInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetAllocationInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.SubnetAllocationInfo.netuid":
+		panic(fmt.Errorf("field netuid of message hetu.event.v1.SubnetAllocationInfo is not mutable"))
+	case "hetu.event.v1.SubnetAllocationInfo.amount":
+		panic(fmt.Errorf("field amount of message hetu.event.v1.SubnetAllocationInfo is not mutable"))
+	case "hetu.event.v1.SubnetAllocationInfo.registration_cost":
+		panic(fmt.Errorf("field registration_cost of message hetu.event.v1.SubnetAllocationInfo is not mutable"))
+	case "hetu.event.v1.SubnetAllocationInfo.last_update_block":
+		panic(fmt.Errorf("field last_update_block of message hetu.event.v1.SubnetAllocationInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetAllocationInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetAllocationInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SubnetAllocationInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.SubnetAllocationInfo.netuid":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.event.v1.SubnetAllocationInfo.amount":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.SubnetAllocationInfo.registration_cost":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.SubnetAllocationInfo.last_update_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetAllocationInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetAllocationInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SubnetAllocationInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.SubnetAllocationInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SubnetAllocationInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetAllocationInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SubnetAllocationInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SubnetAllocationInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SubnetAllocationInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Netuid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Netuid))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RegistrationCost)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LastUpdateBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdateBlock))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SubnetAllocationInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastUpdateBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateBlock))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.RegistrationCost) > 0 {
+			i -= len(x.RegistrationCost)
+			copy(dAtA[i:], x.RegistrationCost)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RegistrationCost)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Netuid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SubnetAllocationInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubnetAllocationInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubnetAllocationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				}
+				x.Netuid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Netuid |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistrationCost", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RegistrationCost = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
+				}
+				x.LastUpdateBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUpdateBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SubnetRegistrationCost        protoreflect.MessageDescriptor
+	fd_SubnetRegistrationCost_netuid protoreflect.FieldDescriptor
+	fd_SubnetRegistrationCost_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_SubnetRegistrationCost = File_hetu_event_v1_query_proto.Messages().ByName("SubnetRegistrationCost")
+	fd_SubnetRegistrationCost_netuid = md_SubnetRegistrationCost.Fields().ByName("netuid")
+	fd_SubnetRegistrationCost_amount = md_SubnetRegistrationCost.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_SubnetRegistrationCost)(nil)
+
+type fastReflection_SubnetRegistrationCost SubnetRegistrationCost
+
+func (x *SubnetRegistrationCost) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SubnetRegistrationCost)(x)
+}
+
+func (x *SubnetRegistrationCost) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SubnetRegistrationCost_messageType fastReflection_SubnetRegistrationCost_messageType
+var _ protoreflect.MessageType = fastReflection_SubnetRegistrationCost_messageType{}
+
+type fastReflection_SubnetRegistrationCost_messageType struct{}
+
+func (x fastReflection_SubnetRegistrationCost_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SubnetRegistrationCost)(nil)
+}
+func (x fastReflection_SubnetRegistrationCost_messageType) New() protoreflect.Message {
+	return new(fastReflection_SubnetRegistrationCost)
+}
+func (x fastReflection_SubnetRegistrationCost_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubnetRegistrationCost
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SubnetRegistrationCost) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubnetRegistrationCost
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SubnetRegistrationCost) Type() protoreflect.MessageType {
+	return _fastReflection_SubnetRegistrationCost_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SubnetRegistrationCost) New() protoreflect.Message {
+	return new(fastReflection_SubnetRegistrationCost)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SubnetRegistrationCost) Interface() protoreflect.ProtoMessage {
+	return (*SubnetRegistrationCost)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SubnetRegistrationCost) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Netuid != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Netuid)
+		if !f(fd_SubnetRegistrationCost_netuid, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_SubnetRegistrationCost_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SubnetRegistrationCost) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.SubnetRegistrationCost.netuid":
+		return x.Netuid != uint32(0)
+	case "hetu.event.v1.SubnetRegistrationCost.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetRegistrationCost"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetRegistrationCost does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetRegistrationCost) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.SubnetRegistrationCost.netuid":
+		x.Netuid = uint32(0)
+	case "hetu.event.v1.SubnetRegistrationCost.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetRegistrationCost"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetRegistrationCost does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SubnetRegistrationCost) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.SubnetRegistrationCost.netuid":
+		value := x.Netuid
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.event.v1.SubnetRegistrationCost.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetRegistrationCost"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetRegistrationCost does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetRegistrationCost) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.SubnetRegistrationCost.netuid":
+		x.Netuid = uint32(value.Uint())
+	case "hetu.event.v1.SubnetRegistrationCost.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetRegistrationCost"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetRegistrationCost does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetRegistrationCost) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.SubnetRegistrationCost.netuid":
+		panic(fmt.Errorf("field netuid of message hetu.event.v1.SubnetRegistrationCost is not mutable"))
+	case "hetu.event.v1.SubnetRegistrationCost.amount":
+		panic(fmt.Errorf("field amount of message hetu.event.v1.SubnetRegistrationCost is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetRegistrationCost"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetRegistrationCost does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SubnetRegistrationCost) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.SubnetRegistrationCost.netuid":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.event.v1.SubnetRegistrationCost.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetRegistrationCost"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetRegistrationCost does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SubnetRegistrationCost) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.SubnetRegistrationCost", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SubnetRegistrationCost) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetRegistrationCost) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SubnetRegistrationCost) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SubnetRegistrationCost) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SubnetRegistrationCost)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Netuid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Netuid))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SubnetRegistrationCost)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Netuid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SubnetRegistrationCost)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubnetRegistrationCost: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubnetRegistrationCost: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				}
+				x.Netuid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Netuid |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SubnetInfo                  protoreflect.MessageDescriptor
+	fd_SubnetInfo_netuid           protoreflect.FieldDescriptor
+	fd_SubnetInfo_owner            protoreflect.FieldDescriptor
+	fd_SubnetInfo_alpha_token      protoreflect.FieldDescriptor
+	fd_SubnetInfo_amm_pool         protoreflect.FieldDescriptor
+	fd_SubnetInfo_locked_amount    protoreflect.FieldDescriptor
+	fd_SubnetInfo_pool_initial_tao protoreflect.FieldDescriptor
+	fd_SubnetInfo_burned_amount    protoreflect.FieldDescriptor
+	fd_SubnetInfo_created_at       protoreflect.FieldDescriptor
+	fd_SubnetInfo_is_active        protoreflect.FieldDescriptor
+	fd_SubnetInfo_name             protoreflect.FieldDescriptor
+	fd_SubnetInfo_description      protoreflect.FieldDescriptor
+	fd_SubnetInfo_activated_at     protoreflect.FieldDescriptor
+	fd_SubnetInfo_activated_block  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_SubnetInfo = File_hetu_event_v1_query_proto.Messages().ByName("SubnetInfo")
+	fd_SubnetInfo_netuid = md_SubnetInfo.Fields().ByName("netuid")
+	fd_SubnetInfo_owner = md_SubnetInfo.Fields().ByName("owner")
+	fd_SubnetInfo_alpha_token = md_SubnetInfo.Fields().ByName("alpha_token")
+	fd_SubnetInfo_amm_pool = md_SubnetInfo.Fields().ByName("amm_pool")
+	fd_SubnetInfo_locked_amount = md_SubnetInfo.Fields().ByName("locked_amount")
+	fd_SubnetInfo_pool_initial_tao = md_SubnetInfo.Fields().ByName("pool_initial_tao")
+	fd_SubnetInfo_burned_amount = md_SubnetInfo.Fields().ByName("burned_amount")
+	fd_SubnetInfo_created_at = md_SubnetInfo.Fields().ByName("created_at")
+	fd_SubnetInfo_is_active = md_SubnetInfo.Fields().ByName("is_active")
+	fd_SubnetInfo_name = md_SubnetInfo.Fields().ByName("name")
+	fd_SubnetInfo_description = md_SubnetInfo.Fields().ByName("description")
+	fd_SubnetInfo_activated_at = md_SubnetInfo.Fields().ByName("activated_at")
+	fd_SubnetInfo_activated_block = md_SubnetInfo.Fields().ByName("activated_block")
+}
+
+var _ protoreflect.Message = (*fastReflection_SubnetInfo)(nil)
+
+type fastReflection_SubnetInfo SubnetInfo
+
+func (x *SubnetInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SubnetInfo)(x)
+}
+
+func (x *SubnetInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SubnetInfo_messageType fastReflection_SubnetInfo_messageType
+var _ protoreflect.MessageType = fastReflection_SubnetInfo_messageType{}
+
+type fastReflection_SubnetInfo_messageType struct{}
+
+func (x fastReflection_SubnetInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SubnetInfo)(nil)
+}
+func (x fastReflection_SubnetInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_SubnetInfo)
+}
+func (x fastReflection_SubnetInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubnetInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SubnetInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubnetInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SubnetInfo) Type() protoreflect.MessageType {
+	return _fastReflection_SubnetInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SubnetInfo) New() protoreflect.Message {
+	return new(fastReflection_SubnetInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SubnetInfo) Interface() protoreflect.ProtoMessage {
+	return (*SubnetInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SubnetInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Netuid != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Netuid)
+		if !f(fd_SubnetInfo_netuid, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_SubnetInfo_owner, value) {
+			return
+		}
+	}
+	if x.AlphaToken != "" {
+		value := protoreflect.ValueOfString(x.AlphaToken)
+		if !f(fd_SubnetInfo_alpha_token, value) {
+			return
+		}
+	}
+	if x.AmmPool != "" {
+		value := protoreflect.ValueOfString(x.AmmPool)
+		if !f(fd_SubnetInfo_amm_pool, value) {
+			return
+		}
+	}
+	if x.LockedAmount != "" {
+		value := protoreflect.ValueOfString(x.LockedAmount)
+		if !f(fd_SubnetInfo_locked_amount, value) {
+			return
+		}
+	}
+	if x.PoolInitialTao != "" {
+		value := protoreflect.ValueOfString(x.PoolInitialTao)
+		if !f(fd_SubnetInfo_pool_initial_tao, value) {
+			return
+		}
+	}
+	if x.BurnedAmount != "" {
+		value := protoreflect.ValueOfString(x.BurnedAmount)
+		if !f(fd_SubnetInfo_burned_amount, value) {
+			return
+		}
+	}
+	if x.CreatedAt != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CreatedAt)
+		if !f(fd_SubnetInfo_created_at, value) {
+			return
+		}
+	}
+	if x.IsActive != false {
+		value := protoreflect.ValueOfBool(x.IsActive)
+		if !f(fd_SubnetInfo_is_active, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_SubnetInfo_name, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_SubnetInfo_description, value) {
+			return
+		}
+	}
+	if x.ActivatedAt != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ActivatedAt)
+		if !f(fd_SubnetInfo_activated_at, value) {
+			return
+		}
+	}
+	if x.ActivatedBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ActivatedBlock)
+		if !f(fd_SubnetInfo_activated_block, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SubnetInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.SubnetInfo.netuid":
+		return x.Netuid != uint32(0)
+	case "hetu.event.v1.SubnetInfo.owner":
+		return x.Owner != ""
+	case "hetu.event.v1.SubnetInfo.alpha_token":
+		return x.AlphaToken != ""
+	case "hetu.event.v1.SubnetInfo.amm_pool":
+		return x.AmmPool != ""
+	case "hetu.event.v1.SubnetInfo.locked_amount":
+		return x.LockedAmount != ""
+	case "hetu.event.v1.SubnetInfo.pool_initial_tao":
+		return x.PoolInitialTao != ""
+	case "hetu.event.v1.SubnetInfo.burned_amount":
+		return x.BurnedAmount != ""
+	case "hetu.event.v1.SubnetInfo.created_at":
+		return x.CreatedAt != uint64(0)
+	case "hetu.event.v1.SubnetInfo.is_active":
+		return x.IsActive != false
+	case "hetu.event.v1.SubnetInfo.name":
+		return x.Name != ""
+	case "hetu.event.v1.SubnetInfo.description":
+		return x.Description != ""
+	case "hetu.event.v1.SubnetInfo.activated_at":
+		return x.ActivatedAt != uint64(0)
+	case "hetu.event.v1.SubnetInfo.activated_block":
+		return x.ActivatedBlock != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.SubnetInfo.netuid":
+		x.Netuid = uint32(0)
+	case "hetu.event.v1.SubnetInfo.owner":
+		x.Owner = ""
+	case "hetu.event.v1.SubnetInfo.alpha_token":
+		x.AlphaToken = ""
+	case "hetu.event.v1.SubnetInfo.amm_pool":
+		x.AmmPool = ""
+	case "hetu.event.v1.SubnetInfo.locked_amount":
+		x.LockedAmount = ""
+	case "hetu.event.v1.SubnetInfo.pool_initial_tao":
+		x.PoolInitialTao = ""
+	case "hetu.event.v1.SubnetInfo.burned_amount":
+		x.BurnedAmount = ""
+	case "hetu.event.v1.SubnetInfo.created_at":
+		x.CreatedAt = uint64(0)
+	case "hetu.event.v1.SubnetInfo.is_active":
+		x.IsActive = false
+	case "hetu.event.v1.SubnetInfo.name":
+		x.Name = ""
+	case "hetu.event.v1.SubnetInfo.description":
+		x.Description = ""
+	case "hetu.event.v1.SubnetInfo.activated_at":
+		x.ActivatedAt = uint64(0)
+	case "hetu.event.v1.SubnetInfo.activated_block":
+		x.ActivatedBlock = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SubnetInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.SubnetInfo.netuid":
+		value := x.Netuid
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.event.v1.SubnetInfo.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.SubnetInfo.alpha_token":
+		value := x.AlphaToken
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.SubnetInfo.amm_pool":
+		value := x.AmmPool
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.SubnetInfo.locked_amount":
+		value := x.LockedAmount
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.SubnetInfo.pool_initial_tao":
+		value := x.PoolInitialTao
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.SubnetInfo.burned_amount":
+		value := x.BurnedAmount
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.SubnetInfo.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfUint64(value)
+	case "hetu.event.v1.SubnetInfo.is_active":
+		value := x.IsActive
+		return protoreflect.ValueOfBool(value)
+	case "hetu.event.v1.SubnetInfo.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.SubnetInfo.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.SubnetInfo.activated_at":
+		value := x.ActivatedAt
+		return protoreflect.ValueOfUint64(value)
+	case "hetu.event.v1.SubnetInfo.activated_block":
+		value := x.ActivatedBlock
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.SubnetInfo.netuid":
+		x.Netuid = uint32(value.Uint())
+	case "hetu.event.v1.SubnetInfo.owner":
+		x.Owner = value.Interface().(string)
+	case "hetu.event.v1.SubnetInfo.alpha_token":
+		x.AlphaToken = value.Interface().(string)
+	case "hetu.event.v1.SubnetInfo.amm_pool":
+		x.AmmPool = value.Interface().(string)
+	case "hetu.event.v1.SubnetInfo.locked_amount":
+		x.LockedAmount = value.Interface().(string)
+	case "hetu.event.v1.SubnetInfo.pool_initial_tao":
+		x.PoolInitialTao = value.Interface().(string)
+	case "hetu.event.v1.SubnetInfo.burned_amount":
+		x.BurnedAmount = value.Interface().(string)
+	case "hetu.event.v1.SubnetInfo.created_at":
+		x.CreatedAt = value.Uint()
+	case "hetu.event.v1.SubnetInfo.is_active":
+		x.IsActive = value.Bool()
+	case "hetu.event.v1.SubnetInfo.name":
+		x.Name = value.Interface().(string)
+	case "hetu.event.v1.SubnetInfo.description":
+		x.Description = value.Interface().(string)
+	case "hetu.event.v1.SubnetInfo.activated_at":
+		x.ActivatedAt = value.Uint()
+	case "hetu.event.v1.SubnetInfo.activated_block":
+		x.ActivatedBlock = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.SubnetInfo.netuid":
+		panic(fmt.Errorf("field netuid of message hetu.event.v1.SubnetInfo is not mutable"))
+	case "hetu.event.v1.SubnetInfo.owner":
+		panic(fmt.Errorf("field owner of message hetu.event.v1.SubnetInfo is not mutable"))
+	case "hetu.event.v1.SubnetInfo.alpha_token":
+		panic(fmt.Errorf("field alpha_token of message hetu.event.v1.SubnetInfo is not mutable"))
+	case "hetu.event.v1.SubnetInfo.amm_pool":
+		panic(fmt.Errorf("field amm_pool of message hetu.event.v1.SubnetInfo is not mutable"))
+	case "hetu.event.v1.SubnetInfo.locked_amount":
+		panic(fmt.Errorf("field locked_amount of message hetu.event.v1.SubnetInfo is not mutable"))
+	case "hetu.event.v1.SubnetInfo.pool_initial_tao":
+		panic(fmt.Errorf("field pool_initial_tao of message hetu.event.v1.SubnetInfo is not mutable"))
+	case "hetu.event.v1.SubnetInfo.burned_amount":
+		panic(fmt.Errorf("field burned_amount of message hetu.event.v1.SubnetInfo is not mutable"))
+	case "hetu.event.v1.SubnetInfo.created_at":
+		panic(fmt.Errorf("field created_at of message hetu.event.v1.SubnetInfo is not mutable"))
+	case "hetu.event.v1.SubnetInfo.is_active":
+		panic(fmt.Errorf("field is_active of message hetu.event.v1.SubnetInfo is not mutable"))
+	case "hetu.event.v1.SubnetInfo.name":
+		panic(fmt.Errorf("field name of message hetu.event.v1.SubnetInfo is not mutable"))
+	case "hetu.event.v1.SubnetInfo.description":
+		panic(fmt.Errorf("field description of message hetu.event.v1.SubnetInfo is not mutable"))
+	case "hetu.event.v1.SubnetInfo.activated_at":
+		panic(fmt.Errorf("field activated_at of message hetu.event.v1.SubnetInfo is not mutable"))
+	case "hetu.event.v1.SubnetInfo.activated_block":
+		panic(fmt.Errorf("field activated_block of message hetu.event.v1.SubnetInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SubnetInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.SubnetInfo.netuid":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.event.v1.SubnetInfo.owner":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.SubnetInfo.alpha_token":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.SubnetInfo.amm_pool":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.SubnetInfo.locked_amount":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.SubnetInfo.pool_initial_tao":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.SubnetInfo.burned_amount":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.SubnetInfo.created_at":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "hetu.event.v1.SubnetInfo.is_active":
+		return protoreflect.ValueOfBool(false)
+	case "hetu.event.v1.SubnetInfo.name":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.SubnetInfo.description":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.SubnetInfo.activated_at":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "hetu.event.v1.SubnetInfo.activated_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.SubnetInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.SubnetInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SubnetInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.SubnetInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SubnetInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubnetInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SubnetInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SubnetInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SubnetInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Netuid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Netuid))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AlphaToken)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AmmPool)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LockedAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PoolInitialTao)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BurnedAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedAt))
+		}
+		if x.IsActive {
+			n += 2
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ActivatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActivatedAt))
+		}
+		if x.ActivatedBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActivatedBlock))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SubnetInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ActivatedBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActivatedBlock))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.ActivatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActivatedAt))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.IsActive {
+			i--
+			if x.IsActive {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.CreatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.BurnedAmount) > 0 {
+			i -= len(x.BurnedAmount)
+			copy(dAtA[i:], x.BurnedAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BurnedAmount)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.PoolInitialTao) > 0 {
+			i -= len(x.PoolInitialTao)
+			copy(dAtA[i:], x.PoolInitialTao)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PoolInitialTao)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.LockedAmount) > 0 {
+			i -= len(x.LockedAmount)
+			copy(dAtA[i:], x.LockedAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LockedAmount)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.AmmPool) > 0 {
+			i -= len(x.AmmPool)
+			copy(dAtA[i:], x.AmmPool)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmmPool)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.AlphaToken) > 0 {
+			i -= len(x.AlphaToken)
+			copy(dAtA[i:], x.AlphaToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AlphaToken)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Netuid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SubnetInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubnetInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubnetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				}
+				x.Netuid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Netuid |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AlphaToken", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AlphaToken = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmmPool", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AmmPool = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockedAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolInitialTao", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PoolInitialTao = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnedAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BurnedAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				x.CreatedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedAt |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsActive = bool(v != 0)
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivatedAt", wireType)
+				}
+				x.ActivatedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActivatedAt |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivatedBlock", wireType)
+				}
+				x.ActivatedBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActivatedBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_NeuronInfo                          protoreflect.MessageDescriptor
+	fd_NeuronInfo_account                  protoreflect.FieldDescriptor
+	fd_NeuronInfo_netuid                   protoreflect.FieldDescriptor
+	fd_NeuronInfo_is_active                protoreflect.FieldDescriptor
+	fd_NeuronInfo_is_validator             protoreflect.FieldDescriptor
+	fd_NeuronInfo_requested_validator_role protoreflect.FieldDescriptor
+	fd_NeuronInfo_stake                    protoreflect.FieldDescriptor
+	fd_NeuronInfo_registration_block       protoreflect.FieldDescriptor
+	fd_NeuronInfo_last_update              protoreflect.FieldDescriptor
+	fd_NeuronInfo_axon_endpoint            protoreflect.FieldDescriptor
+	fd_NeuronInfo_axon_port                protoreflect.FieldDescriptor
+	fd_NeuronInfo_prometheus_endpoint      protoreflect.FieldDescriptor
+	fd_NeuronInfo_prometheus_port          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_NeuronInfo = File_hetu_event_v1_query_proto.Messages().ByName("NeuronInfo")
+	fd_NeuronInfo_account = md_NeuronInfo.Fields().ByName("account")
+	fd_NeuronInfo_netuid = md_NeuronInfo.Fields().ByName("netuid")
+	fd_NeuronInfo_is_active = md_NeuronInfo.Fields().ByName("is_active")
+	fd_NeuronInfo_is_validator = md_NeuronInfo.Fields().ByName("is_validator")
+	fd_NeuronInfo_requested_validator_role = md_NeuronInfo.Fields().ByName("requested_validator_role")
+	fd_NeuronInfo_stake = md_NeuronInfo.Fields().ByName("stake")
+	fd_NeuronInfo_registration_block = md_NeuronInfo.Fields().ByName("registration_block")
+	fd_NeuronInfo_last_update = md_NeuronInfo.Fields().ByName("last_update")
+	fd_NeuronInfo_axon_endpoint = md_NeuronInfo.Fields().ByName("axon_endpoint")
+	fd_NeuronInfo_axon_port = md_NeuronInfo.Fields().ByName("axon_port")
+	fd_NeuronInfo_prometheus_endpoint = md_NeuronInfo.Fields().ByName("prometheus_endpoint")
+	fd_NeuronInfo_prometheus_port = md_NeuronInfo.Fields().ByName("prometheus_port")
+}
+
+var _ protoreflect.Message = (*fastReflection_NeuronInfo)(nil)
+
+type fastReflection_NeuronInfo NeuronInfo
+
+func (x *NeuronInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NeuronInfo)(x)
+}
+
+func (x *NeuronInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_NeuronInfo_messageType fastReflection_NeuronInfo_messageType
+var _ protoreflect.MessageType = fastReflection_NeuronInfo_messageType{}
+
+type fastReflection_NeuronInfo_messageType struct{}
+
+func (x fastReflection_NeuronInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NeuronInfo)(nil)
+}
+func (x fastReflection_NeuronInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_NeuronInfo)
+}
+func (x fastReflection_NeuronInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NeuronInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_NeuronInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_NeuronInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_NeuronInfo) Type() protoreflect.MessageType {
+	return _fastReflection_NeuronInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_NeuronInfo) New() protoreflect.Message {
+	return new(fastReflection_NeuronInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_NeuronInfo) Interface() protoreflect.ProtoMessage {
+	return (*NeuronInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_NeuronInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Account != "" {
+		value := protoreflect.ValueOfString(x.Account)
+		if !f(fd_NeuronInfo_account, value) {
+			return
+		}
+	}
+	if x.Netuid != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Netuid)
+		if !f(fd_NeuronInfo_netuid, value) {
+			return
+		}
+	}
+	if x.IsActive != false {
+		value := protoreflect.ValueOfBool(x.IsActive)
+		if !f(fd_NeuronInfo_is_active, value) {
+			return
+		}
+	}
+	if x.IsValidator != false {
+		value := protoreflect.ValueOfBool(x.IsValidator)
+		if !f(fd_NeuronInfo_is_validator, value) {
+			return
+		}
+	}
+	if x.RequestedValidatorRole != false {
+		value := protoreflect.ValueOfBool(x.RequestedValidatorRole)
+		if !f(fd_NeuronInfo_requested_validator_role, value) {
+			return
+		}
+	}
+	if x.Stake != "" {
+		value := protoreflect.ValueOfString(x.Stake)
+		if !f(fd_NeuronInfo_stake, value) {
+			return
+		}
+	}
+	if x.RegistrationBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RegistrationBlock)
+		if !f(fd_NeuronInfo_registration_block, value) {
+			return
+		}
+	}
+	if x.LastUpdate != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastUpdate)
+		if !f(fd_NeuronInfo_last_update, value) {
+			return
+		}
+	}
+	if x.AxonEndpoint != "" {
+		value := protoreflect.ValueOfString(x.AxonEndpoint)
+		if !f(fd_NeuronInfo_axon_endpoint, value) {
+			return
+		}
+	}
+	if x.AxonPort != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.AxonPort)
+		if !f(fd_NeuronInfo_axon_port, value) {
+			return
+		}
+	}
+	if x.PrometheusEndpoint != "" {
+		value := protoreflect.ValueOfString(x.PrometheusEndpoint)
+		if !f(fd_NeuronInfo_prometheus_endpoint, value) {
+			return
+		}
+	}
+	if x.PrometheusPort != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PrometheusPort)
+		if !f(fd_NeuronInfo_prometheus_port, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_NeuronInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.NeuronInfo.account":
+		return x.Account != ""
+	case "hetu.event.v1.NeuronInfo.netuid":
+		return x.Netuid != uint32(0)
+	case "hetu.event.v1.NeuronInfo.is_active":
+		return x.IsActive != false
+	case "hetu.event.v1.NeuronInfo.is_validator":
+		return x.IsValidator != false
+	case "hetu.event.v1.NeuronInfo.requested_validator_role":
+		return x.RequestedValidatorRole != false
+	case "hetu.event.v1.NeuronInfo.stake":
+		return x.Stake != ""
+	case "hetu.event.v1.NeuronInfo.registration_block":
+		return x.RegistrationBlock != uint64(0)
+	case "hetu.event.v1.NeuronInfo.last_update":
+		return x.LastUpdate != uint64(0)
+	case "hetu.event.v1.NeuronInfo.axon_endpoint":
+		return x.AxonEndpoint != ""
+	case "hetu.event.v1.NeuronInfo.axon_port":
+		return x.AxonPort != uint32(0)
+	case "hetu.event.v1.NeuronInfo.prometheus_endpoint":
+		return x.PrometheusEndpoint != ""
+	case "hetu.event.v1.NeuronInfo.prometheus_port":
+		return x.PrometheusPort != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NeuronInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.NeuronInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NeuronInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.NeuronInfo.account":
+		x.Account = ""
+	case "hetu.event.v1.NeuronInfo.netuid":
+		x.Netuid = uint32(0)
+	case "hetu.event.v1.NeuronInfo.is_active":
+		x.IsActive = false
+	case "hetu.event.v1.NeuronInfo.is_validator":
+		x.IsValidator = false
+	case "hetu.event.v1.NeuronInfo.requested_validator_role":
+		x.RequestedValidatorRole = false
+	case "hetu.event.v1.NeuronInfo.stake":
+		x.Stake = ""
+	case "hetu.event.v1.NeuronInfo.registration_block":
+		x.RegistrationBlock = uint64(0)
+	case "hetu.event.v1.NeuronInfo.last_update":
+		x.LastUpdate = uint64(0)
+	case "hetu.event.v1.NeuronInfo.axon_endpoint":
+		x.AxonEndpoint = ""
+	case "hetu.event.v1.NeuronInfo.axon_port":
+		x.AxonPort = uint32(0)
+	case "hetu.event.v1.NeuronInfo.prometheus_endpoint":
+		x.PrometheusEndpoint = ""
+	case "hetu.event.v1.NeuronInfo.prometheus_port":
+		x.PrometheusPort = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NeuronInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.NeuronInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_NeuronInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.NeuronInfo.account":
+		value := x.Account
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.NeuronInfo.netuid":
+		value := x.Netuid
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.event.v1.NeuronInfo.is_active":
+		value := x.IsActive
+		return protoreflect.ValueOfBool(value)
+	case "hetu.event.v1.NeuronInfo.is_validator":
+		value := x.IsValidator
+		return protoreflect.ValueOfBool(value)
+	case "hetu.event.v1.NeuronInfo.requested_validator_role":
+		value := x.RequestedValidatorRole
+		return protoreflect.ValueOfBool(value)
+	case "hetu.event.v1.NeuronInfo.stake":
+		value := x.Stake
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.NeuronInfo.registration_block":
+		value := x.RegistrationBlock
+		return protoreflect.ValueOfUint64(value)
+	case "hetu.event.v1.NeuronInfo.last_update":
+		value := x.LastUpdate
+		return protoreflect.ValueOfUint64(value)
+	case "hetu.event.v1.NeuronInfo.axon_endpoint":
+		value := x.AxonEndpoint
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.NeuronInfo.axon_port":
+		value := x.AxonPort
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.event.v1.NeuronInfo.prometheus_endpoint":
+		value := x.PrometheusEndpoint
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.NeuronInfo.prometheus_port":
+		value := x.PrometheusPort
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NeuronInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.NeuronInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NeuronInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.NeuronInfo.account":
+		x.Account = value.Interface().(string)
+	case "hetu.event.v1.NeuronInfo.netuid":
+		x.Netuid = uint32(value.Uint())
+	case "hetu.event.v1.NeuronInfo.is_active":
+		x.IsActive = value.Bool()
+	case "hetu.event.v1.NeuronInfo.is_validator":
+		x.IsValidator = value.Bool()
+	case "hetu.event.v1.NeuronInfo.requested_validator_role":
+		x.RequestedValidatorRole = value.Bool()
+	case "hetu.event.v1.NeuronInfo.stake":
+		x.Stake = value.Interface().(string)
+	case "hetu.event.v1.NeuronInfo.registration_block":
+		x.RegistrationBlock = value.Uint()
+	case "hetu.event.v1.NeuronInfo.last_update":
+		x.LastUpdate = value.Uint()
+	case "hetu.event.v1.NeuronInfo.axon_endpoint":
+		x.AxonEndpoint = value.Interface().(string)
+	case "hetu.event.v1.NeuronInfo.axon_port":
+		x.AxonPort = uint32(value.Uint())
+	case "hetu.event.v1.NeuronInfo.prometheus_endpoint":
+		x.PrometheusEndpoint = value.Interface().(string)
+	case "hetu.event.v1.NeuronInfo.prometheus_port":
+		x.PrometheusPort = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NeuronInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.NeuronInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NeuronInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.NeuronInfo.account":
+		panic(fmt.Errorf("field account of message hetu.event.v1.NeuronInfo is not mutable"))
+	case "hetu.event.v1.NeuronInfo.netuid":
+		panic(fmt.Errorf("field netuid of message hetu.event.v1.NeuronInfo is not mutable"))
+	case "hetu.event.v1.NeuronInfo.is_active":
+		panic(fmt.Errorf("field is_active of message hetu.event.v1.NeuronInfo is not mutable"))
+	case "hetu.event.v1.NeuronInfo.is_validator":
+		panic(fmt.Errorf("field is_validator of message hetu.event.v1.NeuronInfo is not mutable"))
+	case "hetu.event.v1.NeuronInfo.requested_validator_role":
+		panic(fmt.Errorf("field requested_validator_role of message hetu.event.v1.NeuronInfo is not mutable"))
+	case "hetu.event.v1.NeuronInfo.stake":
+		panic(fmt.Errorf("field stake of message hetu.event.v1.NeuronInfo is not mutable"))
+	case "hetu.event.v1.NeuronInfo.registration_block":
+		panic(fmt.Errorf("field registration_block of message hetu.event.v1.NeuronInfo is not mutable"))
+	case "hetu.event.v1.NeuronInfo.last_update":
+		panic(fmt.Errorf("field last_update of message hetu.event.v1.NeuronInfo is not mutable"))
+	case "hetu.event.v1.NeuronInfo.axon_endpoint":
+		panic(fmt.Errorf("field axon_endpoint of message hetu.event.v1.NeuronInfo is not mutable"))
+	case "hetu.event.v1.NeuronInfo.axon_port":
+		panic(fmt.Errorf("field axon_port of message hetu.event.v1.NeuronInfo is not mutable"))
+	case "hetu.event.v1.NeuronInfo.prometheus_endpoint":
+		panic(fmt.Errorf("field prometheus_endpoint of message hetu.event.v1.NeuronInfo is not mutable"))
+	case "hetu.event.v1.NeuronInfo.prometheus_port":
+		panic(fmt.Errorf("field prometheus_port of message hetu.event.v1.NeuronInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NeuronInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.NeuronInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_NeuronInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.NeuronInfo.account":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.NeuronInfo.netuid":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.event.v1.NeuronInfo.is_active":
+		return protoreflect.ValueOfBool(false)
+	case "hetu.event.v1.NeuronInfo.is_validator":
+		return protoreflect.ValueOfBool(false)
+	case "hetu.event.v1.NeuronInfo.requested_validator_role":
+		return protoreflect.ValueOfBool(false)
+	case "hetu.event.v1.NeuronInfo.stake":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.NeuronInfo.registration_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "hetu.event.v1.NeuronInfo.last_update":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "hetu.event.v1.NeuronInfo.axon_endpoint":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.NeuronInfo.axon_port":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.event.v1.NeuronInfo.prometheus_endpoint":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.NeuronInfo.prometheus_port":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NeuronInfo"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.NeuronInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_NeuronInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.NeuronInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_NeuronInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NeuronInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_NeuronInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_NeuronInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*NeuronInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Account)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Netuid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Netuid))
+		}
+		if x.IsActive {
+			n += 2
+		}
+		if x.IsValidator {
+			n += 2
+		}
+		if x.RequestedValidatorRole {
+			n += 2
+		}
+		l = len(x.Stake)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RegistrationBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.RegistrationBlock))
+		}
+		if x.LastUpdate != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdate))
+		}
+		l = len(x.AxonEndpoint)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AxonPort != 0 {
+			n += 1 + runtime.Sov(uint64(x.AxonPort))
+		}
+		l = len(x.PrometheusEndpoint)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PrometheusPort != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrometheusPort))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*NeuronInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PrometheusPort != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrometheusPort))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.PrometheusEndpoint) > 0 {
+			i -= len(x.PrometheusEndpoint)
+			copy(dAtA[i:], x.PrometheusEndpoint)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PrometheusEndpoint)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.AxonPort != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AxonPort))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.AxonEndpoint) > 0 {
+			i -= len(x.AxonEndpoint)
+			copy(dAtA[i:], x.AxonEndpoint)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AxonEndpoint)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.LastUpdate != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdate))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.RegistrationBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RegistrationBlock))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Stake) > 0 {
+			i -= len(x.Stake)
+			copy(dAtA[i:], x.Stake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Stake)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.RequestedValidatorRole {
+			i--
+			if x.RequestedValidatorRole {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.IsValidator {
+			i--
+			if x.IsValidator {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.IsActive {
+			i--
+			if x.IsActive {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Netuid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Account) > 0 {
+			i -= len(x.Account)
+			copy(dAtA[i:], x.Account)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Account)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*NeuronInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NeuronInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NeuronInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Account = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				}
+				x.Netuid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Netuid |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsActive = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsValidator", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsValidator = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestedValidatorRole", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RequestedValidatorRole = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Stake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistrationBlock", wireType)
+				}
+				x.RegistrationBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RegistrationBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+				}
+				x.LastUpdate = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUpdate |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AxonEndpoint", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AxonEndpoint = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AxonPort", wireType)
+				}
+				x.AxonPort = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AxonPort |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrometheusEndpoint", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PrometheusEndpoint = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrometheusPort", wireType)
+				}
+				x.PrometheusPort = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrometheusPort |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryValidatorTakeRequest           protoreflect.MessageDescriptor
+	fd_QueryValidatorTakeRequest_netuid    protoreflect.FieldDescriptor
+	fd_QueryValidatorTakeRequest_validator protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_QueryValidatorTakeRequest = File_hetu_event_v1_query_proto.Messages().ByName("QueryValidatorTakeRequest")
+	fd_QueryValidatorTakeRequest_netuid = md_QueryValidatorTakeRequest.Fields().ByName("netuid")
+	fd_QueryValidatorTakeRequest_validator = md_QueryValidatorTakeRequest.Fields().ByName("validator")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorTakeRequest)(nil)
+
+type fastReflection_QueryValidatorTakeRequest QueryValidatorTakeRequest
+
+func (x *QueryValidatorTakeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorTakeRequest)(x)
+}
+
+func (x *QueryValidatorTakeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorTakeRequest_messageType fastReflection_QueryValidatorTakeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorTakeRequest_messageType{}
+
+type fastReflection_QueryValidatorTakeRequest_messageType struct{}
+
+func (x fastReflection_QueryValidatorTakeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorTakeRequest)(nil)
+}
+func (x fastReflection_QueryValidatorTakeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorTakeRequest)
+}
+func (x fastReflection_QueryValidatorTakeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorTakeRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorTakeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorTakeRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorTakeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorTakeRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorTakeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorTakeRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorTakeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorTakeRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorTakeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Netuid != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Netuid)
+		if !f(fd_QueryValidatorTakeRequest_netuid, value) {
+			return
+		}
+	}
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_QueryValidatorTakeRequest_validator, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorTakeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryValidatorTakeRequest.netuid":
+		return x.Netuid != uint32(0)
+	case "hetu.event.v1.QueryValidatorTakeRequest.validator":
+		return x.Validator != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryValidatorTakeRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryValidatorTakeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorTakeRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryValidatorTakeRequest.netuid":
+		x.Netuid = uint32(0)
+	case "hetu.event.v1.QueryValidatorTakeRequest.validator":
+		x.Validator = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryValidatorTakeRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryValidatorTakeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorTakeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.QueryValidatorTakeRequest.netuid":
+		value := x.Netuid
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.event.v1.QueryValidatorTakeRequest.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryValidatorTakeRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryValidatorTakeRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorTakeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryValidatorTakeRequest.netuid":
+		x.Netuid = uint32(value.Uint())
+	case "hetu.event.v1.QueryValidatorTakeRequest.validator":
+		x.Validator = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryValidatorTakeRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryValidatorTakeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorTakeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryValidatorTakeRequest.netuid":
+		panic(fmt.Errorf("field netuid of message hetu.event.v1.QueryValidatorTakeRequest is not mutable"))
+	case "hetu.event.v1.QueryValidatorTakeRequest.validator":
+		panic(fmt.Errorf("field validator of message hetu.event.v1.QueryValidatorTakeRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryValidatorTakeRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryValidatorTakeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorTakeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryValidatorTakeRequest.netuid":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.event.v1.QueryValidatorTakeRequest.validator":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryValidatorTakeRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryValidatorTakeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorTakeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryValidatorTakeRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorTakeRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorTakeRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorTakeRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorTakeRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorTakeRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Netuid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Netuid))
+		}
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorTakeRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Netuid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorTakeRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorTakeRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorTakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				}
+				x.Netuid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Netuid |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryValidatorTakeResponse                   protoreflect.MessageDescriptor
+	fd_QueryValidatorTakeResponse_netuid            protoreflect.FieldDescriptor
+	fd_QueryValidatorTakeResponse_validator         protoreflect.FieldDescriptor
+	fd_QueryValidatorTakeResponse_take              protoreflect.FieldDescriptor
+	fd_QueryValidatorTakeResponse_last_update_block protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_QueryValidatorTakeResponse = File_hetu_event_v1_query_proto.Messages().ByName("QueryValidatorTakeResponse")
+	fd_QueryValidatorTakeResponse_netuid = md_QueryValidatorTakeResponse.Fields().ByName("netuid")
+	fd_QueryValidatorTakeResponse_validator = md_QueryValidatorTakeResponse.Fields().ByName("validator")
+	fd_QueryValidatorTakeResponse_take = md_QueryValidatorTakeResponse.Fields().ByName("take")
+	fd_QueryValidatorTakeResponse_last_update_block = md_QueryValidatorTakeResponse.Fields().ByName("last_update_block")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorTakeResponse)(nil)
+
+type fastReflection_QueryValidatorTakeResponse QueryValidatorTakeResponse
+
+func (x *QueryValidatorTakeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorTakeResponse)(x)
+}
+
+func (x *QueryValidatorTakeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorTakeResponse_messageType fastReflection_QueryValidatorTakeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorTakeResponse_messageType{}
+
+type fastReflection_QueryValidatorTakeResponse_messageType struct{}
+
+func (x fastReflection_QueryValidatorTakeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorTakeResponse)(nil)
+}
+func (x fastReflection_QueryValidatorTakeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorTakeResponse)
+}
+func (x fastReflection_QueryValidatorTakeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorTakeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorTakeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorTakeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorTakeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorTakeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorTakeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorTakeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorTakeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorTakeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorTakeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Netuid != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Netuid)
+		if !f(fd_QueryValidatorTakeResponse_netuid, value) {
+			return
+		}
+	}
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_QueryValidatorTakeResponse_validator, value) {
+			return
+		}
+	}
+	if x.Take != "" {
+		value := protoreflect.ValueOfString(x.Take)
+		if !f(fd_QueryValidatorTakeResponse_take, value) {
+			return
+		}
+	}
+	if x.LastUpdateBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastUpdateBlock)
+		if !f(fd_QueryValidatorTakeResponse_last_update_block, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorTakeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryValidatorTakeResponse.netuid":
+		return x.Netuid != uint32(0)
+	case "hetu.event.v1.QueryValidatorTakeResponse.validator":
+		return x.Validator != ""
+	case "hetu.event.v1.QueryValidatorTakeResponse.take":
+		return x.Take != ""
+	case "hetu.event.v1.QueryValidatorTakeResponse.last_update_block":
+		return x.LastUpdateBlock != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryValidatorTakeResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryValidatorTakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorTakeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryValidatorTakeResponse.netuid":
+		x.Netuid = uint32(0)
+	case "hetu.event.v1.QueryValidatorTakeResponse.validator":
+		x.Validator = ""
+	case "hetu.event.v1.QueryValidatorTakeResponse.take":
+		x.Take = ""
+	case "hetu.event.v1.QueryValidatorTakeResponse.last_update_block":
+		x.LastUpdateBlock = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryValidatorTakeResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryValidatorTakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorTakeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.QueryValidatorTakeResponse.netuid":
+		value := x.Netuid
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.event.v1.QueryValidatorTakeResponse.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.QueryValidatorTakeResponse.take":
+		value := x.Take
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.QueryValidatorTakeResponse.last_update_block":
+		value := x.LastUpdateBlock
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryValidatorTakeResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryValidatorTakeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorTakeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryValidatorTakeResponse.netuid":
+		x.Netuid = uint32(value.Uint())
+	case "hetu.event.v1.QueryValidatorTakeResponse.validator":
+		x.Validator = value.Interface().(string)
+	case "hetu.event.v1.QueryValidatorTakeResponse.take":
+		x.Take = value.Interface().(string)
+	case "hetu.event.v1.QueryValidatorTakeResponse.last_update_block":
+		x.LastUpdateBlock = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryValidatorTakeResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryValidatorTakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorTakeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryValidatorTakeResponse.netuid":
+		panic(fmt.Errorf("field netuid of message hetu.event.v1.QueryValidatorTakeResponse is not mutable"))
+	case "hetu.event.v1.QueryValidatorTakeResponse.validator":
+		panic(fmt.Errorf("field validator of message hetu.event.v1.QueryValidatorTakeResponse is not mutable"))
+	case "hetu.event.v1.QueryValidatorTakeResponse.take":
+		panic(fmt.Errorf("field take of message hetu.event.v1.QueryValidatorTakeResponse is not mutable"))
+	case "hetu.event.v1.QueryValidatorTakeResponse.last_update_block":
+		panic(fmt.Errorf("field last_update_block of message hetu.event.v1.QueryValidatorTakeResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryValidatorTakeResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryValidatorTakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorTakeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryValidatorTakeResponse.netuid":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.event.v1.QueryValidatorTakeResponse.validator":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.QueryValidatorTakeResponse.take":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.QueryValidatorTakeResponse.last_update_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryValidatorTakeResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryValidatorTakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorTakeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryValidatorTakeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorTakeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorTakeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorTakeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorTakeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorTakeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Netuid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Netuid))
+		}
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Take)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LastUpdateBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdateBlock))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorTakeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastUpdateBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateBlock))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Take) > 0 {
+			i -= len(x.Take)
+			copy(dAtA[i:], x.Take)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Take)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Netuid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorTakeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorTakeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorTakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				}
+				x.Netuid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Netuid |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Take", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Take = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
+				}
+				x.LastUpdateBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUpdateBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDividendsRequest           protoreflect.MessageDescriptor
+	fd_QueryDividendsRequest_delegator protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_QueryDividendsRequest = File_hetu_event_v1_query_proto.Messages().ByName("QueryDividendsRequest")
+	fd_QueryDividendsRequest_delegator = md_QueryDividendsRequest.Fields().ByName("delegator")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDividendsRequest)(nil)
+
+type fastReflection_QueryDividendsRequest QueryDividendsRequest
+
+func (x *QueryDividendsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDividendsRequest)(x)
+}
+
+func (x *QueryDividendsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDividendsRequest_messageType fastReflection_QueryDividendsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDividendsRequest_messageType{}
+
+type fastReflection_QueryDividendsRequest_messageType struct{}
+
+func (x fastReflection_QueryDividendsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDividendsRequest)(nil)
+}
+func (x fastReflection_QueryDividendsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDividendsRequest)
+}
+func (x fastReflection_QueryDividendsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDividendsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDividendsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDividendsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDividendsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDividendsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDividendsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDividendsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDividendsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDividendsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDividendsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_QueryDividendsRequest_delegator, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDividendsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryDividendsRequest.delegator":
+		return x.Delegator != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryDividendsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryDividendsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDividendsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryDividendsRequest.delegator":
+		x.Delegator = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryDividendsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryDividendsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDividendsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.QueryDividendsRequest.delegator":
+		value := x.Delegator
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryDividendsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryDividendsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDividendsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryDividendsRequest.delegator":
+		x.Delegator = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryDividendsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryDividendsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDividendsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryDividendsRequest.delegator":
+		panic(fmt.Errorf("field delegator of message hetu.event.v1.QueryDividendsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryDividendsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryDividendsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDividendsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryDividendsRequest.delegator":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryDividendsRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryDividendsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDividendsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryDividendsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDividendsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDividendsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDividendsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDividendsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDividendsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDividendsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDividendsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDividendsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDividendsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryDividendsResponse_2_list)(nil)
+
+type _QueryDividendsResponse_2_list struct {
+	list *[]*ClaimableDividend
+}
+
+func (x *_QueryDividendsResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryDividendsResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryDividendsResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClaimableDividend)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryDividendsResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClaimableDividend)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryDividendsResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(ClaimableDividend)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDividendsResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryDividendsResponse_2_list) NewElement() protoreflect.Value {
+	v := new(ClaimableDividend)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDividendsResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryDividendsResponse           protoreflect.MessageDescriptor
+	fd_QueryDividendsResponse_delegator protoreflect.FieldDescriptor
+	fd_QueryDividendsResponse_dividends protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_QueryDividendsResponse = File_hetu_event_v1_query_proto.Messages().ByName("QueryDividendsResponse")
+	fd_QueryDividendsResponse_delegator = md_QueryDividendsResponse.Fields().ByName("delegator")
+	fd_QueryDividendsResponse_dividends = md_QueryDividendsResponse.Fields().ByName("dividends")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDividendsResponse)(nil)
+
+type fastReflection_QueryDividendsResponse QueryDividendsResponse
+
+func (x *QueryDividendsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDividendsResponse)(x)
+}
+
+func (x *QueryDividendsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDividendsResponse_messageType fastReflection_QueryDividendsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDividendsResponse_messageType{}
+
+type fastReflection_QueryDividendsResponse_messageType struct{}
+
+func (x fastReflection_QueryDividendsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDividendsResponse)(nil)
+}
+func (x fastReflection_QueryDividendsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDividendsResponse)
+}
+func (x fastReflection_QueryDividendsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDividendsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDividendsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDividendsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDividendsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDividendsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDividendsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDividendsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDividendsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDividendsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDividendsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_QueryDividendsResponse_delegator, value) {
+			return
+		}
+	}
+	if len(x.Dividends) != 0 {
+		value := protoreflect.ValueOfList(&_QueryDividendsResponse_2_list{list: &x.Dividends})
+		if !f(fd_QueryDividendsResponse_dividends, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDividendsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryDividendsResponse.delegator":
+		return x.Delegator != ""
+	case "hetu.event.v1.QueryDividendsResponse.dividends":
+		return len(x.Dividends) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryDividendsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryDividendsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDividendsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryDividendsResponse.delegator":
+		x.Delegator = ""
+	case "hetu.event.v1.QueryDividendsResponse.dividends":
+		x.Dividends = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryDividendsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryDividendsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDividendsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.QueryDividendsResponse.delegator":
+		value := x.Delegator
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.QueryDividendsResponse.dividends":
+		if len(x.Dividends) == 0 {
+			return protoreflect.ValueOfList(&_QueryDividendsResponse_2_list{})
+		}
+		listValue := &_QueryDividendsResponse_2_list{list: &x.Dividends}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryDividendsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryDividendsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDividendsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryDividendsResponse.delegator":
+		x.Delegator = value.Interface().(string)
+	case "hetu.event.v1.QueryDividendsResponse.dividends":
+		lv := value.List()
+		clv := lv.(*_QueryDividendsResponse_2_list)
+		x.Dividends = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryDividendsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryDividendsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDividendsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryDividendsResponse.dividends":
+		if x.Dividends == nil {
+			x.Dividends = []*ClaimableDividend{}
+		}
+		value := &_QueryDividendsResponse_2_list{list: &x.Dividends}
+		return protoreflect.ValueOfList(value)
+	case "hetu.event.v1.QueryDividendsResponse.delegator":
+		panic(fmt.Errorf("field delegator of message hetu.event.v1.QueryDividendsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryDividendsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryDividendsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDividendsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryDividendsResponse.delegator":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.QueryDividendsResponse.dividends":
+		list := []*ClaimableDividend{}
+		return protoreflect.ValueOfList(&_QueryDividendsResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryDividendsResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryDividendsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDividendsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryDividendsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDividendsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDividendsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDividendsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDividendsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDividendsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Dividends) > 0 {
+			for _, e := range x.Dividends {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDividendsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Dividends) > 0 {
+			for iNdEx := len(x.Dividends) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Dividends[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDividendsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDividendsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDividendsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dividends", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Dividends = append(x.Dividends, &ClaimableDividend{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Dividends[len(x.Dividends)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ClaimableDividend        protoreflect.MessageDescriptor
+	fd_ClaimableDividend_netuid protoreflect.FieldDescriptor
+	fd_ClaimableDividend_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_ClaimableDividend = File_hetu_event_v1_query_proto.Messages().ByName("ClaimableDividend")
+	fd_ClaimableDividend_netuid = md_ClaimableDividend.Fields().ByName("netuid")
+	fd_ClaimableDividend_amount = md_ClaimableDividend.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_ClaimableDividend)(nil)
+
+type fastReflection_ClaimableDividend ClaimableDividend
+
+func (x *ClaimableDividend) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ClaimableDividend)(x)
+}
+
+func (x *ClaimableDividend) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ClaimableDividend_messageType fastReflection_ClaimableDividend_messageType
+var _ protoreflect.MessageType = fastReflection_ClaimableDividend_messageType{}
+
+type fastReflection_ClaimableDividend_messageType struct{}
+
+func (x fastReflection_ClaimableDividend_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ClaimableDividend)(nil)
+}
+func (x fastReflection_ClaimableDividend_messageType) New() protoreflect.Message {
+	return new(fastReflection_ClaimableDividend)
+}
+func (x fastReflection_ClaimableDividend_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ClaimableDividend
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ClaimableDividend) Descriptor() protoreflect.MessageDescriptor {
+	return md_ClaimableDividend
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ClaimableDividend) Type() protoreflect.MessageType {
+	return _fastReflection_ClaimableDividend_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ClaimableDividend) New() protoreflect.Message {
+	return new(fastReflection_ClaimableDividend)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ClaimableDividend) Interface() protoreflect.ProtoMessage {
+	return (*ClaimableDividend)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ClaimableDividend) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Netuid != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Netuid)
+		if !f(fd_ClaimableDividend_netuid, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_ClaimableDividend_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ClaimableDividend) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.ClaimableDividend.netuid":
+		return x.Netuid != uint32(0)
+	case "hetu.event.v1.ClaimableDividend.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.ClaimableDividend"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.ClaimableDividend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClaimableDividend) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.ClaimableDividend.netuid":
+		x.Netuid = uint32(0)
+	case "hetu.event.v1.ClaimableDividend.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.ClaimableDividend"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.ClaimableDividend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ClaimableDividend) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.ClaimableDividend.netuid":
+		value := x.Netuid
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.event.v1.ClaimableDividend.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.ClaimableDividend"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.ClaimableDividend does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClaimableDividend) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.ClaimableDividend.netuid":
+		x.Netuid = uint32(value.Uint())
+	case "hetu.event.v1.ClaimableDividend.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.ClaimableDividend"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.ClaimableDividend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClaimableDividend) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.ClaimableDividend.netuid":
+		panic(fmt.Errorf("field netuid of message hetu.event.v1.ClaimableDividend is not mutable"))
+	case "hetu.event.v1.ClaimableDividend.amount":
+		panic(fmt.Errorf("field amount of message hetu.event.v1.ClaimableDividend is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.ClaimableDividend"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.ClaimableDividend does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ClaimableDividend) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.ClaimableDividend.netuid":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.event.v1.ClaimableDividend.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.ClaimableDividend"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.ClaimableDividend does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ClaimableDividend) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.ClaimableDividend", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ClaimableDividend) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClaimableDividend) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ClaimableDividend) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ClaimableDividend) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ClaimableDividend)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Netuid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Netuid))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ClaimableDividend)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Netuid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ClaimableDividend)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ClaimableDividend: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ClaimableDividend: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				}
+				x.Netuid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Netuid |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryNativeStakeRequest           protoreflect.MessageDescriptor
+	fd_QueryNativeStakeRequest_validator protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_QueryNativeStakeRequest = File_hetu_event_v1_query_proto.Messages().ByName("QueryNativeStakeRequest")
+	fd_QueryNativeStakeRequest_validator = md_QueryNativeStakeRequest.Fields().ByName("validator")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryNativeStakeRequest)(nil)
+
+type fastReflection_QueryNativeStakeRequest QueryNativeStakeRequest
+
+func (x *QueryNativeStakeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryNativeStakeRequest)(x)
+}
+
+func (x *QueryNativeStakeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryNativeStakeRequest_messageType fastReflection_QueryNativeStakeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryNativeStakeRequest_messageType{}
+
+type fastReflection_QueryNativeStakeRequest_messageType struct{}
+
+func (x fastReflection_QueryNativeStakeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryNativeStakeRequest)(nil)
+}
+func (x fastReflection_QueryNativeStakeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryNativeStakeRequest)
+}
+func (x fastReflection_QueryNativeStakeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryNativeStakeRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryNativeStakeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryNativeStakeRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryNativeStakeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryNativeStakeRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryNativeStakeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryNativeStakeRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryNativeStakeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryNativeStakeRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryNativeStakeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_QueryNativeStakeRequest_validator, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryNativeStakeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryNativeStakeRequest.validator":
+		return x.Validator != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryNativeStakeRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryNativeStakeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryNativeStakeRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryNativeStakeRequest.validator":
+		x.Validator = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryNativeStakeRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryNativeStakeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryNativeStakeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.QueryNativeStakeRequest.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryNativeStakeRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryNativeStakeRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryNativeStakeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryNativeStakeRequest.validator":
+		x.Validator = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryNativeStakeRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryNativeStakeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryNativeStakeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryNativeStakeRequest.validator":
+		panic(fmt.Errorf("field validator of message hetu.event.v1.QueryNativeStakeRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryNativeStakeRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryNativeStakeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryNativeStakeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryNativeStakeRequest.validator":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryNativeStakeRequest"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryNativeStakeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryNativeStakeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryNativeStakeRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryNativeStakeRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryNativeStakeRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryNativeStakeRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryNativeStakeRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryNativeStakeRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryNativeStakeRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryNativeStakeRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryNativeStakeRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryNativeStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryNativeStakeResponse_4_list)(nil)
+
+type _QueryNativeStakeResponse_4_list struct {
+	list *[]*NativeSubnetStake
+}
+
+func (x *_QueryNativeStakeResponse_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryNativeStakeResponse_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryNativeStakeResponse_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NativeSubnetStake)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryNativeStakeResponse_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NativeSubnetStake)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryNativeStakeResponse_4_list) AppendMutable() protoreflect.Value {
+	v := new(NativeSubnetStake)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryNativeStakeResponse_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryNativeStakeResponse_4_list) NewElement() protoreflect.Value {
+	v := new(NativeSubnetStake)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryNativeStakeResponse_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryNativeStakeResponse               protoreflect.MessageDescriptor
+	fd_QueryNativeStakeResponse_validator     protoreflect.FieldDescriptor
+	fd_QueryNativeStakeResponse_bonded_tokens protoreflect.FieldDescriptor
+	fd_QueryNativeStakeResponse_enabled       protoreflect.FieldDescriptor
+	fd_QueryNativeStakeResponse_stakes        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_QueryNativeStakeResponse = File_hetu_event_v1_query_proto.Messages().ByName("QueryNativeStakeResponse")
+	fd_QueryNativeStakeResponse_validator = md_QueryNativeStakeResponse.Fields().ByName("validator")
+	fd_QueryNativeStakeResponse_bonded_tokens = md_QueryNativeStakeResponse.Fields().ByName("bonded_tokens")
+	fd_QueryNativeStakeResponse_enabled = md_QueryNativeStakeResponse.Fields().ByName("enabled")
+	fd_QueryNativeStakeResponse_stakes = md_QueryNativeStakeResponse.Fields().ByName("stakes")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryNativeStakeResponse)(nil)
+
+type fastReflection_QueryNativeStakeResponse QueryNativeStakeResponse
+
+func (x *QueryNativeStakeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryNativeStakeResponse)(x)
+}
+
+func (x *QueryNativeStakeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryNativeStakeResponse_messageType fastReflection_QueryNativeStakeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryNativeStakeResponse_messageType{}
+
+type fastReflection_QueryNativeStakeResponse_messageType struct{}
+
+func (x fastReflection_QueryNativeStakeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryNativeStakeResponse)(nil)
+}
+func (x fastReflection_QueryNativeStakeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryNativeStakeResponse)
+}
+func (x fastReflection_QueryNativeStakeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryNativeStakeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryNativeStakeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryNativeStakeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryNativeStakeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryNativeStakeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryNativeStakeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryNativeStakeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryNativeStakeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryNativeStakeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryNativeStakeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_QueryNativeStakeResponse_validator, value) {
+			return
+		}
+	}
+	if x.BondedTokens != "" {
+		value := protoreflect.ValueOfString(x.BondedTokens)
+		if !f(fd_QueryNativeStakeResponse_bonded_tokens, value) {
+			return
+		}
+	}
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_QueryNativeStakeResponse_enabled, value) {
+			return
+		}
+	}
+	if len(x.Stakes) != 0 {
+		value := protoreflect.ValueOfList(&_QueryNativeStakeResponse_4_list{list: &x.Stakes})
+		if !f(fd_QueryNativeStakeResponse_stakes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryNativeStakeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryNativeStakeResponse.validator":
+		return x.Validator != ""
+	case "hetu.event.v1.QueryNativeStakeResponse.bonded_tokens":
+		return x.BondedTokens != ""
+	case "hetu.event.v1.QueryNativeStakeResponse.enabled":
+		return x.Enabled != false
+	case "hetu.event.v1.QueryNativeStakeResponse.stakes":
+		return len(x.Stakes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryNativeStakeResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryNativeStakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryNativeStakeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryNativeStakeResponse.validator":
+		x.Validator = ""
+	case "hetu.event.v1.QueryNativeStakeResponse.bonded_tokens":
+		x.BondedTokens = ""
+	case "hetu.event.v1.QueryNativeStakeResponse.enabled":
+		x.Enabled = false
+	case "hetu.event.v1.QueryNativeStakeResponse.stakes":
+		x.Stakes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryNativeStakeResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryNativeStakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryNativeStakeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.QueryNativeStakeResponse.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.QueryNativeStakeResponse.bonded_tokens":
+		value := x.BondedTokens
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.QueryNativeStakeResponse.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "hetu.event.v1.QueryNativeStakeResponse.stakes":
+		if len(x.Stakes) == 0 {
+			return protoreflect.ValueOfList(&_QueryNativeStakeResponse_4_list{})
+		}
+		listValue := &_QueryNativeStakeResponse_4_list{list: &x.Stakes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryNativeStakeResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryNativeStakeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryNativeStakeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryNativeStakeResponse.validator":
+		x.Validator = value.Interface().(string)
+	case "hetu.event.v1.QueryNativeStakeResponse.bonded_tokens":
+		x.BondedTokens = value.Interface().(string)
+	case "hetu.event.v1.QueryNativeStakeResponse.enabled":
+		x.Enabled = value.Bool()
+	case "hetu.event.v1.QueryNativeStakeResponse.stakes":
+		lv := value.List()
+		clv := lv.(*_QueryNativeStakeResponse_4_list)
+		x.Stakes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryNativeStakeResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryNativeStakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryNativeStakeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryNativeStakeResponse.stakes":
+		if x.Stakes == nil {
+			x.Stakes = []*NativeSubnetStake{}
+		}
+		value := &_QueryNativeStakeResponse_4_list{list: &x.Stakes}
+		return protoreflect.ValueOfList(value)
+	case "hetu.event.v1.QueryNativeStakeResponse.validator":
+		panic(fmt.Errorf("field validator of message hetu.event.v1.QueryNativeStakeResponse is not mutable"))
+	case "hetu.event.v1.QueryNativeStakeResponse.bonded_tokens":
+		panic(fmt.Errorf("field bonded_tokens of message hetu.event.v1.QueryNativeStakeResponse is not mutable"))
+	case "hetu.event.v1.QueryNativeStakeResponse.enabled":
+		panic(fmt.Errorf("field enabled of message hetu.event.v1.QueryNativeStakeResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryNativeStakeResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryNativeStakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryNativeStakeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.QueryNativeStakeResponse.validator":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.QueryNativeStakeResponse.bonded_tokens":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.QueryNativeStakeResponse.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "hetu.event.v1.QueryNativeStakeResponse.stakes":
+		list := []*NativeSubnetStake{}
+		return protoreflect.ValueOfList(&_QueryNativeStakeResponse_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.QueryNativeStakeResponse"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.QueryNativeStakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryNativeStakeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.QueryNativeStakeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryNativeStakeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryNativeStakeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryNativeStakeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryNativeStakeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryNativeStakeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BondedTokens)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Enabled {
+			n += 2
+		}
+		if len(x.Stakes) > 0 {
+			for _, e := range x.Stakes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryNativeStakeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Stakes) > 0 {
+			for iNdEx := len(x.Stakes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Stakes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.BondedTokens) > 0 {
+			i -= len(x.BondedTokens)
+			copy(dAtA[i:], x.BondedTokens)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondedTokens)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryNativeStakeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryNativeStakeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryNativeStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BondedTokens = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Stakes = append(x.Stakes, &NativeSubnetStake{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stakes[len(x.Stakes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_NativeSubnetStake            protoreflect.MessageDescriptor
+	fd_NativeSubnetStake_netuid     protoreflect.FieldDescriptor
+	fd_NativeSubnetStake_proportion protoreflect.FieldDescriptor
+	fd_NativeSubnetStake_amount     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hetu_event_v1_query_proto_init()
+	md_NativeSubnetStake = File_hetu_event_v1_query_proto.Messages().ByName("NativeSubnetStake")
+	fd_NativeSubnetStake_netuid = md_NativeSubnetStake.Fields().ByName("netuid")
+	fd_NativeSubnetStake_proportion = md_NativeSubnetStake.Fields().ByName("proportion")
+	fd_NativeSubnetStake_amount = md_NativeSubnetStake.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_NativeSubnetStake)(nil)
+
+type fastReflection_NativeSubnetStake NativeSubnetStake
+
+func (x *NativeSubnetStake) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NativeSubnetStake)(x)
+}
+
+func (x *NativeSubnetStake) slowProtoReflect() protoreflect.Message {
+	mi := &file_hetu_event_v1_query_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_NativeSubnetStake_messageType fastReflection_NativeSubnetStake_messageType
+var _ protoreflect.MessageType = fastReflection_NativeSubnetStake_messageType{}
+
+type fastReflection_NativeSubnetStake_messageType struct{}
+
+func (x fastReflection_NativeSubnetStake_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NativeSubnetStake)(nil)
+}
+func (x fastReflection_NativeSubnetStake_messageType) New() protoreflect.Message {
+	return new(fastReflection_NativeSubnetStake)
+}
+func (x fastReflection_NativeSubnetStake_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NativeSubnetStake
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_NativeSubnetStake) Descriptor() protoreflect.MessageDescriptor {
+	return md_NativeSubnetStake
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_NativeSubnetStake) Type() protoreflect.MessageType {
+	return _fastReflection_NativeSubnetStake_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_NativeSubnetStake) New() protoreflect.Message {
+	return new(fastReflection_NativeSubnetStake)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_NativeSubnetStake) Interface() protoreflect.ProtoMessage {
+	return (*NativeSubnetStake)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_NativeSubnetStake) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Netuid != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Netuid)
+		if !f(fd_NativeSubnetStake_netuid, value) {
+			return
+		}
+	}
+	if x.Proportion != "" {
+		value := protoreflect.ValueOfString(x.Proportion)
+		if !f(fd_NativeSubnetStake_proportion, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_NativeSubnetStake_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_NativeSubnetStake) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hetu.event.v1.NativeSubnetStake.netuid":
+		return x.Netuid != uint32(0)
+	case "hetu.event.v1.NativeSubnetStake.proportion":
+		return x.Proportion != ""
+	case "hetu.event.v1.NativeSubnetStake.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NativeSubnetStake"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.NativeSubnetStake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NativeSubnetStake) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hetu.event.v1.NativeSubnetStake.netuid":
+		x.Netuid = uint32(0)
+	case "hetu.event.v1.NativeSubnetStake.proportion":
+		x.Proportion = ""
+	case "hetu.event.v1.NativeSubnetStake.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NativeSubnetStake"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.NativeSubnetStake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_NativeSubnetStake) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hetu.event.v1.NativeSubnetStake.netuid":
+		value := x.Netuid
+		return protoreflect.ValueOfUint32(value)
+	case "hetu.event.v1.NativeSubnetStake.proportion":
+		value := x.Proportion
+		return protoreflect.ValueOfString(value)
+	case "hetu.event.v1.NativeSubnetStake.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NativeSubnetStake"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.NativeSubnetStake does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NativeSubnetStake) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hetu.event.v1.NativeSubnetStake.netuid":
+		x.Netuid = uint32(value.Uint())
+	case "hetu.event.v1.NativeSubnetStake.proportion":
+		x.Proportion = value.Interface().(string)
+	case "hetu.event.v1.NativeSubnetStake.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NativeSubnetStake"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.NativeSubnetStake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NativeSubnetStake) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.NativeSubnetStake.netuid":
+		panic(fmt.Errorf("field netuid of message hetu.event.v1.NativeSubnetStake is not mutable"))
+	case "hetu.event.v1.NativeSubnetStake.proportion":
+		panic(fmt.Errorf("field proportion of message hetu.event.v1.NativeSubnetStake is not mutable"))
+	case "hetu.event.v1.NativeSubnetStake.amount":
+		panic(fmt.Errorf("field amount of message hetu.event.v1.NativeSubnetStake is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NativeSubnetStake"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.NativeSubnetStake does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_NativeSubnetStake) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hetu.event.v1.NativeSubnetStake.netuid":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "hetu.event.v1.NativeSubnetStake.proportion":
+		return protoreflect.ValueOfString("")
+	case "hetu.event.v1.NativeSubnetStake.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hetu.event.v1.NativeSubnetStake"))
+		}
+		panic(fmt.Errorf("message hetu.event.v1.NativeSubnetStake does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_NativeSubnetStake) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hetu.event.v1.NativeSubnetStake", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_NativeSubnetStake) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NativeSubnetStake) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_NativeSubnetStake) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_NativeSubnetStake) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*NativeSubnetStake)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Netuid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Netuid))
+		}
+		l = len(x.Proportion)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*NativeSubnetStake)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Proportion) > 0 {
+			i -= len(x.Proportion)
+			copy(dAtA[i:], x.Proportion)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proportion)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Netuid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Netuid))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*NativeSubnetStake)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NativeSubnetStake: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NativeSubnetStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+				}
+				x.Netuid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Netuid |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proportion", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proportion = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: hetu/event/v1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QuerySubnetsRequest is the request type for the Query/Subnets RPC method
+type QuerySubnetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QuerySubnetsRequest) Reset() {
+	*x = QuerySubnetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySubnetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySubnetsRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySubnetsRequest.ProtoReflect.Descriptor instead.
+func (*QuerySubnetsRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QuerySubnetsResponse is the response type for the Query/Subnets RPC method
+type QuerySubnetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subnets []*SubnetInfo `protobuf:"bytes,1,rep,name=subnets,proto3" json:"subnets,omitempty"`
+}
+
+func (x *QuerySubnetsResponse) Reset() {
+	*x = QuerySubnetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySubnetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySubnetsResponse) ProtoMessage() {}
+
+// Deprecated: Use QuerySubnetsResponse.ProtoReflect.Descriptor instead.
+func (*QuerySubnetsResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QuerySubnetsResponse) GetSubnets() []*SubnetInfo {
+	if x != nil {
+		return x.Subnets
+	}
+	return nil
+}
+
+// QuerySubnetRequest is the request type for the Query/Subnet RPC method
+type QuerySubnetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Netuid uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+}
+
+func (x *QuerySubnetRequest) Reset() {
+	*x = QuerySubnetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySubnetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySubnetRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySubnetRequest.ProtoReflect.Descriptor instead.
+func (*QuerySubnetRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QuerySubnetRequest) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+// QuerySubnetResponse is the response type for the Query/Subnet RPC method
+type QuerySubnetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subnet *SubnetInfo `protobuf:"bytes,1,opt,name=subnet,proto3" json:"subnet,omitempty"`
+}
+
+func (x *QuerySubnetResponse) Reset() {
+	*x = QuerySubnetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySubnetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySubnetResponse) ProtoMessage() {}
+
+// Deprecated: Use QuerySubnetResponse.ProtoReflect.Descriptor instead.
+func (*QuerySubnetResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QuerySubnetResponse) GetSubnet() *SubnetInfo {
+	if x != nil {
+		return x.Subnet
+	}
+	return nil
+}
+
+// QuerySubnetNeuronsRequest is the request type for the Query/SubnetNeurons RPC method
+type QuerySubnetNeuronsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Netuid uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+}
+
+func (x *QuerySubnetNeuronsRequest) Reset() {
+	*x = QuerySubnetNeuronsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySubnetNeuronsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySubnetNeuronsRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySubnetNeuronsRequest.ProtoReflect.Descriptor instead.
+func (*QuerySubnetNeuronsRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QuerySubnetNeuronsRequest) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+// QuerySubnetNeuronsResponse is the response type for the Query/SubnetNeurons RPC method
+type QuerySubnetNeuronsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Neurons []*NeuronInfo `protobuf:"bytes,1,rep,name=neurons,proto3" json:"neurons,omitempty"`
+}
+
+func (x *QuerySubnetNeuronsResponse) Reset() {
+	*x = QuerySubnetNeuronsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySubnetNeuronsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySubnetNeuronsResponse) ProtoMessage() {}
+
+// Deprecated: Use QuerySubnetNeuronsResponse.ProtoReflect.Descriptor instead.
+func (*QuerySubnetNeuronsResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QuerySubnetNeuronsResponse) GetNeurons() []*NeuronInfo {
+	if x != nil {
+		return x.Neurons
+	}
+	return nil
+}
+
+// QuerySubnetPoolRequest is the request type for the Query/SubnetPool RPC method
+type QuerySubnetPoolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Netuid uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+}
+
+func (x *QuerySubnetPoolRequest) Reset() {
+	*x = QuerySubnetPoolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySubnetPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySubnetPoolRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySubnetPoolRequest.ProtoReflect.Descriptor instead.
+func (*QuerySubnetPoolRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QuerySubnetPoolRequest) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+// QuerySubnetPoolResponse is the response type for the Query/SubnetPool RPC method
+type QuerySubnetPoolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Netuid         uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	AmmPool        string `protobuf:"bytes,2,opt,name=amm_pool,json=ammPool,proto3" json:"amm_pool,omitempty"`
+	LockedAmount   string `protobuf:"bytes,3,opt,name=locked_amount,json=lockedAmount,proto3" json:"locked_amount,omitempty"`
+	BurnedAmount   string `protobuf:"bytes,4,opt,name=burned_amount,json=burnedAmount,proto3" json:"burned_amount,omitempty"`
+	SubnetTaoIn    string `protobuf:"bytes,5,opt,name=subnet_tao_in,json=subnetTaoIn,proto3" json:"subnet_tao_in,omitempty"`
+	SubnetAlphaIn  string `protobuf:"bytes,6,opt,name=subnet_alpha_in,json=subnetAlphaIn,proto3" json:"subnet_alpha_in,omitempty"`
+	SubnetAlphaOut string `protobuf:"bytes,7,opt,name=subnet_alpha_out,json=subnetAlphaOut,proto3" json:"subnet_alpha_out,omitempty"`
+}
+
+func (x *QuerySubnetPoolResponse) Reset() {
+	*x = QuerySubnetPoolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySubnetPoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySubnetPoolResponse) ProtoMessage() {}
+
+// Deprecated: Use QuerySubnetPoolResponse.ProtoReflect.Descriptor instead.
+func (*QuerySubnetPoolResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QuerySubnetPoolResponse) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+func (x *QuerySubnetPoolResponse) GetAmmPool() string {
+	if x != nil {
+		return x.AmmPool
+	}
+	return ""
+}
+
+func (x *QuerySubnetPoolResponse) GetLockedAmount() string {
+	if x != nil {
+		return x.LockedAmount
+	}
+	return ""
+}
+
+func (x *QuerySubnetPoolResponse) GetBurnedAmount() string {
+	if x != nil {
+		return x.BurnedAmount
+	}
+	return ""
+}
+
+func (x *QuerySubnetPoolResponse) GetSubnetTaoIn() string {
+	if x != nil {
+		return x.SubnetTaoIn
+	}
+	return ""
+}
+
+func (x *QuerySubnetPoolResponse) GetSubnetAlphaIn() string {
+	if x != nil {
+		return x.SubnetAlphaIn
+	}
+	return ""
+}
+
+func (x *QuerySubnetPoolResponse) GetSubnetAlphaOut() string {
+	if x != nil {
+		return x.SubnetAlphaOut
+	}
+	return ""
+}
+
+// QueryValidatorWeightsRequest is the request type for the Query/ValidatorWeights RPC method
+type QueryValidatorWeightsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Netuid    uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (x *QueryValidatorWeightsRequest) Reset() {
+	*x = QueryValidatorWeightsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValidatorWeightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidatorWeightsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryValidatorWeightsRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidatorWeightsRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryValidatorWeightsRequest) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+func (x *QueryValidatorWeightsRequest) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+// QueryValidatorWeightsResponse is the response type for the Query/ValidatorWeights RPC method
+type QueryValidatorWeightsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Netuid    uint32   `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	Validator string   `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Weights   []string `protobuf:"bytes,3,rep,name=weights,proto3" json:"weights,omitempty"`
+}
+
+func (x *QueryValidatorWeightsResponse) Reset() {
+	*x = QueryValidatorWeightsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValidatorWeightsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidatorWeightsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryValidatorWeightsResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidatorWeightsResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryValidatorWeightsResponse) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+func (x *QueryValidatorWeightsResponse) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *QueryValidatorWeightsResponse) GetWeights() []string {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+// QueryStakePositionRequest is the request type for the Query/StakePosition RPC method
+type QueryStakePositionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *QueryStakePositionRequest) Reset() {
+	*x = QueryStakePositionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryStakePositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryStakePositionRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryStakePositionRequest.ProtoReflect.Descriptor instead.
+func (*QueryStakePositionRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryStakePositionRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+// QueryStakePositionResponse is the response type for the Query/StakePosition RPC method
+type QueryStakePositionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account          string                  `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	TotalStaked      string                  `protobuf:"bytes,2,opt,name=total_staked,json=totalStaked,proto3" json:"total_staked,omitempty"`
+	Allocated        string                  `protobuf:"bytes,3,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	RegistrationCost string                  `protobuf:"bytes,4,opt,name=registration_cost,json=registrationCost,proto3" json:"registration_cost,omitempty"`
+	Available        string                  `protobuf:"bytes,5,opt,name=available,proto3" json:"available,omitempty"`
+	Allocations      []*SubnetAllocationInfo `protobuf:"bytes,6,rep,name=allocations,proto3" json:"allocations,omitempty"`
+}
+
+func (x *QueryStakePositionResponse) Reset() {
+	*x = QueryStakePositionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryStakePositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryStakePositionResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryStakePositionResponse.ProtoReflect.Descriptor instead.
+func (*QueryStakePositionResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryStakePositionResponse) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *QueryStakePositionResponse) GetTotalStaked() string {
+	if x != nil {
+		return x.TotalStaked
+	}
+	return ""
+}
+
+func (x *QueryStakePositionResponse) GetAllocated() string {
+	if x != nil {
+		return x.Allocated
+	}
+	return ""
+}
+
+func (x *QueryStakePositionResponse) GetRegistrationCost() string {
+	if x != nil {
+		return x.RegistrationCost
+	}
+	return ""
+}
+
+func (x *QueryStakePositionResponse) GetAvailable() string {
+	if x != nil {
+		return x.Available
+	}
+	return ""
+}
+
+func (x *QueryStakePositionResponse) GetAllocations() []*SubnetAllocationInfo {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+// QueryRegistrationCostsRequest is the request type for the Query/RegistrationCosts RPC method
+type QueryRegistrationCostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryRegistrationCostsRequest) Reset() {
+	*x = QueryRegistrationCostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRegistrationCostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRegistrationCostsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryRegistrationCostsRequest.ProtoReflect.Descriptor instead.
+func (*QueryRegistrationCostsRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+// QueryRegistrationCostsResponse is the response type for the Query/RegistrationCosts RPC method
+type QueryRegistrationCostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total   string                    `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	Subnets []*SubnetRegistrationCost `protobuf:"bytes,2,rep,name=subnets,proto3" json:"subnets,omitempty"`
+}
+
+func (x *QueryRegistrationCostsResponse) Reset() {
+	*x = QueryRegistrationCostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRegistrationCostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRegistrationCostsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryRegistrationCostsResponse.ProtoReflect.Descriptor instead.
+func (*QueryRegistrationCostsResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryRegistrationCostsResponse) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *QueryRegistrationCostsResponse) GetSubnets() []*SubnetRegistrationCost {
+	if x != nil {
+		return x.Subnets
+	}
+	return nil
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{14}
+}
+
+// QueryParamsResponse is the response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PriceHistoryRetention  uint64 `protobuf:"varint,1,opt,name=price_history_retention,json=priceHistoryRetention,proto3" json:"price_history_retention,omitempty"`
+	ChildKeyCooldown       uint64 `protobuf:"varint,2,opt,name=child_key_cooldown,json=childKeyCooldown,proto3" json:"child_key_cooldown,omitempty"`
+	MaxChildKeyTake        string `protobuf:"bytes,3,opt,name=max_child_key_take,json=maxChildKeyTake,proto3" json:"max_child_key_take,omitempty"`
+	MinValidatorTake       string `protobuf:"bytes,4,opt,name=min_validator_take,json=minValidatorTake,proto3" json:"min_validator_take,omitempty"`
+	MaxValidatorTake       string `protobuf:"bytes,5,opt,name=max_validator_take,json=maxValidatorTake,proto3" json:"max_validator_take,omitempty"`
+	DefaultValidatorTake   string `protobuf:"bytes,6,opt,name=default_validator_take,json=defaultValidatorTake,proto3" json:"default_validator_take,omitempty"`
+	ValidatorTakeCooldown  uint64 `protobuf:"varint,7,opt,name=validator_take_cooldown,json=validatorTakeCooldown,proto3" json:"validator_take_cooldown,omitempty"`
+	NativeStakeEnabled     bool   `protobuf:"varint,8,opt,name=native_stake_enabled,json=nativeStakeEnabled,proto3" json:"native_stake_enabled,omitempty"`
+	AlphaTokenPairsEnabled bool   `protobuf:"varint,9,opt,name=alpha_token_pairs_enabled,json=alphaTokenPairsEnabled,proto3" json:"alpha_token_pairs_enabled,omitempty"`
+	PriceCandleRetention   uint64 `protobuf:"varint,10,opt,name=price_candle_retention,json=priceCandleRetention,proto3" json:"price_candle_retention,omitempty"`
+	GlobalStakingAddress   string `protobuf:"bytes,11,opt,name=global_staking_address,json=globalStakingAddress,proto3" json:"global_staking_address,omitempty"`
+	SubnetManagerAddress   string `protobuf:"bytes,12,opt,name=subnet_manager_address,json=subnetManagerAddress,proto3" json:"subnet_manager_address,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QueryParamsResponse) GetPriceHistoryRetention() uint64 {
+	if x != nil {
+		return x.PriceHistoryRetention
+	}
+	return 0
+}
+
+func (x *QueryParamsResponse) GetChildKeyCooldown() uint64 {
+	if x != nil {
+		return x.ChildKeyCooldown
+	}
+	return 0
+}
+
+func (x *QueryParamsResponse) GetMaxChildKeyTake() string {
+	if x != nil {
+		return x.MaxChildKeyTake
+	}
+	return ""
+}
+
+func (x *QueryParamsResponse) GetMinValidatorTake() string {
+	if x != nil {
+		return x.MinValidatorTake
+	}
+	return ""
+}
+
+func (x *QueryParamsResponse) GetMaxValidatorTake() string {
+	if x != nil {
+		return x.MaxValidatorTake
+	}
+	return ""
+}
+
+func (x *QueryParamsResponse) GetDefaultValidatorTake() string {
+	if x != nil {
+		return x.DefaultValidatorTake
+	}
+	return ""
+}
+
+func (x *QueryParamsResponse) GetValidatorTakeCooldown() uint64 {
+	if x != nil {
+		return x.ValidatorTakeCooldown
+	}
+	return 0
+}
+
+func (x *QueryParamsResponse) GetNativeStakeEnabled() bool {
+	if x != nil {
+		return x.NativeStakeEnabled
+	}
+	return false
+}
+
+func (x *QueryParamsResponse) GetAlphaTokenPairsEnabled() bool {
+	if x != nil {
+		return x.AlphaTokenPairsEnabled
+	}
+	return false
+}
+
+func (x *QueryParamsResponse) GetPriceCandleRetention() uint64 {
+	if x != nil {
+		return x.PriceCandleRetention
+	}
+	return 0
+}
+
+func (x *QueryParamsResponse) GetGlobalStakingAddress() string {
+	if x != nil {
+		return x.GlobalStakingAddress
+	}
+	return ""
+}
+
+func (x *QueryParamsResponse) GetSubnetManagerAddress() string {
+	if x != nil {
+		return x.SubnetManagerAddress
+	}
+	return ""
+}
+
+// QueryPriceHistoryRequest is the request type for the Query/PriceHistory RPC method
+type QueryPriceHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Netuid uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	// start_height is the first height returned, inclusive
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last height returned, inclusive, zero for no bound
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// limit is the maximum number of snapshots returned, zero for the default
+	Limit uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *QueryPriceHistoryRequest) Reset() {
+	*x = QueryPriceHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPriceHistoryRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QueryPriceHistoryRequest) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+func (x *QueryPriceHistoryRequest) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *QueryPriceHistoryRequest) GetEndHeight() int64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+func (x *QueryPriceHistoryRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// QueryPriceHistoryResponse is the response type for the Query/PriceHistory RPC method
+type QueryPriceHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshots []*PriceSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	// next_height is the start_height of the next page, zero when there is none
+	NextHeight int64 `protobuf:"varint,2,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+}
+
+func (x *QueryPriceHistoryResponse) Reset() {
+	*x = QueryPriceHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPriceHistoryResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*QueryPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QueryPriceHistoryResponse) GetSnapshots() []*PriceSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *QueryPriceHistoryResponse) GetNextHeight() int64 {
+	if x != nil {
+		return x.NextHeight
+	}
+	return 0
+}
+
+// QueryPriceCandlesRequest is the request type for the Query/PriceCandles RPC method
+type QueryPriceCandlesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Netuid uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	// start_height is the first height returned, inclusive
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last height returned, inclusive, zero for no bound
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// limit is the maximum number of candles returned, zero for the default
+	Limit uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *QueryPriceCandlesRequest) Reset() {
+	*x = QueryPriceCandlesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPriceCandlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPriceCandlesRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPriceCandlesRequest.ProtoReflect.Descriptor instead.
+func (*QueryPriceCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *QueryPriceCandlesRequest) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+func (x *QueryPriceCandlesRequest) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *QueryPriceCandlesRequest) GetEndHeight() int64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+func (x *QueryPriceCandlesRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// QueryPriceCandlesResponse is the response type for the Query/PriceCandles RPC method
+type QueryPriceCandlesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Candles []*PriceCandle `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles,omitempty"`
+	// next_height is the start_height of the next page, zero when there is none
+	NextHeight int64 `protobuf:"varint,2,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+}
+
+func (x *QueryPriceCandlesResponse) Reset() {
+	*x = QueryPriceCandlesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPriceCandlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPriceCandlesResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPriceCandlesResponse.ProtoReflect.Descriptor instead.
+func (*QueryPriceCandlesResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueryPriceCandlesResponse) GetCandles() []*PriceCandle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+func (x *QueryPriceCandlesResponse) GetNextHeight() int64 {
+	if x != nil {
+		return x.NextHeight
+	}
+	return 0
+}
+
+// QueryChildKeysRequest is the request type for the Query/ChildKeys RPC method
+type QueryChildKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Netuid uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	Hotkey string `protobuf:"bytes,2,opt,name=hotkey,proto3" json:"hotkey,omitempty"`
+}
+
+func (x *QueryChildKeysRequest) Reset() {
+	*x = QueryChildKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryChildKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryChildKeysRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryChildKeysRequest.ProtoReflect.Descriptor instead.
+func (*QueryChildKeysRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QueryChildKeysRequest) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+func (x *QueryChildKeysRequest) GetHotkey() string {
+	if x != nil {
+		return x.Hotkey
+	}
+	return ""
+}
+
+// QueryChildKeysResponse is the response type for the Query/ChildKeys RPC method
+type QueryChildKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Netuid uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	Hotkey string `protobuf:"bytes,2,opt,name=hotkey,proto3" json:"hotkey,omitempty"`
+	// children the hotkey lends its stake weight to
+	Children []*ChildKeyInfo `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
+	// pending_children replace the children at cooldown_block
+	PendingChildren []*ChildKeyInfo `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
+	CooldownBlock   uint64          `protobuf:"varint,5,opt,name=cooldown_block,json=cooldownBlock,proto3" json:"cooldown_block,omitempty"`
+	// parents lending their stake weight to the hotkey
+	Parents []*ChildKeyInfo `protobuf:"bytes,6,rep,name=parents,proto3" json:"parents,omitempty"`
+	// take is the share of the parents' dividends kept by the hotkey
+	Take           string `protobuf:"bytes,7,opt,name=take,proto3" json:"take,omitempty"`
+	Stake          string `protobuf:"bytes,8,opt,name=stake,proto3" json:"stake,omitempty"`
+	EffectiveStake string `protobuf:"bytes,9,opt,name=effective_stake,json=effectiveStake,proto3" json:"effective_stake,omitempty"`
+}
+
+func (x *QueryChildKeysResponse) Reset() {
+	*x = QueryChildKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+}
+
+func (x *QueryChildKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryChildKeysResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryChildKeysResponse.ProtoReflect.Descriptor instead.
+func (*QueryChildKeysResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QueryChildKeysResponse) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
 	}
+	return 0
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: hetu/event/v1/query.proto
+func (x *QueryChildKeysResponse) GetHotkey() string {
+	if x != nil {
+		return x.Hotkey
+	}
+	return ""
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *QueryChildKeysResponse) GetChildren() []*ChildKeyInfo {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
 
-// QuerySubnetsRequest is the request type for the Query/Subnets RPC method
-type QuerySubnetsRequest struct {
+func (x *QueryChildKeysResponse) GetPendingChildren() []*ChildKeyInfo {
+	if x != nil {
+		return x.PendingChildren
+	}
+	return nil
+}
+
+func (x *QueryChildKeysResponse) GetCooldownBlock() uint64 {
+	if x != nil {
+		return x.CooldownBlock
+	}
+	return 0
+}
+
+func (x *QueryChildKeysResponse) GetParents() []*ChildKeyInfo {
+	if x != nil {
+		return x.Parents
+	}
+	return nil
+}
+
+func (x *QueryChildKeysResponse) GetTake() string {
+	if x != nil {
+		return x.Take
+	}
+	return ""
+}
+
+func (x *QueryChildKeysResponse) GetStake() string {
+	if x != nil {
+		return x.Stake
+	}
+	return ""
+}
+
+func (x *QueryChildKeysResponse) GetEffectiveStake() string {
+	if x != nil {
+		return x.EffectiveStake
+	}
+	return ""
+}
+
+// ChildKeyInfo represents the share of stake weight lent between a parent and a child key
+type ChildKeyInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Hotkey     string `protobuf:"bytes,1,opt,name=hotkey,proto3" json:"hotkey,omitempty"`
+	Proportion string `protobuf:"bytes,2,opt,name=proportion,proto3" json:"proportion,omitempty"`
 }
 
-func (x *QuerySubnetsRequest) Reset() {
-	*x = QuerySubnetsRequest{}
+func (x *ChildKeyInfo) Reset() {
+	*x = ChildKeyInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hetu_event_v1_query_proto_msgTypes[0]
+		mi := &file_hetu_event_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySubnetsRequest) String() string {
+func (x *ChildKeyInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySubnetsRequest) ProtoMessage() {}
+func (*ChildKeyInfo) ProtoMessage() {}
 
-// Deprecated: Use QuerySubnetsRequest.ProtoReflect.Descriptor instead.
-func (*QuerySubnetsRequest) Descriptor() ([]byte, []int) {
-	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ChildKeyInfo.ProtoReflect.Descriptor instead.
+func (*ChildKeyInfo) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
-// QuerySubnetsResponse is the response type for the Query/Subnets RPC method
-type QuerySubnetsResponse struct {
+func (x *ChildKeyInfo) GetHotkey() string {
+	if x != nil {
+		return x.Hotkey
+	}
+	return ""
+}
+
+func (x *ChildKeyInfo) GetProportion() string {
+	if x != nil {
+		return x.Proportion
+	}
+	return ""
+}
+
+// PriceSnapshot represents the price and emission state of a subnet at a tempo
+type PriceSnapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Subnets []*SubnetInfo `protobuf:"bytes,1,rep,name=subnets,proto3" json:"subnets,omitempty"`
+	Netuid          uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	Height          int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Price           string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	MovingPrice     string `protobuf:"bytes,4,opt,name=moving_price,json=movingPrice,proto3" json:"moving_price,omitempty"`
+	TaoIn           string `protobuf:"bytes,5,opt,name=tao_in,json=taoIn,proto3" json:"tao_in,omitempty"`
+	AlphaIn         string `protobuf:"bytes,6,opt,name=alpha_in,json=alphaIn,proto3" json:"alpha_in,omitempty"`
+	AlphaOut        string `protobuf:"bytes,7,opt,name=alpha_out,json=alphaOut,proto3" json:"alpha_out,omitempty"`
+	PendingEmission string `protobuf:"bytes,8,opt,name=pending_emission,json=pendingEmission,proto3" json:"pending_emission,omitempty"`
+	OwnerCut        string `protobuf:"bytes,9,opt,name=owner_cut,json=ownerCut,proto3" json:"owner_cut,omitempty"`
 }
 
-func (x *QuerySubnetsResponse) Reset() {
-	*x = QuerySubnetsResponse{}
+func (x *PriceSnapshot) Reset() {
+	*x = PriceSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hetu_event_v1_query_proto_msgTypes[1]
+		mi := &file_hetu_event_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySubnetsResponse) String() string {
+func (x *PriceSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySubnetsResponse) ProtoMessage() {}
+func (*PriceSnapshot) ProtoMessage() {}
 
-// Deprecated: Use QuerySubnetsResponse.ProtoReflect.Descriptor instead.
-func (*QuerySubnetsResponse) Descriptor() ([]byte, []int) {
-	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use PriceSnapshot.ProtoReflect.Descriptor instead.
+func (*PriceSnapshot) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QuerySubnetsResponse) GetSubnets() []*SubnetInfo {
+func (x *PriceSnapshot) GetNetuid() uint32 {
 	if x != nil {
-		return x.Subnets
+		return x.Netuid
 	}
-	return nil
+	return 0
 }
 
-// QuerySubnetRequest is the request type for the Query/Subnet RPC method
-type QuerySubnetRequest struct {
+func (x *PriceSnapshot) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *PriceSnapshot) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *PriceSnapshot) GetMovingPrice() string {
+	if x != nil {
+		return x.MovingPrice
+	}
+	return ""
+}
+
+func (x *PriceSnapshot) GetTaoIn() string {
+	if x != nil {
+		return x.TaoIn
+	}
+	return ""
+}
+
+func (x *PriceSnapshot) GetAlphaIn() string {
+	if x != nil {
+		return x.AlphaIn
+	}
+	return ""
+}
+
+func (x *PriceSnapshot) GetAlphaOut() string {
+	if x != nil {
+		return x.AlphaOut
+	}
+	return ""
+}
+
+func (x *PriceSnapshot) GetPendingEmission() string {
+	if x != nil {
+		return x.PendingEmission
+	}
+	return ""
+}
+
+func (x *PriceSnapshot) GetOwnerCut() string {
+	if x != nil {
+		return x.OwnerCut
+	}
+	return ""
+}
+
+// PriceCandle represents the OHLC price data of a subnet AMM pool for one block
+type PriceCandle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Netuid uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Open   string `protobuf:"bytes,3,opt,name=open,proto3" json:"open,omitempty"`
+	High   string `protobuf:"bytes,4,opt,name=high,proto3" json:"high,omitempty"`
+	Low    string `protobuf:"bytes,5,opt,name=low,proto3" json:"low,omitempty"`
+	Close  string `protobuf:"bytes,6,opt,name=close,proto3" json:"close,omitempty"`
+	// volume is the HETU volume of the swaps
+	Volume string `protobuf:"bytes,7,opt,name=volume,proto3" json:"volume,omitempty"`
+	// trades is the number of swaps
+	Trades uint64 `protobuf:"varint,8,opt,name=trades,proto3" json:"trades,omitempty"`
+}
+
+func (x *PriceCandle) Reset() {
+	*x = PriceCandle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceCandle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceCandle) ProtoMessage() {}
+
+// Deprecated: Use PriceCandle.ProtoReflect.Descriptor instead.
+func (*PriceCandle) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PriceCandle) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+func (x *PriceCandle) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *PriceCandle) GetOpen() string {
+	if x != nil {
+		return x.Open
+	}
+	return ""
+}
+
+func (x *PriceCandle) GetHigh() string {
+	if x != nil {
+		return x.High
+	}
+	return ""
+}
+
+func (x *PriceCandle) GetLow() string {
+	if x != nil {
+		return x.Low
+	}
+	return ""
+}
+
+func (x *PriceCandle) GetClose() string {
+	if x != nil {
+		return x.Close
+	}
+	return ""
+}
+
+func (x *PriceCandle) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *PriceCandle) GetTrades() uint64 {
+	if x != nil {
+		return x.Trades
+	}
+	return 0
+}
+
+// SubnetAllocationInfo represents the global stake an account allocated to a subnet
+type SubnetAllocationInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Netuid           uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	Amount           string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	RegistrationCost string `protobuf:"bytes,3,opt,name=registration_cost,json=registrationCost,proto3" json:"registration_cost,omitempty"`
+	LastUpdateBlock  uint64 `protobuf:"varint,4,opt,name=last_update_block,json=lastUpdateBlock,proto3" json:"last_update_block,omitempty"`
+}
+
+func (x *SubnetAllocationInfo) Reset() {
+	*x = SubnetAllocationInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubnetAllocationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubnetAllocationInfo) ProtoMessage() {}
+
+// Deprecated: Use SubnetAllocationInfo.ProtoReflect.Descriptor instead.
+func (*SubnetAllocationInfo) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SubnetAllocationInfo) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+func (x *SubnetAllocationInfo) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *SubnetAllocationInfo) GetRegistrationCost() string {
+	if x != nil {
+		return x.RegistrationCost
+	}
+	return ""
+}
+
+func (x *SubnetAllocationInfo) GetLastUpdateBlock() uint64 {
+	if x != nil {
+		return x.LastUpdateBlock
+	}
+	return 0
+}
+
+// SubnetRegistrationCost represents the registration costs paid for a subnet
+type SubnetRegistrationCost struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Netuid uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *QuerySubnetRequest) Reset() {
-	*x = QuerySubnetRequest{}
+func (x *SubnetRegistrationCost) Reset() {
+	*x = SubnetRegistrationCost{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hetu_event_v1_query_proto_msgTypes[2]
+		mi := &file_hetu_event_v1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySubnetRequest) String() string {
+func (x *SubnetRegistrationCost) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySubnetRequest) ProtoMessage() {}
+func (*SubnetRegistrationCost) ProtoMessage() {}
 
-// Deprecated: Use QuerySubnetRequest.ProtoReflect.Descriptor instead.
-func (*QuerySubnetRequest) Descriptor() ([]byte, []int) {
-	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SubnetRegistrationCost.ProtoReflect.Descriptor instead.
+func (*SubnetRegistrationCost) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *QuerySubnetRequest) GetNetuid() uint32 {
+func (x *SubnetRegistrationCost) GetNetuid() uint32 {
 	if x != nil {
 		return x.Netuid
 	}
 	return 0
 }
 
-// QuerySubnetResponse is the response type for the Query/Subnet RPC method
-type QuerySubnetResponse struct {
+func (x *SubnetRegistrationCost) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// SubnetInfo represents subnet information
+type SubnetInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Subnet *SubnetInfo `protobuf:"bytes,1,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Netuid         uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	Owner          string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	AlphaToken     string `protobuf:"bytes,3,opt,name=alpha_token,json=alphaToken,proto3" json:"alpha_token,omitempty"`
+	AmmPool        string `protobuf:"bytes,4,opt,name=amm_pool,json=ammPool,proto3" json:"amm_pool,omitempty"`
+	LockedAmount   string `protobuf:"bytes,5,opt,name=locked_amount,json=lockedAmount,proto3" json:"locked_amount,omitempty"`
+	PoolInitialTao string `protobuf:"bytes,6,opt,name=pool_initial_tao,json=poolInitialTao,proto3" json:"pool_initial_tao,omitempty"`
+	BurnedAmount   string `protobuf:"bytes,7,opt,name=burned_amount,json=burnedAmount,proto3" json:"burned_amount,omitempty"`
+	CreatedAt      uint64 `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IsActive       bool   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Name           string `protobuf:"bytes,10,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
+	ActivatedAt    uint64 `protobuf:"varint,12,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
+	ActivatedBlock uint64 `protobuf:"varint,13,opt,name=activated_block,json=activatedBlock,proto3" json:"activated_block,omitempty"`
 }
 
-func (x *QuerySubnetResponse) Reset() {
-	*x = QuerySubnetResponse{}
+func (x *SubnetInfo) Reset() {
+	*x = SubnetInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hetu_event_v1_query_proto_msgTypes[3]
+		mi := &file_hetu_event_v1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySubnetResponse) String() string {
+func (x *SubnetInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySubnetResponse) ProtoMessage() {}
+func (*SubnetInfo) ProtoMessage() {}
 
-// Deprecated: Use QuerySubnetResponse.ProtoReflect.Descriptor instead.
-func (*QuerySubnetResponse) Descriptor() ([]byte, []int) {
-	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SubnetInfo.ProtoReflect.Descriptor instead.
+func (*SubnetInfo) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *QuerySubnetResponse) GetSubnet() *SubnetInfo {
+func (x *SubnetInfo) GetNetuid() uint32 {
 	if x != nil {
-		return x.Subnet
+		return x.Netuid
 	}
-	return nil
+	return 0
 }
 
-// QuerySubnetNeuronsRequest is the request type for the Query/SubnetNeurons RPC method
-type QuerySubnetNeuronsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SubnetInfo) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
 
-	Netuid uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+func (x *SubnetInfo) GetAlphaToken() string {
+	if x != nil {
+		return x.AlphaToken
+	}
+	return ""
 }
 
-func (x *QuerySubnetNeuronsRequest) Reset() {
-	*x = QuerySubnetNeuronsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hetu_event_v1_query_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SubnetInfo) GetAmmPool() string {
+	if x != nil {
+		return x.AmmPool
 	}
+	return ""
 }
 
-func (x *QuerySubnetNeuronsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SubnetInfo) GetLockedAmount() string {
+	if x != nil {
+		return x.LockedAmount
+	}
+	return ""
 }
 
-func (*QuerySubnetNeuronsRequest) ProtoMessage() {}
+func (x *SubnetInfo) GetPoolInitialTao() string {
+	if x != nil {
+		return x.PoolInitialTao
+	}
+	return ""
+}
 
-// Deprecated: Use QuerySubnetNeuronsRequest.ProtoReflect.Descriptor instead.
-func (*QuerySubnetNeuronsRequest) Descriptor() ([]byte, []int) {
-	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{4}
+func (x *SubnetInfo) GetBurnedAmount() string {
+	if x != nil {
+		return x.BurnedAmount
+	}
+	return ""
 }
 
-func (x *QuerySubnetNeuronsRequest) GetNetuid() uint32 {
+func (x *SubnetInfo) GetCreatedAt() uint64 {
 	if x != nil {
-		return x.Netuid
+		return x.CreatedAt
 	}
 	return 0
 }
 
-// QuerySubnetNeuronsResponse is the response type for the Query/SubnetNeurons RPC method
-type QuerySubnetNeuronsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Neurons []*NeuronInfo `protobuf:"bytes,1,rep,name=neurons,proto3" json:"neurons,omitempty"`
+func (x *SubnetInfo) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
 }
 
-func (x *QuerySubnetNeuronsResponse) Reset() {
-	*x = QuerySubnetNeuronsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hetu_event_v1_query_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SubnetInfo) GetName() string {
+	if x != nil {
+		return x.Name
 	}
+	return ""
 }
 
-func (x *QuerySubnetNeuronsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SubnetInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (*QuerySubnetNeuronsResponse) ProtoMessage() {}
-
-// Deprecated: Use QuerySubnetNeuronsResponse.ProtoReflect.Descriptor instead.
-func (*QuerySubnetNeuronsResponse) Descriptor() ([]byte, []int) {
-	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{5}
+func (x *SubnetInfo) GetActivatedAt() uint64 {
+	if x != nil {
+		return x.ActivatedAt
+	}
+	return 0
 }
 
-func (x *QuerySubnetNeuronsResponse) GetNeurons() []*NeuronInfo {
+func (x *SubnetInfo) GetActivatedBlock() uint64 {
 	if x != nil {
-		return x.Neurons
+		return x.ActivatedBlock
 	}
-	return nil
+	return 0
 }
 
-// QuerySubnetPoolRequest is the request type for the Query/SubnetPool RPC method
-type QuerySubnetPoolRequest struct {
+// NeuronInfo represents neuron information
+type NeuronInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Netuid uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	Account                string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Netuid                 uint32 `protobuf:"varint,2,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	IsActive               bool   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsValidator            bool   `protobuf:"varint,4,opt,name=is_validator,json=isValidator,proto3" json:"is_validator,omitempty"`
+	RequestedValidatorRole bool   `protobuf:"varint,5,opt,name=requested_validator_role,json=requestedValidatorRole,proto3" json:"requested_validator_role,omitempty"`
+	Stake                  string `protobuf:"bytes,6,opt,name=stake,proto3" json:"stake,omitempty"`
+	RegistrationBlock      uint64 `protobuf:"varint,7,opt,name=registration_block,json=registrationBlock,proto3" json:"registration_block,omitempty"`
+	LastUpdate             uint64 `protobuf:"varint,8,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	AxonEndpoint           string `protobuf:"bytes,9,opt,name=axon_endpoint,json=axonEndpoint,proto3" json:"axon_endpoint,omitempty"`
+	AxonPort               uint32 `protobuf:"varint,10,opt,name=axon_port,json=axonPort,proto3" json:"axon_port,omitempty"`
+	PrometheusEndpoint     string `protobuf:"bytes,11,opt,name=prometheus_endpoint,json=prometheusEndpoint,proto3" json:"prometheus_endpoint,omitempty"`
+	PrometheusPort         uint32 `protobuf:"varint,12,opt,name=prometheus_port,json=prometheusPort,proto3" json:"prometheus_port,omitempty"`
 }
 
-func (x *QuerySubnetPoolRequest) Reset() {
-	*x = QuerySubnetPoolRequest{}
+func (x *NeuronInfo) Reset() {
+	*x = NeuronInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hetu_event_v1_query_proto_msgTypes[6]
+		mi := &file_hetu_event_v1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySubnetPoolRequest) String() string {
+func (x *NeuronInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySubnetPoolRequest) ProtoMessage() {}
+func (*NeuronInfo) ProtoMessage() {}
 
-// Deprecated: Use QuerySubnetPoolRequest.ProtoReflect.Descriptor instead.
-func (*QuerySubnetPoolRequest) Descriptor() ([]byte, []int) {
-	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use NeuronInfo.ProtoReflect.Descriptor instead.
+func (*NeuronInfo) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *QuerySubnetPoolRequest) GetNetuid() uint32 {
+func (x *NeuronInfo) GetAccount() string {
 	if x != nil {
-		return x.Netuid
+		return x.Account
 	}
-	return 0
+	return ""
 }
 
-// QuerySubnetPoolResponse is the response type for the Query/SubnetPool RPC method
-type QuerySubnetPoolResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Netuid         uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
-	AmmPool        string `protobuf:"bytes,2,opt,name=amm_pool,json=ammPool,proto3" json:"amm_pool,omitempty"`
-	LockedAmount   string `protobuf:"bytes,3,opt,name=locked_amount,json=lockedAmount,proto3" json:"locked_amount,omitempty"`
-	BurnedAmount   string `protobuf:"bytes,4,opt,name=burned_amount,json=burnedAmount,proto3" json:"burned_amount,omitempty"`
-	SubnetTaoIn    string `protobuf:"bytes,5,opt,name=subnet_tao_in,json=subnetTaoIn,proto3" json:"subnet_tao_in,omitempty"`
-	SubnetAlphaIn  string `protobuf:"bytes,6,opt,name=subnet_alpha_in,json=subnetAlphaIn,proto3" json:"subnet_alpha_in,omitempty"`
-	SubnetAlphaOut string `protobuf:"bytes,7,opt,name=subnet_alpha_out,json=subnetAlphaOut,proto3" json:"subnet_alpha_out,omitempty"`
+func (x *NeuronInfo) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
 }
 
-func (x *QuerySubnetPoolResponse) Reset() {
-	*x = QuerySubnetPoolResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hetu_event_v1_query_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *NeuronInfo) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
 	}
+	return false
 }
 
-func (x *QuerySubnetPoolResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *NeuronInfo) GetIsValidator() bool {
+	if x != nil {
+		return x.IsValidator
+	}
+	return false
 }
 
-func (*QuerySubnetPoolResponse) ProtoMessage() {}
-
-// Deprecated: Use QuerySubnetPoolResponse.ProtoReflect.Descriptor instead.
-func (*QuerySubnetPoolResponse) Descriptor() ([]byte, []int) {
-	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{7}
+func (x *NeuronInfo) GetRequestedValidatorRole() bool {
+	if x != nil {
+		return x.RequestedValidatorRole
+	}
+	return false
 }
 
-func (x *QuerySubnetPoolResponse) GetNetuid() uint32 {
+func (x *NeuronInfo) GetStake() string {
 	if x != nil {
-		return x.Netuid
+		return x.Stake
 	}
-	return 0
+	return ""
 }
 
-func (x *QuerySubnetPoolResponse) GetAmmPool() string {
+func (x *NeuronInfo) GetRegistrationBlock() uint64 {
 	if x != nil {
-		return x.AmmPool
+		return x.RegistrationBlock
 	}
-	return ""
+	return 0
 }
 
-func (x *QuerySubnetPoolResponse) GetLockedAmount() string {
+func (x *NeuronInfo) GetLastUpdate() uint64 {
 	if x != nil {
-		return x.LockedAmount
+		return x.LastUpdate
 	}
-	return ""
+	return 0
 }
 
-func (x *QuerySubnetPoolResponse) GetBurnedAmount() string {
+func (x *NeuronInfo) GetAxonEndpoint() string {
 	if x != nil {
-		return x.BurnedAmount
+		return x.AxonEndpoint
 	}
 	return ""
 }
 
-func (x *QuerySubnetPoolResponse) GetSubnetTaoIn() string {
+func (x *NeuronInfo) GetAxonPort() uint32 {
 	if x != nil {
-		return x.SubnetTaoIn
+		return x.AxonPort
 	}
-	return ""
+	return 0
 }
 
-func (x *QuerySubnetPoolResponse) GetSubnetAlphaIn() string {
+func (x *NeuronInfo) GetPrometheusEndpoint() string {
 	if x != nil {
-		return x.SubnetAlphaIn
+		return x.PrometheusEndpoint
 	}
 	return ""
 }
 
-func (x *QuerySubnetPoolResponse) GetSubnetAlphaOut() string {
+func (x *NeuronInfo) GetPrometheusPort() uint32 {
 	if x != nil {
-		return x.SubnetAlphaOut
+		return x.PrometheusPort
 	}
-	return ""
+	return 0
 }
 
-// QueryValidatorWeightsRequest is the request type for the Query/ValidatorWeights RPC method
-type QueryValidatorWeightsRequest struct {
+// QueryValidatorTakeRequest is the request type for the Query/ValidatorTake RPC method
+type QueryValidatorTakeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -7331,346 +23411,375 @@ type QueryValidatorWeightsRequest struct {
 	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func (x *QueryValidatorWeightsRequest) Reset() {
-	*x = QueryValidatorWeightsRequest{}
+func (x *QueryValidatorTakeRequest) Reset() {
+	*x = QueryValidatorTakeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hetu_event_v1_query_proto_msgTypes[8]
+		mi := &file_hetu_event_v1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryValidatorWeightsRequest) String() string {
+func (x *QueryValidatorTakeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryValidatorWeightsRequest) ProtoMessage() {}
+func (*QueryValidatorTakeRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryValidatorWeightsRequest.ProtoReflect.Descriptor instead.
-func (*QueryValidatorWeightsRequest) Descriptor() ([]byte, []int) {
-	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use QueryValidatorTakeRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidatorTakeRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *QueryValidatorWeightsRequest) GetNetuid() uint32 {
+func (x *QueryValidatorTakeRequest) GetNetuid() uint32 {
 	if x != nil {
 		return x.Netuid
 	}
 	return 0
 }
 
-func (x *QueryValidatorWeightsRequest) GetValidator() string {
+func (x *QueryValidatorTakeRequest) GetValidator() string {
 	if x != nil {
 		return x.Validator
 	}
 	return ""
 }
 
-// QueryValidatorWeightsResponse is the response type for the Query/ValidatorWeights RPC method
-type QueryValidatorWeightsResponse struct {
+// QueryValidatorTakeResponse is the response type for the Query/ValidatorTake RPC method
+type QueryValidatorTakeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Netuid    uint32   `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
-	Validator string   `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
-	Weights   []string `protobuf:"bytes,3,rep,name=weights,proto3" json:"weights,omitempty"`
+	Netuid    uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// take is the share of the dividends kept by the validator
+	Take string `protobuf:"bytes,3,opt,name=take,proto3" json:"take,omitempty"`
+	// last_update_block is the block the take was last set at, zero for the default take
+	LastUpdateBlock uint64 `protobuf:"varint,4,opt,name=last_update_block,json=lastUpdateBlock,proto3" json:"last_update_block,omitempty"`
 }
 
-func (x *QueryValidatorWeightsResponse) Reset() {
-	*x = QueryValidatorWeightsResponse{}
+func (x *QueryValidatorTakeResponse) Reset() {
+	*x = QueryValidatorTakeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hetu_event_v1_query_proto_msgTypes[9]
+		mi := &file_hetu_event_v1_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryValidatorWeightsResponse) String() string {
+func (x *QueryValidatorTakeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryValidatorWeightsResponse) ProtoMessage() {}
+func (*QueryValidatorTakeResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryValidatorWeightsResponse.ProtoReflect.Descriptor instead.
-func (*QueryValidatorWeightsResponse) Descriptor() ([]byte, []int) {
-	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use QueryValidatorTakeResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidatorTakeResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *QueryValidatorWeightsResponse) GetNetuid() uint32 {
+func (x *QueryValidatorTakeResponse) GetNetuid() uint32 {
 	if x != nil {
 		return x.Netuid
 	}
 	return 0
 }
 
-func (x *QueryValidatorWeightsResponse) GetValidator() string {
+func (x *QueryValidatorTakeResponse) GetValidator() string {
 	if x != nil {
 		return x.Validator
 	}
 	return ""
 }
 
-func (x *QueryValidatorWeightsResponse) GetWeights() []string {
+func (x *QueryValidatorTakeResponse) GetTake() string {
 	if x != nil {
-		return x.Weights
+		return x.Take
 	}
-	return nil
+	return ""
 }
 
-// SubnetInfo represents subnet information
-type SubnetInfo struct {
+func (x *QueryValidatorTakeResponse) GetLastUpdateBlock() uint64 {
+	if x != nil {
+		return x.LastUpdateBlock
+	}
+	return 0
+}
+
+// QueryDividendsRequest is the request type for the Query/Dividends RPC method
+type QueryDividendsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Netuid         uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
-	Owner          string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	AlphaToken     string `protobuf:"bytes,3,opt,name=alpha_token,json=alphaToken,proto3" json:"alpha_token,omitempty"`
-	AmmPool        string `protobuf:"bytes,4,opt,name=amm_pool,json=ammPool,proto3" json:"amm_pool,omitempty"`
-	LockedAmount   string `protobuf:"bytes,5,opt,name=locked_amount,json=lockedAmount,proto3" json:"locked_amount,omitempty"`
-	PoolInitialTao string `protobuf:"bytes,6,opt,name=pool_initial_tao,json=poolInitialTao,proto3" json:"pool_initial_tao,omitempty"`
-	BurnedAmount   string `protobuf:"bytes,7,opt,name=burned_amount,json=burnedAmount,proto3" json:"burned_amount,omitempty"`
-	CreatedAt      uint64 `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	IsActive       bool   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Name           string `protobuf:"bytes,10,opt,name=name,proto3" json:"name,omitempty"`
-	Description    string `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
-	ActivatedAt    uint64 `protobuf:"varint,12,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
-	ActivatedBlock uint64 `protobuf:"varint,13,opt,name=activated_block,json=activatedBlock,proto3" json:"activated_block,omitempty"`
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
 }
 
-func (x *SubnetInfo) Reset() {
-	*x = SubnetInfo{}
+func (x *QueryDividendsRequest) Reset() {
+	*x = QueryDividendsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hetu_event_v1_query_proto_msgTypes[10]
+		mi := &file_hetu_event_v1_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubnetInfo) String() string {
+func (x *QueryDividendsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubnetInfo) ProtoMessage() {}
+func (*QueryDividendsRequest) ProtoMessage() {}
 
-// Deprecated: Use SubnetInfo.ProtoReflect.Descriptor instead.
-func (*SubnetInfo) Descriptor() ([]byte, []int) {
-	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use QueryDividendsRequest.ProtoReflect.Descriptor instead.
+func (*QueryDividendsRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SubnetInfo) GetNetuid() uint32 {
+func (x *QueryDividendsRequest) GetDelegator() string {
 	if x != nil {
-		return x.Netuid
+		return x.Delegator
 	}
-	return 0
+	return ""
 }
 
-func (x *SubnetInfo) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
+// QueryDividendsResponse is the response type for the Query/Dividends RPC method
+type QueryDividendsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delegator string               `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Dividends []*ClaimableDividend `protobuf:"bytes,2,rep,name=dividends,proto3" json:"dividends,omitempty"`
 }
 
-func (x *SubnetInfo) GetAlphaToken() string {
-	if x != nil {
-		return x.AlphaToken
+func (x *QueryDividendsResponse) Reset() {
+	*x = QueryDividendsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *SubnetInfo) GetAmmPool() string {
-	if x != nil {
-		return x.AmmPool
-	}
-	return ""
+func (x *QueryDividendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SubnetInfo) GetLockedAmount() string {
-	if x != nil {
-		return x.LockedAmount
-	}
-	return ""
+func (*QueryDividendsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryDividendsResponse.ProtoReflect.Descriptor instead.
+func (*QueryDividendsResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *SubnetInfo) GetPoolInitialTao() string {
+func (x *QueryDividendsResponse) GetDelegator() string {
 	if x != nil {
-		return x.PoolInitialTao
+		return x.Delegator
 	}
 	return ""
 }
 
-func (x *SubnetInfo) GetBurnedAmount() string {
+func (x *QueryDividendsResponse) GetDividends() []*ClaimableDividend {
 	if x != nil {
-		return x.BurnedAmount
+		return x.Dividends
 	}
-	return ""
+	return nil
 }
 
-func (x *SubnetInfo) GetCreatedAt() uint64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
+// ClaimableDividend represents the alpha dividends a delegator can claim on a subnet
+type ClaimableDividend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Netuid uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *SubnetInfo) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
+func (x *ClaimableDividend) Reset() {
+	*x = ClaimableDividend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *SubnetInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *ClaimableDividend) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SubnetInfo) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (*ClaimableDividend) ProtoMessage() {}
+
+// Deprecated: Use ClaimableDividend.ProtoReflect.Descriptor instead.
+func (*ClaimableDividend) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *SubnetInfo) GetActivatedAt() uint64 {
+func (x *ClaimableDividend) GetNetuid() uint32 {
 	if x != nil {
-		return x.ActivatedAt
+		return x.Netuid
 	}
 	return 0
 }
 
-func (x *SubnetInfo) GetActivatedBlock() uint64 {
+func (x *ClaimableDividend) GetAmount() string {
 	if x != nil {
-		return x.ActivatedBlock
+		return x.Amount
 	}
-	return 0
+	return ""
 }
 
-// NeuronInfo represents neuron information
-type NeuronInfo struct {
+// QueryNativeStakeRequest is the request type for the Query/NativeStake RPC method
+type QueryNativeStakeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account                string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Netuid                 uint32 `protobuf:"varint,2,opt,name=netuid,proto3" json:"netuid,omitempty"`
-	IsActive               bool   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	IsValidator            bool   `protobuf:"varint,4,opt,name=is_validator,json=isValidator,proto3" json:"is_validator,omitempty"`
-	RequestedValidatorRole bool   `protobuf:"varint,5,opt,name=requested_validator_role,json=requestedValidatorRole,proto3" json:"requested_validator_role,omitempty"`
-	Stake                  string `protobuf:"bytes,6,opt,name=stake,proto3" json:"stake,omitempty"`
-	RegistrationBlock      uint64 `protobuf:"varint,7,opt,name=registration_block,json=registrationBlock,proto3" json:"registration_block,omitempty"`
-	LastUpdate             uint64 `protobuf:"varint,8,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
-	AxonEndpoint           string `protobuf:"bytes,9,opt,name=axon_endpoint,json=axonEndpoint,proto3" json:"axon_endpoint,omitempty"`
-	AxonPort               uint32 `protobuf:"varint,10,opt,name=axon_port,json=axonPort,proto3" json:"axon_port,omitempty"`
-	PrometheusEndpoint     string `protobuf:"bytes,11,opt,name=prometheus_endpoint,json=prometheusEndpoint,proto3" json:"prometheus_endpoint,omitempty"`
-	PrometheusPort         uint32 `protobuf:"varint,12,opt,name=prometheus_port,json=prometheusPort,proto3" json:"prometheus_port,omitempty"`
+	// validator is the hex address of the validator operator
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func (x *NeuronInfo) Reset() {
-	*x = NeuronInfo{}
+func (x *QueryNativeStakeRequest) Reset() {
+	*x = QueryNativeStakeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hetu_event_v1_query_proto_msgTypes[11]
+		mi := &file_hetu_event_v1_query_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NeuronInfo) String() string {
+func (x *QueryNativeStakeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NeuronInfo) ProtoMessage() {}
+func (*QueryNativeStakeRequest) ProtoMessage() {}
 
-// Deprecated: Use NeuronInfo.ProtoReflect.Descriptor instead.
-func (*NeuronInfo) Descriptor() ([]byte, []int) {
-	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use QueryNativeStakeRequest.ProtoReflect.Descriptor instead.
+func (*QueryNativeStakeRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *NeuronInfo) GetAccount() string {
+func (x *QueryNativeStakeRequest) GetValidator() string {
 	if x != nil {
-		return x.Account
+		return x.Validator
 	}
 	return ""
 }
 
-func (x *NeuronInfo) GetNetuid() uint32 {
-	if x != nil {
-		return x.Netuid
-	}
-	return 0
+// QueryNativeStakeResponse is the response type for the Query/NativeStake RPC method
+type QueryNativeStakeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// bonded_tokens are the tokens bonded to the validator on x/staking
+	BondedTokens string `protobuf:"bytes,2,opt,name=bonded_tokens,json=bondedTokens,proto3" json:"bonded_tokens,omitempty"`
+	// enabled reports whether the native stake counts as subnet stake
+	Enabled bool                 `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Stakes  []*NativeSubnetStake `protobuf:"bytes,4,rep,name=stakes,proto3" json:"stakes,omitempty"`
 }
 
-func (x *NeuronInfo) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
+func (x *QueryNativeStakeResponse) Reset() {
+	*x = QueryNativeStakeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *NeuronInfo) GetIsValidator() bool {
-	if x != nil {
-		return x.IsValidator
-	}
-	return false
+func (x *QueryNativeStakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *NeuronInfo) GetRequestedValidatorRole() bool {
+func (*QueryNativeStakeResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryNativeStakeResponse.ProtoReflect.Descriptor instead.
+func (*QueryNativeStakeResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *QueryNativeStakeResponse) GetValidator() string {
 	if x != nil {
-		return x.RequestedValidatorRole
+		return x.Validator
 	}
-	return false
+	return ""
 }
 
-func (x *NeuronInfo) GetStake() string {
+func (x *QueryNativeStakeResponse) GetBondedTokens() string {
 	if x != nil {
-		return x.Stake
+		return x.BondedTokens
 	}
 	return ""
 }
 
-func (x *NeuronInfo) GetRegistrationBlock() uint64 {
+func (x *QueryNativeStakeResponse) GetEnabled() bool {
 	if x != nil {
-		return x.RegistrationBlock
+		return x.Enabled
 	}
-	return 0
+	return false
 }
 
-func (x *NeuronInfo) GetLastUpdate() uint64 {
+func (x *QueryNativeStakeResponse) GetStakes() []*NativeSubnetStake {
 	if x != nil {
-		return x.LastUpdate
+		return x.Stakes
 	}
-	return 0
+	return nil
 }
 
-func (x *NeuronInfo) GetAxonEndpoint() string {
-	if x != nil {
-		return x.AxonEndpoint
+// NativeSubnetStake represents the bonded tokens of a validator allocated to a subnet
+type NativeSubnetStake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Netuid     uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	Proportion string `protobuf:"bytes,2,opt,name=proportion,proto3" json:"proportion,omitempty"`
+	Amount     string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *NativeSubnetStake) Reset() {
+	*x = NativeSubnetStake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hetu_event_v1_query_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *NeuronInfo) GetAxonPort() uint32 {
+func (x *NativeSubnetStake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NativeSubnetStake) ProtoMessage() {}
+
+// Deprecated: Use NativeSubnetStake.ProtoReflect.Descriptor instead.
+func (*NativeSubnetStake) Descriptor() ([]byte, []int) {
+	return file_hetu_event_v1_query_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *NativeSubnetStake) GetNetuid() uint32 {
 	if x != nil {
-		return x.AxonPort
+		return x.Netuid
 	}
 	return 0
 }
 
-func (x *NeuronInfo) GetPrometheusEndpoint() string {
+func (x *NativeSubnetStake) GetProportion() string {
 	if x != nil {
-		return x.PrometheusEndpoint
+		return x.Proportion
 	}
 	return ""
 }
 
-func (x *NeuronInfo) GetPrometheusPort() uint32 {
+func (x *NativeSubnetStake) GetAmount() string {
 	if x != nil {
-		return x.PrometheusPort
+		return x.Amount
 	}
-	return 0
+	return ""
 }
 
 var File_hetu_event_v1_query_proto protoreflect.FileDescriptor
@@ -7737,117 +23846,435 @@ var file_hetu_event_v1_query_proto_rawDesc = []byte{
 	0x74, 0x75, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
 	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
 	0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0xa8, 0x03, 0x0a,
-	0x0a, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x35, 0x0a, 0x19,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x8f, 0x02, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x6b, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x0a,
+	0x11, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7d, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x45,
+	0x0a, 0x07, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x73, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x81, 0x05, 0x0a, 0x13,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x70, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x4b, 0x65,
+	0x79, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4b,
+	0x65, 0x79, 0x54, 0x61, 0x6b, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x54, 0x61, 0x6b, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x61,
+	0x6b, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x54, 0x61, 0x6b, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x6f, 0x6c, 0x64,
+	0x6f, 0x77, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x54, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e,
+	0x12, 0x30, 0x0a, 0x14, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
+	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x34, 0x0a,
+	0x16, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x72, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x73, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x73, 0x75, 0x62, 0x6e, 0x65,
+	0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x8a, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x65,
+	0x74, 0x75, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x64,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x7e, 0x0a, 0x19,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68,
+	0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x8a, 0x01, 0x0a,
+	0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x74,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x78, 0x0a, 0x19, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x47, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x65,
+	0x74, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x74, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x74, 0x6b, 0x65, 0x79, 0x22, 0x8c, 0x03, 0x0a,
+	0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x6f, 0x74, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x68, 0x6f, 0x74, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x68, 0x65, 0x74, 0x75,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4b,
+	0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x72, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f,
+	0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x3b, 0x0a, 0x07, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x68,
+	0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x6b, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x22, 0x46, 0x0a, 0x0c, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x6f, 0x74, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x74,
+	0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x8f, 0x02, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
+	0x6f, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x15,
+	0x0a, 0x06, 0x74, 0x61, 0x6f, 0x5f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x61, 0x6f, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x69,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x49, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x4f, 0x75, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x5f, 0x63, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x43, 0x75, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x10, 0x0a,
+	0x03, 0x6c, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b,
+	0x0a, 0x11, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x48, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x6e, 0x65,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xa8, 0x03, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x19, 0x0a, 0x08, 0x61, 0x6d, 0x6d, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x6d, 0x6d, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x28, 0x0a, 0x10, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x74, 0x61, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x6f, 0x6f, 0x6c, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x61, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0xba, 0x03, 0x0a,
+	0x0a, 0x4e, 0x65, 0x75, 0x72, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73,
+	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x38, 0x0a,
+	0x18, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x16, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x2d, 0x0a,
+	0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x78, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x78, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x78, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x78, 0x6f, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x12,
+	0x2f, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x5f, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x72,
+	0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x6d, 0x65,
+	0x74, 0x68, 0x65, 0x75, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x51, 0x0a, 0x19, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x61, 0x6b, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x92, 0x01, 0x0a,
+	0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x54,
+	0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e,
 	0x65, 0x74, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x65, 0x74,
-	0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d,
-	0x6d, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6d,
-	0x6d, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f,
-	0x63, 0x6b, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x6f,
-	0x6f, 0x6c, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x61, 0x6f, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x6c, 0x54, 0x61, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x72,
-	0x6e, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x27,
-	0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0xba, 0x03, 0x0a, 0x0a, 0x4e, 0x65, 0x75, 0x72,
-	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x18, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
-	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f,
-	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6c, 0x61,
-	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x78, 0x6f, 0x6e,
-	0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x61, 0x78, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x61, 0x78, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x08, 0x61, 0x78, 0x6f, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x72,
-	0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68,
-	0x65, 0x75, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x70,
-	0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73,
-	0x50, 0x6f, 0x72, 0x74, 0x32, 0xcc, 0x05, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x72,
-	0x0a, 0x07, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x68, 0x65, 0x74, 0x75,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x68, 0x65, 0x74,
+	0x75, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0x35, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x69, 0x76, 0x69, 0x64, 0x65,
+	0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x7c, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x44, 0x69, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x44, 0x0a, 0x09, 0x64, 0x69, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x69, 0x76,
+	0x69, 0x64, 0x65, 0x6e, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x64, 0x69, 0x76,
+	0x69, 0x64, 0x65, 0x6e, 0x64, 0x73, 0x22, 0x43, 0x0a, 0x11, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61,
+	0x62, 0x6c, 0x65, 0x44, 0x69, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x65, 0x74, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x65, 0x74,
+	0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x37, 0x0a, 0x17, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x22, 0xb7, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x23, 0x0a, 0x0d, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3e,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x22, 0x63,
+	0x0a, 0x11, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x32, 0xf9, 0x0f, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x72, 0x0a,
+	0x07, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x68,
+	0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x68, 0x65, 0x74, 0x75,
+	0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x73, 0x12, 0x77, 0x0a, 0x06, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x68, 0x65,
+	0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x68, 0x65, 0x74,
 	0x75, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6e, 0x65,
-	0x74, 0x73, 0x12, 0x77, 0x0a, 0x06, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x68,
+	0x74, 0x2f, 0x7b, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x94, 0x01, 0x0a, 0x0d, 0x53,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x4e, 0x65, 0x75, 0x72, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x68,
 	0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x22, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x68, 0x65,
-	0x74, 0x75, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6e,
-	0x65, 0x74, 0x2f, 0x7b, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x94, 0x01, 0x0a, 0x0d,
-	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x4e, 0x65, 0x75, 0x72, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e,
-	0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x4e, 0x65, 0x75, 0x72, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65,
+	0x72, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x4e, 0x65, 0x75, 0x72, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x4e, 0x65, 0x75, 0x72, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x68, 0x65, 0x74, 0x75,
+	0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x2f, 0x7b, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x65, 0x75, 0x72, 0x6f, 0x6e,
+	0x73, 0x12, 0x88, 0x01, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c,
+	0x12, 0x25, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65,
 	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62,
-	0x6e, 0x65, 0x74, 0x4e, 0x65, 0x75, 0x72, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x68, 0x65, 0x74,
-	0x75, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6e, 0x65,
-	0x74, 0x2f, 0x7b, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x65, 0x75, 0x72, 0x6f,
-	0x6e, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x50, 0x6f, 0x6f,
-	0x6c, 0x12, 0x25, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x50, 0x6f, 0x6f,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75,
-	0x62, 0x6e, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x68, 0x65, 0x74, 0x75, 0x2f,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x2f,
-	0x7b, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0xb3, 0x01,
-	0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x73, 0x12, 0x2b, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2c, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x68, 0x65, 0x74, 0x75, 0x2f, 0x65, 0x76, 0x65,
+	0x6e, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x68, 0x65, 0x74, 0x75, 0x2f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x2f, 0x7b,
+	0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0xb3, 0x01, 0x0a,
+	0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x12, 0x2b, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x68, 0x65, 0x74, 0x75, 0x2f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x6e, 0x65, 0x74,
+	0x75, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x7b,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x2f, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x12, 0x8c, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x12, 0x1e, 0x2f, 0x68, 0x65, 0x74, 0x75, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x7d, 0x12, 0x9b, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x68,
+	0x65, 0x74, 0x75, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x6e, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x2e, 0x68, 0x65, 0x74, 0x75,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x68,
+	0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x68, 0x65, 0x74, 0x75, 0x2f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x97, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x27, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x65, 0x74, 0x75,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x68, 0x65,
+	0x74, 0x75, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x2f, 0x7b, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x97, 0x01, 0x0a, 0x0c, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x68, 0x65, 0x74,
+	0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x68, 0x65, 0x74, 0x75, 0x2f, 0x65, 0x76, 0x65,
 	0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x6e, 0x65,
-	0x74, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f,
-	0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x2f, 0x77, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x73, 0x42, 0x9d, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x65, 0x74, 0x75,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x74, 0x75, 0x2f, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x48, 0x45, 0x58, 0xaa, 0x02, 0x0d, 0x48, 0x65, 0x74, 0x75, 0x2e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x48, 0x65, 0x74, 0x75, 0x5c, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x48, 0x65, 0x74, 0x75, 0x5c, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0f, 0x48, 0x65, 0x74, 0x75, 0x3a, 0x3a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x73, 0x12, 0x94, 0x01, 0x0a, 0x09, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x24, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4b, 0x65, 0x79, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x68, 0x65, 0x74, 0x75, 0x2f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x6e,
+	0x65, 0x74, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6b, 0x65, 0x79,
+	0x73, 0x2f, 0x7b, 0x68, 0x6f, 0x74, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0xa7, 0x01, 0x0a, 0x0d, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x61, 0x6b, 0x65, 0x12, 0x28, 0x2e, 0x68,
+	0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x61, 0x6b, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12, 0x39, 0x2f, 0x68, 0x65, 0x74, 0x75,
+	0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x2f, 0x7b, 0x6e, 0x65, 0x74, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x2f,
+	0x74, 0x61, 0x6b, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x09, 0x44, 0x69, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x64, 0x73, 0x12, 0x24, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x69, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x69,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x68, 0x65, 0x74, 0x75, 0x2f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x64,
+	0x73, 0x2f, 0x7b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x12, 0x8f, 0x01,
+	0x0a, 0x0b, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x26, 0x2e,
+	0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x68, 0x65, 0x74, 0x75, 0x2f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x42,
+	0x9d, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x65, 0x74, 0x75, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x74, 0x75, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x45,
+	0x58, 0xaa, 0x02, 0x0d, 0x48, 0x65, 0x74, 0x75, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x0d, 0x48, 0x65, 0x74, 0x75, 0x5c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x19, 0x48, 0x65, 0x74, 0x75, 0x5c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f,
+	0x48, 0x65, 0x74, 0x75, 0x3a, 0x3a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7862,40 +24289,92 @@ func file_hetu_event_v1_query_proto_rawDescGZIP() []byte {
 	return file_hetu_event_v1_query_proto_rawDescData
 }
 
-var file_hetu_event_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_hetu_event_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_hetu_event_v1_query_proto_goTypes = []interface{}{
-	(*QuerySubnetsRequest)(nil),           // 0: hetu.event.v1.QuerySubnetsRequest
-	(*QuerySubnetsResponse)(nil),          // 1: hetu.event.v1.QuerySubnetsResponse
-	(*QuerySubnetRequest)(nil),            // 2: hetu.event.v1.QuerySubnetRequest
-	(*QuerySubnetResponse)(nil),           // 3: hetu.event.v1.QuerySubnetResponse
-	(*QuerySubnetNeuronsRequest)(nil),     // 4: hetu.event.v1.QuerySubnetNeuronsRequest
-	(*QuerySubnetNeuronsResponse)(nil),    // 5: hetu.event.v1.QuerySubnetNeuronsResponse
-	(*QuerySubnetPoolRequest)(nil),        // 6: hetu.event.v1.QuerySubnetPoolRequest
-	(*QuerySubnetPoolResponse)(nil),       // 7: hetu.event.v1.QuerySubnetPoolResponse
-	(*QueryValidatorWeightsRequest)(nil),  // 8: hetu.event.v1.QueryValidatorWeightsRequest
-	(*QueryValidatorWeightsResponse)(nil), // 9: hetu.event.v1.QueryValidatorWeightsResponse
-	(*SubnetInfo)(nil),                    // 10: hetu.event.v1.SubnetInfo
-	(*NeuronInfo)(nil),                    // 11: hetu.event.v1.NeuronInfo
+	(*QuerySubnetsRequest)(nil),            // 0: hetu.event.v1.QuerySubnetsRequest
+	(*QuerySubnetsResponse)(nil),           // 1: hetu.event.v1.QuerySubnetsResponse
+	(*QuerySubnetRequest)(nil),             // 2: hetu.event.v1.QuerySubnetRequest
+	(*QuerySubnetResponse)(nil),            // 3: hetu.event.v1.QuerySubnetResponse
+	(*QuerySubnetNeuronsRequest)(nil),      // 4: hetu.event.v1.QuerySubnetNeuronsRequest
+	(*QuerySubnetNeuronsResponse)(nil),     // 5: hetu.event.v1.QuerySubnetNeuronsResponse
+	(*QuerySubnetPoolRequest)(nil),         // 6: hetu.event.v1.QuerySubnetPoolRequest
+	(*QuerySubnetPoolResponse)(nil),        // 7: hetu.event.v1.QuerySubnetPoolResponse
+	(*QueryValidatorWeightsRequest)(nil),   // 8: hetu.event.v1.QueryValidatorWeightsRequest
+	(*QueryValidatorWeightsResponse)(nil),  // 9: hetu.event.v1.QueryValidatorWeightsResponse
+	(*QueryStakePositionRequest)(nil),      // 10: hetu.event.v1.QueryStakePositionRequest
+	(*QueryStakePositionResponse)(nil),     // 11: hetu.event.v1.QueryStakePositionResponse
+	(*QueryRegistrationCostsRequest)(nil),  // 12: hetu.event.v1.QueryRegistrationCostsRequest
+	(*QueryRegistrationCostsResponse)(nil), // 13: hetu.event.v1.QueryRegistrationCostsResponse
+	(*QueryParamsRequest)(nil),             // 14: hetu.event.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),            // 15: hetu.event.v1.QueryParamsResponse
+	(*QueryPriceHistoryRequest)(nil),       // 16: hetu.event.v1.QueryPriceHistoryRequest
+	(*QueryPriceHistoryResponse)(nil),      // 17: hetu.event.v1.QueryPriceHistoryResponse
+	(*QueryPriceCandlesRequest)(nil),       // 18: hetu.event.v1.QueryPriceCandlesRequest
+	(*QueryPriceCandlesResponse)(nil),      // 19: hetu.event.v1.QueryPriceCandlesResponse
+	(*QueryChildKeysRequest)(nil),          // 20: hetu.event.v1.QueryChildKeysRequest
+	(*QueryChildKeysResponse)(nil),         // 21: hetu.event.v1.QueryChildKeysResponse
+	(*ChildKeyInfo)(nil),                   // 22: hetu.event.v1.ChildKeyInfo
+	(*PriceSnapshot)(nil),                  // 23: hetu.event.v1.PriceSnapshot
+	(*PriceCandle)(nil),                    // 24: hetu.event.v1.PriceCandle
+	(*SubnetAllocationInfo)(nil),           // 25: hetu.event.v1.SubnetAllocationInfo
+	(*SubnetRegistrationCost)(nil),         // 26: hetu.event.v1.SubnetRegistrationCost
+	(*SubnetInfo)(nil),                     // 27: hetu.event.v1.SubnetInfo
+	(*NeuronInfo)(nil),                     // 28: hetu.event.v1.NeuronInfo
+	(*QueryValidatorTakeRequest)(nil),      // 29: hetu.event.v1.QueryValidatorTakeRequest
+	(*QueryValidatorTakeResponse)(nil),     // 30: hetu.event.v1.QueryValidatorTakeResponse
+	(*QueryDividendsRequest)(nil),          // 31: hetu.event.v1.QueryDividendsRequest
+	(*QueryDividendsResponse)(nil),         // 32: hetu.event.v1.QueryDividendsResponse
+	(*ClaimableDividend)(nil),              // 33: hetu.event.v1.ClaimableDividend
+	(*QueryNativeStakeRequest)(nil),        // 34: hetu.event.v1.QueryNativeStakeRequest
+	(*QueryNativeStakeResponse)(nil),       // 35: hetu.event.v1.QueryNativeStakeResponse
+	(*NativeSubnetStake)(nil),              // 36: hetu.event.v1.NativeSubnetStake
 }
 var file_hetu_event_v1_query_proto_depIdxs = []int32{
-	10, // 0: hetu.event.v1.QuerySubnetsResponse.subnets:type_name -> hetu.event.v1.SubnetInfo
-	10, // 1: hetu.event.v1.QuerySubnetResponse.subnet:type_name -> hetu.event.v1.SubnetInfo
-	11, // 2: hetu.event.v1.QuerySubnetNeuronsResponse.neurons:type_name -> hetu.event.v1.NeuronInfo
-	0,  // 3: hetu.event.v1.Query.Subnets:input_type -> hetu.event.v1.QuerySubnetsRequest
-	2,  // 4: hetu.event.v1.Query.Subnet:input_type -> hetu.event.v1.QuerySubnetRequest
-	4,  // 5: hetu.event.v1.Query.SubnetNeurons:input_type -> hetu.event.v1.QuerySubnetNeuronsRequest
-	6,  // 6: hetu.event.v1.Query.SubnetPool:input_type -> hetu.event.v1.QuerySubnetPoolRequest
-	8,  // 7: hetu.event.v1.Query.ValidatorWeights:input_type -> hetu.event.v1.QueryValidatorWeightsRequest
-	1,  // 8: hetu.event.v1.Query.Subnets:output_type -> hetu.event.v1.QuerySubnetsResponse
-	3,  // 9: hetu.event.v1.Query.Subnet:output_type -> hetu.event.v1.QuerySubnetResponse
-	5,  // 10: hetu.event.v1.Query.SubnetNeurons:output_type -> hetu.event.v1.QuerySubnetNeuronsResponse
-	7,  // 11: hetu.event.v1.Query.SubnetPool:output_type -> hetu.event.v1.QuerySubnetPoolResponse
-	9,  // 12: hetu.event.v1.Query.ValidatorWeights:output_type -> hetu.event.v1.QueryValidatorWeightsResponse
-	8,  // [8:13] is the sub-list for method output_type
-	3,  // [3:8] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	27, // 0: hetu.event.v1.QuerySubnetsResponse.subnets:type_name -> hetu.event.v1.SubnetInfo
+	27, // 1: hetu.event.v1.QuerySubnetResponse.subnet:type_name -> hetu.event.v1.SubnetInfo
+	28, // 2: hetu.event.v1.QuerySubnetNeuronsResponse.neurons:type_name -> hetu.event.v1.NeuronInfo
+	25, // 3: hetu.event.v1.QueryStakePositionResponse.allocations:type_name -> hetu.event.v1.SubnetAllocationInfo
+	26, // 4: hetu.event.v1.QueryRegistrationCostsResponse.subnets:type_name -> hetu.event.v1.SubnetRegistrationCost
+	23, // 5: hetu.event.v1.QueryPriceHistoryResponse.snapshots:type_name -> hetu.event.v1.PriceSnapshot
+	24, // 6: hetu.event.v1.QueryPriceCandlesResponse.candles:type_name -> hetu.event.v1.PriceCandle
+	22, // 7: hetu.event.v1.QueryChildKeysResponse.children:type_name -> hetu.event.v1.ChildKeyInfo
+	22, // 8: hetu.event.v1.QueryChildKeysResponse.pending_children:type_name -> hetu.event.v1.ChildKeyInfo
+	22, // 9: hetu.event.v1.QueryChildKeysResponse.parents:type_name -> hetu.event.v1.ChildKeyInfo
+	33, // 10: hetu.event.v1.QueryDividendsResponse.dividends:type_name -> hetu.event.v1.ClaimableDividend
+	36, // 11: hetu.event.v1.QueryNativeStakeResponse.stakes:type_name -> hetu.event.v1.NativeSubnetStake
+	0,  // 12: hetu.event.v1.Query.Subnets:input_type -> hetu.event.v1.QuerySubnetsRequest
+	2,  // 13: hetu.event.v1.Query.Subnet:input_type -> hetu.event.v1.QuerySubnetRequest
+	4,  // 14: hetu.event.v1.Query.SubnetNeurons:input_type -> hetu.event.v1.QuerySubnetNeuronsRequest
+	6,  // 15: hetu.event.v1.Query.SubnetPool:input_type -> hetu.event.v1.QuerySubnetPoolRequest
+	8,  // 16: hetu.event.v1.Query.ValidatorWeights:input_type -> hetu.event.v1.QueryValidatorWeightsRequest
+	10, // 17: hetu.event.v1.Query.StakePosition:input_type -> hetu.event.v1.QueryStakePositionRequest
+	12, // 18: hetu.event.v1.Query.RegistrationCosts:input_type -> hetu.event.v1.QueryRegistrationCostsRequest
+	14, // 19: hetu.event.v1.Query.Params:input_type -> hetu.event.v1.QueryParamsRequest
+	16, // 20: hetu.event.v1.Query.PriceHistory:input_type -> hetu.event.v1.QueryPriceHistoryRequest
+	18, // 21: hetu.event.v1.Query.PriceCandles:input_type -> hetu.event.v1.QueryPriceCandlesRequest
+	20, // 22: hetu.event.v1.Query.ChildKeys:input_type -> hetu.event.v1.QueryChildKeysRequest
+	29, // 23: hetu.event.v1.Query.ValidatorTake:input_type -> hetu.event.v1.QueryValidatorTakeRequest
+	31, // 24: hetu.event.v1.Query.Dividends:input_type -> hetu.event.v1.QueryDividendsRequest
+	34, // 25: hetu.event.v1.Query.NativeStake:input_type -> hetu.event.v1.QueryNativeStakeRequest
+	1,  // 26: hetu.event.v1.Query.Subnets:output_type -> hetu.event.v1.QuerySubnetsResponse
+	3,  // 27: hetu.event.v1.Query.Subnet:output_type -> hetu.event.v1.QuerySubnetResponse
+	5,  // 28: hetu.event.v1.Query.SubnetNeurons:output_type -> hetu.event.v1.QuerySubnetNeuronsResponse
+	7,  // 29: hetu.event.v1.Query.SubnetPool:output_type -> hetu.event.v1.QuerySubnetPoolResponse
+	9,  // 30: hetu.event.v1.Query.ValidatorWeights:output_type -> hetu.event.v1.QueryValidatorWeightsResponse
+	11, // 31: hetu.event.v1.Query.StakePosition:output_type -> hetu.event.v1.QueryStakePositionResponse
+	13, // 32: hetu.event.v1.Query.RegistrationCosts:output_type -> hetu.event.v1.QueryRegistrationCostsResponse
+	15, // 33: hetu.event.v1.Query.Params:output_type -> hetu.event.v1.QueryParamsResponse
+	17, // 34: hetu.event.v1.Query.PriceHistory:output_type -> hetu.event.v1.QueryPriceHistoryResponse
+	19, // 35: hetu.event.v1.Query.PriceCandles:output_type -> hetu.event.v1.QueryPriceCandlesResponse
+	21, // 36: hetu.event.v1.Query.ChildKeys:output_type -> hetu.event.v1.QueryChildKeysResponse
+	30, // 37: hetu.event.v1.Query.ValidatorTake:output_type -> hetu.event.v1.QueryValidatorTakeResponse
+	32, // 38: hetu.event.v1.Query.Dividends:output_type -> hetu.event.v1.QueryDividendsResponse
+	35, // 39: hetu.event.v1.Query.NativeStake:output_type -> hetu.event.v1.QueryNativeStakeResponse
+	26, // [26:40] is the sub-list for method output_type
+	12, // [12:26] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_hetu_event_v1_query_proto_init() }
@@ -8025,7 +24504,7 @@ func file_hetu_event_v1_query_proto_init() {
 			}
 		}
 		file_hetu_event_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubnetInfo); i {
+			switch v := v.(*QueryStakePositionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8037,6 +24516,210 @@ func file_hetu_event_v1_query_proto_init() {
 			}
 		}
 		file_hetu_event_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryStakePositionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRegistrationCostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRegistrationCostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPriceHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPriceHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPriceCandlesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPriceCandlesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryChildKeysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryChildKeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChildKeyInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceCandle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubnetAllocationInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubnetRegistrationCost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubnetInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NeuronInfo); i {
 			case 0:
 				return &v.state
@@ -8048,6 +24731,102 @@ func file_hetu_event_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_hetu_event_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorTakeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorTakeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDividendsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDividendsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimableDividend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryNativeStakeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryNativeStakeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hetu_event_v1_query_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NativeSubnetStake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -8055,7 +24834,7 @@ func file_hetu_event_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_hetu_event_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
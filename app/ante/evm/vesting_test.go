@@ -2,16 +2,24 @@ package evm_test
 
 import (
 	"math/big"
+	"testing"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
+	"github.com/hetu-project/hetu/v1/app"
 	ethante "github.com/hetu-project/hetu/v1/app/ante/evm"
 	"github.com/hetu-project/hetu/v1/testutil"
 	testutiltx "github.com/hetu-project/hetu/v1/testutil/tx"
+	"github.com/hetu-project/hetu/v1/utils"
+	eventtypes "github.com/hetu-project/hetu/v1/x/event/types"
 	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
 	vestingtypes "github.com/hetu-project/hetu/v1/x/vesting/types"
 )
@@ -127,3 +135,94 @@ func (suite *AnteTestSuite) TestEthVestingTransactionDecorator() {
 		})
 	}
 }
+
+// TestEthVestingTransactionDecoratorSubnetAllocation checks that the locked
+// tokens a clawback vesting account allocates to subnet stake leave both the
+// EVM balance and the spendable balance consistent, and that a clawback undoes
+// the allocation.
+func TestEthVestingTransactionDecoratorSubnetAllocation(t *testing.T) {
+	evmosApp := app.EthSetup(false, nil)
+	_, err := evmosApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = evmosApp.Commit()
+	require.NoError(t, err)
+	header := tmproto.Header{Height: 2, ChainID: utils.MainnetChainID + "-1", Time: time.Now().UTC()}
+	ctx := evmosApp.BaseApp.NewUncachedContext(false, header)
+
+	denom := evmosApp.EvmKeeper.GetParams(ctx).EvmDenom
+	params, err := evmosApp.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.BondDenom = denom
+	require.NoError(t, evmosApp.StakingKeeper.SetParams(ctx, params))
+
+	evmosApp.EventKeeper.SetSubnet(ctx, eventtypes.Subnet{Netuid: 1, Owner: testutiltx.GenerateAddress().Hex()})
+
+	// the account vests 1000 over four periods, locked up until the end, and
+	// holds 100 free tokens for fees
+	funder := sdk.AccAddress(testutiltx.GenerateAddress().Bytes())
+	addr := testutiltx.GenerateAddress()
+	grant := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+	free := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+	require.NoError(t, testutil.FundAccount(ctx, evmosApp.BankKeeper, funder, grant))
+	_, err = evmosApp.VestingKeeper.CreateClawbackVestingAccount(ctx, vestingtypes.NewMsgCreateClawbackVestingAccount(
+		funder, addr.Bytes(), ctx.BlockTime(),
+		sdkvesting.Periods{{Length: 8000, Amount: grant}},
+		sdkvesting.Periods{
+			{Length: 2000, Amount: sdk.NewCoins(sdk.NewInt64Coin(denom, 250))},
+			{Length: 2000, Amount: sdk.NewCoins(sdk.NewInt64Coin(denom, 250))},
+			{Length: 2000, Amount: sdk.NewCoins(sdk.NewInt64Coin(denom, 250))},
+			{Length: 2000, Amount: sdk.NewCoins(sdk.NewInt64Coin(denom, 250))},
+		},
+		false,
+	))
+	require.NoError(t, err)
+	require.NoError(t, testutil.FundAccount(ctx, evmosApp.BankKeeper, addr.Bytes(), free))
+
+	// locked tokens are allocated within the locked coins only
+	allocation := sdk.NewInt64Coin(denom, 600)
+	_, err = evmosApp.VestingKeeper.AllocateToSubnet(ctx, vestingtypes.NewMsgAllocateToSubnet(addr.Bytes(), 1, sdk.NewInt64Coin(denom, 1001)))
+	require.ErrorIs(t, err, vestingtypes.ErrInsufficientLockedCoins)
+	_, err = evmosApp.VestingKeeper.AllocateToSubnet(ctx, vestingtypes.NewMsgAllocateToSubnet(addr.Bytes(), 1, allocation))
+	require.NoError(t, err)
+
+	own, _ := evmosApp.EventKeeper.GetStakeContributions(ctx, 1, addr.Hex())
+	require.Equal(t, allocation.Amount, own)
+	vestingModule := evmosApp.AccountKeeper.GetModuleAddress(vestingtypes.ModuleName)
+	require.Equal(t, allocation, evmosApp.BankKeeper.GetBalance(ctx, vestingModule, denom))
+
+	// the EVM balance is the bank balance without the escrowed tokens, and the
+	// spendable balance is still only the free tokens
+	require.Equal(t, big.NewInt(500), evmosApp.EvmKeeper.GetBalance(ctx, addr))
+	require.Equal(t, free, evmosApp.BankKeeper.SpendableCoins(ctx, addr.Bytes()))
+
+	dec := ethante.NewEthVestingTransactionDecorator(evmosApp.AccountKeeper, evmosApp.BankKeeper, evmosApp.EvmKeeper)
+	ethTx := func(amount int64) sdk.Tx {
+		tx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  evmosApp.EvmKeeper.ChainID(),
+			Nonce:    1,
+			To:       &addr,
+			Amount:   big.NewInt(amount),
+			GasLimit: 100000,
+			GasPrice: big.NewInt(1),
+		})
+		tx.From = addr.Hex()
+		return tx
+	}
+	_, err = dec.AnteHandle(ctx, ethTx(100), false, testutil.NextFn)
+	require.NoError(t, err)
+	_, err = dec.AnteHandle(ctx, ethTx(101), false, testutil.NextFn)
+	require.ErrorIs(t, err, vestingtypes.ErrInsufficientUnlockedCoins)
+
+	// a clawback after the first vesting period returns the allocated tokens
+	// first, then claws back the 750 unvested tokens
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2500 * time.Second))
+	_, err = evmosApp.VestingKeeper.Clawback(ctx, vestingtypes.NewMsgClawback(funder, addr.Bytes(), nil))
+	require.NoError(t, err)
+
+	own, _ = evmosApp.EventKeeper.GetStakeContributions(ctx, 1, addr.Hex())
+	require.True(t, own.IsZero())
+	require.True(t, evmosApp.BankKeeper.GetBalance(ctx, vestingModule, denom).IsZero())
+	require.Equal(t, int64(750), evmosApp.BankKeeper.GetBalance(ctx, funder, denom).Amount.Int64())
+	require.Equal(t, big.NewInt(350), evmosApp.EvmKeeper.GetBalance(ctx, addr))
+	require.Equal(t, free, evmosApp.BankKeeper.SpendableCoins(ctx, addr.Bytes()))
+}
@@ -36,6 +36,7 @@ import (
 	"cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmos "github.com/cometbft/cometbft/libs/os"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
 
@@ -64,7 +65,6 @@ import (
 	runtimeservices "github.com/cosmos/cosmos-sdk/runtime/services"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	sdktestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -118,6 +118,9 @@ import (
 
 	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
 
+	ibcfee "github.com/cosmos/ibc-go/v8/modules/apps/29-fee"
+	ibcfeekeeper "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/keeper"
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	ibctransfer "github.com/cosmos/ibc-go/v8/modules/apps/transfer"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/v8/modules/core"
@@ -131,11 +134,17 @@ import (
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 
+	packetforward "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward"
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
 	ratelimit "github.com/cosmos/ibc-apps/modules/rate-limiting/v8"
 	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/keeper"
 	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
 
 	ica "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icahost "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host"
 	icahostkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
@@ -158,10 +167,16 @@ import (
 	stakeworkkeeper "github.com/hetu-project/hetu/v1/x/stakework/keeper"
 	stakeworktypes "github.com/hetu-project/hetu/v1/x/stakework/types"
 
+	channelpause "github.com/hetu-project/hetu/v1/x/ibc/pause"
+	channelpausekeeper "github.com/hetu-project/hetu/v1/x/ibc/pause/keeper"
+	channelpausetypes "github.com/hetu-project/hetu/v1/x/ibc/pause/types"
+
 	// unnamed import of statik for swagger UI support
 	_ "github.com/hetu-project/hetu/v1/client/docs/statik"
 
 	"github.com/hetu-project/hetu/v1/app/ante"
+	evmmempool "github.com/hetu-project/hetu/v1/app/mempool"
+	"github.com/hetu-project/hetu/v1/app/upgrades/v1_0_0"
 	epochskeeper "github.com/hetu-project/hetu/v1/x/epochs/keeper"
 	epochstypes "github.com/hetu-project/hetu/v1/x/epochs/types"
 	"github.com/hetu-project/hetu/v1/x/erc20"
@@ -243,6 +258,8 @@ var (
 		slashing.AppModuleBasic{},
 		ibc.AppModuleBasic{},
 		ica.AppModuleBasic{},
+		ibcfee.AppModuleBasic{},
+		packetforward.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
 		feegrantmodule.AppModuleBasic{},
 		upgrade.AppModuleBasic{},
@@ -256,6 +273,7 @@ var (
 		blockinflation.AppModuleBasic{},
 		event.AppModuleBasic{},
 		ratelimit.AppModuleBasic{},
+		channelpause.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -267,10 +285,12 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		icatypes.ModuleName:            nil,
+		ibcfeetypes.ModuleName:         nil,
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
 		inflationtypes.ModuleName:      {authtypes.Minter},
 		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
 		ratelimittypes.ModuleName:      nil,
+		vestingtypes.ModuleName:        {authtypes.Staking}, // escrows the locked tokens vesting accounts allocate to subnet stake
 		blockinflationtypes.ModuleName: {authtypes.Minter, authtypes.Burner},
 	}
 
@@ -321,10 +341,14 @@ type Evmos struct {
 	AuthzKeeper           authzkeeper.Keeper
 	IBCKeeper             *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	ICAHostKeeper         icahostkeeper.Keeper
+	ICAControllerKeeper   icacontrollerkeeper.Keeper
+	IBCFeeKeeper          ibcfeekeeper.Keeper
+	PacketForwardKeeper   *packetforwardkeeper.Keeper
 	EvidenceKeeper        evidencekeeper.Keeper
 	TransferKeeper        transferkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 	RateLimitKeeper       ratelimitkeeper.Keeper
+	ChannelPauseKeeper    channelpausekeeper.Keeper
 	EventKeeper           *eventkeeper.Keeper
 
 	// make scoped keepers public for test purposes
@@ -381,14 +405,6 @@ func NewEvmos(
 	eip712.SetEncodingConfig(encodingConfig)
 	// setup memiavl if it's enabled in config
 	// baseAppOptions = memiavlstore.SetupMemIAVL(logger, homePath, appOpts, false, false, baseAppOptions)
-	// Setup Mempool and Proposal Handlers
-	baseAppOptions = append(baseAppOptions, func(app *baseapp.BaseApp) {
-		mempool := mempool.NoOpMempool{}
-		app.SetMempool(mempool)
-		handler := baseapp.NewDefaultProposalHandler(mempool, app)
-		app.SetPrepareProposal(handler.PrepareProposalHandler())
-		app.SetProcessProposal(handler.ProcessProposalHandler())
-	})
 	// enable optimistic execution
 	baseAppOptions = append(baseAppOptions, baseapp.SetOptimisticExecution())
 	// NOTE we use custom transaction decoder that supports the sdk.Tx interface instead of sdk.StdTx
@@ -412,9 +428,11 @@ func NewEvmos(
 		// ibc keys
 		ibcexported.StoreKey, ibctransfertypes.StoreKey,
 		// ica keys
-		icahosttypes.StoreKey,
+		icahosttypes.StoreKey, icacontrollertypes.StoreKey,
+		// ibc middleware keys
+		ibcfeetypes.StoreKey, packetforwardtypes.StoreKey,
 		// ibc rate-limit keys
-		ratelimittypes.StoreKey,
+		ratelimittypes.StoreKey, channelpausetypes.StoreKey,
 		// ethermint keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey,
 		// evmos keys
@@ -463,6 +481,7 @@ func NewEvmos(
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibcexported.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
 	app.CapabilityKeeper.Seal()
@@ -598,12 +617,6 @@ func NewEvmos(
 		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.StakingKeeper,
 		authtypes.FeeCollectorName,
 	)
-	app.StakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(
-			app.DistrKeeper.Hooks(),
-			app.SlashingKeeper.Hooks(),
-		),
-	)
 	app.VestingKeeper = vestingkeeper.NewKeeper(
 		keys[vestingtypes.StoreKey], appCodec,
 		app.AccountKeeper, app.BankKeeper, app.StakingKeeper,
@@ -628,14 +641,39 @@ func NewEvmos(
 			app.Erc20Keeper.Hooks(),
 		),
 	)
+	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
+		appCodec, keys[ibcfeetypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper: the fee middleware wraps the core channel
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.PortKeeper, app.AccountKeeper, app.BankKeeper,
+	)
+	// the transfer keeper is set once it is created, as both keepers depend on each other
+	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
+		appCodec,
+		keys[packetforwardtypes.StoreKey],
+		nil,
+		app.IBCKeeper.ChannelKeeper,
+		app.BankKeeper,
+		app.IBCFeeKeeper, // ICS4Wrapper: fee IBC middleware
+		authAddr,
+	)
+	app.ChannelPauseKeeper = channelpausekeeper.NewKeeper(
+		appCodec, keys[channelpausetypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper,
+		app.PacketForwardKeeper, // ICS4Wrapper: packet-forward IBC middleware
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+	)
+	// the quotas of the rate limits are a percentage of the supply of their
+	// denomination, which the erc20 keeper reads from the ERC20 contract for
+	// the tokens owned by a contract, such as the subnet alpha tokens
 	app.RateLimitKeeper = *ratelimitkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[ratelimittypes.StoreKey]),
 		app.GetSubspace(ratelimittypes.ModuleName),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-		app.BankKeeper,
+		app.Erc20Keeper,
 		app.IBCKeeper.ChannelKeeper,
-		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
+		app.ChannelPauseKeeper, // ICS4Wrapper: channelpause IBC middleware
 	)
 	app.TransferKeeper = transferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
@@ -645,11 +683,12 @@ func NewEvmos(
 		app.Erc20Keeper, // Add ERC20 Keeper for ERC20 transfers
 		authAddr,
 	)
+	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec, app.keys[icahosttypes.StoreKey],
 		app.GetSubspace(icahosttypes.SubModuleName),
-		app.IBCKeeper.ChannelKeeper,
+		app.IBCFeeKeeper, // ICS4Wrapper: fee IBC middleware
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
@@ -658,14 +697,55 @@ func NewEvmos(
 		authAddr,
 	)
 	app.ICAHostKeeper.WithQueryRouter(bApp.GRPCQueryRouter())
-	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
+	// the controller has no authentication module: the accounts are registered
+	// and controlled through the MsgRegisterInterchainAccount and MsgSendTx of
+	// the controller Msg service, signed by their owner
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec, app.keys[icacontrollertypes.StoreKey],
+		app.GetSubspace(icacontrollertypes.SubModuleName),
+		app.IBCFeeKeeper, // ICS4Wrapper: fee IBC middleware
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.PortKeeper,
+		scopedICAControllerKeeper,
+		bApp.MsgServiceRouter(),
+		authAddr,
+	)
+	// create the ICA host stack:
+	// icahost -> ibcfee
+	var icaHostStack porttypes.IBCModule
+	icaHostStack = icahost.NewIBCModule(app.ICAHostKeeper)
+	icaHostStack = ibcfee.NewIBCMiddleware(icaHostStack, app.IBCFeeKeeper)
+	// create the ICA controller stack:
+	// icacontroller -> ibcfee
+	var icaControllerStack porttypes.IBCModule
+	icaControllerStack = icacontroller.NewIBCMiddleware(nil, app.ICAControllerKeeper)
+	icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, app.IBCFeeKeeper)
+	// create the transfer stack, from the application to the core channel:
+	// transfer -> ratelimit -> channelpause -> packetforward -> erc20 -> ibcfee
+	//
+	// The packets of a paused channel are rejected before the rate limits count
+	// them, on both send and receive.
+	//
+	// The erc20 middleware sits above packetforward, so a packet forwarded through
+	// this chain is not converted here, while the final hop of a forward converts
+	// the received coins as any other transfer. The fee middleware is outermost so
+	// that the middlewares below it receive the unwrapped acknowledgements.
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
 	transferStack = ratelimit.NewIBCMiddleware(app.RateLimitKeeper, transferStack)
+	transferStack = channelpause.NewIBCMiddleware(app.ChannelPauseKeeper, transferStack)
+	transferStack = packetforward.NewIBCMiddleware(
+		transferStack,
+		app.PacketForwardKeeper,
+		0, // retries on timeout
+		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
+	)
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper)
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.
-		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
+		AddRoute(icahosttypes.SubModuleName, icaHostStack).
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
 		AddRoute(ibctransfertypes.ModuleName, transferStack)
 	app.IBCKeeper.SetRouter(ibcRouter)
 	evidenceKeeper := evidencekeeper.NewKeeper(
@@ -707,6 +787,10 @@ func NewEvmos(
 	if err != nil {
 		panic(fmt.Sprintf("failed to parse GlobalStaking ABI: %v", err))
 	}
+	subnetAMMABI, err := abi.JSON(strings.NewReader(string(eventabi.SubnetAMMABI)))
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse SubnetAMM ABI: %v", err))
+	}
 
 	app.EventKeeper = eventkeeper.NewKeeper(
 		appCodec,
@@ -718,6 +802,21 @@ func NewEvmos(
 		subnetManagerABI,
 		neuronManagerABI,
 		globalStakingABI,
+		subnetAMMABI,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+	)
+
+	// Bonded x/staking tokens allocated to subnets count as subnet stake
+	app.EventKeeper.SetStakingKeeper(app.StakingKeeper)
+	// Locked tokens allocated by vesting accounts count as subnet stake
+	app.VestingKeeper.SetEventKeeper(app.EventKeeper)
+	// Staking hooks are set once the event keeper exists
+	app.StakingKeeper.SetHooks(
+		stakingtypes.NewMultiStakingHooks(
+			app.DistrKeeper.Hooks(),
+			app.SlashingKeeper.Hooks(),
+			app.EventKeeper.Hooks(),
+		),
 	)
 
 	// Initialize StakeworkKeeper (must be after EventKeeper)
@@ -727,24 +826,33 @@ func NewEvmos(
 		app.EventKeeper,
 	)
 
-	subspace := app.GetSubspace(blockinflationtypes.ModuleName)
-	// fmt.Printf("DEBUG: blockinflation subspace is zero? %v, hasKeyTable? %v\n", reflect.ValueOf(subspace).IsZero(), subspace.HasKeyTable())
 	app.BlockInflationKeeper = blockinflationkeeper.NewKeeper(
 		appCodec,
 		keys[blockinflationtypes.StoreKey],
 		memKeys[blockinflationtypes.MemStoreKey],
 		app.AccountKeeper,
+		authkeeper.NewQueryServer(app.AccountKeeper),
 		app.BankKeeper,
 		app.EventKeeper,
 		app.StakeworkKeeper,
 		app.Erc20Keeper,
 		app.EvmKeeper,
+		distrkeeper.NewQuerier(app.DistrKeeper),
 		authtypes.FeeCollectorName,
-		subspace,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 	if app.BlockInflationKeeper == nil {
 		panic("app.BlockInflationKeeper is nil after NewKeeper")
 	}
+	// Delegators claim their dividends as alpha tokens minted by the block inflation module
+	app.EventKeeper.SetAlphaMinter(app.BlockInflationKeeper)
+	// Subnet registration and lock costs paid to the contracts are applied to the supply by its policy
+	app.EventKeeper.SetSubnetCostPolicy(app.BlockInflationKeeper)
+	// The alpha tokens of new subnets are registered as x/erc20 token pairs
+	app.EventKeeper.SetAlphaTokenRegistrar(app.Erc20Keeper)
+	// x/inflation mints through the supply policy of the block inflation module,
+	// which enforces the supply cap and the active minter across both modules
+	app.InflationKeeper.SetSupplyPolicy(app.BlockInflationKeeper)
 	/****  Module Options ****/
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
@@ -772,10 +880,13 @@ func NewEvmos(
 
 		// ibc modules
 		ibc.NewAppModule(app.IBCKeeper),
-		ica.NewAppModule(nil, &app.ICAHostKeeper),
+		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
+		ibcfee.NewAppModule(app.IBCFeeKeeper),
 		transferModule,
+		packetforward.NewAppModule(app.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName)),
 		ibctm.NewAppModule(),
 		ratelimit.NewAppModule(appCodec, app.RateLimitKeeper),
+		channelpause.NewAppModule(app.ChannelPauseKeeper),
 		// Ethermint app modules
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, app.GetSubspace(evmtypes.ModuleName)),
 		feemarket.NewAppModule(app.FeeMarketKeeper, app.GetSubspace(feemarkettypes.ModuleName)),
@@ -787,7 +898,8 @@ func NewEvmos(
 		// epochs.NewAppModule(appCodec, app.EpochsKeeper), // Commented out epochs module registration
 		vesting.NewAppModule(app.VestingKeeper, app.AccountKeeper, app.BankKeeper, *app.StakingKeeper),
 		stakework.NewAppModule(appCodec, app.StakeworkKeeper),
-		blockinflation.NewAppModule(*app.BlockInflationKeeper),
+		blockinflation.NewAppModule(*app.BlockInflationKeeper,
+			app.GetSubspace(blockinflationtypes.ModuleName)),
 		event.NewAppModule(appCodec, *app.EventKeeper),
 	)
 	// BasicModuleManager defines the module BasicManager which is in charge of setting up basic,
@@ -839,6 +951,8 @@ func NewEvmos(
 		// no-op modules
 		ibctransfertypes.ModuleName,
 		icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
+		packetforwardtypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		govtypes.ModuleName,
@@ -866,6 +980,8 @@ func NewEvmos(
 		ibcexported.ModuleName,
 		ibctransfertypes.ModuleName,
 		icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
+		packetforwardtypes.ModuleName,
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
@@ -913,6 +1029,8 @@ func NewEvmos(
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
 		icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
+		packetforwardtypes.ModuleName,
 		authz.ModuleName,
 		feegrant.ModuleName,
 		paramstypes.ModuleName,
@@ -924,6 +1042,7 @@ func NewEvmos(
 		erc20types.ModuleName,
 		// epochs.ModuleName, // Commented out epochs module registration
 		ratelimittypes.ModuleName,
+		channelpausetypes.ModuleName,
 		blockinflationtypes.ModuleName, // Block inflation module
 		// NOTE: crisis module must go at the end to check for invariants on each module
 		crisistypes.ModuleName,
@@ -949,6 +1068,7 @@ func NewEvmos(
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
 	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted)
 	app.setPostHandler()
+	app.setMempool()
 	app.SetEndBlocker(app.EndBlocker)
 	app.setupUpgradeHandlers()
 	protoFiles, err := proto.MergedRegistry()
@@ -1023,6 +1143,33 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 	app.SetAnteHandler(ante.NewAnteHandler(options))
 }
 
+// setMempool installs the nonce-aware priority mempool together with the
+// proposal handlers that build blocks from it. New senders are read from the
+// committed account nonces, and the mempool is reset with them after every block.
+func (app *Evmos) setMempool() {
+	cfg := evmmempool.DefaultConfig()
+	cfg.AccountNonce = func(ctx sdk.Context, addr sdk.AccAddress) uint64 {
+		acc := app.AccountKeeper.GetAccount(ctx, addr)
+		if acc == nil {
+			return 0
+		}
+		return acc.GetSequence()
+	}
+	cfg.StateContext = func() sdk.Context {
+		return sdk.NewContext(app.CommitMultiStore().CacheMultiStore(), tmproto.Header{}, true, app.Logger())
+	}
+	cfg.BaseFee = app.FeeMarketKeeper.GetBaseFee
+	cfg.TxEncoder = app.txConfig.TxEncoder()
+
+	mp := evmmempool.NewMempool(cfg)
+	app.SetMempool(mp)
+	app.SetPrepareCheckStater(mp.Reset)
+
+	handler := baseapp.NewDefaultProposalHandler(mp, app)
+	app.SetPrepareProposal(handler.PrepareProposalHandler())
+	app.SetProcessProposal(handler.ProcessProposalHandler())
+}
+
 func (app *Evmos) setPostHandler() {
 	postHandler, err := posthandler.NewPostHandler(
 		posthandler.HandlerOptions{},
@@ -1038,6 +1185,10 @@ func (app *Evmos) setPostHandler() {
 // of the new block for every registered module. If there is a registered fork at the current height,
 // BeginBlocker will schedule the upgrade plan and perform the state migration (if any).
 func (app *Evmos) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
+	// the rate-limit module does not implement the BeginBlock of the module
+	// manager, so its quotas are reset here at the end of their windows
+	app.RateLimitKeeper.BeginBlocker(ctx)
+
 	return app.mm.BeginBlock(ctx)
 }
 
@@ -1049,6 +1200,9 @@ func (app *Evmos) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
 // The DeliverTx method is intentionally decomposed to calculate the transactions per second.
 func (app *Evmos) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.ResponseFinalizeBlock, err error) {
 	defer func() {
+		if res == nil {
+			return
+		}
 		// TODO: Record the count along with the code and or reason so as to display
 		// in the transactions per second live dashboards.
 		for _, txRes := range res.TxResults {
@@ -1309,25 +1463,24 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(ibcexported.ModuleName).WithKeyTable(keyTable)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName).WithKeyTable(ibctransfertypes.ParamKeyTable())
 	paramsKeeper.Subspace(icahosttypes.SubModuleName).WithKeyTable(icahosttypes.ParamKeyTable())
+	paramsKeeper.Subspace(icacontrollertypes.SubModuleName).WithKeyTable(icacontrollertypes.ParamKeyTable())
+	paramsKeeper.Subspace(packetforwardtypes.ModuleName)
 	// ethermint subspaces
 	paramsKeeper.Subspace(evmtypes.ModuleName).WithKeyTable(evmtypes.ParamKeyTable()) //nolint: staticcheck
 	paramsKeeper.Subspace(feemarkettypes.ModuleName).WithKeyTable(feemarkettypes.ParamKeyTable())
 	// evmos subspaces
 	paramsKeeper.Subspace(inflationtypes.ModuleName)
 	paramsKeeper.Subspace(erc20types.ModuleName)
-	paramsKeeper.Subspace(blockinflationtypes.ModuleName).WithKeyTable(blockinflationtypes.ParamKeyTable())
+	paramsKeeper.Subspace(blockinflationtypes.ModuleName)
 	return paramsKeeper
 }
 
 func (app *Evmos) setupUpgradeHandlers() {
-	// v0 upgrade handler
-	// app.UpgradeKeeper.SetUpgradeHandler(
-	// 	v0.UpgradeName,
-	// 	v0.CreateUpgradeHandler(
-	// 		app.mm, app.configurator,
-	// 		app.DistrKeeper,
-	// 	),
-	// )
+	// v1.0.0 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v1_0_0.UpgradeName,
+		v1_0_0.CreateUpgradeHandler(app.mm, app.configurator, app.ICAControllerKeeper, app.EvmKeeper, app.EventKeeper),
+	)
 
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
@@ -1344,14 +1497,20 @@ func (app *Evmos) setupUpgradeHandlers() {
 	var storeUpgrades *storetypes.StoreUpgrades
 
 	switch upgradeInfo.Name {
-	// case v0.UpgradeName:
-	// no store upgrades
+	case v1_0_0.UpgradeName:
+		storeUpgrades = &v1_0_0.StoreUpgrades
 	default:
 		// no store upgrades
 	}
 
-	if storeUpgrades != nil {
+	if storeUpgrades != nil && hasStoreUpgrades(storeUpgrades) {
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, storeUpgrades))
 	}
 }
+
+// hasStoreUpgrades returns true if the store upgrades add, rename or delete
+// at least one store key.
+func hasStoreUpgrades(storeUpgrades *storetypes.StoreUpgrades) bool {
+	return len(storeUpgrades.Added) > 0 || len(storeUpgrades.Renamed) > 0 || len(storeUpgrades.Deleted) > 0
+}
@@ -0,0 +1,32 @@
+package mempool
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ModuleName is the codespace used by the app-side mempool errors.
+const ModuleName = "mempool"
+
+const (
+	codeErrNonceTooLow = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrReplaceUnderpriced
+	codeErrAccountQueueFull
+	codeErrInvalidTx
+)
+
+var (
+	// ErrNonceTooLow is returned if the nonce of a transaction is lower than the
+	// one present in the local chain.
+	ErrNonceTooLow = errorsmod.Register(ModuleName, codeErrNonceTooLow, "nonce too low")
+
+	// ErrReplaceUnderpriced is returned if a transaction is attempted to be replaced
+	// with a different one without the required price bump.
+	ErrReplaceUnderpriced = errorsmod.Register(ModuleName, codeErrReplaceUnderpriced, "replacement transaction underpriced")
+
+	// ErrAccountQueueFull is returned if an account already holds the maximum number
+	// of non-executable (future nonce) transactions.
+	ErrAccountQueueFull = errorsmod.Register(ModuleName, codeErrAccountQueueFull, "account queue full")
+
+	// ErrInvalidTx is returned if the sender or nonce of a transaction cannot be determined.
+	ErrInvalidTx = errorsmod.Register(ModuleName, codeErrInvalidTx, "invalid mempool transaction")
+)
@@ -0,0 +1,57 @@
+package mempool
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ sdkmempool.Iterator = (*iterator)(nil)
+
+// iterator walks over a snapshot of the transactions selected from the mempool.
+type iterator struct {
+	txs []sdk.Tx
+}
+
+// Next implements sdkmempool.Iterator. It returns nil once the snapshot is exhausted.
+func (it *iterator) Next() sdkmempool.Iterator {
+	if len(it.txs) <= 1 {
+		return nil
+	}
+	return &iterator{txs: it.txs[1:]}
+}
+
+// Tx implements sdkmempool.Iterator.
+func (it *iterator) Tx() sdk.Tx {
+	return it.txs[0]
+}
+
+// heapItem holds the remaining pending transactions of a sender, ranked by the
+// priority of the lowest nonce.
+type heapItem struct {
+	txs      []*poolTx
+	priority int64
+}
+
+// txHeap is a max-heap of senders ordered by priority, then by insertion order.
+type txHeap []*heapItem
+
+func (h txHeap) Len() int { return len(h) }
+
+func (h txHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+	return h[i].txs[0].seq < h[j].txs[0].seq
+}
+
+func (h txHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *txHeap) Push(x any) { *h = append(*h, x.(*heapItem)) }
+
+func (h *txHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
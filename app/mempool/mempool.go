@@ -0,0 +1,346 @@
+// Package mempool implements the application-side mempool used by the block
+// proposer. Transactions are grouped per sender account and kept in nonce order;
+// the executable (pending) transactions of every sender are then merged by
+// priority, which for Ethereum transactions is the effective gas tip.
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+const (
+	// DefaultPriceBump is the minimum price bump percentage required to replace
+	// a transaction with the same sender and nonce.
+	DefaultPriceBump uint64 = 10
+	// DefaultAccountQueue is the maximum number of non-executable transactions
+	// kept for a single account.
+	DefaultAccountQueue uint64 = 64
+	// DefaultMaxTxs is the maximum number of transactions held by the mempool.
+	DefaultMaxTxs = 5120
+)
+
+var _ sdkmempool.Mempool = (*Mempool)(nil)
+
+// Config defines the mempool limits and the state accessors used to order transactions.
+type Config struct {
+	// PriceBump is the minimum price bump percentage to replace an already existing transaction.
+	PriceBump uint64
+	// AccountQueue is the maximum number of non-executable transactions permitted per account.
+	AccountQueue uint64
+	// MaxTxs is the maximum number of transactions in the mempool. Zero means no limit.
+	MaxTxs int
+	// AccountNonce returns the nonce of the account on the given state. It is used
+	// to split pending from queued transactions and to evict included ones on Reset.
+	AccountNonce func(ctx sdk.Context, addr sdk.AccAddress) uint64
+	// StateContext returns a context on the last committed state. The nonce of a
+	// sender new to the mempool is read from it, since the AnteHandler already
+	// advanced the nonce on the check state of Insert.
+	StateContext func() sdk.Context
+	// BaseFee returns the base fee used to compute the effective gas tip of
+	// Ethereum transactions. A nil base fee orders them by gas price.
+	BaseFee func(ctx sdk.Context) *big.Int
+	// TxEncoder encodes Cosmos transactions to identify them by the hash of
+	// their bytes. Cosmos transactions are rejected without it.
+	TxEncoder sdk.TxEncoder
+}
+
+// DefaultConfig returns the default mempool configuration without state accessors.
+func DefaultConfig() Config {
+	return Config{
+		PriceBump:    DefaultPriceBump,
+		AccountQueue: DefaultAccountQueue,
+		MaxTxs:       DefaultMaxTxs,
+	}
+}
+
+// account holds the transactions of a single sender indexed by nonce.
+type account struct {
+	sender sdk.AccAddress
+	// nonce is the next nonce expected by the state for this account.
+	nonce uint64
+	// synced is true once the nonce has been read from the state on Reset.
+	synced bool
+	txs    map[uint64]*poolTx
+}
+
+// pending returns the transactions that are executable on top of the account
+// nonce, i.e. the contiguous nonce sequence starting at it.
+func (acc *account) pending() []*poolTx {
+	var txs []*poolTx
+	for nonce := acc.nonce; ; nonce++ {
+		ptx, ok := acc.txs[nonce]
+		if !ok {
+			return txs
+		}
+		txs = append(txs, ptx)
+	}
+}
+
+// queued returns the transactions that are waiting for a nonce gap to be filled,
+// sorted by nonce.
+func (acc *account) queued() []*poolTx {
+	pending := uint64(len(acc.pending()))
+	txs := make([]*poolTx, 0, len(acc.txs))
+	for nonce, ptx := range acc.txs {
+		if nonce >= acc.nonce+pending {
+			txs = append(txs, ptx)
+		}
+	}
+	sort.Slice(txs, func(i, j int) bool { return txs[i].nonce < txs[j].nonce })
+	return txs
+}
+
+// Mempool is a nonce-aware priority mempool for Ethereum and Cosmos transactions.
+// Each sender's transactions are only ever selected in nonce order, while the
+// heads of all senders compete on priority.
+type Mempool struct {
+	cfg Config
+
+	mtx      sync.RWMutex
+	accounts map[string]*account
+	count    int
+	seq      uint64
+}
+
+// NewMempool creates a new mempool with the given configuration.
+func NewMempool(cfg Config) *Mempool {
+	return &Mempool{
+		cfg:      cfg,
+		accounts: make(map[string]*account),
+	}
+}
+
+// Insert adds a transaction to the mempool. A transaction with the same sender
+// and nonce as an existing one replaces it only if it satisfies the price bump.
+// Transactions with a nonce gap are queued until the gap is filled.
+func (mp *Mempool) Insert(goCtx context.Context, tx sdk.Tx) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ptx, err := newPoolTx(ctx, tx, mp.cfg.TxEncoder)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	key := ptx.sender.String()
+	acc, ok := mp.accounts[key]
+	if !ok {
+		acc = mp.newAccount(ptx)
+	}
+
+	if ptx.nonce < acc.nonce {
+		if acc.synced {
+			return errorsmod.Wrapf(ErrNonceTooLow, "next nonce %d, tx nonce %d", acc.nonce, ptx.nonce)
+		}
+		acc.nonce = ptx.nonce
+	}
+
+	if old, ok := acc.txs[ptx.nonce]; ok {
+		if !canReplace(old, ptx, mp.cfg.PriceBump) {
+			return errorsmod.Wrapf(ErrReplaceUnderpriced, "minimum price bump is %d%%", mp.cfg.PriceBump)
+		}
+		ptx.seq = old.seq
+		acc.txs[ptx.nonce] = ptx
+		return nil
+	}
+
+	if mp.cfg.MaxTxs > 0 && mp.count >= mp.cfg.MaxTxs {
+		return sdkmempool.ErrMempoolTxMaxCapacity
+	}
+
+	if ptx.nonce > acc.nonce+uint64(len(acc.pending())) &&
+		mp.cfg.AccountQueue > 0 && uint64(len(acc.queued())) >= mp.cfg.AccountQueue {
+		return errorsmod.Wrapf(ErrAccountQueueFull, "limit %d", mp.cfg.AccountQueue)
+	}
+
+	mp.seq++
+	ptx.seq = mp.seq
+	acc.txs[ptx.nonce] = ptx
+	mp.accounts[key] = acc
+	mp.count++
+
+	return nil
+}
+
+// newAccount returns the account of a sender new to the mempool, with its nonce
+// read from the committed state. Without state accessors, the nonce of the first
+// transaction is assumed to be executable until the account nonce is read from
+// the state on Reset.
+func (mp *Mempool) newAccount(ptx *poolTx) *account {
+	acc := &account{sender: ptx.sender, nonce: ptx.nonce, txs: make(map[uint64]*poolTx)}
+	if mp.cfg.AccountNonce != nil && mp.cfg.StateContext != nil {
+		acc.nonce = mp.cfg.AccountNonce(mp.cfg.StateContext(), ptx.sender)
+		acc.synced = true
+	}
+	return acc
+}
+
+// Select returns an iterator over the executable transactions of the mempool.
+// Senders are interleaved by the priority of their lowest pending nonce, so that
+// nonces are never skipped. Ethereum transactions whose fee cap is below the
+// current base fee stop the selection of their sender.
+func (mp *Mempool) Select(goCtx context.Context, _ [][]byte) sdkmempool.Iterator {
+	var baseFee *big.Int
+	if mp.cfg.BaseFee != nil {
+		baseFee = mp.cfg.BaseFee(sdk.UnwrapSDKContext(goCtx))
+	}
+
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	heads := make(txHeap, 0, len(mp.accounts))
+	for _, acc := range mp.accounts {
+		pending := acc.pending()
+		if len(pending) == 0 || pending[0].underpriced(baseFee) {
+			continue
+		}
+		heads = append(heads, &heapItem{
+			txs:      pending,
+			priority: pending[0].effectivePriority(baseFee),
+		})
+	}
+	heap.Init(&heads)
+
+	var txs []sdk.Tx
+	for heads.Len() > 0 {
+		head := heads[0]
+		txs = append(txs, head.txs[0].tx)
+
+		head.txs = head.txs[1:]
+		if len(head.txs) == 0 || head.txs[0].underpriced(baseFee) {
+			heap.Pop(&heads)
+			continue
+		}
+		head.priority = head.txs[0].effectivePriority(baseFee)
+		heap.Fix(&heads, 0)
+	}
+
+	if len(txs) == 0 {
+		return nil
+	}
+	return &iterator{txs: txs}
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *Mempool) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+	return mp.count
+}
+
+// Remove removes a transaction from the mempool. The hash must match, so that a
+// replaced transaction does not evict its replacement.
+func (mp *Mempool) Remove(tx sdk.Tx) error {
+	ptx, err := newPoolTx(sdk.Context{}, tx, mp.cfg.TxEncoder)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	key := ptx.sender.String()
+	acc, ok := mp.accounts[key]
+	if !ok {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	old, ok := acc.txs[ptx.nonce]
+	if !ok || old.hash != ptx.hash {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	mp.removeTx(key, acc, ptx.nonce)
+	return nil
+}
+
+// Reset refreshes the account nonces from the given state and evicts the
+// transactions whose nonce has already been used. It is meant to be called
+// after every commit with the new check state.
+func (mp *Mempool) Reset(ctx sdk.Context) {
+	if mp.cfg.AccountNonce == nil {
+		return
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	for key, acc := range mp.accounts {
+		acc.nonce = mp.cfg.AccountNonce(ctx, acc.sender)
+		acc.synced = true
+		for nonce := range acc.txs {
+			if nonce < acc.nonce {
+				mp.removeTx(key, acc, nonce)
+			}
+		}
+	}
+}
+
+// removeTx deletes the transaction with the given nonce and drops the account
+// once it holds no more transactions. The caller must hold the write lock.
+func (mp *Mempool) removeTx(key string, acc *account, nonce uint64) {
+	delete(acc.txs, nonce)
+	mp.count--
+	if len(acc.txs) == 0 {
+		delete(mp.accounts, key)
+	}
+}
+
+// Content returns the Ethereum transactions of the mempool, split into pending
+// and queued, grouped by sender and sorted by nonce.
+func (mp *Mempool) Content() (pending, queued map[common.Address][]*evmtypes.MsgEthereumTx) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	pending = make(map[common.Address][]*evmtypes.MsgEthereumTx)
+	queued = make(map[common.Address][]*evmtypes.MsgEthereumTx)
+	for _, acc := range mp.accounts {
+		for _, ptx := range acc.pending() {
+			if ptx.isEthereum() {
+				addr := common.BytesToAddress(ptx.sender)
+				pending[addr] = append(pending[addr], ptx.msgs...)
+			}
+		}
+		for _, ptx := range acc.queued() {
+			if ptx.isEthereum() {
+				addr := common.BytesToAddress(ptx.sender)
+				queued[addr] = append(queued[addr], ptx.msgs...)
+			}
+		}
+	}
+	return pending, queued
+}
+
+// Stats returns the number of pending and queued Ethereum transactions, i.e.
+// the transactions listed by Content.
+func (mp *Mempool) Stats() (pending, queued int) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	for _, acc := range mp.accounts {
+		for _, ptx := range acc.pending() {
+			if ptx.isEthereum() {
+				pending += len(ptx.msgs)
+			}
+		}
+		for _, ptx := range acc.queued() {
+			if ptx.isEthereum() {
+				queued += len(ptx.msgs)
+			}
+		}
+	}
+	return pending, queued
+}
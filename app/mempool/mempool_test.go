@@ -0,0 +1,307 @@
+package mempool_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/app/mempool"
+	"github.com/hetu-project/hetu/v1/encoding"
+	utiltx "github.com/hetu-project/hetu/v1/testutil/tx"
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+func newContext(t *testing.T) sdk.Context {
+	key := storetypes.NewKVStoreKey("mempool_test")
+	return testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_mempool_test")).
+		WithBlockHeader(cmtproto.Header{}).
+		WithLogger(log.NewTestLogger(t))
+}
+
+// newEthTx returns a dynamic fee MsgEthereumTx with the sender already populated.
+func newEthTx(from common.Address, nonce uint64, feeCap, tipCap int64) *evmtypes.MsgEthereumTx {
+	to := utiltx.GenerateAddress()
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:   big.NewInt(9000),
+		Nonce:     nonce,
+		To:        &to,
+		Amount:    big.NewInt(1),
+		GasLimit:  21000,
+		GasFeeCap: big.NewInt(feeCap),
+		GasTipCap: big.NewInt(tipCap),
+		Accesses:  &ethtypes.AccessList{},
+	})
+	msg.From = from.Hex()
+	return msg
+}
+
+func newCosmosTx(t *testing.T, sender sdk.AccAddress, sequence uint64) sdk.Tx {
+	_, priv := utiltx.NewAccAddressAndKey()
+	txBuilder := encoding.MakeConfig().TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(sender, sender, sdk.NewCoins(sdk.NewInt64Coin("ahetu", 1)))))
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: sequence,
+	}))
+	return txBuilder.GetTx()
+}
+
+func selectAll(ctx sdk.Context, mp *mempool.Mempool) []sdk.Tx {
+	var txs []sdk.Tx
+	for it := mp.Select(ctx, nil); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	return txs
+}
+
+func nonces(t *testing.T, txs []sdk.Tx) []uint64 {
+	res := make([]uint64, len(txs))
+	for i, tx := range txs {
+		msg, ok := tx.(*evmtypes.MsgEthereumTx)
+		require.True(t, ok)
+		res[i] = msg.AsTransaction().Nonce()
+	}
+	return res
+}
+
+func newTestMempool(baseFee int64) *mempool.Mempool {
+	cfg := mempool.DefaultConfig()
+	cfg.BaseFee = func(sdk.Context) *big.Int { return big.NewInt(baseFee) }
+	cfg.TxEncoder = encoding.MakeConfig().TxConfig.TxEncoder()
+	return mempool.NewMempool(cfg)
+}
+
+// withStateNonces makes the mempool read the nonces of new senders from the
+// given map, on the committed state.
+func withStateNonces(ctx sdk.Context, cfg *mempool.Config, stateNonces map[common.Address]uint64) {
+	cfg.AccountNonce = func(_ sdk.Context, addr sdk.AccAddress) uint64 {
+		return stateNonces[common.BytesToAddress(addr)]
+	}
+	cfg.StateContext = func() sdk.Context { return ctx }
+}
+
+func TestSelectOrdersByTipAndNonce(t *testing.T) {
+	ctx := newContext(t)
+	alice, bob := utiltx.GenerateAddress(), utiltx.GenerateAddress()
+
+	cfg := mempool.DefaultConfig()
+	cfg.BaseFee = func(sdk.Context) *big.Int { return big.NewInt(100) }
+	withStateNonces(ctx, &cfg, map[common.Address]uint64{bob: 5})
+	mp := mempool.NewMempool(cfg)
+
+	// alice pays a low tip on her first nonce and a high one afterwards, bob pays
+	// a medium tip: nonce order must still be respected for alice.
+	aliceTxs := []*evmtypes.MsgEthereumTx{
+		newEthTx(alice, 0, 1_000, 10),
+		newEthTx(alice, 1, 1_000, 900),
+	}
+	bobTx := newEthTx(bob, 5, 1_000, 500)
+
+	require.NoError(t, mp.Insert(ctx, aliceTxs[1]))
+	require.NoError(t, mp.Insert(ctx, bobTx))
+	require.NoError(t, mp.Insert(ctx, aliceTxs[0]))
+	require.Equal(t, 3, mp.CountTx())
+
+	pending, queued := mp.Stats()
+	require.Equal(t, 3, pending)
+	require.Equal(t, 0, queued)
+
+	txs := selectAll(ctx, mp)
+	require.Len(t, txs, 3)
+	require.Equal(t, bobTx, txs[0])
+	require.Equal(t, aliceTxs[0], txs[1])
+	require.Equal(t, aliceTxs[1], txs[2])
+}
+
+func TestNewSenderNonceFromState(t *testing.T) {
+	ctx := newContext(t)
+	alice := utiltx.GenerateAddress()
+
+	cfg := mempool.DefaultConfig()
+	withStateNonces(ctx, &cfg, map[common.Address]uint64{alice: 3})
+	mp := mempool.NewMempool(cfg)
+
+	// the first tx seen from a sender is not executable unless it has the
+	// committed nonce
+	require.ErrorIs(t, mp.Insert(ctx, newEthTx(alice, 2, 1_000, 10)), mempool.ErrNonceTooLow)
+	require.NoError(t, mp.Insert(ctx, newEthTx(alice, 4, 1_000, 10)))
+	pending, queued := mp.Stats()
+	require.Equal(t, 0, pending)
+	require.Equal(t, 1, queued)
+	require.Nil(t, mp.Select(ctx, nil))
+
+	require.NoError(t, mp.Insert(ctx, newEthTx(alice, 3, 1_000, 10)))
+	require.Equal(t, []uint64{3, 4}, nonces(t, selectAll(ctx, mp)))
+}
+
+func TestQueuedUntilGapFilled(t *testing.T) {
+	ctx := newContext(t)
+	cfg := mempool.DefaultConfig()
+	cfg.AccountNonce = func(sdk.Context, sdk.AccAddress) uint64 { return 0 }
+	mp := mempool.NewMempool(cfg)
+
+	alice := utiltx.GenerateAddress()
+	require.NoError(t, mp.Insert(ctx, newEthTx(alice, 0, 1_000, 10)))
+	require.NoError(t, mp.Insert(ctx, newEthTx(alice, 2, 1_000, 10)))
+	require.NoError(t, mp.Insert(ctx, newEthTx(alice, 3, 1_000, 10)))
+
+	pending, queued := mp.Stats()
+	require.Equal(t, 1, pending)
+	require.Equal(t, 2, queued)
+	require.Equal(t, []uint64{0}, nonces(t, selectAll(ctx, mp)))
+
+	pendingTxs, queuedTxs := mp.Content()
+	require.Len(t, pendingTxs[alice], 1)
+	require.Len(t, queuedTxs[alice], 2)
+	require.Equal(t, uint64(2), queuedTxs[alice][0].AsTransaction().Nonce())
+
+	require.NoError(t, mp.Insert(ctx, newEthTx(alice, 1, 1_000, 10)))
+	pending, queued = mp.Stats()
+	require.Equal(t, 4, pending)
+	require.Equal(t, 0, queued)
+	require.Equal(t, []uint64{0, 1, 2, 3}, nonces(t, selectAll(ctx, mp)))
+}
+
+func TestReplaceByFee(t *testing.T) {
+	ctx := newContext(t)
+	mp := newTestMempool(0)
+
+	alice := utiltx.GenerateAddress()
+	original := newEthTx(alice, 0, 1_000, 100)
+	require.NoError(t, mp.Insert(ctx, original))
+
+	// below the 10% bump on either field
+	require.ErrorIs(t, mp.Insert(ctx, newEthTx(alice, 0, 1_000, 100)), mempool.ErrReplaceUnderpriced)
+	require.ErrorIs(t, mp.Insert(ctx, newEthTx(alice, 0, 1_099, 200)), mempool.ErrReplaceUnderpriced)
+	require.ErrorIs(t, mp.Insert(ctx, newEthTx(alice, 0, 2_000, 109)), mempool.ErrReplaceUnderpriced)
+
+	replacement := newEthTx(alice, 0, 1_100, 110)
+	require.NoError(t, mp.Insert(ctx, replacement))
+	require.Equal(t, 1, mp.CountTx())
+
+	// removing the replaced tx must not evict the replacement
+	require.ErrorIs(t, mp.Remove(original), sdkmempool.ErrTxNotFound)
+	require.Equal(t, []sdk.Tx{replacement}, selectAll(ctx, mp))
+
+	require.NoError(t, mp.Remove(replacement))
+	require.Zero(t, mp.CountTx())
+	require.Nil(t, mp.Select(ctx, nil))
+}
+
+func TestResetEvictsIncludedNonces(t *testing.T) {
+	ctx := newContext(t)
+	stateNonce := uint64(0)
+	cfg := mempool.DefaultConfig()
+	cfg.AccountNonce = func(sdk.Context, sdk.AccAddress) uint64 { return stateNonce }
+	mp := mempool.NewMempool(cfg)
+
+	alice := utiltx.GenerateAddress()
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		require.NoError(t, mp.Insert(ctx, newEthTx(alice, nonce, 1_000, 10)))
+	}
+
+	stateNonce = 2
+	mp.Reset(ctx)
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []uint64{2}, nonces(t, selectAll(ctx, mp)))
+	require.ErrorIs(t, mp.Insert(ctx, newEthTx(alice, 1, 1_000, 10)), mempool.ErrNonceTooLow)
+
+	stateNonce = 3
+	mp.Reset(ctx)
+	require.Zero(t, mp.CountTx())
+}
+
+func TestUnderpricedStopsSender(t *testing.T) {
+	ctx := newContext(t)
+	mp := newTestMempool(500)
+
+	alice := utiltx.GenerateAddress()
+	require.NoError(t, mp.Insert(ctx, newEthTx(alice, 0, 1_000, 10)))
+	require.NoError(t, mp.Insert(ctx, newEthTx(alice, 1, 400, 10)))
+	require.NoError(t, mp.Insert(ctx, newEthTx(alice, 2, 1_000, 10)))
+
+	require.Equal(t, []uint64{0}, nonces(t, selectAll(ctx, mp)))
+}
+
+func TestLimits(t *testing.T) {
+	ctx := newContext(t)
+	cfg := mempool.DefaultConfig()
+	cfg.AccountQueue = 1
+	cfg.MaxTxs = 3
+	mp := mempool.NewMempool(cfg)
+
+	alice, bob := utiltx.GenerateAddress(), utiltx.GenerateAddress()
+	require.NoError(t, mp.Insert(ctx, newEthTx(alice, 0, 1_000, 10)))
+	require.NoError(t, mp.Insert(ctx, newEthTx(alice, 2, 1_000, 10)))
+	require.ErrorIs(t, mp.Insert(ctx, newEthTx(alice, 3, 1_000, 10)), mempool.ErrAccountQueueFull)
+
+	require.NoError(t, mp.Insert(ctx, newEthTx(bob, 0, 1_000, 10)))
+	require.ErrorIs(t, mp.Insert(ctx, newEthTx(bob, 1, 1_000, 10)), sdkmempool.ErrMempoolTxMaxCapacity)
+}
+
+func TestCosmosTxs(t *testing.T) {
+	ctx := newContext(t)
+	mp := newTestMempool(0)
+
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	first := newCosmosTx(t, sender, 7)
+	second := newCosmosTx(t, sender, 8)
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), second))
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), first))
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(1), second), mempool.ErrReplaceUnderpriced)
+	require.NoError(t, mp.Insert(ctx.WithPriority(2), second))
+	require.Equal(t, 2, mp.CountTx())
+
+	ethSender := utiltx.GenerateAddress()
+	ethTx := newEthTx(ethSender, 0, 1_000, 10)
+	require.NoError(t, mp.Insert(ctx, ethTx))
+
+	txs := selectAll(ctx, mp)
+	require.Len(t, txs, 3)
+	// the eth tx tip is scaled down by the priority reduction, so the cosmos txs come first
+	require.Same(t, first, txs[0])
+	require.Same(t, second, txs[1])
+	require.Same(t, ethTx, txs[2])
+
+	// removing the replaced cosmos tx must not evict its replacement
+	replaced := newCosmosTx(t, sender, 7)
+	require.NoError(t, mp.Insert(ctx.WithPriority(2), replaced))
+	require.ErrorIs(t, mp.Remove(first), sdkmempool.ErrTxNotFound)
+	require.Equal(t, 3, mp.CountTx())
+	require.NoError(t, mp.Remove(replaced))
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), first))
+
+	// Stats counts the transactions listed by Content
+	pending, queued := mp.Content()
+	require.Len(t, pending, 1)
+	require.Contains(t, pending, ethSender)
+	require.Empty(t, queued)
+	pendingCount, queuedCount := mp.Stats()
+	require.Equal(t, 1, pendingCount)
+	require.Equal(t, 0, queuedCount)
+}
+
+func TestMultipleEthereumMessagesRejected(t *testing.T) {
+	ctx := newContext(t)
+	mp := newTestMempool(0)
+
+	alice := utiltx.GenerateAddress()
+	txBuilder := encoding.MakeConfig().TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(newEthTx(alice, 0, 1_000, 100), newEthTx(alice, 1, 1_000, 100)))
+
+	require.ErrorIs(t, mp.Insert(ctx, txBuilder.GetTx()), mempool.ErrInvalidTx)
+	require.Zero(t, mp.CountTx())
+}
@@ -0,0 +1,176 @@
+package mempool
+
+import (
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+// poolTx wraps a transaction held by the mempool together with the metadata
+// required to order it and to apply the replacement rules.
+type poolTx struct {
+	tx     sdk.Tx
+	sender sdk.AccAddress
+	nonce  uint64
+	// priority is the context priority computed by the AnteHandler at insertion.
+	priority int64
+	// seq is the insertion sequence, used as a FIFO tie breaker.
+	seq uint64
+	// hash identifies the transaction: the Ethereum transaction hash, or the
+	// hash of the tx bytes for Cosmos transactions.
+	hash common.Hash
+
+	// Ethereum transaction fields, nil for Cosmos transactions.
+	msgs      []*evmtypes.MsgEthereumTx
+	txData    []evmtypes.TxData
+	gasFeeCap *big.Int
+	gasTipCap *big.Int
+}
+
+// isEthereum returns true if the wrapped transaction carries MsgEthereumTx messages.
+func (ptx *poolTx) isEthereum() bool {
+	return len(ptx.msgs) > 0
+}
+
+// effectivePriority returns the priority used to order the transaction for block
+// inclusion. Ethereum transactions are ranked by their effective gas tip for the
+// given base fee, Cosmos transactions by the priority assigned by the AnteHandler.
+func (ptx *poolTx) effectivePriority(baseFee *big.Int) int64 {
+	if !ptx.isEthereum() {
+		return ptx.priority
+	}
+
+	if baseFee == nil {
+		// dynamic fee transactions require a base fee to compute their tip
+		baseFee = new(big.Int)
+	}
+
+	priority := int64(math.MaxInt64)
+	for _, txData := range ptx.txData {
+		if p := evmtypes.GetTxPriority(txData, baseFee); p < priority {
+			priority = p
+		}
+	}
+	return priority
+}
+
+// underpriced returns true if the fee cap of an Ethereum transaction cannot cover
+// the given base fee, in which case it cannot be included in the next block.
+func (ptx *poolTx) underpriced(baseFee *big.Int) bool {
+	return ptx.isEthereum() && baseFee != nil && ptx.gasFeeCap.Cmp(baseFee) < 0
+}
+
+// newPoolTx extracts the sender, nonce, hash and fee information of the
+// transaction. Cosmos transactions are keyed by their first signer and its
+// sequence and identified by the hash of their bytes. Ethereum transactions must
+// contain a single MsgEthereumTx, since the mempool orders them by one nonce.
+func newPoolTx(ctx sdk.Context, tx sdk.Tx, txEncoder sdk.TxEncoder) (*poolTx, error) {
+	ptx := &poolTx{
+		tx:       tx,
+		priority: ctx.Priority(),
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidTx, "transaction has no messages")
+	}
+
+	ethMsg, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		sigTx, ok := tx.(authsigning.SigVerifiableTx)
+		if !ok {
+			return nil, errorsmod.Wrapf(ErrInvalidTx, "transaction of type %T does not implement SigVerifiableTx", tx)
+		}
+		signers, err := sigTx.GetSigners()
+		if err != nil {
+			return nil, errorsmod.Wrap(ErrInvalidTx, err.Error())
+		}
+		sigs, err := sigTx.GetSignaturesV2()
+		if err != nil {
+			return nil, errorsmod.Wrap(ErrInvalidTx, err.Error())
+		}
+		if len(signers) == 0 || len(sigs) == 0 {
+			return nil, errorsmod.Wrap(ErrInvalidTx, "transaction has no signers")
+		}
+		if txEncoder == nil {
+			return nil, errorsmod.Wrap(ErrInvalidTx, "no tx encoder to identify cosmos transactions")
+		}
+		bz, err := txEncoder(tx)
+		if err != nil {
+			return nil, errorsmod.Wrap(ErrInvalidTx, err.Error())
+		}
+		ptx.sender = sdk.AccAddress(signers[0])
+		ptx.nonce = sigs[0].Sequence
+		ptx.hash = common.BytesToHash(cmttypes.Tx(bz).Hash())
+		return ptx, nil
+	}
+
+	if len(msgs) > 1 {
+		return nil, errorsmod.Wrapf(ErrInvalidTx, "ethereum transaction has %d messages, only one is accepted", len(msgs))
+	}
+
+	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidTx, err.Error())
+	}
+
+	from := ethMsg.GetFrom()
+	if from.Empty() {
+		// the sender is only cached on the message once the signature is verified
+		sender, err := ethMsg.GetSender(txData.GetChainID())
+		if err != nil {
+			return nil, errorsmod.Wrap(ErrInvalidTx, err.Error())
+		}
+		from = sender.Bytes()
+	}
+
+	ptx.sender = from
+	ptx.nonce = txData.GetNonce()
+	ptx.hash = ethMsg.AsTransaction().Hash()
+	ptx.gasFeeCap = bigOrZero(txData.GetGasFeeCap())
+	ptx.gasTipCap = bigOrZero(txData.GetGasTipCap())
+	ptx.msgs = []*evmtypes.MsgEthereumTx{ethMsg}
+	ptx.txData = []evmtypes.TxData{txData}
+
+	return ptx, nil
+}
+
+// canReplace checks the replace-by-fee rules: an Ethereum transaction must raise
+// both its fee cap and its tip cap by at least priceBump percent, other
+// transactions must raise their priority by the same amount.
+func canReplace(old, tx *poolTx, priceBump uint64) bool {
+	if old.isEthereum() && tx.isEthereum() {
+		if old.gasFeeCap.Cmp(tx.gasFeeCap) >= 0 || old.gasTipCap.Cmp(tx.gasTipCap) >= 0 {
+			return false
+		}
+		return tx.gasFeeCap.Cmp(bumpThreshold(old.gasFeeCap, priceBump)) >= 0 &&
+			tx.gasTipCap.Cmp(bumpThreshold(old.gasTipCap, priceBump)) >= 0
+	}
+
+	oldPriority := big.NewInt(old.priority)
+	newPriority := big.NewInt(tx.priority)
+	if oldPriority.Cmp(newPriority) >= 0 {
+		return false
+	}
+	return newPriority.Cmp(bumpThreshold(oldPriority, priceBump)) >= 0
+}
+
+// bumpThreshold returns value * (100 + priceBump) / 100.
+func bumpThreshold(value *big.Int, priceBump uint64) *big.Int {
+	threshold := new(big.Int).Mul(value, new(big.Int).SetUint64(100+priceBump))
+	return threshold.Quo(threshold, big.NewInt(100))
+}
+
+func bigOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(value)
+}
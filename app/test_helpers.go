@@ -204,8 +204,14 @@ func GenesisStateWithValSet(app *Evmos, genesisState simapp.GenesisState,
 
 // SetupTestingApp initializes the IBC-go testing application
 func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	return SetupTestingAppWithChainID(utils.MainnetChainID + "-1")
+}
+
+// SetupTestingAppWithChainID initializes the IBC-go testing application with
+// the given chain ID, so that several test chains can be initialized.
+func SetupTestingAppWithChainID(chainID string) (ibctesting.TestingApp, map[string]json.RawMessage) {
 	db := dbm.NewMemDB()
 	cfg := encoding.MakeConfig()
-	app := NewEvmos(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 5, cfg, simtestutil.NewAppOptionsWithFlagHome(DefaultNodeHome), baseapp.SetChainID(utils.MainnetChainID+"-1"))
+	app := NewEvmos(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 5, cfg, simtestutil.NewAppOptionsWithFlagHome(DefaultNodeHome), baseapp.SetChainID(chainID))
 	return app, NewDefaultGenesisState()
 }
@@ -0,0 +1,6 @@
+package v1_0_0
+
+const (
+	// UpgradeName is the shared upgrade plan name for the v1.0.0 release
+	UpgradeName = "v1.0.0"
+)
@@ -0,0 +1,104 @@
+package v1_0_0
+
+import (
+	"context"
+	"os"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	"github.com/spf13/viper"
+
+	blockinflationkeeper "github.com/hetu-project/hetu/v1/x/blockinflation/keeper"
+	eventkeeper "github.com/hetu-project/hetu/v1/x/event/keeper"
+	evmkeeper "github.com/hetu-project/hetu/v1/x/evm/keeper"
+	channelpausetypes "github.com/hetu-project/hetu/v1/x/ibc/pause/types"
+)
+
+// StoreUpgrades lists the store keys added, renamed or deleted by the upgrade
+var StoreUpgrades = storetypes.StoreUpgrades{
+	Added: []string{
+		ibcfeetypes.StoreKey,
+		packetforwardtypes.StoreKey,
+		icacontrollertypes.StoreKey,
+		channelpausetypes.StoreKey,
+	},
+}
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v1.0.0, the only
+// upgrade of the release. It owns every store addition, param seeding and
+// module migration of the release:
+//   - The IBC fee, packet-forward and channelpause middleware modules are new
+//     and initialized from their default genesis.
+//   - The ICA controller is enabled with its default params, as the ICA module
+//     already exists and is not initialized again.
+//   - The Shanghai and Cancun EIPs implemented by the EVM module are activated
+//     from the upgrade height.
+//   - The module migrations move the blockinflation params from the x/params
+//     subspace into the module store, where they are updated through
+//     MsgUpdateParams governance proposals, and make blockinflation the active
+//     minter of the supply policy that now also caps x/inflation and the AMM
+//     liquidity mints, burning the subnet registration and lock costs.
+//   - The GlobalStaking and SubnetManager contracts configured for the node
+//     are set in the event params, so only they can write the stake ledger
+//     and register subnets. While a contract is not set, the event module
+//     accepts its logs from any contract.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	icaControllerKeeper icacontrollerkeeper.Keeper,
+	evmKeeper *evmkeeper.Keeper,
+	eventKeeper *eventkeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		logger := sdkCtx.Logger().With("upgrade", UpgradeName)
+
+		icaControllerKeeper.SetParams(sdkCtx, icacontrollertypes.DefaultParams())
+
+		evmParams := evmKeeper.GetParams(sdkCtx)
+		activationBlock := math.NewInt(sdkCtx.BlockHeight())
+		evmParams.ChainConfig.EIPActivationBlock = &activationBlock
+		if err := evmKeeper.SetParams(sdkCtx, evmParams); err != nil {
+			return vm, err
+		}
+
+		logger.Info("running module migrations")
+
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return vm, err
+		}
+
+		// the event params are only stored in the module store once migrated
+		eventParams := eventKeeper.GetParams(sdkCtx)
+		if addr := globalStakingAddress(); addr != "" {
+			eventParams.GlobalStakingAddress = addr
+		}
+		if addr := blockinflationkeeper.GetSubnetManagerAddress(); addr != "" {
+			eventParams.SubnetManagerAddress = addr
+		}
+		if err := eventKeeper.SetParams(sdkCtx, eventParams); err != nil {
+			return vm, err
+		}
+
+		return vm, nil
+	}
+}
+
+// globalStakingAddress returns the GlobalStaking contract address configured
+// for the node, from the GLOBAL_STAKING_CONTRACT_ADDRESS environment variable
+// or else the global_staking_contract_address config entry.
+func globalStakingAddress() string {
+	if addr := os.Getenv("GLOBAL_STAKING_CONTRACT_ADDRESS"); addr != "" {
+		return addr
+	}
+	return viper.GetString("global_staking_contract_address")
+}
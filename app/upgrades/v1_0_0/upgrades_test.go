@@ -0,0 +1,94 @@
+package v1_0_0_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/app"
+	"github.com/hetu-project/hetu/v1/app/upgrades/v1_0_0"
+	"github.com/hetu-project/hetu/v1/testutil"
+	"github.com/hetu-project/hetu/v1/utils"
+	eventabi "github.com/hetu-project/hetu/v1/x/event/abi"
+	eventtypes "github.com/hetu-project/hetu/v1/x/event/types"
+	feemarkettypes "github.com/hetu-project/hetu/v1/x/feemarket/types"
+)
+
+// runUpgrade runs the upgrade on a new app whose event module is still at its
+// baseline consensus version, which stored no params.
+func runUpgrade(t *testing.T) (*app.Evmos, sdk.Context) {
+	hetu := app.Setup(false, feemarkettypes.DefaultGenesisState())
+	header := testutil.NewHeader(10, time.Now().UTC(), utils.MainnetChainID+"-1", sdk.ConsAddress{}, nil, nil)
+	ctx := hetu.BaseApp.NewContextLegacy(false, header)
+
+	vm, err := hetu.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	vm[eventtypes.ModuleName] = 1
+	require.NoError(t, hetu.UpgradeKeeper.SetModuleVersionMap(ctx, vm))
+	ctx.KVStore(hetu.GetKey(eventtypes.StoreKey)).Delete(eventtypes.ParamsKey)
+
+	plan := upgradetypes.Plan{Name: v1_0_0.UpgradeName, Height: ctx.BlockHeight()}
+	require.NoError(t, hetu.UpgradeKeeper.ApplyUpgrade(ctx, plan))
+	return hetu, ctx
+}
+
+func TestUpgradeSetsEventContracts(t *testing.T) {
+	stakingABI, err := abi.JSON(bytes.NewReader(eventabi.GlobalStakingABI))
+	require.NoError(t, err)
+	globalStaking := common.HexToAddress("0x00000000000000000000000000000000000000d4")
+	subnetManager := common.HexToAddress("0x00000000000000000000000000000000000000d5")
+	emitter := common.HexToAddress("0x00000000000000000000000000000000000000e5")
+	user := common.HexToAddress("0x00000000000000000000000000000000000000c3")
+
+	event := stakingABI.Events["GlobalStakeAdded"]
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(1000))
+	require.NoError(t, err)
+	log := ethTypes.Log{
+		Address: emitter,
+		Topics:  []common.Hash{event.ID, common.BytesToHash(user.Bytes())},
+		Data:    data,
+	}
+
+	testCases := []struct {
+		name          string
+		globalStaking string
+		subnetManager string
+		expTracked    bool
+	}{
+		{
+			"contracts not configured, any emitter is accepted",
+			"",
+			"",
+			true,
+		},
+		{
+			"contracts configured, other emitters are ignored",
+			globalStaking.Hex(),
+			subnetManager.Hex(),
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("GLOBAL_STAKING_CONTRACT_ADDRESS", tc.globalStaking)
+			t.Setenv("SUBNET_MANAGER_CONTRACT_ADDRESS", tc.subnetManager)
+			hetu, ctx := runUpgrade(t)
+
+			params := hetu.EventKeeper.GetParams(ctx)
+			require.Equal(t, tc.globalStaking, params.GlobalStakingAddress)
+			require.Equal(t, tc.subnetManager, params.SubnetManagerAddress)
+
+			hetu.EventKeeper.HandleEvmLogs(ctx, []ethTypes.Log{log})
+			_, found := hetu.EventKeeper.GetGlobalStake(ctx, user.Hex())
+			require.Equal(t, tc.expTracked, found)
+		})
+	}
+}
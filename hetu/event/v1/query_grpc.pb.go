@@ -19,11 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Subnets_FullMethodName          = "/hetu.event.v1.Query/Subnets"
-	Query_Subnet_FullMethodName           = "/hetu.event.v1.Query/Subnet"
-	Query_SubnetNeurons_FullMethodName    = "/hetu.event.v1.Query/SubnetNeurons"
-	Query_SubnetPool_FullMethodName       = "/hetu.event.v1.Query/SubnetPool"
-	Query_ValidatorWeights_FullMethodName = "/hetu.event.v1.Query/ValidatorWeights"
+	Query_Subnets_FullMethodName           = "/hetu.event.v1.Query/Subnets"
+	Query_Subnet_FullMethodName            = "/hetu.event.v1.Query/Subnet"
+	Query_SubnetNeurons_FullMethodName     = "/hetu.event.v1.Query/SubnetNeurons"
+	Query_SubnetPool_FullMethodName        = "/hetu.event.v1.Query/SubnetPool"
+	Query_ValidatorWeights_FullMethodName  = "/hetu.event.v1.Query/ValidatorWeights"
+	Query_StakePosition_FullMethodName     = "/hetu.event.v1.Query/StakePosition"
+	Query_RegistrationCosts_FullMethodName = "/hetu.event.v1.Query/RegistrationCosts"
+	Query_Params_FullMethodName            = "/hetu.event.v1.Query/Params"
+	Query_PriceHistory_FullMethodName      = "/hetu.event.v1.Query/PriceHistory"
+	Query_PriceCandles_FullMethodName      = "/hetu.event.v1.Query/PriceCandles"
+	Query_ChildKeys_FullMethodName         = "/hetu.event.v1.Query/ChildKeys"
+	Query_ValidatorTake_FullMethodName     = "/hetu.event.v1.Query/ValidatorTake"
+	Query_Dividends_FullMethodName         = "/hetu.event.v1.Query/Dividends"
+	Query_NativeStake_FullMethodName       = "/hetu.event.v1.Query/NativeStake"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +49,24 @@ type QueryClient interface {
 	SubnetPool(ctx context.Context, in *QuerySubnetPoolRequest, opts ...grpc.CallOption) (*QuerySubnetPoolResponse, error)
 	// ValidatorWeights returns weights for a validator in a subnet
 	ValidatorWeights(ctx context.Context, in *QueryValidatorWeightsRequest, opts ...grpc.CallOption) (*QueryValidatorWeightsResponse, error)
+	// StakePosition returns the global staking position of an account across subnets
+	StakePosition(ctx context.Context, in *QueryStakePositionRequest, opts ...grpc.CallOption) (*QueryStakePositionResponse, error)
+	// RegistrationCosts returns the registration costs paid to the treasury
+	RegistrationCosts(ctx context.Context, in *QueryRegistrationCostsRequest, opts ...grpc.CallOption) (*QueryRegistrationCostsResponse, error)
+	// Params returns the event module parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PriceHistory returns the price and emission snapshots of a subnet
+	PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error)
+	// PriceCandles returns the per-block OHLC price candles of a subnet AMM pool
+	PriceCandles(ctx context.Context, in *QueryPriceCandlesRequest, opts ...grpc.CallOption) (*QueryPriceCandlesResponse, error)
+	// ChildKeys returns the child and parent keys of a hotkey on a subnet and its effective stake
+	ChildKeys(ctx context.Context, in *QueryChildKeysRequest, opts ...grpc.CallOption) (*QueryChildKeysResponse, error)
+	// ValidatorTake returns the take of a validator on a subnet
+	ValidatorTake(ctx context.Context, in *QueryValidatorTakeRequest, opts ...grpc.CallOption) (*QueryValidatorTakeResponse, error)
+	// Dividends returns the alpha dividends a delegator can claim
+	Dividends(ctx context.Context, in *QueryDividendsRequest, opts ...grpc.CallOption) (*QueryDividendsResponse, error)
+	// NativeStake returns the bonded x/staking tokens of a validator counted as subnet stake
+	NativeStake(ctx context.Context, in *QueryNativeStakeRequest, opts ...grpc.CallOption) (*QueryNativeStakeResponse, error)
 }
 
 type queryClient struct {
@@ -95,6 +122,87 @@ func (c *queryClient) ValidatorWeights(ctx context.Context, in *QueryValidatorWe
 	return out, nil
 }
 
+func (c *queryClient) StakePosition(ctx context.Context, in *QueryStakePositionRequest, opts ...grpc.CallOption) (*QueryStakePositionResponse, error) {
+	out := new(QueryStakePositionResponse)
+	err := c.cc.Invoke(ctx, Query_StakePosition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegistrationCosts(ctx context.Context, in *QueryRegistrationCostsRequest, opts ...grpc.CallOption) (*QueryRegistrationCostsResponse, error) {
+	out := new(QueryRegistrationCostsResponse)
+	err := c.cc.Invoke(ctx, Query_RegistrationCosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error) {
+	out := new(QueryPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_PriceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceCandles(ctx context.Context, in *QueryPriceCandlesRequest, opts ...grpc.CallOption) (*QueryPriceCandlesResponse, error) {
+	out := new(QueryPriceCandlesResponse)
+	err := c.cc.Invoke(ctx, Query_PriceCandles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChildKeys(ctx context.Context, in *QueryChildKeysRequest, opts ...grpc.CallOption) (*QueryChildKeysResponse, error) {
+	out := new(QueryChildKeysResponse)
+	err := c.cc.Invoke(ctx, Query_ChildKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorTake(ctx context.Context, in *QueryValidatorTakeRequest, opts ...grpc.CallOption) (*QueryValidatorTakeResponse, error) {
+	out := new(QueryValidatorTakeResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorTake_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Dividends(ctx context.Context, in *QueryDividendsRequest, opts ...grpc.CallOption) (*QueryDividendsResponse, error) {
+	out := new(QueryDividendsResponse)
+	err := c.cc.Invoke(ctx, Query_Dividends_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NativeStake(ctx context.Context, in *QueryNativeStakeRequest, opts ...grpc.CallOption) (*QueryNativeStakeResponse, error) {
+	out := new(QueryNativeStakeResponse)
+	err := c.cc.Invoke(ctx, Query_NativeStake_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -109,6 +217,24 @@ type QueryServer interface {
 	SubnetPool(context.Context, *QuerySubnetPoolRequest) (*QuerySubnetPoolResponse, error)
 	// ValidatorWeights returns weights for a validator in a subnet
 	ValidatorWeights(context.Context, *QueryValidatorWeightsRequest) (*QueryValidatorWeightsResponse, error)
+	// StakePosition returns the global staking position of an account across subnets
+	StakePosition(context.Context, *QueryStakePositionRequest) (*QueryStakePositionResponse, error)
+	// RegistrationCosts returns the registration costs paid to the treasury
+	RegistrationCosts(context.Context, *QueryRegistrationCostsRequest) (*QueryRegistrationCostsResponse, error)
+	// Params returns the event module parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PriceHistory returns the price and emission snapshots of a subnet
+	PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error)
+	// PriceCandles returns the per-block OHLC price candles of a subnet AMM pool
+	PriceCandles(context.Context, *QueryPriceCandlesRequest) (*QueryPriceCandlesResponse, error)
+	// ChildKeys returns the child and parent keys of a hotkey on a subnet and its effective stake
+	ChildKeys(context.Context, *QueryChildKeysRequest) (*QueryChildKeysResponse, error)
+	// ValidatorTake returns the take of a validator on a subnet
+	ValidatorTake(context.Context, *QueryValidatorTakeRequest) (*QueryValidatorTakeResponse, error)
+	// Dividends returns the alpha dividends a delegator can claim
+	Dividends(context.Context, *QueryDividendsRequest) (*QueryDividendsResponse, error)
+	// NativeStake returns the bonded x/staking tokens of a validator counted as subnet stake
+	NativeStake(context.Context, *QueryNativeStakeRequest) (*QueryNativeStakeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -131,6 +257,33 @@ func (UnimplementedQueryServer) SubnetPool(context.Context, *QuerySubnetPoolRequ
 func (UnimplementedQueryServer) ValidatorWeights(context.Context, *QueryValidatorWeightsRequest) (*QueryValidatorWeightsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorWeights not implemented")
 }
+func (UnimplementedQueryServer) StakePosition(context.Context, *QueryStakePositionRequest) (*QueryStakePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakePosition not implemented")
+}
+func (UnimplementedQueryServer) RegistrationCosts(context.Context, *QueryRegistrationCostsRequest) (*QueryRegistrationCostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistrationCosts not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHistory not implemented")
+}
+func (UnimplementedQueryServer) PriceCandles(context.Context, *QueryPriceCandlesRequest) (*QueryPriceCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceCandles not implemented")
+}
+func (UnimplementedQueryServer) ChildKeys(context.Context, *QueryChildKeysRequest) (*QueryChildKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChildKeys not implemented")
+}
+func (UnimplementedQueryServer) ValidatorTake(context.Context, *QueryValidatorTakeRequest) (*QueryValidatorTakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorTake not implemented")
+}
+func (UnimplementedQueryServer) Dividends(context.Context, *QueryDividendsRequest) (*QueryDividendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dividends not implemented")
+}
+func (UnimplementedQueryServer) NativeStake(context.Context, *QueryNativeStakeRequest) (*QueryNativeStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NativeStake not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -234,6 +387,168 @@ func _Query_ValidatorWeights_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StakePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakePositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StakePosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakePosition(ctx, req.(*QueryStakePositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegistrationCosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegistrationCostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegistrationCosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RegistrationCosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegistrationCosts(ctx, req.(*QueryRegistrationCostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceHistory(ctx, req.(*QueryPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceCandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceCandles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceCandles(ctx, req.(*QueryPriceCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChildKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChildKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChildKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ChildKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChildKeys(ctx, req.(*QueryChildKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorTake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorTakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorTake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorTake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorTake(ctx, req.(*QueryValidatorTakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Dividends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDividendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Dividends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Dividends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Dividends(ctx, req.(*QueryDividendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NativeStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNativeStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NativeStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NativeStake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NativeStake(ctx, req.(*QueryNativeStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,6 +576,42 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidatorWeights",
 			Handler:    _Query_ValidatorWeights_Handler,
 		},
+		{
+			MethodName: "StakePosition",
+			Handler:    _Query_StakePosition_Handler,
+		},
+		{
+			MethodName: "RegistrationCosts",
+			Handler:    _Query_RegistrationCosts_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PriceHistory",
+			Handler:    _Query_PriceHistory_Handler,
+		},
+		{
+			MethodName: "PriceCandles",
+			Handler:    _Query_PriceCandles_Handler,
+		},
+		{
+			MethodName: "ChildKeys",
+			Handler:    _Query_ChildKeys_Handler,
+		},
+		{
+			MethodName: "ValidatorTake",
+			Handler:    _Query_ValidatorTake_Handler,
+		},
+		{
+			MethodName: "Dividends",
+			Handler:    _Query_Dividends_Handler,
+		},
+		{
+			MethodName: "NativeStake",
+			Handler:    _Query_NativeStake_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "hetu/event/v1/query.proto",
@@ -45,6 +45,7 @@ var ChainIDPrefix = "hetu_560000-"
 
 func init() {
 	ibcgotesting.ChainIDPrefix = ChainIDPrefix
+	ibcgotesting.ChainIDSuffix = ""
 }
 
 // NewTestChain initializes a new TestChain instance with a single validator set using a
@@ -94,6 +95,8 @@ func NewTestChain(t *testing.T, coord *ibcgotesting.Coordinator, chainID string)
 		ChainID: chainID,
 		Height:  1,
 		Time:    coord.CurrentTime.UTC(),
+		// the proposer is the coinbase of the EVM transactions
+		ProposerAddress: valSet.Proposer.Address,
 	}
 
 	txConfig := app.GetTxConfig()
@@ -17,10 +17,13 @@
 package ibctesting
 
 import (
+	"fmt"
 	"math/rand"
 	"testing"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -44,8 +47,6 @@ func NewCoordinator(t *testing.T, nEVMChains, mCosmosChains int) *ibctesting.Coo
 	}
 
 	// setup EVM chains
-	ibctesting.DefaultTestingAppInit = DefaultTestingAppInit
-
 	for i := 1; i <= nEVMChains; i++ {
 		chainID := ibctesting.GetChainID(i)
 		chains[chainID] = NewTestChain(t, coord, chainID)
@@ -151,34 +152,80 @@ func SendMsgs(chain *ibctesting.TestChain, feeAmt int64, msgs ...sdk.Msg) (*sdk.
 		return nil, err
 	}
 
+	// increment acc sequence regardless of success or failure tx execution
+	defer func() {
+		if err := chain.SenderAccount.SetSequence(chain.SenderAccount.GetSequence() + 1); err != nil {
+			panic(err)
+		}
+	}()
+
 	fee := sdk.Coins{sdk.NewInt64Coin(bondDenom, feeAmt)}
-	_, r, err := SignAndDeliver(
-		chain.TB,
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
 		chain.TxConfig,
-		chain.App.GetBaseApp(),
 		msgs,
 		fee,
+		simtestutil.DefaultGenTxGas,
 		chain.ChainID,
 		[]uint64{chain.SenderAccount.GetAccountNumber()},
 		[]uint64{chain.SenderAccount.GetSequence()},
-		true, chain.SenderPrivKey,
+		chain.SenderPrivKey,
 	)
 	if err != nil {
 		return nil, err
 	}
+	txBytes, err := chain.TxConfig.TxEncoder()(tx)
+	if err != nil {
+		return nil, err
+	}
 
-	// NextBlock calls app.Commit()
-	chain.NextBlock()
-
-	// increment sequence for successful transaction execution
-	err = chain.SenderAccount.SetSequence(chain.SenderAccount.GetSequence() + 1)
+	// deliver the transaction in a block, as the deliver state of the
+	// application only exists while finalizing a block
+	res, err := chain.App.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:             chain.CurrentHeader.Height,
+		Time:               chain.CurrentHeader.GetTime(),
+		NextValidatorsHash: chain.NextVals.Hash(),
+		ProposerAddress:    chain.CurrentHeader.ProposerAddress,
+		Txs:                [][]byte{txBytes},
+	})
 	if err != nil {
 		return nil, err
 	}
+	commitBlock(chain, res)
+
+	txResult := res.TxResults[0]
+	if txResult.Code != 0 {
+		return nil, fmt.Errorf("%s/%d: %q", txResult.Codespace, txResult.Code, txResult.Log)
+	}
 
 	chain.Coordinator.IncrementTime()
 
-	return r, nil
+	return &sdk.Result{Data: txResult.Data, Log: txResult.Log, Events: txResult.Events}, nil
+}
+
+// commitBlock commits the block finalized on the chain and updates the headers
+// of the chain, as the ibc-go TestChain does after delivering a transaction.
+func commitBlock(chain *ibctesting.TestChain, res *abci.ResponseFinalizeBlock) {
+	_, err := chain.App.Commit()
+	require.NoError(chain.TB, err)
+
+	// set the last header to the current header
+	chain.LastHeader = chain.CurrentTMClientHeader()
+
+	// val set changes returned from previous block get applied to the next validators
+	chain.Vals = chain.NextVals
+	chain.NextVals = ibctesting.ApplyValSetChanges(chain, chain.Vals, res.ValidatorUpdates)
+
+	// increment the current header, the time is increased by the coordinator
+	chain.CurrentHeader = cmtproto.Header{
+		ChainID:            chain.ChainID,
+		Height:             chain.App.LastBlockHeight() + 1,
+		AppHash:            chain.App.LastCommitID().Hash,
+		Time:               chain.CurrentHeader.Time,
+		ValidatorsHash:     chain.Vals.Hash(),
+		NextValidatorsHash: chain.NextVals.Hash(),
+		ProposerAddress:    chain.CurrentHeader.ProposerAddress,
+	}
 }
 
 // SignAndDeliver signs and delivers a transaction. No simulation occurs as the
@@ -0,0 +1,160 @@
+package ibctesting_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcgotesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/hetu-project/hetu/v1/app"
+	"github.com/hetu-project/hetu/v1/contracts"
+	ibctesting "github.com/hetu-project/hetu/v1/ibc/testing"
+	"github.com/hetu-project/hetu/v1/utils"
+	erc20types "github.com/hetu-project/hetu/v1/x/erc20/types"
+)
+
+// fundSender mints fee tokens to the sender account of the chain, which
+// delivers the transactions of the relayer.
+func fundSender(t *testing.T, chain *ibcgotesting.TestChain) {
+	evmosApp := chain.App.(*app.Evmos)
+	amt, ok := sdkmath.NewIntFromString("1000000000000000000000")
+	require.True(t, ok)
+	coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, amt))
+
+	require.NoError(t, evmosApp.BankKeeper.MintCoins(chain.GetContext(), erc20types.ModuleName, coins))
+	require.NoError(t, evmosApp.BankKeeper.SendCoinsFromModuleToAccount(chain.GetContext(), erc20types.ModuleName, chain.SenderAccount.GetAddress(), coins))
+}
+
+// registerCoin registers a token pair for the IBC denom on the chain, minting
+// a unit of the denom beforehand as the registration requires a supply.
+func registerCoin(t *testing.T, chain *ibcgotesting.TestChain, denomTrace transfertypes.DenomTrace) *erc20types.TokenPair {
+	evmosApp := chain.App.(*app.Evmos)
+	ibcDenom := denomTrace.IBCDenom()
+
+	coins := sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.OneInt()))
+	require.NoError(t, evmosApp.BankKeeper.MintCoins(chain.GetContext(), erc20types.ModuleName, coins))
+
+	pair, err := evmosApp.Erc20Keeper.RegisterCoin(chain.GetContext(), banktypes.Metadata{
+		Description: "IBC Coin forwarded from the IBC chain A",
+		Base:        ibcDenom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    denomTrace.BaseDenom,
+				Exponent: 0,
+			},
+		},
+		Name:    ibcDenom,
+		Symbol:  "HETU",
+		Display: denomTrace.BaseDenom,
+	})
+	require.NoError(t, err)
+	chain.Coordinator.CommitBlock(chain)
+
+	return pair
+}
+
+// TestForwardTransferWithConversion transfers HETU from chain A to chain C
+// through chain B with the packet-forward middleware. The coins are converted
+// to their ERC20 representation on C only, and the acknowledgement of C is
+// relayed back to A.
+func TestForwardTransferWithConversion(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 3, 0)
+	chainA := coord.GetChain(ibcgotesting.GetChainID(1))
+	chainB := coord.GetChain(ibcgotesting.GetChainID(2))
+	chainC := coord.GetChain(ibcgotesting.GetChainID(3))
+	for _, chain := range []*ibcgotesting.TestChain{chainA, chainB, chainC} {
+		fundSender(t, chain)
+	}
+	coord.CommitBlock(chainA, chainB, chainC)
+
+	pathAB := ibctesting.NewTransferPath(chainA, chainB)
+	pathBC := ibctesting.NewTransferPath(chainB, chainC)
+	ibctesting.SetupPath(coord, pathAB)
+	ibctesting.SetupPath(coord, pathBC)
+
+	// the denom of the coins received on C, as traced through B
+	denomTrace := transfertypes.ParseDenomTrace(fmt.Sprintf(
+		"%s/%s/%s/%s/%s",
+		pathBC.EndpointB.ChannelConfig.PortID, pathBC.EndpointB.ChannelID,
+		pathAB.EndpointB.ChannelConfig.PortID, pathAB.EndpointB.ChannelID,
+		utils.BaseDenom,
+	))
+	pair := registerCoin(t, chainC, denomTrace)
+
+	amount := sdkmath.NewInt(1000)
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := chainC.SenderAccount.GetAddress()
+	memo := fmt.Sprintf(
+		`{"forward":{"receiver":"%s","port":"%s","channel":"%s"}}`,
+		receiver, pathBC.EndpointA.ChannelConfig.PortID, pathBC.EndpointA.ChannelID,
+	)
+
+	// send from A to B, forwarding to C
+	msg := transfertypes.NewMsgTransfer(
+		pathAB.EndpointA.ChannelConfig.PortID, pathAB.EndpointA.ChannelID,
+		sdk.NewCoin(utils.BaseDenom, amount),
+		sender.String(), chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1000, 1000), 0, memo,
+	)
+	res, err := ibctesting.SendMsgs(chainA, ibctesting.DefaultFeeAmt, msg)
+	require.NoError(t, err)
+	packetAB, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents().ToABCIEvents())
+	require.NoError(t, err)
+
+	// receive on B, which forwards the coins to C without acknowledging
+	require.NoError(t, pathAB.EndpointB.UpdateClient())
+	res, err = pathAB.EndpointB.RecvPacketWithResult(packetAB)
+	require.NoError(t, err)
+	packetBC, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents().ToABCIEvents())
+	require.NoError(t, err)
+
+	evmosAppB := chainB.App.(*app.Evmos)
+	_, found := evmosAppB.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(
+		chainB.GetContext(), packetAB.DestinationPort, packetAB.DestinationChannel, packetAB.Sequence,
+	)
+	require.False(t, found, "the forwarded packet must be acknowledged asynchronously")
+
+	// receive on C, which converts the coins to ERC20
+	require.NoError(t, pathBC.EndpointB.UpdateClient())
+	res, err = pathBC.EndpointB.RecvPacketWithResult(packetBC)
+	require.NoError(t, err)
+	ackBC, err := ibcgotesting.ParseAckFromEvents(res.GetEvents().ToABCIEvents())
+	require.NoError(t, err)
+
+	evmosAppC := chainC.App.(*app.Evmos)
+	ctxC := chainC.GetContext()
+	require.True(t, evmosAppC.BankKeeper.GetBalance(ctxC, receiver, denomTrace.IBCDenom()).IsZero())
+	balance := evmosAppC.Erc20Keeper.BalanceOf(
+		ctxC, contracts.ERC20MinterBurnerDecimalsContract.ABI,
+		pair.GetERC20Contract(), common.BytesToAddress(receiver.Bytes()),
+	)
+	require.Equal(t, amount.BigInt(), balance)
+
+	// acknowledge on B, which writes the acknowledgement of the packet from A
+	require.NoError(t, pathBC.EndpointA.AcknowledgePacket(packetBC, ackBC))
+	_, found = evmosAppB.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(
+		chainB.GetContext(), packetAB.DestinationPort, packetAB.DestinationChannel, packetAB.Sequence,
+	)
+	require.True(t, found)
+
+	// acknowledge on A
+	require.NoError(t, pathAB.EndpointA.UpdateClient())
+	ackAB := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	require.NoError(t, pathAB.EndpointA.AcknowledgePacket(packetAB, ackAB.Acknowledgement()))
+
+	evmosAppA := chainA.App.(*app.Evmos)
+	require.Empty(t, evmosAppA.IBCKeeper.ChannelKeeper.GetPacketCommitment(
+		chainA.GetContext(), packetAB.SourcePort, packetAB.SourceChannel, packetAB.Sequence,
+	))
+}
@@ -0,0 +1,103 @@
+package ibctesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcgotesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/hetu-project/hetu/v1/app"
+	ibctesting "github.com/hetu-project/hetu/v1/ibc/testing"
+	eventtypes "github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+// TestInterchainAccountSetWeights registers an interchain account of chain A
+// on chain B, and sets the weights of the account on a subnet of B through it.
+func TestInterchainAccountSetWeights(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2, 0)
+	chainA := coord.GetChain(ibcgotesting.GetChainID(1))
+	chainB := coord.GetChain(ibcgotesting.GetChainID(2))
+	for _, chain := range []*ibcgotesting.TestChain{chainA, chainB} {
+		fundSender(t, chain)
+	}
+	coord.CommitBlock(chainA, chainB)
+
+	owner := chainA.SenderAccount.GetAddress().String()
+	portID, err := icatypes.NewControllerPortID(owner)
+	require.NoError(t, err)
+
+	path := ibctesting.NewPath(chainA, chainB)
+	ibctesting.SetupConnections(coord, path)
+	version := icatypes.NewDefaultMetadataString(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+	path.EndpointA.ChannelConfig.PortID = portID
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointB.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+
+	// the owner registers the account, which opens the channel on A
+	res, err := ibctesting.SendMsgs(chainA, ibctesting.DefaultFeeAmt, icacontrollertypes.NewMsgRegisterInterchainAccountWithOrdering(
+		path.EndpointA.ConnectionID, owner, version, channeltypes.ORDERED,
+	))
+	require.NoError(t, err)
+	path.EndpointA.ChannelID, err = ibcgotesting.ParseChannelIDFromEvents(res.GetEvents().ToABCIEvents())
+	require.NoError(t, err)
+
+	require.NoError(t, path.EndpointB.ChanOpenTry())
+	require.NoError(t, path.EndpointA.ChanOpenAck())
+	require.NoError(t, path.EndpointB.ChanOpenConfirm())
+
+	evmosAppB := chainB.App.(*app.Evmos)
+	icaAddr, found := evmosAppB.ICAHostKeeper.GetInterchainAccountAddress(chainB.GetContext(), path.EndpointB.ConnectionID, portID)
+	require.True(t, found)
+	evmosAppA := chainA.App.(*app.Evmos)
+	controllerAddr, found := evmosAppA.ICAControllerKeeper.GetInterchainAccountAddress(chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+	require.True(t, found)
+	require.Equal(t, icaAddr, controllerAddr)
+
+	// register a subnet on B, with the account as a validator
+	validator := common.BytesToAddress(sdk.MustAccAddressFromBech32(icaAddr)).Hex()
+	evmosAppB.EventKeeper.SetSubnet(chainB.GetContext(), eventtypes.Subnet{
+		Netuid: 1,
+		Params: map[string]string{eventtypes.KeyMaxWeightsLimit: "2"},
+	})
+	evmosAppB.EventKeeper.SetNeuronInfo(chainB.GetContext(), eventtypes.NeuronInfo{
+		Account:     validator,
+		Netuid:      1,
+		IsActive:    true,
+		IsValidator: true,
+	})
+	coord.CommitBlock(chainB)
+
+	// the owner sets the weights of the account through the controller
+	dest := common.HexToAddress("0x0000000000000000000000000000000000000abc").Hex()
+	setWeights := eventtypes.NewMsgSetWeights(sdk.MustAccAddressFromBech32(icaAddr), 1, []eventtypes.DestWeight{
+		{Dest: dest, Weight: 100},
+	})
+	data, err := icatypes.SerializeCosmosTx(chainA.Codec, []proto.Message{setWeights}, icatypes.EncodingProtobuf)
+	require.NoError(t, err)
+
+	res, err = ibctesting.SendMsgs(chainA, ibctesting.DefaultFeeAmt, icacontrollertypes.NewMsgSendTx(
+		owner, path.EndpointA.ConnectionID, uint64(time.Hour.Nanoseconds()),
+		icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data},
+	))
+	require.NoError(t, err)
+	packet, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents().ToABCIEvents())
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+
+	weight, found := evmosAppB.EventKeeper.GetValidatorWeight(chainB.GetContext(), 1, validator)
+	require.True(t, found)
+	require.Equal(t, map[string]uint64{dest: 100}, weight.Weights)
+}
@@ -0,0 +1,156 @@
+package ibctesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/keeper"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibcgotesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/hetu-project/hetu/v1/app"
+	ibctesting "github.com/hetu-project/hetu/v1/ibc/testing"
+	"github.com/hetu-project/hetu/v1/utils"
+	channelpausetypes "github.com/hetu-project/hetu/v1/x/ibc/pause/types"
+)
+
+// transferMsg returns a transfer of HETU from the sender of A to the sender of B.
+func transferMsg(path *ibctesting.Path, amount sdkmath.Int) *transfertypes.MsgTransfer {
+	return transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		sdk.NewCoin(utils.BaseDenom, amount),
+		path.EndpointA.Chain.SenderAccount.GetAddress().String(),
+		path.EndpointB.Chain.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1000, 1000), 0, "",
+	)
+}
+
+// TestRateLimitOutflow limits the outflow of HETU through a channel to a
+// percentage of its supply, until the quota is reset at the end of its window.
+func TestRateLimitOutflow(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2, 0)
+	chainA := coord.GetChain(ibcgotesting.GetChainID(1))
+	chainB := coord.GetChain(ibcgotesting.GetChainID(2))
+	for _, chain := range []*ibcgotesting.TestChain{chainA, chainB} {
+		fundSender(t, chain)
+	}
+	coord.CommitBlock(chainA, chainB)
+
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	ibctesting.SetupPath(coord, path)
+
+	// governance limits the outflow to 1% of the supply, over an hour
+	evmosAppA := chainA.App.(*app.Evmos)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	supply := evmosAppA.BankKeeper.GetSupply(chainA.GetContext(), utils.BaseDenom).Amount
+	_, err := ratelimitkeeper.NewMsgServerImpl(evmosAppA.RateLimitKeeper).AddRateLimit(chainA.GetContext(), &ratelimittypes.MsgAddRateLimit{
+		Authority:      authority,
+		Denom:          utils.BaseDenom,
+		ChannelId:      path.EndpointA.ChannelID,
+		MaxPercentSend: sdkmath.NewInt(1),
+		MaxPercentRecv: sdkmath.NewInt(1),
+		DurationHours:  1,
+	})
+	require.NoError(t, err)
+	coord.CommitBlock(chainA)
+
+	rateLimit, found := evmosAppA.RateLimitKeeper.GetRateLimit(chainA.GetContext(), utils.BaseDenom, path.EndpointA.ChannelID)
+	require.True(t, found)
+	require.Equal(t, supply, rateLimit.Flow.ChannelValue)
+	quota := supply.QuoRaw(100)
+
+	// the sender is funded beyond the quota
+	coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, quota.MulRaw(2)))
+	require.NoError(t, evmosAppA.BankKeeper.MintCoins(chainA.GetContext(), transfertypes.ModuleName, coins))
+	require.NoError(t, evmosAppA.BankKeeper.SendCoinsFromModuleToAccount(chainA.GetContext(), transfertypes.ModuleName, chainA.SenderAccount.GetAddress(), coins))
+	coord.CommitBlock(chainA)
+
+	_, err = ibctesting.SendMsgs(chainA, ibctesting.DefaultFeeAmt, transferMsg(path, quota.AddRaw(1)))
+	require.ErrorContains(t, err, ratelimittypes.ErrQuotaExceeded.Error())
+
+	_, err = ibctesting.SendMsgs(chainA, ibctesting.DefaultFeeAmt, transferMsg(path, quota))
+	require.NoError(t, err)
+	rateLimit, _ = evmosAppA.RateLimitKeeper.GetRateLimit(chainA.GetContext(), utils.BaseDenom, path.EndpointA.ChannelID)
+	require.Equal(t, quota, rateLimit.Flow.Outflow)
+
+	_, err = ibctesting.SendMsgs(chainA, ibctesting.DefaultFeeAmt, transferMsg(path, sdkmath.OneInt()))
+	require.ErrorContains(t, err, ratelimittypes.ErrQuotaExceeded.Error())
+
+	// the quota is reset by the first block of the next window
+	coord.IncrementTimeBy(time.Hour)
+	coord.CommitBlock(chainA)
+	rateLimit, _ = evmosAppA.RateLimitKeeper.GetRateLimit(chainA.GetContext(), utils.BaseDenom, path.EndpointA.ChannelID)
+	require.True(t, rateLimit.Flow.Outflow.IsZero())
+
+	_, err = ibctesting.SendMsgs(chainA, ibctesting.DefaultFeeAmt, transferMsg(path, sdkmath.OneInt()))
+	require.NoError(t, err)
+}
+
+// TestPauseChannel pauses a transfer channel on both of its ends: the
+// transfers sent through it are rejected, and the transfers received from it
+// are refunded to their senders.
+func TestPauseChannel(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2, 0)
+	chainA := coord.GetChain(ibcgotesting.GetChainID(1))
+	chainB := coord.GetChain(ibcgotesting.GetChainID(2))
+	for _, chain := range []*ibcgotesting.TestChain{chainA, chainB} {
+		fundSender(t, chain)
+	}
+	coord.CommitBlock(chainA, chainB)
+
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	ibctesting.SetupPath(coord, path)
+
+	evmosAppA := chainA.App.(*app.Evmos)
+	evmosAppB := chainB.App.(*app.Evmos)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	amount := sdkmath.NewInt(1000)
+
+	// a paused channel rejects the transfers sent through it
+	_, err := evmosAppA.ChannelPauseKeeper.PauseChannel(chainA.GetContext(), channelpausetypes.NewMsgPauseChannel(authority, path.EndpointA.ChannelID))
+	require.NoError(t, err)
+	coord.CommitBlock(chainA)
+
+	res, err := evmosAppA.ChannelPauseKeeper.PausedChannels(chainA.GetContext(), &channelpausetypes.QueryPausedChannelsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.PausedChannels, 1)
+	require.Equal(t, path.EndpointA.ChannelID, res.PausedChannels[0].ChannelId)
+
+	_, err = ibctesting.SendMsgs(chainA, ibctesting.DefaultFeeAmt, transferMsg(path, amount))
+	require.ErrorContains(t, err, channelpausetypes.ErrChannelPaused.Error())
+
+	_, err = evmosAppA.ChannelPauseKeeper.ResumeChannel(chainA.GetContext(), channelpausetypes.NewMsgResumeChannel(authority, path.EndpointA.ChannelID))
+	require.NoError(t, err)
+	coord.CommitBlock(chainA)
+
+	// a paused channel refunds the transfers received from it
+	_, err = evmosAppB.ChannelPauseKeeper.PauseChannel(chainB.GetContext(), channelpausetypes.NewMsgPauseChannel(authority, path.EndpointB.ChannelID))
+	require.NoError(t, err)
+	coord.CommitBlock(chainB)
+
+	escrow := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	txRes, err := ibctesting.SendMsgs(chainA, ibctesting.DefaultFeeAmt, transferMsg(path, amount))
+	require.NoError(t, err)
+	require.Equal(t, amount, evmosAppA.BankKeeper.GetBalance(chainA.GetContext(), escrow, utils.BaseDenom).Amount)
+
+	packet, err := ibcgotesting.ParsePacketFromEvents(txRes.GetEvents().ToABCIEvents())
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+
+	require.True(t, evmosAppA.BankKeeper.GetBalance(chainA.GetContext(), escrow, utils.BaseDenom).IsZero())
+	denomTrace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, utils.BaseDenom,
+	))
+	require.True(t, evmosAppB.BankKeeper.GetBalance(
+		chainB.GetContext(), chainB.SenderAccount.GetAddress(), denomTrace.IBCDenom(),
+	).IsZero())
+}
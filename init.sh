@@ -49,7 +49,7 @@ jq ".app_state[\"mint\"][\"params\"][\"mint_denom\"]=\"ahetu\"" "$GENESIS" > "$T
 # - blockinflation module: automatically uses DefaultParams() and zero value states
 # - event module: automatically uses empty arrays as default state (subnets: [], validator_stakes: [], delegations: [], validator_weights: [])
 # Set blockinflation parameters directly in app_state
-jq '.app_state.blockinflation.params = {"enable_block_inflation": true, "mint_denom": "ahetu", "total_supply": "21000000000000000000000000", "default_block_emission": "1000000000000000000", "subnet_reward_base": "0.100000000000000000", "subnet_reward_k": "0.100000000000000000", "subnet_reward_max_ratio": "0.900000000000000000", "subnet_moving_alpha": "0.000003000000000000", "subnet_owner_cut": "0.180000000000000000"}' "$GENESIS" > "$TMPGENESIS" && mv "$TMPGENESIS" "$GENESIS"
+jq '.app_state.blockinflation.params = {"enable_block_inflation": true, "mint_denom": "ahetu", "total_supply": "21000000000000000000000000", "default_block_emission": "1000000000000000000", "subnet_reward_base": "0.100000000000000000", "subnet_reward_k": "0.100000000000000000", "subnet_reward_max_ratio": "0.900000000000000000", "subnet_moving_alpha": "0.000003000000000000", "subnet_owner_cut": "0.180000000000000000", "price_twap_window": 30, "max_price_change_per_block": "0.050000000000000000", "subnet_emission_mode": "price", "root_weight_ratio": "0.500000000000000000", "root_dividend_ratio": "0.100000000000000000", "root_tempo": 100, "active_minter": "blockinflation", "lock_cost_policy": "burn", "registration_cost_policy": "burn"}' "$GENESIS" > "$TMPGENESIS" && mv "$TMPGENESIS" "$GENESIS"
 
 # Set params module for blockinflation parameters
 # jq '.app_state.params = {"subspaces": {"blockinflation": {"key_table": {"params": [{"key": "EnableBlockInflation", "value": true}, {"key": "MintDenom", "value": "ahetu"}, {"key": "TotalSupply", "value": "21000000000000000000000000"}, {"key": "DefaultBlockEmission", "value": "1000000000000000000"}, {"key": "SubnetRewardBase", "value": "0.100000000000000000"}, {"key": "SubnetRewardK", "value": "0.100000000000000000"}, {"key": "SubnetRewardMaxRatio", "value": "0.500000000000000000"}, {"key": "SubnetMovingAlpha", "value": "0.000003000000000000"}, {"key": "SubnetOwnerCut", "value": "0.180000000000000000"}]}}}}' "$GENESIS" > "$TMPGENESIS" && mv "$TMPGENESIS" "$GENESIS"
@@ -0,0 +1,286 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        (unknown)
+// source: hetu/blockinflation/v1/params.proto
+
+package types
+
+import (
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the parameters for the blockinflation module.
+type Params struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	EnableBlockInflation   bool                   `protobuf:"varint,1,opt,name=enable_block_inflation,json=enableBlockInflation,proto3" json:"enable_block_inflation,omitempty"`
+	MintDenom              string                 `protobuf:"bytes,2,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
+	TotalSupply            string                 `protobuf:"bytes,3,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	DefaultBlockEmission   string                 `protobuf:"bytes,4,opt,name=default_block_emission,json=defaultBlockEmission,proto3" json:"default_block_emission,omitempty"`
+	SubnetRewardBase       string                 `protobuf:"bytes,5,opt,name=subnet_reward_base,json=subnetRewardBase,proto3" json:"subnet_reward_base,omitempty"`
+	SubnetRewardK          string                 `protobuf:"bytes,6,opt,name=subnet_reward_k,json=subnetRewardK,proto3" json:"subnet_reward_k,omitempty"`
+	SubnetRewardMaxRatio   string                 `protobuf:"bytes,7,opt,name=subnet_reward_max_ratio,json=subnetRewardMaxRatio,proto3" json:"subnet_reward_max_ratio,omitempty"`
+	SubnetMovingAlpha      string                 `protobuf:"bytes,8,opt,name=subnet_moving_alpha,json=subnetMovingAlpha,proto3" json:"subnet_moving_alpha,omitempty"`
+	SubnetOwnerCut         string                 `protobuf:"bytes,9,opt,name=subnet_owner_cut,json=subnetOwnerCut,proto3" json:"subnet_owner_cut,omitempty"`
+	PriceTwapWindow        uint64                 `protobuf:"varint,10,opt,name=price_twap_window,json=priceTwapWindow,proto3" json:"price_twap_window,omitempty"`
+	MaxPriceChangePerBlock string                 `protobuf:"bytes,11,opt,name=max_price_change_per_block,json=maxPriceChangePerBlock,proto3" json:"max_price_change_per_block,omitempty"`
+	SubnetEmissionMode     string                 `protobuf:"bytes,12,opt,name=subnet_emission_mode,json=subnetEmissionMode,proto3" json:"subnet_emission_mode,omitempty"`
+	RootWeightRatio        string                 `protobuf:"bytes,13,opt,name=root_weight_ratio,json=rootWeightRatio,proto3" json:"root_weight_ratio,omitempty"`
+	RootDividendRatio      string                 `protobuf:"bytes,14,opt,name=root_dividend_ratio,json=rootDividendRatio,proto3" json:"root_dividend_ratio,omitempty"`
+	RootTempo              uint64                 `protobuf:"varint,15,opt,name=root_tempo,json=rootTempo,proto3" json:"root_tempo,omitempty"`
+	// active_minter is the module allowed to mint new supply of the mint denom,
+	// either blockinflation or inflation
+	ActiveMinter string `protobuf:"bytes,16,opt,name=active_minter,json=activeMinter,proto3" json:"active_minter,omitempty"`
+	// lock_cost_policy is where the part of the network lock cost burned at subnet
+	// registration ends up: burn, recycle or community_pool
+	LockCostPolicy string `protobuf:"bytes,17,opt,name=lock_cost_policy,json=lockCostPolicy,proto3" json:"lock_cost_policy,omitempty"`
+	// registration_cost_policy is where the neuron registration costs paid to the
+	// GlobalStaking treasury end up: burn, recycle or community_pool
+	RegistrationCostPolicy string `protobuf:"bytes,18,opt,name=registration_cost_policy,json=registrationCostPolicy,proto3" json:"registration_cost_policy,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	mi := &file_hetu_blockinflation_v1_params_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_params_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetEnableBlockInflation() bool {
+	if x != nil {
+		return x.EnableBlockInflation
+	}
+	return false
+}
+
+func (x *Params) GetMintDenom() string {
+	if x != nil {
+		return x.MintDenom
+	}
+	return ""
+}
+
+func (x *Params) GetTotalSupply() string {
+	if x != nil {
+		return x.TotalSupply
+	}
+	return ""
+}
+
+func (x *Params) GetDefaultBlockEmission() string {
+	if x != nil {
+		return x.DefaultBlockEmission
+	}
+	return ""
+}
+
+func (x *Params) GetSubnetRewardBase() string {
+	if x != nil {
+		return x.SubnetRewardBase
+	}
+	return ""
+}
+
+func (x *Params) GetSubnetRewardK() string {
+	if x != nil {
+		return x.SubnetRewardK
+	}
+	return ""
+}
+
+func (x *Params) GetSubnetRewardMaxRatio() string {
+	if x != nil {
+		return x.SubnetRewardMaxRatio
+	}
+	return ""
+}
+
+func (x *Params) GetSubnetMovingAlpha() string {
+	if x != nil {
+		return x.SubnetMovingAlpha
+	}
+	return ""
+}
+
+func (x *Params) GetSubnetOwnerCut() string {
+	if x != nil {
+		return x.SubnetOwnerCut
+	}
+	return ""
+}
+
+func (x *Params) GetPriceTwapWindow() uint64 {
+	if x != nil {
+		return x.PriceTwapWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMaxPriceChangePerBlock() string {
+	if x != nil {
+		return x.MaxPriceChangePerBlock
+	}
+	return ""
+}
+
+func (x *Params) GetSubnetEmissionMode() string {
+	if x != nil {
+		return x.SubnetEmissionMode
+	}
+	return ""
+}
+
+func (x *Params) GetRootWeightRatio() string {
+	if x != nil {
+		return x.RootWeightRatio
+	}
+	return ""
+}
+
+func (x *Params) GetRootDividendRatio() string {
+	if x != nil {
+		return x.RootDividendRatio
+	}
+	return ""
+}
+
+func (x *Params) GetRootTempo() uint64 {
+	if x != nil {
+		return x.RootTempo
+	}
+	return 0
+}
+
+func (x *Params) GetActiveMinter() string {
+	if x != nil {
+		return x.ActiveMinter
+	}
+	return ""
+}
+
+func (x *Params) GetLockCostPolicy() string {
+	if x != nil {
+		return x.LockCostPolicy
+	}
+	return ""
+}
+
+func (x *Params) GetRegistrationCostPolicy() string {
+	if x != nil {
+		return x.RegistrationCostPolicy
+	}
+	return ""
+}
+
+var File_hetu_blockinflation_v1_params_proto protoreflect.FileDescriptor
+
+const file_hetu_blockinflation_v1_params_proto_rawDesc = "" +
+	"\n" +
+	"#hetu/blockinflation/v1/params.proto\x12\x16hetu.blockinflation.v1\x1a\x14gogoproto/gogo.proto\"\xa7\t\n" +
+	"\x06Params\x124\n" +
+	"\x16enable_block_inflation\x18\x01 \x01(\bR\x14enableBlockInflation\x12\x1d\n" +
+	"\n" +
+	"mint_denom\x18\x02 \x01(\tR\tmintDenom\x12@\n" +
+	"\ftotal_supply\x18\x03 \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\vtotalSupply\x12S\n" +
+	"\x16default_block_emission\x18\x04 \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\x14defaultBlockEmission\x12Q\n" +
+	"\x12subnet_reward_base\x18\x05 \x01(\tB#\xc8\xde\x1f\x00\xda\xde\x1f\x1bcosmossdk.io/math.LegacyDecR\x10subnetRewardBase\x12K\n" +
+	"\x0fsubnet_reward_k\x18\x06 \x01(\tB#\xc8\xde\x1f\x00\xda\xde\x1f\x1bcosmossdk.io/math.LegacyDecR\rsubnetRewardK\x12Z\n" +
+	"\x17subnet_reward_max_ratio\x18\a \x01(\tB#\xc8\xde\x1f\x00\xda\xde\x1f\x1bcosmossdk.io/math.LegacyDecR\x14subnetRewardMaxRatio\x12S\n" +
+	"\x13subnet_moving_alpha\x18\b \x01(\tB#\xc8\xde\x1f\x00\xda\xde\x1f\x1bcosmossdk.io/math.LegacyDecR\x11subnetMovingAlpha\x12M\n" +
+	"\x10subnet_owner_cut\x18\t \x01(\tB#\xc8\xde\x1f\x00\xda\xde\x1f\x1bcosmossdk.io/math.LegacyDecR\x0esubnetOwnerCut\x12*\n" +
+	"\x11price_twap_window\x18\n" +
+	" \x01(\x04R\x0fpriceTwapWindow\x12_\n" +
+	"\x1amax_price_change_per_block\x18\v \x01(\tB#\xc8\xde\x1f\x00\xda\xde\x1f\x1bcosmossdk.io/math.LegacyDecR\x16maxPriceChangePerBlock\x120\n" +
+	"\x14subnet_emission_mode\x18\f \x01(\tR\x12subnetEmissionMode\x12O\n" +
+	"\x11root_weight_ratio\x18\r \x01(\tB#\xc8\xde\x1f\x00\xda\xde\x1f\x1bcosmossdk.io/math.LegacyDecR\x0frootWeightRatio\x12S\n" +
+	"\x13root_dividend_ratio\x18\x0e \x01(\tB#\xc8\xde\x1f\x00\xda\xde\x1f\x1bcosmossdk.io/math.LegacyDecR\x11rootDividendRatio\x12\x1d\n" +
+	"\n" +
+	"root_tempo\x18\x0f \x01(\x04R\trootTempo\x12#\n" +
+	"\ractive_minter\x18\x10 \x01(\tR\factiveMinter\x12(\n" +
+	"\x10lock_cost_policy\x18\x11 \x01(\tR\x0elockCostPolicy\x128\n" +
+	"\x18registration_cost_policy\x18\x12 \x01(\tR\x16registrationCostPolicy:\x04\x98\xa0\x1f\x00B8Z6github.com/hetu-project/hetu/v1/x/blockinflation/typesb\x06proto3"
+
+var (
+	file_hetu_blockinflation_v1_params_proto_rawDescOnce sync.Once
+	file_hetu_blockinflation_v1_params_proto_rawDescData []byte
+)
+
+func file_hetu_blockinflation_v1_params_proto_rawDescGZIP() []byte {
+	file_hetu_blockinflation_v1_params_proto_rawDescOnce.Do(func() {
+		file_hetu_blockinflation_v1_params_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hetu_blockinflation_v1_params_proto_rawDesc), len(file_hetu_blockinflation_v1_params_proto_rawDesc)))
+	})
+	return file_hetu_blockinflation_v1_params_proto_rawDescData
+}
+
+var file_hetu_blockinflation_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_hetu_blockinflation_v1_params_proto_goTypes = []any{
+	(*Params)(nil), // 0: hetu.blockinflation.v1.Params
+}
+var file_hetu_blockinflation_v1_params_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hetu_blockinflation_v1_params_proto_init() }
+func file_hetu_blockinflation_v1_params_proto_init() {
+	if File_hetu_blockinflation_v1_params_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hetu_blockinflation_v1_params_proto_rawDesc), len(file_hetu_blockinflation_v1_params_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hetu_blockinflation_v1_params_proto_goTypes,
+		DependencyIndexes: file_hetu_blockinflation_v1_params_proto_depIdxs,
+		MessageInfos:      file_hetu_blockinflation_v1_params_proto_msgTypes,
+	}.Build()
+	File_hetu_blockinflation_v1_params_proto = out.File
+	file_hetu_blockinflation_v1_params_proto_goTypes = nil
+	file_hetu_blockinflation_v1_params_proto_depIdxs = nil
+}
@@ -8,6 +8,7 @@ package types
 
 import (
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -190,36 +191,27 @@ func (x *QueryPendingSubnetRewardsResponse) GetPendingSubnetRewards() *types.Coi
 	return nil
 }
 
-// Params defines the parameters for the blockinflation module.
-type Params struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	EnableBlockInflation bool                   `protobuf:"varint,1,opt,name=enable_block_inflation,json=enableBlockInflation,proto3" json:"enable_block_inflation,omitempty"`
-	MintDenom            string                 `protobuf:"bytes,2,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
-	TotalSupply          string                 `protobuf:"bytes,3,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
-	DefaultBlockEmission string                 `protobuf:"bytes,4,opt,name=default_block_emission,json=defaultBlockEmission,proto3" json:"default_block_emission,omitempty"`
-	SubnetRewardBase     string                 `protobuf:"bytes,5,opt,name=subnet_reward_base,json=subnetRewardBase,proto3" json:"subnet_reward_base,omitempty"`
-	SubnetRewardK        string                 `protobuf:"bytes,6,opt,name=subnet_reward_k,json=subnetRewardK,proto3" json:"subnet_reward_k,omitempty"`
-	SubnetRewardMaxRatio string                 `protobuf:"bytes,7,opt,name=subnet_reward_max_ratio,json=subnetRewardMaxRatio,proto3" json:"subnet_reward_max_ratio,omitempty"`
-	SubnetMovingAlpha    string                 `protobuf:"bytes,8,opt,name=subnet_moving_alpha,json=subnetMovingAlpha,proto3" json:"subnet_moving_alpha,omitempty"`
-	SubnetOwnerCut       string                 `protobuf:"bytes,9,opt,name=subnet_owner_cut,json=subnetOwnerCut,proto3" json:"subnet_owner_cut,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// QuerySuspendedSubnetsRequest is the request type for the Query/SuspendedSubnets RPC method.
+type QuerySuspendedSubnetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+func (x *QuerySuspendedSubnetsRequest) Reset() {
+	*x = QuerySuspendedSubnetsRequest{}
 	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Params) String() string {
+func (x *QuerySuspendedSubnetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
+func (*QuerySuspendedSubnetsRequest) ProtoMessage() {}
 
-func (x *Params) ProtoReflect() protoreflect.Message {
+func (x *QuerySuspendedSubnetsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -231,99 +223,811 @@ func (x *Params) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuerySuspendedSubnetsRequest.ProtoReflect.Descriptor instead.
+func (*QuerySuspendedSubnetsRequest) Descriptor() ([]byte, []int) {
 	return file_hetu_blockinflation_v1_query_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Params) GetEnableBlockInflation() bool {
+// QuerySuspendedSubnetsResponse is the response type for the Query/SuspendedSubnets RPC method.
+type QuerySuspendedSubnetsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// suspensions defines the suspended subnets ordered by netuid.
+	Suspensions   []*SubnetEmissionSuspension `protobuf:"bytes,1,rep,name=suspensions,proto3" json:"suspensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuerySuspendedSubnetsResponse) Reset() {
+	*x = QuerySuspendedSubnetsResponse{}
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuerySuspendedSubnetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySuspendedSubnetsResponse) ProtoMessage() {}
+
+func (x *QuerySuspendedSubnetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySuspendedSubnetsResponse.ProtoReflect.Descriptor instead.
+func (*QuerySuspendedSubnetsResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QuerySuspendedSubnetsResponse) GetSuspensions() []*SubnetEmissionSuspension {
+	if x != nil {
+		return x.Suspensions
+	}
+	return nil
+}
+
+// QuerySuspendedSubnetRequest is the request type for the Query/SuspendedSubnet RPC method.
+type QuerySuspendedSubnetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// netuid is the subnet to query.
+	Netuid        uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuerySuspendedSubnetRequest) Reset() {
+	*x = QuerySuspendedSubnetRequest{}
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuerySuspendedSubnetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySuspendedSubnetRequest) ProtoMessage() {}
+
+func (x *QuerySuspendedSubnetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySuspendedSubnetRequest.ProtoReflect.Descriptor instead.
+func (*QuerySuspendedSubnetRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QuerySuspendedSubnetRequest) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+// QuerySuspendedSubnetResponse is the response type for the Query/SuspendedSubnet RPC method.
+type QuerySuspendedSubnetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// suspension defines the emission suspension of the subnet.
+	Suspension    *SubnetEmissionSuspension `protobuf:"bytes,1,opt,name=suspension,proto3" json:"suspension,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuerySuspendedSubnetResponse) Reset() {
+	*x = QuerySuspendedSubnetResponse{}
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuerySuspendedSubnetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySuspendedSubnetResponse) ProtoMessage() {}
+
+func (x *QuerySuspendedSubnetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[7]
 	if x != nil {
-		return x.EnableBlockInflation
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySuspendedSubnetResponse.ProtoReflect.Descriptor instead.
+func (*QuerySuspendedSubnetResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_query_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Params) GetMintDenom() string {
+func (x *QuerySuspendedSubnetResponse) GetSuspension() *SubnetEmissionSuspension {
 	if x != nil {
-		return x.MintDenom
+		return x.Suspension
+	}
+	return nil
+}
+
+// QuerySupplyBreakdownRequest is the request type for the Query/SupplyBreakdown RPC method.
+type QuerySupplyBreakdownRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pagination defines an optional pagination for the accounts whose vesting
+	// supply is summed up.
+	Pagination    *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuerySupplyBreakdownRequest) Reset() {
+	*x = QuerySupplyBreakdownRequest{}
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuerySupplyBreakdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySupplyBreakdownRequest) ProtoMessage() {}
+
+func (x *QuerySupplyBreakdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySupplyBreakdownRequest.ProtoReflect.Descriptor instead.
+func (*QuerySupplyBreakdownRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QuerySupplyBreakdownRequest) GetPagination() *query.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QuerySupplyBreakdownResponse is the response type for the Query/SupplyBreakdown RPC method.
+// All amounts are in the mint denom. The vesting and circulating amounts only
+// cover the vesting accounts of the requested page, the vesting supply is the
+// sum of the vesting amounts over all pages.
+type QuerySupplyBreakdownResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// active_minter is the module allowed to mint new supply.
+	ActiveMinter string `protobuf:"bytes,1,opt,name=active_minter,json=activeMinter,proto3" json:"active_minter,omitempty"`
+	// denom is the mint denom.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// supply_cap is the hard cap on the issued supply.
+	SupplyCap string `protobuf:"bytes,3,opt,name=supply_cap,json=supplyCap,proto3" json:"supply_cap,omitempty"`
+	// total_supply is the current bank supply.
+	TotalSupply string `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	// issued is the supply minted under the supply policy.
+	Issued string `protobuf:"bytes,5,opt,name=issued,proto3" json:"issued,omitempty"`
+	// remaining is the supply that can still be minted before the cap.
+	Remaining string `protobuf:"bytes,6,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// burned is the supply burned by the module.
+	Burned string `protobuf:"bytes,7,opt,name=burned,proto3" json:"burned,omitempty"`
+	// locked_in_amm is the supply held in the subnet AMM pools.
+	LockedInAmm string `protobuf:"bytes,8,opt,name=locked_in_amm,json=lockedInAmm,proto3" json:"locked_in_amm,omitempty"`
+	// vesting is the supply still locked in the vesting accounts of the page.
+	Vesting string `protobuf:"bytes,9,opt,name=vesting,proto3" json:"vesting,omitempty"`
+	// community_pool is the supply held by the community pool.
+	CommunityPool string `protobuf:"bytes,10,opt,name=community_pool,json=communityPool,proto3" json:"community_pool,omitempty"`
+	// circulating is the total supply minus the AMM, vesting and community pool amounts.
+	Circulating string `protobuf:"bytes,11,opt,name=circulating,proto3" json:"circulating,omitempty"`
+	// pagination defines the pagination of the accounts in the response.
+	Pagination    *query.PageResponse `protobuf:"bytes,12,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuerySupplyBreakdownResponse) Reset() {
+	*x = QuerySupplyBreakdownResponse{}
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuerySupplyBreakdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySupplyBreakdownResponse) ProtoMessage() {}
+
+func (x *QuerySupplyBreakdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySupplyBreakdownResponse.ProtoReflect.Descriptor instead.
+func (*QuerySupplyBreakdownResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QuerySupplyBreakdownResponse) GetActiveMinter() string {
+	if x != nil {
+		return x.ActiveMinter
+	}
+	return ""
+}
+
+func (x *QuerySupplyBreakdownResponse) GetDenom() string {
+	if x != nil {
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *Params) GetTotalSupply() string {
+func (x *QuerySupplyBreakdownResponse) GetSupplyCap() string {
+	if x != nil {
+		return x.SupplyCap
+	}
+	return ""
+}
+
+func (x *QuerySupplyBreakdownResponse) GetTotalSupply() string {
 	if x != nil {
 		return x.TotalSupply
 	}
 	return ""
 }
 
-func (x *Params) GetDefaultBlockEmission() string {
+func (x *QuerySupplyBreakdownResponse) GetIssued() string {
 	if x != nil {
-		return x.DefaultBlockEmission
+		return x.Issued
 	}
 	return ""
 }
 
-func (x *Params) GetSubnetRewardBase() string {
+func (x *QuerySupplyBreakdownResponse) GetRemaining() string {
 	if x != nil {
-		return x.SubnetRewardBase
+		return x.Remaining
 	}
 	return ""
 }
 
-func (x *Params) GetSubnetRewardK() string {
+func (x *QuerySupplyBreakdownResponse) GetBurned() string {
 	if x != nil {
-		return x.SubnetRewardK
+		return x.Burned
 	}
 	return ""
 }
 
-func (x *Params) GetSubnetRewardMaxRatio() string {
+func (x *QuerySupplyBreakdownResponse) GetLockedInAmm() string {
 	if x != nil {
-		return x.SubnetRewardMaxRatio
+		return x.LockedInAmm
 	}
 	return ""
 }
 
-func (x *Params) GetSubnetMovingAlpha() string {
+func (x *QuerySupplyBreakdownResponse) GetVesting() string {
 	if x != nil {
-		return x.SubnetMovingAlpha
+		return x.Vesting
 	}
 	return ""
 }
 
-func (x *Params) GetSubnetOwnerCut() string {
+func (x *QuerySupplyBreakdownResponse) GetCommunityPool() string {
 	if x != nil {
-		return x.SubnetOwnerCut
+		return x.CommunityPool
 	}
 	return ""
 }
 
+func (x *QuerySupplyBreakdownResponse) GetCirculating() string {
+	if x != nil {
+		return x.Circulating
+	}
+	return ""
+}
+
+func (x *QuerySupplyBreakdownResponse) GetPagination() *query.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryBlockEmissionScheduleRequest is the request type for the Query/BlockEmissionSchedule RPC method.
+type QueryBlockEmissionScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryBlockEmissionScheduleRequest) Reset() {
+	*x = QueryBlockEmissionScheduleRequest{}
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryBlockEmissionScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBlockEmissionScheduleRequest) ProtoMessage() {}
+
+func (x *QueryBlockEmissionScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryBlockEmissionScheduleRequest.ProtoReflect.Descriptor instead.
+func (*QueryBlockEmissionScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+// Halving is an upcoming halving of the block emission.
+type Halving struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// index is the number of halvings once the threshold is reached.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// threshold is the total issuance at which the halving starts.
+	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// block_emission is the block emission after the halving.
+	BlockEmission string `protobuf:"bytes,3,opt,name=block_emission,json=blockEmission,proto3" json:"block_emission,omitempty"`
+	// estimated_height is the estimated block height at which the threshold is reached.
+	EstimatedHeight int64 `protobuf:"varint,4,opt,name=estimated_height,json=estimatedHeight,proto3" json:"estimated_height,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Halving) Reset() {
+	*x = Halving{}
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Halving) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Halving) ProtoMessage() {}
+
+func (x *Halving) ProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Halving.ProtoReflect.Descriptor instead.
+func (*Halving) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Halving) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Halving) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
+	}
+	return ""
+}
+
+func (x *Halving) GetBlockEmission() string {
+	if x != nil {
+		return x.BlockEmission
+	}
+	return ""
+}
+
+func (x *Halving) GetEstimatedHeight() int64 {
+	if x != nil {
+		return x.EstimatedHeight
+	}
+	return 0
+}
+
+// QueryBlockEmissionScheduleResponse is the response type for the Query/BlockEmissionSchedule RPC method.
+// All amounts are in the mint denom.
+type QueryBlockEmissionScheduleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// denom is the mint denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// total_supply is the supply cap the halvings are computed against.
+	TotalSupply string `protobuf:"bytes,2,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	// total_issuance is the supply issued so far.
+	TotalIssuance string `protobuf:"bytes,3,opt,name=total_issuance,json=totalIssuance,proto3" json:"total_issuance,omitempty"`
+	// current_halving is the number of halvings of the block emission so far.
+	CurrentHalving uint64 `protobuf:"varint,4,opt,name=current_halving,json=currentHalving,proto3" json:"current_halving,omitempty"`
+	// current_block_emission is the block emission at the current halving.
+	CurrentBlockEmission string `protobuf:"bytes,5,opt,name=current_block_emission,json=currentBlockEmission,proto3" json:"current_block_emission,omitempty"`
+	// halvings are the upcoming halvings until the block emission reaches zero.
+	Halvings      []*Halving `protobuf:"bytes,6,rep,name=halvings,proto3" json:"halvings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryBlockEmissionScheduleResponse) Reset() {
+	*x = QueryBlockEmissionScheduleResponse{}
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryBlockEmissionScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBlockEmissionScheduleResponse) ProtoMessage() {}
+
+func (x *QueryBlockEmissionScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryBlockEmissionScheduleResponse.ProtoReflect.Descriptor instead.
+func (*QueryBlockEmissionScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryBlockEmissionScheduleResponse) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *QueryBlockEmissionScheduleResponse) GetTotalSupply() string {
+	if x != nil {
+		return x.TotalSupply
+	}
+	return ""
+}
+
+func (x *QueryBlockEmissionScheduleResponse) GetTotalIssuance() string {
+	if x != nil {
+		return x.TotalIssuance
+	}
+	return ""
+}
+
+func (x *QueryBlockEmissionScheduleResponse) GetCurrentHalving() uint64 {
+	if x != nil {
+		return x.CurrentHalving
+	}
+	return 0
+}
+
+func (x *QueryBlockEmissionScheduleResponse) GetCurrentBlockEmission() string {
+	if x != nil {
+		return x.CurrentBlockEmission
+	}
+	return ""
+}
+
+func (x *QueryBlockEmissionScheduleResponse) GetHalvings() []*Halving {
+	if x != nil {
+		return x.Halvings
+	}
+	return nil
+}
+
+// QueryAllSubnetCostsRequest is the request type for the Query/AllSubnetCosts RPC method.
+type QueryAllSubnetCostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryAllSubnetCostsRequest) Reset() {
+	*x = QueryAllSubnetCostsRequest{}
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryAllSubnetCostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllSubnetCostsRequest) ProtoMessage() {}
+
+func (x *QueryAllSubnetCostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAllSubnetCostsRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllSubnetCostsRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+// QueryAllSubnetCostsResponse is the response type for the Query/AllSubnetCosts RPC method.
+type QueryAllSubnetCostsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// denom is the mint denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// subnet_costs defines the costs of the subnets ordered by netuid.
+	SubnetCosts   []*SubnetCosts `protobuf:"bytes,2,rep,name=subnet_costs,json=subnetCosts,proto3" json:"subnet_costs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryAllSubnetCostsResponse) Reset() {
+	*x = QueryAllSubnetCostsResponse{}
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryAllSubnetCostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllSubnetCostsResponse) ProtoMessage() {}
+
+func (x *QueryAllSubnetCostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAllSubnetCostsResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllSubnetCostsResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QueryAllSubnetCostsResponse) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *QueryAllSubnetCostsResponse) GetSubnetCosts() []*SubnetCosts {
+	if x != nil {
+		return x.SubnetCosts
+	}
+	return nil
+}
+
+// QuerySubnetCostsRequest is the request type for the Query/SubnetCosts RPC method.
+type QuerySubnetCostsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// netuid is the subnet to query.
+	Netuid        uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuerySubnetCostsRequest) Reset() {
+	*x = QuerySubnetCostsRequest{}
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuerySubnetCostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySubnetCostsRequest) ProtoMessage() {}
+
+func (x *QuerySubnetCostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySubnetCostsRequest.ProtoReflect.Descriptor instead.
+func (*QuerySubnetCostsRequest) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_query_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QuerySubnetCostsRequest) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+// QuerySubnetCostsResponse is the response type for the Query/SubnetCosts RPC method.
+type QuerySubnetCostsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// denom is the mint denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// subnet_costs defines the costs of the subnet.
+	SubnetCosts   *SubnetCosts `protobuf:"bytes,2,opt,name=subnet_costs,json=subnetCosts,proto3" json:"subnet_costs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuerySubnetCostsResponse) Reset() {
+	*x = QuerySubnetCostsResponse{}
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuerySubnetCostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySubnetCostsResponse) ProtoMessage() {}
+
+func (x *QuerySubnetCostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_query_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySubnetCostsResponse.ProtoReflect.Descriptor instead.
+func (*QuerySubnetCostsResponse) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_query_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QuerySubnetCostsResponse) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *QuerySubnetCostsResponse) GetSubnetCosts() *SubnetCosts {
+	if x != nil {
+		return x.SubnetCosts
+	}
+	return nil
+}
+
 var File_hetu_blockinflation_v1_query_proto protoreflect.FileDescriptor
 
 const file_hetu_blockinflation_v1_query_proto_rawDesc = "" +
 	"\n" +
-	"\"hetu/blockinflation/v1/query.proto\x12\x16hetu.blockinflation.v1\x1a\x14gogoproto/gogo.proto\x1a\x1ecosmos/base/v1beta1/coin.proto\x1a\x1cgoogle/api/annotations.proto\"\x14\n" +
+	"\"hetu/blockinflation/v1/query.proto\x12\x16hetu.blockinflation.v1\x1a\x14gogoproto/gogo.proto\x1a*cosmos/base/query/v1beta1/pagination.proto\x1a\x1ecosmos/base/v1beta1/coin.proto\x1a\x1cgoogle/api/annotations.proto\x1a$hetu/blockinflation/v1/genesis.proto\x1a#hetu/blockinflation/v1/params.proto\"\x14\n" +
 	"\x12QueryParamsRequest\"S\n" +
 	"\x13QueryParamsResponse\x12<\n" +
 	"\x06params\x18\x01 \x01(\v2\x1e.hetu.blockinflation.v1.ParamsB\x04\xc8\xde\x1f\x00R\x06params\"\"\n" +
 	" QueryPendingSubnetRewardsRequest\"z\n" +
 	"!QueryPendingSubnetRewardsResponse\x12U\n" +
-	"\x16pending_subnet_rewards\x18\x01 \x01(\v2\x19.cosmos.base.v1beta1.CoinB\x04\xc8\xde\x1f\x00R\x14pendingSubnetRewards\"\x9a\x05\n" +
-	"\x06Params\x124\n" +
-	"\x16enable_block_inflation\x18\x01 \x01(\bR\x14enableBlockInflation\x12\x1d\n" +
+	"\x16pending_subnet_rewards\x18\x01 \x01(\v2\x19.cosmos.base.v1beta1.CoinB\x04\xc8\xde\x1f\x00R\x14pendingSubnetRewards\"\x1e\n" +
+	"\x1cQuerySuspendedSubnetsRequest\"y\n" +
+	"\x1dQuerySuspendedSubnetsResponse\x12X\n" +
+	"\vsuspensions\x18\x01 \x03(\v20.hetu.blockinflation.v1.SubnetEmissionSuspensionB\x04\xc8\xde\x1f\x00R\vsuspensions\"5\n" +
+	"\x1bQuerySuspendedSubnetRequest\x12\x16\n" +
+	"\x06netuid\x18\x01 \x01(\rR\x06netuid\"v\n" +
+	"\x1cQuerySuspendedSubnetResponse\x12V\n" +
+	"\n" +
+	"suspension\x18\x01 \x01(\v20.hetu.blockinflation.v1.SubnetEmissionSuspensionB\x04\xc8\xde\x1f\x00R\n" +
+	"suspension\"e\n" +
+	"\x1bQuerySupplyBreakdownRequest\x12F\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2&.cosmos.base.query.v1beta1.PageRequestR\n" +
+	"pagination\"\xd0\x05\n" +
+	"\x1cQuerySupplyBreakdownResponse\x12#\n" +
+	"\ractive_minter\x18\x01 \x01(\tR\factiveMinter\x12\x14\n" +
+	"\x05denom\x18\x02 \x01(\tR\x05denom\x12<\n" +
+	"\n" +
+	"supply_cap\x18\x03 \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\tsupplyCap\x12@\n" +
+	"\ftotal_supply\x18\x04 \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\vtotalSupply\x125\n" +
+	"\x06issued\x18\x05 \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\x06issued\x12;\n" +
+	"\tremaining\x18\x06 \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\tremaining\x125\n" +
+	"\x06burned\x18\a \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\x06burned\x12A\n" +
+	"\rlocked_in_amm\x18\b \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\vlockedInAmm\x127\n" +
+	"\avesting\x18\t \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\avesting\x12D\n" +
+	"\x0ecommunity_pool\x18\n" +
+	" \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\rcommunityPool\x12?\n" +
+	"\vcirculating\x18\v \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\vcirculating\x12G\n" +
 	"\n" +
-	"mint_denom\x18\x02 \x01(\tR\tmintDenom\x12@\n" +
-	"\ftotal_supply\x18\x03 \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\vtotalSupply\x12S\n" +
-	"\x16default_block_emission\x18\x04 \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\x14defaultBlockEmission\x12Q\n" +
-	"\x12subnet_reward_base\x18\x05 \x01(\tB#\xc8\xde\x1f\x00\xda\xde\x1f\x1bcosmossdk.io/math.LegacyDecR\x10subnetRewardBase\x12K\n" +
-	"\x0fsubnet_reward_k\x18\x06 \x01(\tB#\xc8\xde\x1f\x00\xda\xde\x1f\x1bcosmossdk.io/math.LegacyDecR\rsubnetRewardK\x12Z\n" +
-	"\x17subnet_reward_max_ratio\x18\a \x01(\tB#\xc8\xde\x1f\x00\xda\xde\x1f\x1bcosmossdk.io/math.LegacyDecR\x14subnetRewardMaxRatio\x12S\n" +
-	"\x13subnet_moving_alpha\x18\b \x01(\tB#\xc8\xde\x1f\x00\xda\xde\x1f\x1bcosmossdk.io/math.LegacyDecR\x11subnetMovingAlpha\x12M\n" +
-	"\x10subnet_owner_cut\x18\t \x01(\tB#\xc8\xde\x1f\x00\xda\xde\x1f\x1bcosmossdk.io/math.LegacyDecR\x0esubnetOwnerCut:\x04\x98\xa0\x1f\x002\xd9\x02\n" +
+	"pagination\x18\f \x01(\v2'.cosmos.base.query.v1beta1.PageResponseR\n" +
+	"pagination\"#\n" +
+	"!QueryBlockEmissionScheduleRequest\"\xcd\x01\n" +
+	"\aHalving\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x04R\x05index\x12;\n" +
+	"\tthreshold\x18\x02 \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\tthreshold\x12D\n" +
+	"\x0eblock_emission\x18\x03 \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\rblockEmission\x12)\n" +
+	"\x10estimated_height\x18\x04 \x01(\x03R\x0festimatedHeight\"\x83\x03\n" +
+	"\"QueryBlockEmissionScheduleResponse\x12\x14\n" +
+	"\x05denom\x18\x01 \x01(\tR\x05denom\x12@\n" +
+	"\ftotal_supply\x18\x02 \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\vtotalSupply\x12D\n" +
+	"\x0etotal_issuance\x18\x03 \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\rtotalIssuance\x12'\n" +
+	"\x0fcurrent_halving\x18\x04 \x01(\x04R\x0ecurrentHalving\x12S\n" +
+	"\x16current_block_emission\x18\x05 \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\x14currentBlockEmission\x12A\n" +
+	"\bhalvings\x18\x06 \x03(\v2\x1f.hetu.blockinflation.v1.HalvingB\x04\xc8\xde\x1f\x00R\bhalvings\"\x1c\n" +
+	"\x1aQueryAllSubnetCostsRequest\"\x81\x01\n" +
+	"\x1bQueryAllSubnetCostsResponse\x12\x14\n" +
+	"\x05denom\x18\x01 \x01(\tR\x05denom\x12L\n" +
+	"\fsubnet_costs\x18\x02 \x03(\v2#.hetu.blockinflation.v1.SubnetCostsB\x04\xc8\xde\x1f\x00R\vsubnetCosts\"1\n" +
+	"\x17QuerySubnetCostsRequest\x12\x16\n" +
+	"\x06netuid\x18\x01 \x01(\rR\x06netuid\"~\n" +
+	"\x18QuerySubnetCostsResponse\x12\x14\n" +
+	"\x05denom\x18\x01 \x01(\tR\x05denom\x12L\n" +
+	"\fsubnet_costs\x18\x02 \x01(\v2#.hetu.blockinflation.v1.SubnetCostsB\x04\xc8\xde\x1f\x00R\vsubnetCosts2\x98\v\n" +
 	"\x05Query\x12\x89\x01\n" +
 	"\x06Params\x12*.hetu.blockinflation.v1.QueryParamsRequest\x1a+.hetu.blockinflation.v1.QueryParamsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/hetu/blockinflation/v1/params\x12\xc3\x01\n" +
-	"\x14PendingSubnetRewards\x128.hetu.blockinflation.v1.QueryPendingSubnetRewardsRequest\x1a9.hetu.blockinflation.v1.QueryPendingSubnetRewardsResponse\"6\x82\xd3\xe4\x93\x020\x12./hetu/blockinflation/v1/pending_subnet_rewardsB8Z6github.com/hetu-project/hetu/v1/x/blockinflation/typesb\x06proto3"
+	"\x14PendingSubnetRewards\x128.hetu.blockinflation.v1.QueryPendingSubnetRewardsRequest\x1a9.hetu.blockinflation.v1.QueryPendingSubnetRewardsResponse\"6\x82\xd3\xe4\x93\x020\x12./hetu/blockinflation/v1/pending_subnet_rewards\x12\xb2\x01\n" +
+	"\x10SuspendedSubnets\x124.hetu.blockinflation.v1.QuerySuspendedSubnetsRequest\x1a5.hetu.blockinflation.v1.QuerySuspendedSubnetsResponse\"1\x82\xd3\xe4\x93\x02+\x12)/hetu/blockinflation/v1/suspended_subnets\x12\xb8\x01\n" +
+	"\x0fSuspendedSubnet\x123.hetu.blockinflation.v1.QuerySuspendedSubnetRequest\x1a4.hetu.blockinflation.v1.QuerySuspendedSubnetResponse\":\x82\xd3\xe4\x93\x024\x122/hetu/blockinflation/v1/suspended_subnets/{netuid}\x12\xae\x01\n" +
+	"\x0fSupplyBreakdown\x123.hetu.blockinflation.v1.QuerySupplyBreakdownRequest\x1a4.hetu.blockinflation.v1.QuerySupplyBreakdownResponse\"0\x82\xd3\xe4\x93\x02*\x12(/hetu/blockinflation/v1/supply_breakdown\x12\xc7\x01\n" +
+	"\x15BlockEmissionSchedule\x129.hetu.blockinflation.v1.QueryBlockEmissionScheduleRequest\x1a:.hetu.blockinflation.v1.QueryBlockEmissionScheduleResponse\"7\x82\xd3\xe4\x93\x021\x12//hetu/blockinflation/v1/block_emission_schedule\x12\xa7\x01\n" +
+	"\x0eAllSubnetCosts\x122.hetu.blockinflation.v1.QueryAllSubnetCostsRequest\x1a3.hetu.blockinflation.v1.QueryAllSubnetCostsResponse\",\x82\xd3\xe4\x93\x02&\x12$/hetu/blockinflation/v1/subnet_costs\x12\xa7\x01\n" +
+	"\vSubnetCosts\x12/.hetu.blockinflation.v1.QuerySubnetCostsRequest\x1a0.hetu.blockinflation.v1.QuerySubnetCostsResponse\"5\x82\xd3\xe4\x93\x02/\x12-/hetu/blockinflation/v1/subnet_costs/{netuid}B8Z6github.com/hetu-project/hetu/v1/x/blockinflation/typesb\x06proto3"
 
 var (
 	file_hetu_blockinflation_v1_query_proto_rawDescOnce sync.Once
@@ -337,27 +1041,63 @@ func file_hetu_blockinflation_v1_query_proto_rawDescGZIP() []byte {
 	return file_hetu_blockinflation_v1_query_proto_rawDescData
 }
 
-var file_hetu_blockinflation_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_hetu_blockinflation_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_hetu_blockinflation_v1_query_proto_goTypes = []any{
-	(*QueryParamsRequest)(nil),                // 0: hetu.blockinflation.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),               // 1: hetu.blockinflation.v1.QueryParamsResponse
-	(*QueryPendingSubnetRewardsRequest)(nil),  // 2: hetu.blockinflation.v1.QueryPendingSubnetRewardsRequest
-	(*QueryPendingSubnetRewardsResponse)(nil), // 3: hetu.blockinflation.v1.QueryPendingSubnetRewardsResponse
-	(*Params)(nil),                            // 4: hetu.blockinflation.v1.Params
-	(*types.Coin)(nil),                        // 5: cosmos.base.v1beta1.Coin
+	(*QueryParamsRequest)(nil),                 // 0: hetu.blockinflation.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),                // 1: hetu.blockinflation.v1.QueryParamsResponse
+	(*QueryPendingSubnetRewardsRequest)(nil),   // 2: hetu.blockinflation.v1.QueryPendingSubnetRewardsRequest
+	(*QueryPendingSubnetRewardsResponse)(nil),  // 3: hetu.blockinflation.v1.QueryPendingSubnetRewardsResponse
+	(*QuerySuspendedSubnetsRequest)(nil),       // 4: hetu.blockinflation.v1.QuerySuspendedSubnetsRequest
+	(*QuerySuspendedSubnetsResponse)(nil),      // 5: hetu.blockinflation.v1.QuerySuspendedSubnetsResponse
+	(*QuerySuspendedSubnetRequest)(nil),        // 6: hetu.blockinflation.v1.QuerySuspendedSubnetRequest
+	(*QuerySuspendedSubnetResponse)(nil),       // 7: hetu.blockinflation.v1.QuerySuspendedSubnetResponse
+	(*QuerySupplyBreakdownRequest)(nil),        // 8: hetu.blockinflation.v1.QuerySupplyBreakdownRequest
+	(*QuerySupplyBreakdownResponse)(nil),       // 9: hetu.blockinflation.v1.QuerySupplyBreakdownResponse
+	(*QueryBlockEmissionScheduleRequest)(nil),  // 10: hetu.blockinflation.v1.QueryBlockEmissionScheduleRequest
+	(*Halving)(nil),                            // 11: hetu.blockinflation.v1.Halving
+	(*QueryBlockEmissionScheduleResponse)(nil), // 12: hetu.blockinflation.v1.QueryBlockEmissionScheduleResponse
+	(*QueryAllSubnetCostsRequest)(nil),         // 13: hetu.blockinflation.v1.QueryAllSubnetCostsRequest
+	(*QueryAllSubnetCostsResponse)(nil),        // 14: hetu.blockinflation.v1.QueryAllSubnetCostsResponse
+	(*QuerySubnetCostsRequest)(nil),            // 15: hetu.blockinflation.v1.QuerySubnetCostsRequest
+	(*QuerySubnetCostsResponse)(nil),           // 16: hetu.blockinflation.v1.QuerySubnetCostsResponse
+	(*Params)(nil),                             // 17: hetu.blockinflation.v1.Params
+	(*types.Coin)(nil),                         // 18: cosmos.base.v1beta1.Coin
+	(*SubnetEmissionSuspension)(nil),           // 19: hetu.blockinflation.v1.SubnetEmissionSuspension
+	(*query.PageRequest)(nil),                  // 20: cosmos.base.query.v1beta1.PageRequest
+	(*query.PageResponse)(nil),                 // 21: cosmos.base.query.v1beta1.PageResponse
+	(*SubnetCosts)(nil),                        // 22: hetu.blockinflation.v1.SubnetCosts
 }
 var file_hetu_blockinflation_v1_query_proto_depIdxs = []int32{
-	4, // 0: hetu.blockinflation.v1.QueryParamsResponse.params:type_name -> hetu.blockinflation.v1.Params
-	5, // 1: hetu.blockinflation.v1.QueryPendingSubnetRewardsResponse.pending_subnet_rewards:type_name -> cosmos.base.v1beta1.Coin
-	0, // 2: hetu.blockinflation.v1.Query.Params:input_type -> hetu.blockinflation.v1.QueryParamsRequest
-	2, // 3: hetu.blockinflation.v1.Query.PendingSubnetRewards:input_type -> hetu.blockinflation.v1.QueryPendingSubnetRewardsRequest
-	1, // 4: hetu.blockinflation.v1.Query.Params:output_type -> hetu.blockinflation.v1.QueryParamsResponse
-	3, // 5: hetu.blockinflation.v1.Query.PendingSubnetRewards:output_type -> hetu.blockinflation.v1.QueryPendingSubnetRewardsResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	17, // 0: hetu.blockinflation.v1.QueryParamsResponse.params:type_name -> hetu.blockinflation.v1.Params
+	18, // 1: hetu.blockinflation.v1.QueryPendingSubnetRewardsResponse.pending_subnet_rewards:type_name -> cosmos.base.v1beta1.Coin
+	19, // 2: hetu.blockinflation.v1.QuerySuspendedSubnetsResponse.suspensions:type_name -> hetu.blockinflation.v1.SubnetEmissionSuspension
+	19, // 3: hetu.blockinflation.v1.QuerySuspendedSubnetResponse.suspension:type_name -> hetu.blockinflation.v1.SubnetEmissionSuspension
+	20, // 4: hetu.blockinflation.v1.QuerySupplyBreakdownRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	21, // 5: hetu.blockinflation.v1.QuerySupplyBreakdownResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	11, // 6: hetu.blockinflation.v1.QueryBlockEmissionScheduleResponse.halvings:type_name -> hetu.blockinflation.v1.Halving
+	22, // 7: hetu.blockinflation.v1.QueryAllSubnetCostsResponse.subnet_costs:type_name -> hetu.blockinflation.v1.SubnetCosts
+	22, // 8: hetu.blockinflation.v1.QuerySubnetCostsResponse.subnet_costs:type_name -> hetu.blockinflation.v1.SubnetCosts
+	0,  // 9: hetu.blockinflation.v1.Query.Params:input_type -> hetu.blockinflation.v1.QueryParamsRequest
+	2,  // 10: hetu.blockinflation.v1.Query.PendingSubnetRewards:input_type -> hetu.blockinflation.v1.QueryPendingSubnetRewardsRequest
+	4,  // 11: hetu.blockinflation.v1.Query.SuspendedSubnets:input_type -> hetu.blockinflation.v1.QuerySuspendedSubnetsRequest
+	6,  // 12: hetu.blockinflation.v1.Query.SuspendedSubnet:input_type -> hetu.blockinflation.v1.QuerySuspendedSubnetRequest
+	8,  // 13: hetu.blockinflation.v1.Query.SupplyBreakdown:input_type -> hetu.blockinflation.v1.QuerySupplyBreakdownRequest
+	10, // 14: hetu.blockinflation.v1.Query.BlockEmissionSchedule:input_type -> hetu.blockinflation.v1.QueryBlockEmissionScheduleRequest
+	13, // 15: hetu.blockinflation.v1.Query.AllSubnetCosts:input_type -> hetu.blockinflation.v1.QueryAllSubnetCostsRequest
+	15, // 16: hetu.blockinflation.v1.Query.SubnetCosts:input_type -> hetu.blockinflation.v1.QuerySubnetCostsRequest
+	1,  // 17: hetu.blockinflation.v1.Query.Params:output_type -> hetu.blockinflation.v1.QueryParamsResponse
+	3,  // 18: hetu.blockinflation.v1.Query.PendingSubnetRewards:output_type -> hetu.blockinflation.v1.QueryPendingSubnetRewardsResponse
+	5,  // 19: hetu.blockinflation.v1.Query.SuspendedSubnets:output_type -> hetu.blockinflation.v1.QuerySuspendedSubnetsResponse
+	7,  // 20: hetu.blockinflation.v1.Query.SuspendedSubnet:output_type -> hetu.blockinflation.v1.QuerySuspendedSubnetResponse
+	9,  // 21: hetu.blockinflation.v1.Query.SupplyBreakdown:output_type -> hetu.blockinflation.v1.QuerySupplyBreakdownResponse
+	12, // 22: hetu.blockinflation.v1.Query.BlockEmissionSchedule:output_type -> hetu.blockinflation.v1.QueryBlockEmissionScheduleResponse
+	14, // 23: hetu.blockinflation.v1.Query.AllSubnetCosts:output_type -> hetu.blockinflation.v1.QueryAllSubnetCostsResponse
+	16, // 24: hetu.blockinflation.v1.Query.SubnetCosts:output_type -> hetu.blockinflation.v1.QuerySubnetCostsResponse
+	17, // [17:25] is the sub-list for method output_type
+	9,  // [9:17] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_hetu_blockinflation_v1_query_proto_init() }
@@ -365,13 +1105,15 @@ func file_hetu_blockinflation_v1_query_proto_init() {
 	if File_hetu_blockinflation_v1_query_proto != nil {
 		return
 	}
+	file_hetu_blockinflation_v1_genesis_proto_init()
+	file_hetu_blockinflation_v1_params_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hetu_blockinflation_v1_query_proto_rawDesc), len(file_hetu_blockinflation_v1_query_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName               = "/hetu.blockinflation.v1.Query/Params"
-	Query_PendingSubnetRewards_FullMethodName = "/hetu.blockinflation.v1.Query/PendingSubnetRewards"
+	Query_Params_FullMethodName                = "/hetu.blockinflation.v1.Query/Params"
+	Query_PendingSubnetRewards_FullMethodName  = "/hetu.blockinflation.v1.Query/PendingSubnetRewards"
+	Query_SuspendedSubnets_FullMethodName      = "/hetu.blockinflation.v1.Query/SuspendedSubnets"
+	Query_SuspendedSubnet_FullMethodName       = "/hetu.blockinflation.v1.Query/SuspendedSubnet"
+	Query_SupplyBreakdown_FullMethodName       = "/hetu.blockinflation.v1.Query/SupplyBreakdown"
+	Query_BlockEmissionSchedule_FullMethodName = "/hetu.blockinflation.v1.Query/BlockEmissionSchedule"
+	Query_AllSubnetCosts_FullMethodName        = "/hetu.blockinflation.v1.Query/AllSubnetCosts"
+	Query_SubnetCosts_FullMethodName           = "/hetu.blockinflation.v1.Query/SubnetCosts"
 )
 
 // QueryClient is the client API for Query service.
@@ -33,6 +39,18 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// PendingSubnetRewards queries the pending subnet rewards.
 	PendingSubnetRewards(ctx context.Context, in *QueryPendingSubnetRewardsRequest, opts ...grpc.CallOption) (*QueryPendingSubnetRewardsResponse, error)
+	// SuspendedSubnets queries all subnets whose emission is suspended.
+	SuspendedSubnets(ctx context.Context, in *QuerySuspendedSubnetsRequest, opts ...grpc.CallOption) (*QuerySuspendedSubnetsResponse, error)
+	// SuspendedSubnet queries the emission suspension of a subnet.
+	SuspendedSubnet(ctx context.Context, in *QuerySuspendedSubnetRequest, opts ...grpc.CallOption) (*QuerySuspendedSubnetResponse, error)
+	// SupplyBreakdown queries the supply of the mint denom against the supply cap.
+	SupplyBreakdown(ctx context.Context, in *QuerySupplyBreakdownRequest, opts ...grpc.CallOption) (*QuerySupplyBreakdownResponse, error)
+	// BlockEmissionSchedule queries the current block emission and the upcoming halvings.
+	BlockEmissionSchedule(ctx context.Context, in *QueryBlockEmissionScheduleRequest, opts ...grpc.CallOption) (*QueryBlockEmissionScheduleResponse, error)
+	// AllSubnetCosts queries the registration and lock costs paid for all subnets.
+	AllSubnetCosts(ctx context.Context, in *QueryAllSubnetCostsRequest, opts ...grpc.CallOption) (*QueryAllSubnetCostsResponse, error)
+	// SubnetCosts queries the registration and lock costs paid for a subnet.
+	SubnetCosts(ctx context.Context, in *QuerySubnetCostsRequest, opts ...grpc.CallOption) (*QuerySubnetCostsResponse, error)
 }
 
 type queryClient struct {
@@ -63,6 +81,66 @@ func (c *queryClient) PendingSubnetRewards(ctx context.Context, in *QueryPending
 	return out, nil
 }
 
+func (c *queryClient) SuspendedSubnets(ctx context.Context, in *QuerySuspendedSubnetsRequest, opts ...grpc.CallOption) (*QuerySuspendedSubnetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySuspendedSubnetsResponse)
+	err := c.cc.Invoke(ctx, Query_SuspendedSubnets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SuspendedSubnet(ctx context.Context, in *QuerySuspendedSubnetRequest, opts ...grpc.CallOption) (*QuerySuspendedSubnetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySuspendedSubnetResponse)
+	err := c.cc.Invoke(ctx, Query_SuspendedSubnet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyBreakdown(ctx context.Context, in *QuerySupplyBreakdownRequest, opts ...grpc.CallOption) (*QuerySupplyBreakdownResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySupplyBreakdownResponse)
+	err := c.cc.Invoke(ctx, Query_SupplyBreakdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockEmissionSchedule(ctx context.Context, in *QueryBlockEmissionScheduleRequest, opts ...grpc.CallOption) (*QueryBlockEmissionScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBlockEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_BlockEmissionSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllSubnetCosts(ctx context.Context, in *QueryAllSubnetCostsRequest, opts ...grpc.CallOption) (*QueryAllSubnetCostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllSubnetCostsResponse)
+	err := c.cc.Invoke(ctx, Query_AllSubnetCosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubnetCosts(ctx context.Context, in *QuerySubnetCostsRequest, opts ...grpc.CallOption) (*QuerySubnetCostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySubnetCostsResponse)
+	err := c.cc.Invoke(ctx, Query_SubnetCosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -73,6 +151,18 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// PendingSubnetRewards queries the pending subnet rewards.
 	PendingSubnetRewards(context.Context, *QueryPendingSubnetRewardsRequest) (*QueryPendingSubnetRewardsResponse, error)
+	// SuspendedSubnets queries all subnets whose emission is suspended.
+	SuspendedSubnets(context.Context, *QuerySuspendedSubnetsRequest) (*QuerySuspendedSubnetsResponse, error)
+	// SuspendedSubnet queries the emission suspension of a subnet.
+	SuspendedSubnet(context.Context, *QuerySuspendedSubnetRequest) (*QuerySuspendedSubnetResponse, error)
+	// SupplyBreakdown queries the supply of the mint denom against the supply cap.
+	SupplyBreakdown(context.Context, *QuerySupplyBreakdownRequest) (*QuerySupplyBreakdownResponse, error)
+	// BlockEmissionSchedule queries the current block emission and the upcoming halvings.
+	BlockEmissionSchedule(context.Context, *QueryBlockEmissionScheduleRequest) (*QueryBlockEmissionScheduleResponse, error)
+	// AllSubnetCosts queries the registration and lock costs paid for all subnets.
+	AllSubnetCosts(context.Context, *QueryAllSubnetCostsRequest) (*QueryAllSubnetCostsResponse, error)
+	// SubnetCosts queries the registration and lock costs paid for a subnet.
+	SubnetCosts(context.Context, *QuerySubnetCostsRequest) (*QuerySubnetCostsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -89,6 +179,24 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) PendingSubnetRewards(context.Context, *QueryPendingSubnetRewardsRequest) (*QueryPendingSubnetRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PendingSubnetRewards not implemented")
 }
+func (UnimplementedQueryServer) SuspendedSubnets(context.Context, *QuerySuspendedSubnetsRequest) (*QuerySuspendedSubnetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendedSubnets not implemented")
+}
+func (UnimplementedQueryServer) SuspendedSubnet(context.Context, *QuerySuspendedSubnetRequest) (*QuerySuspendedSubnetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendedSubnet not implemented")
+}
+func (UnimplementedQueryServer) SupplyBreakdown(context.Context, *QuerySupplyBreakdownRequest) (*QuerySupplyBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyBreakdown not implemented")
+}
+func (UnimplementedQueryServer) BlockEmissionSchedule(context.Context, *QueryBlockEmissionScheduleRequest) (*QueryBlockEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockEmissionSchedule not implemented")
+}
+func (UnimplementedQueryServer) AllSubnetCosts(context.Context, *QueryAllSubnetCostsRequest) (*QueryAllSubnetCostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllSubnetCosts not implemented")
+}
+func (UnimplementedQueryServer) SubnetCosts(context.Context, *QuerySubnetCostsRequest) (*QuerySubnetCostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubnetCosts not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -146,6 +254,114 @@ func _Query_PendingSubnetRewards_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SuspendedSubnets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySuspendedSubnetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SuspendedSubnets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SuspendedSubnets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuspendedSubnets(ctx, req.(*QuerySuspendedSubnetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SuspendedSubnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySuspendedSubnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SuspendedSubnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SuspendedSubnet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuspendedSubnet(ctx, req.(*QuerySuspendedSubnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SupplyBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyBreakdown(ctx, req.(*QuerySupplyBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockEmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockEmissionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockEmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BlockEmissionSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockEmissionSchedule(ctx, req.(*QueryBlockEmissionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllSubnetCosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSubnetCostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllSubnetCosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllSubnetCosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllSubnetCosts(ctx, req.(*QueryAllSubnetCostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubnetCosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubnetCostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubnetCosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SubnetCosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubnetCosts(ctx, req.(*QuerySubnetCostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,6 +377,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PendingSubnetRewards",
 			Handler:    _Query_PendingSubnetRewards_Handler,
 		},
+		{
+			MethodName: "SuspendedSubnets",
+			Handler:    _Query_SuspendedSubnets_Handler,
+		},
+		{
+			MethodName: "SuspendedSubnet",
+			Handler:    _Query_SuspendedSubnet_Handler,
+		},
+		{
+			MethodName: "SupplyBreakdown",
+			Handler:    _Query_SupplyBreakdown_Handler,
+		},
+		{
+			MethodName: "BlockEmissionSchedule",
+			Handler:    _Query_BlockEmissionSchedule_Handler,
+		},
+		{
+			MethodName: "AllSubnetCosts",
+			Handler:    _Query_AllSubnetCosts_Handler,
+		},
+		{
+			MethodName: "SubnetCosts",
+			Handler:    _Query_SubnetCosts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "hetu/blockinflation/v1/query.proto",
@@ -37,6 +37,10 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// errBundlerAPIDisabled is returned by the bundler API extensions when they are
+// not enabled in the node config.
+var errBundlerAPIDisabled = errors.New("bundler API is disabled, enable it with the json-rpc.enable-bundler-api config")
+
 // Resend accepts an existing transaction and a new gas price and limit. It will remove
 // the given transaction from the pool and reinsert it with the new gas price and limit.
 func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error) {
@@ -117,6 +121,14 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	// reject the typed transactions unknown to the decoder with an explicit error
+	if len(data) > 0 {
+		if err := evmtypes.ValidateTxType(data[0]); err != nil {
+			b.logger.Debug("unsupported transaction type", "type", data[0], "error", err.Error())
+			return common.Hash{}, err
+		}
+	}
+
 	// RLP decode raw transaction bytes
 	tx := &ethtypes.Transaction{}
 	if err := tx.UnmarshalBinary(data); err != nil {
@@ -176,6 +188,48 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 	return txHash, nil
 }
 
+// SendRawTransactionConditional sends a raw Ethereum transaction if the latest
+// block satisfies the given conditional. It is part of the bundler API used by
+// ERC-4337 bundlers and is only available when enabled in the node config.
+// The knownAccounts conditions must list storage slots, as storage roots cannot
+// be checked, see rpctypes.ErrStorageRootNotSupported.
+func (b *Backend) SendRawTransactionConditional(data hexutil.Bytes, conditional rpctypes.TransactionConditional) (common.Hash, error) {
+	if !b.BundlerAPIEnabled() {
+		return common.Hash{}, errBundlerAPIDisabled
+	}
+
+	if cost := conditional.Cost(); cost > rpctypes.MaxKnownAccountsCost {
+		return common.Hash{}, fmt.Errorf("conditional is too expensive: %d > %d storage checks", cost, rpctypes.MaxKnownAccountsCost)
+	}
+	if err := conditional.ValidateKnownAccounts(); err != nil {
+		return common.Hash{}, err
+	}
+
+	header, err := b.HeaderByNumber(rpctypes.EthLatestBlockNumber)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := conditional.ValidateBounds(header.Number, header.Time); err != nil {
+		return common.Hash{}, err
+	}
+
+	height := rpctypes.NewBlockNumber(header.Number)
+	latest := rpctypes.BlockNumberOrHash{BlockNumber: &height}
+	for address, account := range conditional.KnownAccounts {
+		for slot, expected := range account.StorageSlots {
+			value, err := b.GetStorageAt(address, slot.Hex(), latest)
+			if err != nil {
+				return common.Hash{}, err
+			}
+			if common.BytesToHash(value) != expected {
+				return common.Hash{}, fmt.Errorf("storage slot %s of account %s does not match the conditional", slot, address)
+			}
+		}
+	}
+
+	return b.SendRawTransaction(data)
+}
+
 // SetTxDefaults populates tx message with default values in case they are not
 // provided on the args
 func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error) {
@@ -342,12 +396,23 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 // DoCall performs a simulated call operation through the evmtypes. It returns the
 // estimated gas used on the operation or an error if fails.
 func (b *Backend) DoCall(
-	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return nil, err
 	}
+
+	var overridesBz []byte
+	if overrides != nil {
+		if !b.BundlerAPIEnabled() {
+			return nil, errBundlerAPIDisabled
+		}
+		if overridesBz, err = json.Marshal(overrides); err != nil {
+			return nil, err
+		}
+	}
+
 	header, err := b.TendermintBlockByNumber(blockNr)
 	if err != nil {
 		// the error message imitates geth behavior
@@ -359,6 +424,7 @@ func (b *Backend) DoCall(
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
+		Overrides:       overridesBz,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
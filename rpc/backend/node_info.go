@@ -296,6 +296,12 @@ func (b Backend) UnprotectedAllowed() bool {
 	return b.allowUnprotectedTxs
 }
 
+// BundlerAPIEnabled returns the node configuration value for enabling the
+// ERC-4337 bundler RPC extensions.
+func (b *Backend) BundlerAPIEnabled() bool {
+	return b.cfg.JSONRPC.EnableBundlerAPI
+}
+
 // RPCGasCap is the global gas cap for eth-call variants.
 func (b *Backend) RPCGasCap() uint64 {
 	return b.cfg.JSONRPC.GasCap
@@ -0,0 +1,413 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"golang.org/x/time/rate"
+
+	"github.com/hetu-project/hetu/v1/server/config"
+)
+
+// JSON-RPC error codes returned when a request is rejected by the RequestLimiter.
+const (
+	// ErrCodeInvalidRequest is returned when a batch exceeds the configured number of items.
+	ErrCodeInvalidRequest = -32600
+	// ErrCodeMethodNotFound is returned for methods in the denylist.
+	ErrCodeMethodNotFound = -32601
+	// ErrCodeResponseTooLarge is returned when a response exceeds the configured size.
+	ErrCodeResponseTooLarge = -32003
+	// ErrCodeLimitExceeded is returned when a rate limit is hit (EIP-1474).
+	ErrCodeLimitExceeded = -32005
+)
+
+const (
+	// maxRequestContentLength mirrors the request body limit of the go-ethereum HTTP server
+	maxRequestContentLength = 1024 * 1024 * 5
+	// bucketIdleTimeout is how long an unused token bucket is kept before being pruned
+	bucketIdleTimeout = 10 * time.Minute
+	// bucketPruneInterval is how often idle token buckets are pruned
+	bucketPruneInterval = time.Minute
+)
+
+// LimitError is returned when a request is rejected by the RequestLimiter.
+type LimitError struct {
+	Code    int
+	Message string
+	// reason is the metrics label of the rejection
+	reason string
+}
+
+func (e *LimitError) Error() string {
+	return e.Message
+}
+
+// bucket is a token bucket together with the last time it was used.
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RequestLimiter enforces the JSON-RPC batch, response size, rate limit and
+// denylist settings of the JSON-RPC configuration. It is shared by the HTTP and
+// WebSocket servers so that a client is accounted the same way on both.
+type RequestLimiter struct {
+	batchLimit       int
+	maxResponseBytes int
+	denylist         []string
+	ipRate           rate.Limit
+	ipBurst          int
+	methodLimits     []config.MethodRateLimit
+
+	mu            sync.Mutex
+	ipBuckets     map[string]*bucket
+	methodBuckets map[string]*bucket
+	lastPrune     time.Time
+}
+
+// NewRequestLimiter creates a RequestLimiter from the JSON-RPC configuration.
+// Invalid method rate limit entries are skipped; they are rejected by JSONRPCConfig.Validate.
+func NewRequestLimiter(cfg config.JSONRPCConfig) *RequestLimiter {
+	methodLimits := make([]config.MethodRateLimit, 0, len(cfg.MethodRateLimits))
+	for _, entry := range cfg.MethodRateLimits {
+		limit, err := config.ParseMethodRateLimit(entry)
+		if err != nil {
+			continue
+		}
+		methodLimits = append(methodLimits, limit)
+	}
+
+	return &RequestLimiter{
+		batchLimit:       cfg.BatchRequestLimit,
+		maxResponseBytes: cfg.MaxResponseBytes,
+		denylist:         cfg.MethodDenylist,
+		ipRate:           rate.Limit(cfg.RateLimitPerIP),
+		ipBurst:          cfg.RateLimitBurstPerIP,
+		methodLimits:     methodLimits,
+		ipBuckets:        make(map[string]*bucket),
+		methodBuckets:    make(map[string]*bucket),
+		lastPrune:        time.Now(),
+	}
+}
+
+// CheckBatchSize returns an error if a batch of the given size exceeds the batch limit.
+func (l *RequestLimiter) CheckBatchSize(size int) *LimitError {
+	if l.batchLimit > 0 && size > l.batchLimit {
+		return l.reject(ErrCodeInvalidRequest, "batch", fmt.Sprintf("batch too large: %d requests, limit is %d", size, l.batchLimit))
+	}
+	return nil
+}
+
+// CheckMethod returns an error if the method is denied or if the client identified by
+// ip ran out of tokens, either for all methods or for the given method.
+func (l *RequestLimiter) CheckMethod(ip, method string) *LimitError {
+	for _, pattern := range l.denylist {
+		if matchMethod(pattern, method) {
+			return l.reject(ErrCodeMethodNotFound, "denied", fmt.Sprintf("the method %s does not exist/is not available", method))
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.pruneBuckets(now)
+
+	if l.ipRate > 0 && !l.take(l.ipBuckets, ip, l.ipRate, l.ipBurst, now) {
+		return l.reject(ErrCodeLimitExceeded, "ip", fmt.Sprintf("rate limit exceeded for %s", ip))
+	}
+
+	for _, limit := range l.methodLimits {
+		if !matchMethod(limit.Method, method) {
+			continue
+		}
+		if !l.take(l.methodBuckets, ip+"|"+limit.Method, rate.Limit(limit.Rate), limit.Burst, now) {
+			return l.reject(ErrCodeLimitExceeded, "method", fmt.Sprintf("rate limit exceeded for method %s", method))
+		}
+		break
+	}
+
+	return nil
+}
+
+// CheckResponseSize returns an error if a response of the given size exceeds the response limit.
+func (l *RequestLimiter) CheckResponseSize(size int) *LimitError {
+	if l.maxResponseBytes > 0 && size > l.maxResponseBytes {
+		return l.reject(ErrCodeResponseTooLarge, "response", fmt.Sprintf("response too large, limit is %d bytes", l.maxResponseBytes))
+	}
+	return nil
+}
+
+// take consumes a token from the bucket stored under key, creating the bucket if needed.
+// It must be called with the mutex held.
+func (l *RequestLimiter) take(buckets map[string]*bucket, key string, r rate.Limit, burst int, now time.Time) bool {
+	b, ok := buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(r, burst)}
+		buckets[key] = b
+	}
+	b.lastSeen = now
+
+	return b.limiter.AllowN(now, 1)
+}
+
+// pruneBuckets drops the buckets of clients that have been idle for a while.
+// It must be called with the mutex held.
+func (l *RequestLimiter) pruneBuckets(now time.Time) {
+	if now.Sub(l.lastPrune) < bucketPruneInterval {
+		return
+	}
+	l.lastPrune = now
+
+	for _, buckets := range []map[string]*bucket{l.ipBuckets, l.methodBuckets} {
+		for key, b := range buckets {
+			if now.Sub(b.lastSeen) > bucketIdleTimeout {
+				delete(buckets, key)
+			}
+		}
+	}
+}
+
+// reject records the rejection in the metrics and builds the corresponding error.
+func (l *RequestLimiter) reject(code int, reason, msg string) *LimitError {
+	metrics.GetOrRegisterCounter("rpc/limits/rejected/"+reason, nil).Inc(1)
+	return &LimitError{Code: code, Message: msg, reason: reason}
+}
+
+// matchMethod reports whether the method matches the pattern, which may end with '*'.
+func matchMethod(pattern, method string) bool {
+	if strings.HasSuffix(pattern, "*") {
+		return strings.HasPrefix(method, strings.TrimSuffix(pattern, "*"))
+	}
+	return pattern == method
+}
+
+// clientIP returns the IP address of the client that sent the request. The X-Forwarded-For
+// header is only trusted for requests coming from the loopback interface, such as the
+// ones relayed by the WebSocket server or a local reverse proxy. The client is the
+// right-most entry that was not added by a loopback proxy, since the entries on its
+// left are set by the client itself.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return host
+	}
+
+	entries := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := strings.TrimSpace(entries[i])
+		if entry == "" {
+			continue
+		}
+		if ip := net.ParseIP(entry); ip != nil && ip.IsLoopback() {
+			continue
+		}
+		return entry
+	}
+
+	return host
+}
+
+// jsonrpcRequest holds the fields of a JSON-RPC request needed to apply the limits.
+type jsonrpcRequest struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+}
+
+// jsonrpcErrorResponse is a JSON-RPC error response.
+type jsonrpcErrorResponse struct {
+	Version string           `json:"jsonrpc"`
+	ID      json.RawMessage  `json:"id"`
+	Error   jsonrpcErrorBody `json:"error"`
+}
+
+type jsonrpcErrorBody struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func newErrorResponse(id json.RawMessage, err *LimitError) *jsonrpcErrorResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return &jsonrpcErrorResponse{
+		Version: "2.0",
+		ID:      id,
+		Error:   jsonrpcErrorBody{Code: err.Code, Message: err.Message},
+	}
+}
+
+// parseRequests decodes a single request or a batch of requests.
+func parseRequests(body []byte) ([]json.RawMessage, []jsonrpcRequest, bool, error) {
+	var raws []json.RawMessage
+	batch := isBatch(body)
+	if batch {
+		if err := json.Unmarshal(body, &raws); err != nil {
+			return nil, nil, true, err
+		}
+	} else {
+		raws = []json.RawMessage{body}
+	}
+
+	reqs := make([]jsonrpcRequest, len(raws))
+	for i, raw := range raws {
+		if err := json.Unmarshal(raw, &reqs[i]); err != nil {
+			return nil, nil, batch, err
+		}
+	}
+
+	return raws, reqs, batch, nil
+}
+
+// responseRecorder buffers the response of the wrapped handler up to a maximum size.
+type responseRecorder struct {
+	header   http.Header
+	status   int
+	buf      bytes.Buffer
+	limit    int
+	overflow bool
+}
+
+func newResponseRecorder(limit int) *responseRecorder {
+	return &responseRecorder{header: make(http.Header), status: http.StatusOK, limit: limit}
+}
+
+func (rr *responseRecorder) Header() http.Header {
+	return rr.header
+}
+
+func (rr *responseRecorder) WriteHeader(status int) {
+	rr.status = status
+}
+
+func (rr *responseRecorder) Write(p []byte) (int, error) {
+	if rr.overflow {
+		return len(p), nil
+	}
+	if rr.limit > 0 && rr.buf.Len()+len(p) > rr.limit {
+		rr.overflow = true
+		rr.buf.Reset()
+		return len(p), nil
+	}
+	return rr.buf.Write(p)
+}
+
+// HTTPHandler wraps the JSON-RPC HTTP handler, rejecting oversized batches, denied methods
+// and rate limited requests before they reach the server, and oversized responses after.
+func (l *RequestLimiter) HTTPHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		raws, reqs, batch, err := parseRequests(body)
+		if err != nil || len(body) > maxRequestContentLength {
+			// let the server produce the appropriate parse error
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if batch {
+			if lerr := l.CheckBatchSize(len(reqs)); lerr != nil {
+				writeJSONResponse(w, newErrorResponse(nil, lerr))
+				return
+			}
+		}
+
+		ip := clientIP(r)
+		allowed := make([]json.RawMessage, 0, len(raws))
+		allowedIDs := make([]json.RawMessage, 0, len(raws))
+		rejected := make([]interface{}, 0)
+		for i, req := range reqs {
+			if lerr := l.CheckMethod(ip, req.Method); lerr != nil {
+				rejected = append(rejected, newErrorResponse(req.ID, lerr))
+				continue
+			}
+			allowed = append(allowed, raws[i])
+			allowedIDs = append(allowedIDs, req.ID)
+		}
+
+		if len(allowed) == 0 {
+			if batch {
+				writeJSONResponse(w, rejected)
+			} else {
+				writeJSONResponse(w, rejected[0])
+			}
+			return
+		}
+
+		forwardBody := body
+		if batch && len(rejected) > 0 {
+			if forwardBody, err = json.Marshal(allowed); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		forwarded := r.Clone(r.Context())
+		forwarded.Body = io.NopCloser(bytes.NewReader(forwardBody))
+		forwarded.ContentLength = int64(len(forwardBody))
+
+		if l.maxResponseBytes <= 0 && len(rejected) == 0 {
+			// nothing to check or merge, stream the response
+			next.ServeHTTP(w, forwarded)
+			return
+		}
+
+		rec := newResponseRecorder(l.maxResponseBytes)
+		next.ServeHTTP(rec, forwarded)
+
+		if rec.overflow {
+			lerr := l.CheckResponseSize(l.maxResponseBytes + 1)
+			if !batch {
+				writeJSONResponse(w, newErrorResponse(allowedIDs[0], lerr))
+				return
+			}
+			for _, id := range allowedIDs {
+				rejected = append(rejected, newErrorResponse(id, lerr))
+			}
+			writeJSONResponse(w, rejected)
+			return
+		}
+
+		if !batch || len(rejected) == 0 {
+			for key, values := range rec.header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(rec.status)
+			_, _ = w.Write(rec.buf.Bytes()) // #nosec G104
+			return
+		}
+
+		var responses []json.RawMessage
+		if err := json.Unmarshal(rec.buf.Bytes(), &responses); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		merged := make([]interface{}, 0, len(responses)+len(rejected))
+		for _, res := range responses {
+			merged = append(merged, res)
+		}
+		writeJSONResponse(w, append(merged, rejected...))
+	})
+}
+
+func writeJSONResponse(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v) // #nosec G104
+}
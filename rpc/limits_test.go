@@ -0,0 +1,175 @@
+package rpc
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/server/config"
+)
+
+// echoHandler answers every request of a batch with its method name as result.
+func echoHandler(t *testing.T) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		_, reqs, batch, err := parseRequests(body)
+		require.NoError(t, err)
+
+		responses := make([]map[string]interface{}, len(reqs))
+		for i, req := range reqs {
+			responses[i] = map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": req.Method}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if batch {
+			require.NoError(t, json.NewEncoder(w).Encode(responses))
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(responses[0]))
+	})
+}
+
+func doRequest(t *testing.T, h http.Handler, body string) []map[string]interface{} {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.RemoteAddr = "10.0.0.1:1234"
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	raw := rec.Body.Bytes()
+	if isBatch(raw) {
+		var res []map[string]interface{}
+		require.NoError(t, json.Unmarshal(raw, &res))
+		return res
+	}
+	var res map[string]interface{}
+	require.NoError(t, json.Unmarshal(raw, &res))
+	return []map[string]interface{}{res}
+}
+
+func errorCode(res map[string]interface{}) int {
+	errObj, ok := res["error"].(map[string]interface{})
+	if !ok {
+		return 0
+	}
+	return int(errObj["code"].(float64))
+}
+
+func TestRequestLimiterHTTP(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *config.JSONRPCConfig)
+		body     string
+		expCodes []int
+	}{
+		{
+			"no limits",
+			func(*config.JSONRPCConfig) {},
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"eth_getLogs"}]`,
+			[]int{0, 0},
+		},
+		{
+			"batch too large",
+			func(cfg *config.JSONRPCConfig) { cfg.BatchRequestLimit = 1 },
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"eth_getLogs"}]`,
+			[]int{ErrCodeInvalidRequest},
+		},
+		{
+			"denied method in batch is rejected, others are served",
+			func(cfg *config.JSONRPCConfig) { cfg.MethodDenylist = []string{"debug_*"} },
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"debug_traceTransaction"}]`,
+			[]int{0, ErrCodeMethodNotFound},
+		},
+		{
+			"denied single request",
+			func(cfg *config.JSONRPCConfig) { cfg.MethodDenylist = []string{"personal_sign"} },
+			`{"jsonrpc":"2.0","id":1,"method":"personal_sign"}`,
+			[]int{ErrCodeMethodNotFound},
+		},
+		{
+			"per IP rate limit",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.RateLimitPerIP = 0.001
+				cfg.RateLimitBurstPerIP = 2
+			},
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"},{"jsonrpc":"2.0","id":3,"method":"eth_chainId"}]`,
+			[]int{0, 0, ErrCodeLimitExceeded},
+		},
+		{
+			"per method rate limit",
+			func(cfg *config.JSONRPCConfig) { cfg.MethodRateLimits = []string{"eth_getLogs:0.001:1"} },
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_getLogs"},{"jsonrpc":"2.0","id":2,"method":"eth_getLogs"},{"jsonrpc":"2.0","id":3,"method":"eth_chainId"}]`,
+			[]int{0, 0, ErrCodeLimitExceeded},
+		},
+		{
+			"unlimited response",
+			func(cfg *config.JSONRPCConfig) { cfg.MaxResponseBytes = 0 },
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"eth_getLogs"}]`,
+			[]int{0, 0},
+		},
+		{
+			"unlimited response with a denied method in batch",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.MaxResponseBytes = 0
+				cfg.MethodDenylist = []string{"debug_*"}
+			},
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"debug_traceTransaction"}]`,
+			[]int{0, ErrCodeMethodNotFound},
+		},
+		{
+			"response too large",
+			func(cfg *config.JSONRPCConfig) { cfg.MaxResponseBytes = 10 },
+			`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`,
+			[]int{ErrCodeResponseTooLarge},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := config.DefaultJSONRPCConfig()
+			tc.malleate(cfg)
+			require.NoError(t, cfg.Validate())
+
+			h := NewRequestLimiter(*cfg).HTTPHandler(echoHandler(t))
+			res := doRequest(t, h, tc.body)
+
+			codes := make([]int, len(res))
+			for i, r := range res {
+				codes[i] = errorCode(r)
+			}
+			require.ElementsMatch(t, tc.expCodes, codes)
+		})
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	req.Header.Set("X-Forwarded-For", "1.2.3.4")
+	require.Equal(t, "10.0.0.1", clientIP(req), "forwarded header must be ignored for remote peers")
+
+	req.RemoteAddr = "127.0.0.1:1234"
+	require.Equal(t, "1.2.3.4", clientIP(req))
+
+	req.Header.Set("X-Forwarded-For", "5.6.7.8, 1.2.3.4, 127.0.0.1")
+	require.Equal(t, "1.2.3.4", clientIP(req), "entries set by the client must be ignored")
+
+	req.Header.Set("X-Forwarded-For", "127.0.0.1")
+	require.Equal(t, "127.0.0.1", clientIP(req))
+
+	req.Header.Del("X-Forwarded-For")
+	require.Equal(t, "127.0.0.1", clientIP(req))
+}
+
+func TestMatchMethod(t *testing.T) {
+	require.True(t, matchMethod("debug_*", "debug_traceCall"))
+	require.True(t, matchMethod("eth_getLogs", "eth_getLogs"))
+	require.False(t, matchMethod("eth_getLogs", "eth_getLogsX"))
+	require.False(t, matchMethod("debug_*", "eth_call"))
+}
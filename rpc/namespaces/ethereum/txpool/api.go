@@ -16,23 +16,39 @@
 package txpool
 
 import (
+	"fmt"
+	"strconv"
+
 	"cosmossdk.io/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/hetu-project/hetu/v1/rpc/types"
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
 )
 
+// Mempool defines the app-side mempool content read by the txpool namespace.
+type Mempool interface {
+	// Content returns the pending and queued Ethereum transactions by sender.
+	Content() (pending, queued map[common.Address][]*evmtypes.MsgEthereumTx)
+	// Stats returns the number of transactions listed by Content.
+	Stats() (pending, queued int)
+}
+
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// The content is read from the app-side mempool of the node; without one the pool is reported empty.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	mempool Mempool
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+// The mempool may be nil when the node does not run an app-side mempool.
+func NewPublicAPI(logger log.Logger, mempool Mempool) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		mempool: mempool,
 	}
 }
 
@@ -43,24 +59,69 @@ func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTran
 		"pending": make(map[string]map[string]*types.RPCTransaction),
 		"queued":  make(map[string]map[string]*types.RPCTransaction),
 	}
+
+	if api.mempool == nil {
+		return content, nil
+	}
+
+	pending, queued := api.mempool.Content()
+	for status, txs := range map[string]map[common.Address][]*evmtypes.MsgEthereumTx{"pending": pending, "queued": queued} {
+		for addr, msgs := range txs {
+			dump := make(map[string]*types.RPCTransaction, len(msgs))
+			for _, msg := range msgs {
+				tx := msg.AsTransaction()
+				rpcTx, err := types.NewRPCTransaction(tx, common.Hash{}, 0, 0, nil, tx.ChainId())
+				if err != nil {
+					return nil, err
+				}
+				dump[strconv.FormatUint(tx.Nonce(), 10)] = rpcTx
+			}
+			content[status][addr.Hex()] = dump
+		}
+	}
 	return content, nil
 }
 
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+
+	if api.mempool == nil {
+		return content, nil
+	}
+
+	pending, queued := api.mempool.Content()
+	for status, txs := range map[string]map[common.Address][]*evmtypes.MsgEthereumTx{"pending": pending, "queued": queued} {
+		for addr, msgs := range txs {
+			dump := make(map[string]string, len(msgs))
+			for _, msg := range msgs {
+				tx := msg.AsTransaction()
+				summary := fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
+				if to := tx.To(); to != nil {
+					summary = fmt.Sprintf("%s: %v wei + %v gas × %v wei", to.Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+				}
+				dump[strconv.FormatUint(tx.Nonce(), 10)] = summary
+			}
+			content[status][addr.Hex()] = dump
+		}
+	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
 func (api *PublicAPI) Status() map[string]hexutil.Uint {
 	api.logger.Debug("txpool_status")
+	var pending, queued int
+	if api.mempool != nil {
+		pending, queued = api.mempool.Stats()
+	}
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(pending),
+		"queued":  hexutil.Uint(queued),
 	}
 }
@@ -0,0 +1,125 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// MaxKnownAccountsCost is the maximum number of storage roots and slots that can
+// be checked by a single eth_sendRawTransactionConditional request.
+const MaxKnownAccountsCost = 1000
+
+// ErrStorageRootNotSupported is returned for knownAccounts conditions on the
+// storage root of an account. Accounts have no storage trie on the Cosmos
+// state, which is also why eth_getProof reports an empty storage hash, so only
+// conditions on storage slots can be checked.
+var ErrStorageRootNotSupported = errors.New(
+	"knownAccounts storage root conditions are not supported, as accounts have no storage trie: list the expected storage slots instead",
+)
+
+// KnownAccount is the expected storage of an account in a TransactionConditional.
+// It is either the storage root of the account or a set of storage slots.
+type KnownAccount struct {
+	StorageRoot  *common.Hash
+	StorageSlots map[common.Hash]common.Hash
+}
+
+// UnmarshalJSON decodes either a storage root hash or a slot to value map.
+func (ka *KnownAccount) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("\"")) {
+		var root common.Hash
+		if err := json.Unmarshal(data, &root); err != nil {
+			return err
+		}
+		ka.StorageRoot = &root
+		return nil
+	}
+
+	slots := make(map[common.Hash]common.Hash)
+	if err := json.Unmarshal(data, &slots); err != nil {
+		return err
+	}
+	ka.StorageSlots = slots
+	return nil
+}
+
+// MarshalJSON encodes the storage root if set, or the storage slots otherwise.
+func (ka KnownAccount) MarshalJSON() ([]byte, error) {
+	if ka.StorageRoot != nil {
+		return json.Marshal(ka.StorageRoot)
+	}
+	return json.Marshal(ka.StorageSlots)
+}
+
+// TransactionConditional defines the preconditions of a transaction submitted
+// through eth_sendRawTransactionConditional, as used by ERC-4337 bundlers.
+type TransactionConditional struct {
+	KnownAccounts  map[common.Address]KnownAccount `json:"knownAccounts,omitempty"`
+	BlockNumberMin *hexutil.Big                    `json:"blockNumberMin,omitempty"`
+	BlockNumberMax *hexutil.Big                    `json:"blockNumberMax,omitempty"`
+	TimestampMin   *hexutil.Uint64                 `json:"timestampMin,omitempty"`
+	TimestampMax   *hexutil.Uint64                 `json:"timestampMax,omitempty"`
+}
+
+// Cost returns the number of storage roots and slots that must be checked.
+func (tc TransactionConditional) Cost() int {
+	cost := 0
+	for _, account := range tc.KnownAccounts {
+		if account.StorageRoot != nil {
+			cost++
+			continue
+		}
+		cost += len(account.StorageSlots)
+	}
+	return cost
+}
+
+// ValidateKnownAccounts checks that the knownAccounts conditions can be
+// checked, i.e. that they only list storage slots.
+func (tc TransactionConditional) ValidateKnownAccounts() error {
+	for address, account := range tc.KnownAccounts {
+		if account.StorageRoot != nil {
+			return fmt.Errorf("account %s: %w", address, ErrStorageRootNotSupported)
+		}
+	}
+	return nil
+}
+
+// ValidateBounds checks the block number and timestamp bounds against the
+// given block header values.
+func (tc TransactionConditional) ValidateBounds(number *big.Int, timestamp uint64) error {
+	if tc.BlockNumberMin != nil && number.Cmp(tc.BlockNumberMin.ToInt()) < 0 {
+		return fmt.Errorf("block number %s is lower than the minimum %s", number, tc.BlockNumberMin.ToInt())
+	}
+	if tc.BlockNumberMax != nil && number.Cmp(tc.BlockNumberMax.ToInt()) > 0 {
+		return fmt.Errorf("block number %s is higher than the maximum %s", number, tc.BlockNumberMax.ToInt())
+	}
+	if tc.TimestampMin != nil && timestamp < uint64(*tc.TimestampMin) {
+		return fmt.Errorf("timestamp %d is lower than the minimum %d", timestamp, uint64(*tc.TimestampMin))
+	}
+	if tc.TimestampMax != nil && timestamp > uint64(*tc.TimestampMax) {
+		return fmt.Errorf("timestamp %d is higher than the maximum %d", timestamp, uint64(*tc.TimestampMax))
+	}
+	return nil
+}
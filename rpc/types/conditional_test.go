@@ -0,0 +1,50 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionConditional(t *testing.T) {
+	input := []byte(`{
+		"knownAccounts": {
+			"0x0000000000000000000000000000000000000001": "0x579917054e325746fda5c3ee431d73d26255bc4e10b51163862368629ae19739",
+			"0x0000000000000000000000000000000000000002": {
+				"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000002"
+			}
+		},
+		"blockNumberMin": "0xa",
+		"blockNumberMax": "0x14",
+		"timestampMax": "0x64"
+	}`)
+
+	var conditional TransactionConditional
+	require.NoError(t, json.Unmarshal(input, &conditional))
+	require.Equal(t, 2, conditional.Cost())
+
+	root := conditional.KnownAccounts[common.BigToAddress(big.NewInt(1))]
+	require.Equal(t, common.HexToHash("0x579917054e325746fda5c3ee431d73d26255bc4e10b51163862368629ae19739"), *root.StorageRoot)
+	slots := conditional.KnownAccounts[common.BigToAddress(big.NewInt(2))]
+	require.Nil(t, slots.StorageRoot)
+	require.Equal(t, common.BigToHash(big.NewInt(2)), slots.StorageSlots[common.BigToHash(big.NewInt(1))])
+
+	bz, err := json.Marshal(conditional)
+	require.NoError(t, err)
+	var decoded TransactionConditional
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, conditional, decoded)
+
+	// storage roots cannot be checked
+	require.ErrorIs(t, conditional.ValidateKnownAccounts(), ErrStorageRootNotSupported)
+	delete(conditional.KnownAccounts, common.BigToAddress(big.NewInt(1)))
+	require.NoError(t, conditional.ValidateKnownAccounts())
+
+	require.NoError(t, conditional.ValidateBounds(big.NewInt(10), 100))
+	require.Error(t, conditional.ValidateBounds(big.NewInt(9), 100))
+	require.Error(t, conditional.ValidateBounds(big.NewInt(21), 100))
+	require.Error(t, conditional.ValidateBounds(big.NewInt(15), 101))
+}
@@ -33,9 +33,9 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
-	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"cosmossdk.io/log"
@@ -81,26 +81,41 @@ type ErrorMessageJSON struct {
 	Message string   `json:"message"`
 }
 
+// errSlowConsumer is returned when a peer does not drain its send buffer fast enough
+var errSlowConsumer = errors.New("websocket peer is too slow, dropping connection")
+
 type websocketsServer struct {
-	rpcAddr  string // listen address of rest-server
-	wsAddr   string // listen address of ws server
-	certFile string
-	keyFile  string
-	api      *pubSubAPI
-	logger   log.Logger
+	rpcAddr          string // listen address of rest-server
+	wsAddr           string // listen address of ws server
+	certFile         string
+	keyFile          string
+	maxSubscriptions int // maximum number of active subscriptions per connection (0=unlimited)
+	sendBufferSize   int // number of outgoing messages buffered per connection (0=unbuffered)
+	limiter          *RequestLimiter
+	api              *pubSubAPI
+	logger           log.Logger
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	cfg *config.Config,
+	limiter *RequestLimiter,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address) // #nosec G703
 
 	return &websocketsServer{
-		rpcAddr:  "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
-		wsAddr:   cfg.JSONRPC.WsAddress,
-		certFile: cfg.TLS.CertificatePath,
-		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
-		logger:   logger,
+		rpcAddr:          "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
+		wsAddr:           cfg.JSONRPC.WsAddress,
+		certFile:         cfg.TLS.CertificatePath,
+		keyFile:          cfg.TLS.KeyPath,
+		maxSubscriptions: cfg.JSONRPC.WsMaxSubscriptions,
+		sendBufferSize:   cfg.JSONRPC.WsSendBufferSize,
+		limiter:          limiter,
+		api:              newPubSubAPI(clientCtx, logger, tmWSClient),
+		logger:           logger,
 	}
 }
 
@@ -140,10 +155,9 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
-	})
+	wsConn := newWsConn(conn, s.sendBufferSize, s.logger)
+	wsConn.remoteIP = clientIP(r)
+	s.readLoop(wsConn)
 }
 
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
@@ -159,23 +173,115 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	_ = wsConn.WriteJSON(res) // #nosec G703
 }
 
+// checkLimits applies the request limiter to the methods served by the WebSocket server
+// itself. Other methods are relayed to the HTTP server, which applies the limits there.
+func (s *websocketsServer) checkLimits(wsConn *wsConn, id interface{}, method string) bool {
+	if s.limiter == nil {
+		return true
+	}
+
+	lerr := s.limiter.CheckMethod(wsConn.remoteIP, method)
+	if lerr == nil {
+		return true
+	}
+
+	rawID, err := json.Marshal(id)
+	if err != nil {
+		rawID = nil
+	}
+	_ = wsConn.WriteJSON(newErrorResponse(rawID, lerr)) // #nosec G703
+	return false
+}
+
 type wsConn struct {
-	conn *websocket.Conn
-	mux  *sync.Mutex
+	conn     *websocket.Conn
+	mux      *sync.Mutex
+	remoteIP string
+
+	// sendCh buffers outgoing messages when a send buffer is configured.
+	// A full buffer means the peer is not keeping up and it gets disconnected.
+	sendCh    chan interface{}
+	done      chan struct{}
+	closeOnce *sync.Once
+	logger    log.Logger
+}
+
+// newWsConn wraps a websocket connection. If bufferSize is positive, writes are
+// queued and flushed by a dedicated goroutine so that a slow peer never blocks
+// the subscription goroutines.
+func newWsConn(conn *websocket.Conn, bufferSize int, logger log.Logger) *wsConn {
+	w := &wsConn{
+		conn:      conn,
+		mux:       new(sync.Mutex),
+		done:      make(chan struct{}),
+		closeOnce: new(sync.Once),
+		logger:    logger,
+	}
+
+	if bufferSize > 0 {
+		w.sendCh = make(chan interface{}, bufferSize)
+		go w.writeLoop()
+	}
+
+	return w
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
-	w.mux.Lock()
-	defer w.mux.Unlock()
+	if w.sendCh == nil {
+		w.mux.Lock()
+		defer w.mux.Unlock()
+
+		return w.conn.WriteJSON(v)
+	}
+
+	select {
+	case <-w.done:
+		return websocket.ErrCloseSent
+	default:
+	}
+
+	select {
+	case w.sendCh <- v:
+		return nil
+	default:
+		w.logger.Debug("send buffer full, dropping slow websocket peer", "remote", w.conn.RemoteAddr().String())
+		_ = w.Close() // #nosec G703
+		return errSlowConsumer
+	}
+}
+
+// writeLoop flushes queued messages to the peer until the connection is closed.
+func (w *wsConn) writeLoop() {
+	for {
+		select {
+		case <-w.done:
+			return
+		case v := <-w.sendCh:
+			w.mux.Lock()
+			err := w.conn.WriteJSON(v)
+			w.mux.Unlock()
 
-	return w.conn.WriteJSON(v)
+			if err != nil {
+				w.logger.Debug("failed to write to websocket peer, closing connection", "error", err.Error())
+				_ = w.Close() // #nosec G703
+				return
+			}
+		}
+	}
 }
 
 func (w *wsConn) Close() error {
-	w.mux.Lock()
-	defer w.mux.Unlock()
+	var err error
+	w.closeOnce.Do(func() {
+		close(w.done)
+
+		w.mux.Lock()
+		defer w.mux.Unlock()
+
+		err = w.conn.Close()
+	})
 
-	return w.conn.Close()
+	return err
 }
 
 func (w *wsConn) ReadMessage() (messageType int, p []byte, err error) {
@@ -244,6 +350,10 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			continue
 		}
 
+		if (method == "eth_subscribe" || method == "eth_unsubscribe") && !s.checkLimits(wsConn, msg["id"], method) {
+			continue
+		}
+
 		switch method {
 		case "eth_subscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
@@ -251,8 +361,14 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				continue
 			}
 
+			if s.maxSubscriptions > 0 && len(subscriptions) >= s.maxSubscriptions {
+				s.sendErrResponse(wsConn, fmt.Sprintf("subscription limit reached (max %d per connection)", s.maxSubscriptions))
+				continue
+			}
+
 			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
+			ready := make(chan struct{})
+			unsubFn, err := s.api.subscribe(wsConn, subID, params, ready)
 			if err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 				continue
@@ -265,7 +381,9 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				Result:  subID,
 			}
 
-			if err := wsConn.WriteJSON(res); err != nil {
+			err = wsConn.WriteJSON(res)
+			close(ready)
+			if err != nil {
 				break
 			}
 		case "eth_unsubscribe":
@@ -330,6 +448,10 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	// let the HTTP server apply the request limits to the original client
+	if wsConn.remoteIP != "" {
+		req.Header.Set("X-Forwarded-For", wsConn.remoteIP)
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -369,7 +491,10 @@ func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpccl
 	}
 }
 
-func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error) {
+// subscribe creates the subscription requested by params. The ready channel is closed once
+// the subscription ID has been sent to the client, notifications sent on their own rather than
+// in response to an event wait for it.
+func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}, ready <-chan struct{}) (pubsub.UnsubscribeFunc, error) {
 	method, ok := params[0].(string)
 	if !ok {
 		return nil, errors.New("invalid parameters")
@@ -385,9 +510,15 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		fullTx := false
+		if len(params) > 1 {
+			if fullTx, ok = params[1].(bool); !ok {
+				return nil, errors.New("invalid parameters: full transaction flag must be a boolean")
+			}
+		}
+		return api.subscribePendingTransactions(wsConn, subID, fullTx)
 	case "syncing":
-		return api.subscribeSyncing(wsConn, subID)
+		return api.subscribeSyncing(wsConn, subID, ready)
 	default:
 		return nil, errors.Errorf("unsupported method %s", method)
 	}
@@ -399,9 +530,6 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 		return nil, errors.Wrap(err, "error creating block filter")
 	}
 
-	// TODO: use events
-	baseFee := big.NewInt(params.InitialBaseFee)
-
 	go func() {
 		headersCh := sub.Event()
 		errCh := sub.Err()
@@ -418,7 +546,7 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 					continue
 				}
 
-				header := types.EthHeaderFromTendermint(data.Header, ethtypes.Bloom{}, baseFee)
+				header := api.ethHeaderFromTendermint(data.Header)
 
 				// write to ws conn
 				res := &SubscriptionNotification{
@@ -452,6 +580,50 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 	return unsubFn, nil
 }
 
+// ethHeaderFromTendermint builds the Ethereum header for a newHeads notification, filling in
+// the bloom, base fee and gas figures from the block results when they are available.
+func (api *pubSubAPI) ethHeaderFromTendermint(tmHeader tmtypes.Header) *ethtypes.Header {
+	var (
+		bloom   ethtypes.Bloom
+		baseFee *big.Int
+		gasUsed uint64
+	)
+
+	height := tmHeader.Height
+	blockRes, err := api.clientCtx.Client.BlockResults(context.Background(), &height)
+	if err != nil {
+		api.logger.Debug("failed to fetch block results for header", "height", height, "error", err.Error())
+	} else {
+		baseFee = types.BaseFeeFromEvents(blockRes.FinalizeBlockEvents)
+		for _, event := range blockRes.FinalizeBlockEvents {
+			if event.Type != evmtypes.EventTypeBlockBloom {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if attr.Key == evmtypes.AttributeKeyEthereumBloom {
+					bloom = ethtypes.BytesToBloom([]byte(attr.Value))
+				}
+			}
+		}
+		for _, txResult := range blockRes.TxsResults {
+			if txResult.GasUsed > 0 {
+				gasUsed += uint64(txResult.GasUsed) // #nosec G701 -- checked for negative values
+			}
+		}
+	}
+
+	header := types.EthHeaderFromTendermint(tmHeader, bloom, baseFee)
+	header.GasUsed = gasUsed
+
+	gasLimit, err := types.BlockMaxGasFromConsensusParams(context.Background(), api.clientCtx, height)
+	if err != nil {
+		api.logger.Debug("failed to fetch block gas limit for header", "height", height, "error", err.Error())
+	}
+	header.GasLimit = uint64(gasLimit) // #nosec G701 -- gas limit is never negative
+
+	return header
+}
+
 func try(fn func(), l log.Logger, desc string) {
 	defer func() {
 		if x := recover(); x != nil {
@@ -633,7 +805,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, fullTx bool) (pubsub.UnsubscribeFunc, error) {
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter: %s")
@@ -658,13 +830,24 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 				}
 
 				for _, ethTx := range ethTxs {
+					var result interface{} = ethTx.Hash
+					if fullTx {
+						tx := ethTx.AsTransaction()
+						rpcTx, err := types.NewRPCTransaction(tx, common.Hash{}, 0, 0, nil, tx.ChainId())
+						if err != nil {
+							api.logger.Debug("failed to build rpc transaction", "hash", ethTx.Hash, "error", err.Error())
+							continue
+						}
+						result = rpcTx
+					}
+
 					// write to ws conn
 					res := &SubscriptionNotification{
 						Jsonrpc: "2.0",
 						Method:  "eth_subscription",
 						Params: &SubscriptionResult{
 							Subscription: subID,
-							Result:       ethTx.Hash,
+							Result:       result,
 						},
 					}
 
@@ -691,8 +874,122 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// syncStatus is the result of a `syncing` subscription notification, following the geth format.
+type syncStatus struct {
+	Syncing bool            `json:"syncing"`
+	Status  *syncingDetails `json:"status,omitempty"`
+}
+
+// syncingDetails holds the block range of an ongoing sync.
+type syncingDetails struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+}
+
+// subscribeSyncing notifies the subscriber of the sync state of the CometBFT node once the
+// subscription ID has been sent, then every time it changes: when block sync starts or stops,
+// and on every new block while catching up. The node status is read on every new block.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID, ready <-chan struct{}) (pubsub.UnsubscribeFunc, error) {
+	if api.clientCtx.Client == nil {
+		return nil, errors.New("syncing subscription requires a CometBFT client")
+	}
+
+	sub, unsubFn, err := api.events.SubscribeNewHeads()
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating block filter")
+	}
+
+	go func() {
+		var (
+			last          *syncStatus
+			startingBlock int64
+			highestBlock  int64
+		)
+
+		// notify sends the sync state if it changed since the last notification and
+		// returns false if the peer has been dropped.
+		notify := func() bool {
+			status, err := api.clientCtx.Client.Status(context.Background())
+			if err != nil {
+				api.logger.Debug("failed to fetch node status for syncing subscription", "subscription-id", subID, "error", err.Error())
+				return true
+			}
+
+			info := status.SyncInfo
+			current := &syncStatus{Syncing: info.CatchingUp}
+			if info.CatchingUp {
+				if last == nil || !last.Syncing {
+					startingBlock = info.LatestBlockHeight
+					highestBlock = 0
+				}
+				if info.LatestBlockHeight > highestBlock {
+					highestBlock = info.LatestBlockHeight
+				}
+				current.Status = &syncingDetails{
+					StartingBlock: hexutil.Uint64(startingBlock),
+					CurrentBlock:  hexutil.Uint64(info.LatestBlockHeight),
+					HighestBlock:  hexutil.Uint64(highestBlock),
+				}
+			}
+
+			if last != nil && last.Syncing == current.Syncing &&
+				(!current.Syncing || *last.Status == *current.Status) {
+				return true
+			}
+
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       current,
+				},
+			}
+
+			if err := wsConn.WriteJSON(res); err != nil {
+				api.logger.Debug("error writing syncing status, will drop peer", "error", err.Error())
+				try(func() {
+					if err != websocket.ErrCloseSent {
+						_ = wsConn.Close() // #nosec G703
+					}
+				}, api.logger, "closing websocket peer sub")
+				return false
+			}
+			last = current
+			return true
+		}
+
+		select {
+		case <-ready:
+		case <-wsConn.done:
+			return
+		}
+
+		if !notify() {
+			return
+		}
+
+		headersCh := sub.Event()
+		errCh := sub.Err()
+		for {
+			select {
+			case _, ok := <-headersCh:
+				if !ok || !notify() {
+					return
+				}
+			case err, ok := <-errCh:
+				if !ok {
+					return
+				}
+				api.logger.Debug("dropping Syncing WebSocket subscription", "subscription-id", subID, "error", err.Error())
+			case <-wsConn.done:
+				return
+			}
+		}
+	}()
+
+	return unsubFn, nil
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
@@ -0,0 +1,71 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// dialTestConn starts a websocket server that never reads and returns the server side connection.
+func dialTestConn(t *testing.T) (*websocket.Conn, *websocket.Conn) {
+	t.Helper()
+
+	serverConnCh := make(chan *websocket.Conn, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		require.NoError(t, err)
+		serverConnCh <- conn
+	}))
+	t.Cleanup(srv.Close)
+
+	clientConn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientConn.Close() })
+
+	select {
+	case conn := <-serverConnCh:
+		return conn, clientConn
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for websocket connection")
+	}
+	return nil, nil
+}
+
+func TestWsConnBufferedWrites(t *testing.T) {
+	serverConn, clientConn := dialTestConn(t)
+	conn := newWsConn(serverConn, 4, log.NewNopLogger())
+	defer conn.Close()
+
+	require.NoError(t, conn.WriteJSON(map[string]int{"n": 1}))
+
+	var msg map[string]int
+	require.NoError(t, clientConn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	require.NoError(t, clientConn.ReadJSON(&msg))
+	require.Equal(t, 1, msg["n"])
+}
+
+func TestWsConnSlowConsumer(t *testing.T) {
+	serverConn, _ := dialTestConn(t)
+	conn := newWsConn(serverConn, 1, log.NewNopLogger())
+
+	// the client never reads, so the buffer eventually fills up and the peer is dropped
+	payload := strings.Repeat("x", 1<<16)
+	var err error
+	for i := 0; i < 10_000 && err == nil; i++ {
+		err = conn.WriteJSON(payload)
+	}
+	require.ErrorIs(t, err, errSlowConsumer)
+
+	select {
+	case <-conn.done:
+	default:
+		t.Fatal("slow consumer connection was not closed")
+	}
+	require.Equal(t, websocket.ErrCloseSent, conn.WriteJSON(payload))
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"strconv"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -87,6 +88,27 @@ const (
 	// DefaultReturnDataLimit is maximum number of bytes returned from eth_call or similar invocations
 	DefaultReturnDataLimit = 100000
 
+	// DefaultWsMaxSubscriptions is the maximum number of active subscriptions per WebSocket connection
+	DefaultWsMaxSubscriptions = 100
+
+	// DefaultWsSendBufferSize is the number of outgoing messages buffered per WebSocket connection
+	DefaultWsSendBufferSize = 256
+
+	// DefaultBatchRequestLimit is the maximum number of requests in a JSON-RPC batch
+	DefaultBatchRequestLimit = 1000
+
+	// DefaultMaxResponseBytes is the maximum size in bytes of a JSON-RPC response (25MB)
+	DefaultMaxResponseBytes = 25 * 1000 * 1000
+
+	// DefaultRateLimitPerIP is the default number of requests per second allowed per client IP (0=unlimited)
+	DefaultRateLimitPerIP = 0
+
+	// DefaultRateLimitBurstPerIP is the default burst size of the per-IP token bucket
+	DefaultRateLimitBurstPerIP = 100
+
+	// DefaultEnableBundlerAPI is the default value for the ERC-4337 bundler RPC extensions
+	DefaultEnableBundlerAPI = false
+
 	// DefaultRosettaEnable is the default value for the parameter that defines if the Rosetta API server is enabled
 	DefaultRosettaEnable = false
 
@@ -186,6 +208,58 @@ type JSONRPCConfig struct {
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
 	// ReturnDataLimit defines maximum number of bytes returned from `eth_call` or similar invocations
 	ReturnDataLimit int64 `mapstructure:"return-data-limit"`
+	// WsMaxSubscriptions defines the maximum number of active subscriptions per WebSocket connection (0=unlimited)
+	WsMaxSubscriptions int `mapstructure:"ws-max-subscriptions"`
+	// WsSendBufferSize defines the number of outgoing messages buffered per WebSocket connection.
+	// Connections whose buffer fills up are considered slow consumers and are disconnected (0=unbuffered).
+	WsSendBufferSize int `mapstructure:"ws-send-buffer-size"`
+	// BatchRequestLimit defines the maximum number of requests in a single batch (0=unlimited)
+	BatchRequestLimit int `mapstructure:"batch-request-limit"`
+	// MaxResponseBytes defines the maximum size in bytes of a single response or batch response (0=unlimited)
+	MaxResponseBytes int `mapstructure:"max-response-bytes"`
+	// RateLimitPerIP defines the number of requests per second allowed for each client IP (0=unlimited)
+	RateLimitPerIP float64 `mapstructure:"rate-limit-per-ip"`
+	// RateLimitBurstPerIP defines the burst size of the per-IP token bucket
+	RateLimitBurstPerIP int `mapstructure:"rate-limit-burst-per-ip"`
+	// MethodRateLimits defines per-method token buckets applied to each client IP,
+	// using the format "<method>:<requests per second>:<burst>". The method may end with '*'.
+	MethodRateLimits []string `mapstructure:"method-rate-limits"`
+	// MethodDenylist defines the methods that are rejected by the server. Entries may end with '*',
+	// e.g. "debug_*" rejects the whole debug namespace.
+	MethodDenylist []string `mapstructure:"method-denylist"`
+	// EnableBundlerAPI enables the RPC extensions used by ERC-4337 bundlers: state overrides on
+	// `eth_call`, `debug_traceCall` and `eth_sendRawTransactionConditional`.
+	EnableBundlerAPI bool `mapstructure:"enable-bundler-api"`
+}
+
+// MethodRateLimit defines a token bucket for the JSON-RPC methods matching Method.
+type MethodRateLimit struct {
+	// Method is the method name, or a prefix ending with '*'
+	Method string
+	// Rate is the number of requests per second
+	Rate float64
+	// Burst is the bucket size
+	Burst int
+}
+
+// ParseMethodRateLimit parses a "<method>:<requests per second>:<burst>" rate limit entry.
+func ParseMethodRateLimit(entry string) (MethodRateLimit, error) {
+	parts := strings.SplitAndTrimEmpty(entry, ":", " ")
+	if len(parts) != 3 || parts[0] == "" {
+		return MethodRateLimit{}, fmt.Errorf("invalid method rate limit %q, expected <method>:<rate>:<burst>", entry)
+	}
+
+	rate, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil || rate <= 0 {
+		return MethodRateLimit{}, fmt.Errorf("invalid rate in method rate limit %q", entry)
+	}
+
+	burst, err := strconv.Atoi(parts[2])
+	if err != nil || burst <= 0 {
+		return MethodRateLimit{}, fmt.Errorf("invalid burst in method rate limit %q", entry)
+	}
+
+	return MethodRateLimit{Method: parts[0], Rate: rate, Burst: burst}, nil
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -304,6 +378,15 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 		ReturnDataLimit:          DefaultReturnDataLimit,
+		WsMaxSubscriptions:       DefaultWsMaxSubscriptions,
+		WsSendBufferSize:         DefaultWsSendBufferSize,
+		BatchRequestLimit:        DefaultBatchRequestLimit,
+		MaxResponseBytes:         DefaultMaxResponseBytes,
+		RateLimitPerIP:           DefaultRateLimitPerIP,
+		RateLimitBurstPerIP:      DefaultRateLimitBurstPerIP,
+		MethodRateLimits:         []string{},
+		MethodDenylist:           []string{},
+		EnableBundlerAPI:         DefaultEnableBundlerAPI,
 	}
 }
 
@@ -345,6 +428,42 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.WsMaxSubscriptions < 0 {
+		return errors.New("JSON-RPC WebSocket max subscriptions cannot be negative")
+	}
+
+	if c.WsSendBufferSize < 0 {
+		return errors.New("JSON-RPC WebSocket send buffer size cannot be negative")
+	}
+
+	if c.BatchRequestLimit < 0 {
+		return errors.New("JSON-RPC batch request limit cannot be negative")
+	}
+
+	if c.MaxResponseBytes < 0 {
+		return errors.New("JSON-RPC max response bytes cannot be negative")
+	}
+
+	if c.RateLimitPerIP < 0 {
+		return errors.New("JSON-RPC rate limit per IP cannot be negative")
+	}
+
+	if c.RateLimitPerIP > 0 && c.RateLimitBurstPerIP <= 0 {
+		return errors.New("JSON-RPC rate limit burst per IP must be positive when rate limiting is enabled")
+	}
+
+	for _, entry := range c.MethodRateLimits {
+		if _, err := ParseMethodRateLimit(entry); err != nil {
+			return err
+		}
+	}
+
+	for _, method := range c.MethodDenylist {
+		if method == "" {
+			return errors.New("JSON-RPC method denylist cannot contain empty entries")
+		}
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -439,6 +558,15 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
 			ReturnDataLimit:          v.GetInt64("json-rpc.return-data-limit"),
+			WsMaxSubscriptions:       v.GetInt("json-rpc.ws-max-subscriptions"),
+			WsSendBufferSize:         v.GetInt("json-rpc.ws-send-buffer-size"),
+			BatchRequestLimit:        v.GetInt("json-rpc.batch-request-limit"),
+			MaxResponseBytes:         v.GetInt("json-rpc.max-response-bytes"),
+			RateLimitPerIP:           v.GetFloat64("json-rpc.rate-limit-per-ip"),
+			RateLimitBurstPerIP:      v.GetInt("json-rpc.rate-limit-burst-per-ip"),
+			MethodRateLimits:         v.GetStringSlice("json-rpc.method-rate-limits"),
+			MethodDenylist:           v.GetStringSlice("json-rpc.method-denylist"),
+			EnableBundlerAPI:         v.GetBool("json-rpc.enable-bundler-api"),
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
@@ -12,3 +12,32 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestJSONRPCConfigValidateWebsocket(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	require.NoError(t, cfg.Validate())
+	require.Equal(t, DefaultWsMaxSubscriptions, cfg.WsMaxSubscriptions)
+	require.Equal(t, DefaultWsSendBufferSize, cfg.WsSendBufferSize)
+
+	cfg.WsMaxSubscriptions = -1
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultJSONRPCConfig()
+	cfg.WsSendBufferSize = -1
+	require.Error(t, cfg.Validate())
+}
+
+func TestParseMethodRateLimit(t *testing.T) {
+	limit, err := ParseMethodRateLimit("eth_getLogs:5:10")
+	require.NoError(t, err)
+	require.Equal(t, MethodRateLimit{Method: "eth_getLogs", Rate: 5, Burst: 10}, limit)
+
+	for _, entry := range []string{"eth_getLogs", "eth_getLogs:0:10", "eth_getLogs:5:0", ":5:10", "eth_getLogs:x:1"} {
+		_, err := ParseMethodRateLimit(entry)
+		require.Error(t, err, entry)
+	}
+
+	cfg := DefaultJSONRPCConfig()
+	cfg.MethodRateLimits = []string{"invalid"}
+	require.Error(t, cfg.Validate())
+}
@@ -95,6 +95,38 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+# WsMaxSubscriptions defines the maximum number of active subscriptions per WebSocket connection (0=unlimited).
+ws-max-subscriptions = {{ .JSONRPC.WsMaxSubscriptions }}
+
+# WsSendBufferSize defines the number of outgoing messages buffered per WebSocket connection.
+# Connections that fall behind by more than this many messages are disconnected (0=unbuffered).
+ws-send-buffer-size = {{ .JSONRPC.WsSendBufferSize }}
+
+# BatchRequestLimit defines the maximum number of requests in a single batch (0=unlimited).
+batch-request-limit = {{ .JSONRPC.BatchRequestLimit }}
+
+# MaxResponseBytes defines the maximum size in bytes of a single response or batch response (0=unlimited).
+max-response-bytes = {{ .JSONRPC.MaxResponseBytes }}
+
+# RateLimitPerIP defines the number of requests per second allowed for each client IP (0=unlimited).
+rate-limit-per-ip = {{ .JSONRPC.RateLimitPerIP }}
+
+# RateLimitBurstPerIP defines the burst size of the per-IP token bucket.
+rate-limit-burst-per-ip = {{ .JSONRPC.RateLimitBurstPerIP }}
+
+# MethodRateLimits defines per-method token buckets applied to each client IP.
+# Format: "<method>:<requests per second>:<burst>". The method may end with '*'.
+# Example: "eth_getLogs:5:10,debug_*:1:2"
+method-rate-limits = "{{range $index, $elmt := .JSONRPC.MethodRateLimits}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# MethodDenylist defines the methods rejected by the server. Entries may end with '*'.
+# Example: "debug_*,personal_*"
+method-denylist = "{{range $index, $elmt := .JSONRPC.MethodDenylist}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# EnableBundlerAPI enables the RPC extensions used by ERC-4337 bundlers: state overrides on eth_call,
+# debug_traceCall (including the erc7562Tracer) and eth_sendRawTransactionConditional.
+enable-bundler-api = {{ .JSONRPC.EnableBundlerAPI }}
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
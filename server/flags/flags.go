@@ -66,6 +66,15 @@ const (
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
 	JSONRPCAllowIndexerGap     = "json-rpc.allow-indexer-gap"
+	JSONRPCWsMaxSubscriptions  = "json-rpc.ws-max-subscriptions"
+	JSONRPCWsSendBufferSize    = "json-rpc.ws-send-buffer-size"
+	JSONRPCBatchRequestLimit   = "json-rpc.batch-request-limit"
+	JSONRPCMaxResponseBytes    = "json-rpc.max-response-bytes"
+	JSONRPCRateLimitPerIP      = "json-rpc.rate-limit-per-ip"
+	JSONRPCRateLimitBurstPerIP = "json-rpc.rate-limit-burst-per-ip"
+	JSONRPCMethodRateLimits    = "json-rpc.method-rate-limits"
+	JSONRPCMethodDenylist      = "json-rpc.method-denylist"
+	JSONRPCEnableBundlerAPI    = "json-rpc.enable-bundler-api"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
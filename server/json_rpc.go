@@ -27,6 +27,7 @@ import (
 	ethlog "github.com/ethereum/go-ethereum/log"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/hetu-project/hetu/v1/rpc"
+	"github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/txpool"
 
 	svrcfg "github.com/hetu-project/hetu/v1/server/config"
 	evmostypes "github.com/hetu-project/hetu/v1/types"
@@ -39,6 +40,7 @@ func StartJSONRPC(ctx *server.Context,
 	tmEndpoint string,
 	config *svrcfg.Config,
 	indexer evmostypes.EVMTxIndexer,
+	mempool txpool.Mempool,
 ) (*http.Server, chan struct{}, error) {
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
 
@@ -60,7 +62,7 @@ func StartJSONRPC(ctx *server.Context,
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
 
-	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, mempool, rpcAPIArr)
 
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
@@ -73,8 +75,10 @@ func StartJSONRPC(ctx *server.Context,
 		}
 	}
 
+	limiter := rpc.NewRequestLimiter(config.JSONRPC)
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", limiter.HTTPHandler(rpcServer)).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -121,7 +125,7 @@ func StartJSONRPC(ctx *server.Context,
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config, limiter)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
@@ -63,10 +63,12 @@ import (
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 
 	"github.com/hetu-project/hetu/v1/indexer"
 	ethdebug "github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/debug"
+	"github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/txpool"
 	"github.com/hetu-project/hetu/v1/server/config"
 	srvflags "github.com/hetu-project/hetu/v1/server/flags"
 	evmostypes "github.com/hetu-project/hetu/v1/types"
@@ -220,6 +222,15 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCAllowIndexerGap, true, "Allow block gap for the custom tx indexer for json-rpc")
+	cmd.Flags().Int(srvflags.JSONRPCWsMaxSubscriptions, config.DefaultWsMaxSubscriptions, "Sets the maximum number of active subscriptions per WebSocket connection (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCWsSendBufferSize, config.DefaultWsSendBufferSize, "Sets the number of outgoing messages buffered per WebSocket connection before it is dropped as a slow consumer")
+	cmd.Flags().Int(srvflags.JSONRPCBatchRequestLimit, config.DefaultBatchRequestLimit, "Sets the maximum number of requests in a JSON-RPC batch (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCMaxResponseBytes, config.DefaultMaxResponseBytes, "Sets the maximum size in bytes of a JSON-RPC response (0=unlimited)")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitPerIP, config.DefaultRateLimitPerIP, "Sets the number of JSON-RPC requests per second allowed per client IP (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitBurstPerIP, config.DefaultRateLimitBurstPerIP, "Sets the burst size of the per-IP JSON-RPC rate limit")
+	cmd.Flags().StringSlice(srvflags.JSONRPCMethodRateLimits, []string{}, "Defines per-method rate limits per client IP as <method>:<rate>:<burst>")
+	cmd.Flags().StringSlice(srvflags.JSONRPCMethodDenylist, []string{}, "Defines a list of JSON-RPC methods to reject, e.g. debug_*,personal_*")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableBundlerAPI, config.DefaultEnableBundlerAPI, "Enable the ERC-4337 bundler RPC extensions (eth_call state overrides, debug_traceCall, eth_sendRawTransactionConditional)") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
@@ -461,7 +472,13 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	startAPIServer(ctx, svrCtx, clientCtx, g, config.Config, app, grpcSrv, metrics)
 
-	clientCtx, httpSrv, httpSrvDone, err := startJSONRPCServer(svrCtx, clientCtx, g, config, genDocProvider, cfg.RPC.ListenAddress, idxer)
+	// the txpool namespace reads the app-side mempool, if the app runs one
+	var txPool txpool.Mempool
+	if mpApp, ok := app.(interface{ Mempool() sdkmempool.Mempool }); ok {
+		txPool, _ = mpApp.Mempool().(txpool.Mempool)
+	}
+
+	clientCtx, httpSrv, httpSrvDone, err := startJSONRPCServer(svrCtx, clientCtx, g, config, genDocProvider, cfg.RPC.ListenAddress, idxer, txPool)
 	if httpSrv != nil {
 		defer func() {
 			shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
@@ -654,6 +671,7 @@ func startAPIServer(
 // - genDocProvider: A function that provides the Genesis document, used to retrieve the chain ID.
 // - cmtRPCAddr: The address of the CometBFT RPC server for WebSocket connections.
 // - idxer: The EVM transaction indexer for indexing transactions.
+// - txPool: The app-side mempool read by the txpool namespace, or nil.
 func startJSONRPCServer(
 	svrCtx *server.Context,
 	clientCtx client.Context,
@@ -662,6 +680,7 @@ func startJSONRPCServer(
 	genDocProvider node.GenesisDocProvider,
 	cmtRPCAddr string,
 	idxer evmostypes.EVMTxIndexer,
+	txPool txpool.Mempool,
 ) (ctx client.Context, httpSrv *http.Server, httpSrvDone chan struct{}, err error) {
 	ctx = clientCtx
 	if !config.JSONRPC.Enable {
@@ -676,7 +695,7 @@ func startJSONRPCServer(
 	ctx = clientCtx.WithChainID(genDoc.ChainID)
 	cmtEndpoint := "/websocket"
 	g.Go(func() error {
-		httpSrv, httpSrvDone, err = StartJSONRPC(svrCtx, clientCtx, cmtRPCAddr, cmtEndpoint, &config, idxer)
+		httpSrv, httpSrvDone, err = StartJSONRPC(svrCtx, clientCtx, cmtRPCAddr, cmtEndpoint, &config, idxer, txPool)
 		return err
 	})
 	return
@@ -0,0 +1,246 @@
+package upgrade
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// oldBinaryEnv is the env var holding the path to the hetud binary that
+	// runs the chain until the upgrade height. It must not know the upgrade.
+	oldBinaryEnv = "E2E_OLD_BINARY"
+	// newBinaryEnv is the env var holding the path to the hetud binary that
+	// contains the upgrade handler.
+	newBinaryEnv = "E2E_NEW_BINARY"
+
+	localKey          = "val"
+	localDenom        = "ahetu"
+	localUpgradeDelta = 15
+	localBlocksAfter  = 5
+	localTimeout      = 3 * time.Minute
+)
+
+// TestLocalBinarySwap runs a single validator with the old binary, passes a
+// software upgrade proposal for the latest upgrade in app/upgrades, waits for
+// the chain to halt at the upgrade height and restarts the node with the new
+// binary on the same home. The upgrade succeeds if the new binary applies the
+// plan and keeps producing blocks past the upgrade height.
+//
+// Build both binaries and run with:
+//
+//	E2E_OLD_BINARY=/path/to/old/hetud E2E_NEW_BINARY=/path/to/new/hetud \
+//		go test ./tests/e2e/upgrade -run TestLocalBinarySwap -v
+func TestLocalBinarySwap(t *testing.T) {
+	oldBinary, newBinary := os.Getenv(oldBinaryEnv), os.Getenv(newBinaryEnv)
+	if oldBinary == "" || newBinary == "" {
+		t.Skipf("set %s and %s to run the local binary swap upgrade test", oldBinaryEnv, newBinaryEnv)
+	}
+
+	upgrades, err := RetrieveUpgradesList(upgradesPath)
+	require.NoError(t, err)
+	upgradeName := upgrades[len(upgrades)-1]
+
+	node := &localNode{t: t, home: t.TempDir(), chainID: defaultChainID}
+	node.init(oldBinary)
+
+	node.start(oldBinary)
+	node.waitForHeight(3)
+
+	upgradeHeight := node.height() + localUpgradeDelta
+	node.tx(oldBinary,
+		"upgrade", "software-upgrade", upgradeName,
+		fmt.Sprintf("--upgrade-height=%d", upgradeHeight),
+		"--title=upgrade", "--summary=local binary swap",
+		fmt.Sprintf("--deposit=10000000%s", localDenom),
+		"--no-validate",
+	)
+	node.waitForHeight(node.height() + 1)
+	node.tx(oldBinary, "gov", "vote", "1", "yes")
+
+	// the old binary halts at the upgrade height and records the plan on disk
+	node.waitForUpgradeInfo(upgradeName, upgradeHeight)
+	node.stop()
+
+	node.start(newBinary)
+	node.waitForHeight(upgradeHeight + localBlocksAfter)
+
+	out := node.exec(newBinary, "query", "upgrade", "applied", upgradeName, "--output=json")
+	require.Contains(t, string(out), strconv.FormatInt(upgradeHeight, 10))
+}
+
+// localNode is a single validator node running from a temporary home
+type localNode struct {
+	t       *testing.T
+	home    string
+	chainID string
+	cmd     *exec.Cmd
+	logs    bytes.Buffer
+}
+
+// exec runs a hetud command against the node home and returns its output
+func (n *localNode) exec(binary string, args ...string) []byte {
+	args = append(args, "--home", n.home)
+	out, err := exec.Command(binary, args...).CombinedOutput()
+	require.NoError(n.t, err, "%s %s: %s", binary, strings.Join(args, " "), out)
+	return out
+}
+
+// tx signs and broadcasts a transaction from the validator key
+func (n *localNode) tx(binary string, args ...string) {
+	args = append([]string{"tx"}, args...)
+	args = append(args,
+		"--from", localKey,
+		"--keyring-backend=test",
+		"--chain-id", n.chainID,
+		"--gas=500000",
+		fmt.Sprintf("--fees=500000000000000%s", localDenom),
+		"--output=json",
+		"--yes",
+	)
+	out := n.exec(binary, args...)
+
+	var res struct {
+		Code   uint32 `json:"code"`
+		RawLog string `json:"raw_log"`
+	}
+	require.NoError(n.t, json.Unmarshal(out, &res), string(out))
+	require.Zero(n.t, res.Code, res.RawLog)
+}
+
+// init creates the validator key, a single validator genesis with short
+// governance periods and one second blocks
+func (n *localNode) init(binary string) {
+	n.exec(binary, "keys", "add", localKey, "--keyring-backend=test", "--algo=eth_secp256k1")
+	n.exec(binary, "init", "local", "--chain-id", n.chainID)
+
+	genesisPath := filepath.Join(n.home, "config", "genesis.json")
+	bz, err := os.ReadFile(genesisPath)
+	require.NoError(n.t, err)
+
+	var genesis map[string]interface{}
+	require.NoError(n.t, json.Unmarshal(bz, &genesis))
+	replaceDenoms(genesis, "stake", localDenom)
+
+	gov := genesis["app_state"].(map[string]interface{})["gov"].(map[string]interface{})
+	govParams := gov["params"].(map[string]interface{})
+	govParams["voting_period"] = "10s"
+	govParams["expedited_voting_period"] = "5s"
+
+	bz, err = json.MarshalIndent(genesis, "", "  ")
+	require.NoError(n.t, err)
+	require.NoError(n.t, os.WriteFile(genesisPath, bz, 0o600))
+
+	n.exec(binary, "add-genesis-account", localKey, "100000000000000000000000000"+localDenom, "--keyring-backend=test")
+	n.exec(binary, "gentx", localKey, "1000000000000000000000"+localDenom, "--keyring-backend=test", "--chain-id", n.chainID)
+	n.exec(binary, "collect-gentxs")
+	n.exec(binary, "validate-genesis")
+
+	configPath := filepath.Join(n.home, "config", "config.toml")
+	bz, err = os.ReadFile(configPath)
+	require.NoError(n.t, err)
+	bz = []byte(strings.Replace(string(bz), `timeout_commit = "3s"`, `timeout_commit = "1s"`, 1))
+	require.NoError(n.t, os.WriteFile(configPath, bz, 0o600))
+}
+
+// start runs the node in the background with the given binary
+func (n *localNode) start(binary string) {
+	n.logs.Reset()
+	n.cmd = exec.Command(binary, "start",
+		"--home", n.home,
+		"--chain-id", n.chainID,
+		"--minimum-gas-prices=0"+localDenom,
+	)
+	n.cmd.Stdout = &n.logs
+	n.cmd.Stderr = &n.logs
+	require.NoError(n.t, n.cmd.Start())
+	n.t.Cleanup(n.stop)
+}
+
+// stop kills the running node, if any
+func (n *localNode) stop() {
+	if n.cmd == nil || n.cmd.Process == nil {
+		return
+	}
+	_ = n.cmd.Process.Kill()
+	_ = n.cmd.Wait()
+	n.cmd = nil
+}
+
+// height returns the latest block height, or zero while the node is not serving
+func (n *localNode) height() int64 {
+	out, err := exec.Command(n.cmd.Path, "status", "--home", n.home).CombinedOutput()
+	if err != nil {
+		return 0
+	}
+	var status struct {
+		SyncInfo struct {
+			LatestBlockHeight string `json:"latest_block_height"`
+		} `json:"sync_info"`
+	}
+	if err := json.Unmarshal(out, &status); err != nil {
+		return 0
+	}
+	height, _ := strconv.ParseInt(status.SyncInfo.LatestBlockHeight, 10, 64)
+	return height
+}
+
+// waitForHeight blocks until the node reaches the given height
+func (n *localNode) waitForHeight(height int64) {
+	n.waitFor(fmt.Sprintf("height %d", height), func() bool {
+		return n.height() >= height
+	})
+}
+
+// waitForUpgradeInfo blocks until the halted node has written the upgrade plan
+func (n *localNode) waitForUpgradeInfo(name string, height int64) {
+	path := filepath.Join(n.home, "data", "upgrade-info.json")
+	n.waitFor("upgrade info", func() bool {
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return false
+		}
+		var info struct {
+			Name   string `json:"name"`
+			Height int64  `json:"height"`
+		}
+		return json.Unmarshal(bz, &info) == nil && info.Name == name && info.Height == height
+	})
+}
+
+func (n *localNode) waitFor(what string, cond func() bool) {
+	deadline := time.Now().Add(localTimeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			n.t.Fatalf("timed out waiting for %s, node logs:\n%s", what, n.logs.String())
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// replaceDenoms replaces every denom field in the genesis that equals from
+func replaceDenoms(v interface{}, from, to string) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if s, ok := value.(string); ok && s == from && strings.HasSuffix(key, "denom") {
+				v[key] = to
+				continue
+			}
+			replaceDenoms(value, from, to)
+		}
+	case []interface{}:
+		for _, value := range v {
+			replaceDenoms(value, from, to)
+		}
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"strconv"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/spf13/cobra"
 
 	"github.com/hetu-project/hetu/v1/x/blockinflation/types"
@@ -30,6 +33,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryAllSubnetEmissionData(),
 		GetCmdQuerySubnetPrice(),
 		GetCmdQueryPendingEmission(),
+		GetCmdQuerySuspendedSubnets(),
+		GetCmdQuerySuspendedSubnet(),
+		GetCmdQuerySupplyBreakdown(),
+		GetCmdQueryBlockEmissionSchedule(),
+		GetCmdQueryAllSubnetCosts(),
+		GetCmdQuerySubnetCosts(),
 	)
 
 	return cmd
@@ -47,7 +56,7 @@ func GetCmdQueryParams() *cobra.Command {
 				return err
 			}
 
-			queryClient := types.NewQueryClient(clientCtx.GRPCClient)
+			queryClient := types.NewQueryClient(clientCtx)
 
 			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
 			if err != nil {
@@ -63,7 +72,16 @@ func GetCmdQueryParams() *cobra.Command {
 				fmt.Sprintf("Subnet Reward K: %s\n", res.Params.SubnetRewardK.String()) +
 				fmt.Sprintf("Subnet Reward Max Ratio: %s\n", res.Params.SubnetRewardMaxRatio.String()) +
 				fmt.Sprintf("Subnet Moving Alpha: %s\n", res.Params.SubnetMovingAlpha.String()) +
-				fmt.Sprintf("Subnet Owner Cut: %s\n", res.Params.SubnetOwnerCut.String()))
+				fmt.Sprintf("Subnet Owner Cut: %s\n", res.Params.SubnetOwnerCut.String()) +
+				fmt.Sprintf("Price TWAP Window: %d\n", res.Params.PriceTwapWindow) +
+				fmt.Sprintf("Max Price Change Per Block: %s\n", res.Params.MaxPriceChangePerBlock.String()) +
+				fmt.Sprintf("Subnet Emission Mode: %s\n", res.Params.SubnetEmissionMode) +
+				fmt.Sprintf("Root Weight Ratio: %s\n", res.Params.RootWeightRatio.String()) +
+				fmt.Sprintf("Root Dividend Ratio: %s\n", res.Params.RootDividendRatio.String()) +
+				fmt.Sprintf("Root Tempo: %d\n", res.Params.RootTempo) +
+				fmt.Sprintf("Active Minter: %s\n", res.Params.ActiveMinter) +
+				fmt.Sprintf("Lock Cost Policy: %s\n", res.Params.LockCostPolicy) +
+				fmt.Sprintf("Registration Cost Policy: %s\n", res.Params.RegistrationCostPolicy))
 		},
 	}
 
@@ -84,7 +102,7 @@ func GetCmdQuerySubnetRewardParams() *cobra.Command {
 				return err
 			}
 
-			queryClient := types.NewQueryClient(clientCtx.GRPCClient)
+			queryClient := types.NewQueryClient(clientCtx)
 
 			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
 			if err != nil {
@@ -117,7 +135,7 @@ func GetCmdQueryPendingSubnetRewards() *cobra.Command {
 				return err
 			}
 
-			queryClient := types.NewQueryClient(clientCtx.GRPCClient)
+			queryClient := types.NewQueryClient(clientCtx)
 
 			res, err := queryClient.PendingSubnetRewards(cmd.Context(), &types.QueryPendingSubnetRewardsRequest{})
 			if err != nil {
@@ -269,3 +287,250 @@ func GetCmdQueryPendingEmission() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQuerySuspendedSubnets implements the suspended subnets query command.
+func GetCmdQuerySuspendedSubnets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "suspended-subnets",
+		Short: "Query all subnets whose emission is suspended",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SuspendedSubnets(cmd.Context(), &types.QuerySuspendedSubnetsRequest{})
+			if err != nil {
+				return fmt.Errorf("Failed to query suspended subnets: %w", err)
+			}
+
+			if len(res.Suspensions) == 0 {
+				return clientCtx.PrintString("No suspended subnets\n")
+			}
+
+			out := ""
+			for _, suspension := range res.Suspensions {
+				out += formatSubnetEmissionSuspension(suspension)
+			}
+			return clientCtx.PrintString(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySuspendedSubnet implements the suspended subnet query command.
+func GetCmdQuerySuspendedSubnet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "suspended-subnet [netuid]",
+		Short: "Query the emission suspension of a specific subnet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse netuid
+			u, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return fmt.Errorf("invalid netuid (must be 0-65535): %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SuspendedSubnet(cmd.Context(), &types.QuerySuspendedSubnetRequest{Netuid: uint16(u)})
+			if err != nil {
+				return fmt.Errorf("Failed to query suspended subnet: %w", err)
+			}
+
+			return clientCtx.PrintString(formatSubnetEmissionSuspension(res.Suspension))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func formatSubnetEmissionSuspension(suspension types.SubnetEmissionSuspension) string {
+	return fmt.Sprintf("Netuid: %d\n", suspension.Netuid) +
+		fmt.Sprintf("Suspended Height: %d\n", suspension.SuspendedHeight) +
+		fmt.Sprintf("Reason: %s\n", suspension.Reason)
+}
+
+// GetCmdQuerySupplyBreakdown implements the supply breakdown query command.
+func GetCmdQuerySupplyBreakdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-breakdown",
+		Short: "Query the supply of the mint denom against the supply cap",
+		Long: `Query the supply of the mint denom against the supply cap.
+The vesting supply is summed up over all pages of the accounts, pass --height
+to query every page at the same height.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySupplyBreakdownRequest{Pagination: &query.PageRequest{}}
+			vesting := math.ZeroInt()
+			var res *types.QuerySupplyBreakdownResponse
+			for {
+				res, err = queryClient.SupplyBreakdown(cmd.Context(), req)
+				if err != nil {
+					return fmt.Errorf("Failed to query supply breakdown: %w", err)
+				}
+				vesting = vesting.Add(res.Vesting)
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				req.Pagination.Key = res.Pagination.NextKey
+			}
+			res.Vesting = vesting
+			res.Circulating = res.TotalSupply.Sub(res.LockedInAMM).Sub(vesting).Sub(res.CommunityPool)
+			if res.Circulating.IsNegative() {
+				res.Circulating = math.ZeroInt()
+			}
+
+			coin := func(amount math.Int) sdk.Coin { return sdk.NewCoin(res.Denom, amount) }
+			return clientCtx.PrintString(fmt.Sprintf("Active Minter: %s\n", res.ActiveMinter) +
+				fmt.Sprintf("Supply Cap: %s\n", coin(res.SupplyCap)) +
+				fmt.Sprintf("Total Supply: %s\n", coin(res.TotalSupply)) +
+				fmt.Sprintf("Issued: %s\n", coin(res.Issued)) +
+				fmt.Sprintf("Remaining: %s\n", coin(res.Remaining)) +
+				fmt.Sprintf("Burned: %s\n", coin(res.Burned)) +
+				fmt.Sprintf("Locked In AMM: %s\n", coin(res.LockedInAMM)) +
+				fmt.Sprintf("Vesting: %s\n", coin(res.Vesting)) +
+				fmt.Sprintf("Community Pool: %s\n", coin(res.CommunityPool)) +
+				fmt.Sprintf("Circulating: %s\n", coin(res.Circulating)))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBlockEmissionSchedule implements the block emission schedule query command.
+func GetCmdQueryBlockEmissionSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-emission-schedule",
+		Short: "Query the current block emission and the upcoming halvings",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockEmissionSchedule(cmd.Context(), &types.QueryBlockEmissionScheduleRequest{})
+			if err != nil {
+				return fmt.Errorf("Failed to query block emission schedule: %w", err)
+			}
+
+			coin := func(amount math.Int) sdk.Coin { return sdk.NewCoin(res.Denom, amount) }
+			out := fmt.Sprintf("Total Supply: %s\n", coin(res.TotalSupply)) +
+				fmt.Sprintf("Total Issuance: %s\n", coin(res.TotalIssuance)) +
+				fmt.Sprintf("Current Halving: %d\n", res.CurrentHalving) +
+				fmt.Sprintf("Current Block Emission: %s\n", coin(res.CurrentBlockEmission)) +
+				"Halvings:\n"
+			for _, halving := range res.Halvings {
+				out += fmt.Sprintf("  Halving %d: threshold %s, block emission %s, estimated height %d\n",
+					halving.Index, coin(halving.Threshold), coin(halving.BlockEmission), halving.EstimatedHeight)
+			}
+			return clientCtx.PrintString(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAllSubnetCosts implements the subnet costs query command.
+func GetCmdQueryAllSubnetCosts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-subnet-costs",
+		Short: "Query the registration and lock costs paid for all subnets",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllSubnetCosts(cmd.Context(), &types.QueryAllSubnetCostsRequest{})
+			if err != nil {
+				return fmt.Errorf("Failed to query subnet costs: %w", err)
+			}
+
+			if len(res.SubnetCosts) == 0 {
+				return clientCtx.PrintString("No subnet costs\n")
+			}
+
+			out := ""
+			for _, costs := range res.SubnetCosts {
+				out += formatSubnetCosts(res.Denom, costs)
+			}
+			return clientCtx.PrintString(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySubnetCosts implements the subnet costs query command for a subnet.
+func GetCmdQuerySubnetCosts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subnet-costs [netuid]",
+		Short: "Query the registration and lock costs paid for a specific subnet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse netuid
+			u, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return fmt.Errorf("invalid netuid (must be 0-65535): %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SubnetCosts(cmd.Context(), &types.QuerySubnetCostsRequest{Netuid: uint16(u)})
+			if err != nil {
+				return fmt.Errorf("Failed to query subnet costs: %w", err)
+			}
+
+			return clientCtx.PrintString(formatSubnetCosts(res.Denom, res.SubnetCosts))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func formatSubnetCosts(denom string, costs types.SubnetCosts) string {
+	return fmt.Sprintf("Netuid: %d\n", costs.Netuid) +
+		fmt.Sprintf("Burned: %s\n", sdk.NewCoin(denom, costs.Burned)) +
+		fmt.Sprintf("Recycled: %s\n", sdk.NewCoin(denom, costs.Recycled)) +
+		fmt.Sprintf("Community Pool: %s\n", sdk.NewCoin(denom, costs.CommunityPool))
+}
@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hetu-project/hetu/v1/x/blockinflation/types"
+)
+
+// GetTxCmd returns the cli transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewUpdateParamsProposalCmd(),
+		NewResumeSubnetEmissionProposalCmd(),
+	)
+
+	return cmd
+}
+
+// NewUpdateParamsProposalCmd implements the command to submit a governance
+// proposal updating the blockinflation parameters.
+func NewUpdateParamsProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params-proposal PARAMS_FILE",
+		Short: "Submit a proposal to update the blockinflation parameters",
+		Long: `Submit a governance proposal to update the blockinflation parameters along with an initial deposit.
+The parameters must be supplied in full via a JSON file, e.g. the output of the params query with the values to change edited.`,
+		Example: fmt.Sprintf(`$ %s tx %s update-params-proposal params.json --title="Lower owner cut" --summary="..." --deposit=10000000ahetu --from=<key_or_address>
+
+Where params.json contains (example):
+
+{
+  "enable_block_inflation": true,
+  "mint_denom": "ahetu",
+  "total_supply": "21000000000000000000000000",
+  "default_block_emission": "1000000000000000000",
+  "subnet_reward_base": "0.100000000000000000",
+  "subnet_reward_k": "0.100000000000000000",
+  "subnet_reward_max_ratio": "0.900000000000000000",
+  "subnet_moving_alpha": "0.000003000000000000",
+  "subnet_owner_cut": "0.150000000000000000",
+  "price_twap_window": "30",
+  "max_price_change_per_block": "0.050000000000000000",
+  "subnet_emission_mode": "price",
+  "root_weight_ratio": "0.500000000000000000",
+  "root_dividend_ratio": "0.100000000000000000",
+  "root_tempo": "100",
+  "active_minter": "blockinflation",
+  "lock_cost_policy": "burn",
+  "registration_cost_policy": "burn"
+}`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read params file: %w", err)
+			}
+
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+				return fmt.Errorf("failed to parse params file: %w", err)
+			}
+
+			authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+			msg := types.NewMsgUpdateParams(authority.String(), params)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewResumeSubnetEmissionProposalCmd implements the command to submit a
+// governance proposal resuming the suspended emission of a subnet.
+func NewResumeSubnetEmissionProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-subnet-emission-proposal NETUID",
+		Short: "Submit a proposal to resume the suspended emission of a subnet",
+		Long: `Submit a governance proposal to resume the emission of a subnet along with an initial deposit.
+The emission of a subnet is suspended when its coinbase fails, see the suspended-subnets query for the reason.`,
+		Example: fmt.Sprintf(`$ %s tx %s resume-subnet-emission-proposal 1 --title="Resume subnet 1" --summary="..." --deposit=10000000ahetu --from=<key_or_address>`,
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			netuid, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return fmt.Errorf("invalid netuid (must be 0-65535): %s", args[0])
+			}
+
+			authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+			msg := types.NewMsgResumeSubnetEmission(authority.String(), uint16(netuid))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -21,7 +21,8 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 		return err
 	}
 
-	// Synchronize the AMM pool status of all subnets every 20 blocks (previously 100 blocks)
+	// Reconcile the AMM pool status of all subnets with the contracts every 20 blocks.
+	// The pool state itself is tracked from the SubnetAMM events.
 	if ctx.BlockHeight()%20 == 0 {
 		k.Logger(ctx).Info("Periodic AMM pool sync", "height", ctx.BlockHeight())
 		k.SyncAllAMMPools(ctx)
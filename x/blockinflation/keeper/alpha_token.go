@@ -101,9 +101,9 @@ func (k Keeper) checkIfAuthorizedMinter(ctx sdk.Context, alphaTokenABI abi.ABI,
 	return isAuthorized, nil
 }
 
-// GetSubnet Manager Address Get Subnet Manager contract address
+// GetSubnetManagerAddress Get Subnet Manager contract address
 // Prioritize obtaining from environment variables. If the environment variable does not exist, attempt to read from the configuration file
-func getSubnetManagerAddress() string {
+func GetSubnetManagerAddress() string {
 	// 1. Get the address from the environment variable first
 	envVarName := "SUBNET_MANAGER_CONTRACT_ADDRESS"
 	if addr := os.Getenv(envVarName); addr != "" {
@@ -219,7 +219,7 @@ func (k Keeper) MintAlphaTokens(ctx sdk.Context, netuid uint16, recipient string
 
 		return fmt.Errorf("blockinflation module is not an authorized minter for subnet %d. "+
 			"Please ask the subnet owner to call addSubnetMinter(%d, %s) on the SubnetManager contract at %s",
-			netuid, netuid, moduleHexAddress, getSubnetManagerAddress())
+			netuid, netuid, moduleHexAddress, GetSubnetManagerAddress())
 	}
 
 	// 10. Call the mint function on the AlphaToken contract
@@ -19,7 +19,10 @@ import (
 	eventabi "github.com/hetu-project/hetu/v1/x/event/abi"
 )
 
-// SyncAMMPoolState Sync the chain state and the AMM pool state of the contract
+// SyncAMMPoolState reconciles the chain state with the AMM pool state of the contract.
+// The pool reserves are tracked from the SubnetAMM events by the event module, so
+// a difference here means that the chain state drifted from the pool (e.g. a
+// liquidity injection that failed): it is corrected and reported with an event.
 func (k Keeper) SyncAMMPoolState(ctx sdk.Context, netuid uint16) error {
 	k.Logger(ctx).Debug("Starting to sync AMM pool state", "netuid", netuid)
 
@@ -164,24 +167,35 @@ func (k Keeper) SyncAMMPoolState(ctx sdk.Context, netuid uint16) error {
 	// No longer use the AlphaOut value of the contract
 	// contractAlphaOut := math.NewIntFromBigInt(subnetAlphaOut)
 
-	// 8. Only update TaoIn and AlphaIn, do not update AlphaOut
-
-	// 8.1 Handle TaoIn
-	if !currentTaoIn.Equal(contractTaoIn) {
-		k.Logger(ctx).Info("Updating chain TaoIn to match contract",
+	// 8. Only reconcile TaoIn and AlphaIn, do not update AlphaOut
+	if !currentTaoIn.Equal(contractTaoIn) || !currentAlphaIn.Equal(contractAlphaIn) {
+		k.Logger(ctx).Info("AMM pool state drifted from the ingested pool events, reconciling with contract",
 			"netuid", netuid,
-			"old_tao_in", currentTaoIn.String(),
-			"new_tao_in", contractTaoIn.String())
-		k.eventKeeper.SetSubnetTaoIn(ctx, netuid, contractTaoIn)
-	}
+			"chain_tao_in", currentTaoIn.String(),
+			"contract_tao_in", contractTaoIn.String(),
+			"chain_alpha_in", currentAlphaIn.String(),
+			"contract_alpha_in", contractAlphaIn.String())
+
+		// 8.1 Handle TaoIn
+		if !currentTaoIn.Equal(contractTaoIn) {
+			k.eventKeeper.SetSubnetTaoIn(ctx, netuid, contractTaoIn)
+		}
 
-	// 8.2 Handle AlphaIn
-	if !currentAlphaIn.Equal(contractAlphaIn) {
-		k.Logger(ctx).Info("Updating chain AlphaIn to match contract",
-			"netuid", netuid,
-			"old_alpha_in", currentAlphaIn.String(),
-			"new_alpha_in", contractAlphaIn.String())
-		k.eventKeeper.SetSubnetAlphaIn(ctx, netuid, contractAlphaIn)
+		// 8.2 Handle AlphaIn
+		if !currentAlphaIn.Equal(contractAlphaIn) {
+			k.eventKeeper.SetSubnetAlphaIn(ctx, netuid, contractAlphaIn)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"amm_pool_reconciled",
+				sdk.NewAttribute("netuid", fmt.Sprintf("%d", netuid)),
+				sdk.NewAttribute("chain_tao_in", currentTaoIn.String()),
+				sdk.NewAttribute("contract_tao_in", contractTaoIn.String()),
+				sdk.NewAttribute("chain_alpha_in", currentAlphaIn.String()),
+				sdk.NewAttribute("contract_alpha_in", contractAlphaIn.String()),
+			),
+		)
 	}
 
 	// 8.3 No longer handle AlphaOut, keep the chain value
@@ -213,7 +227,7 @@ func (k Keeper) SyncAMMPoolState(ctx sdk.Context, netuid uint16) error {
 		k.eventKeeper.SetSubnetMovingPrice(ctx, netuid, contractMovingPriceDec)
 
 		// Then apply the EMA update for future price movements
-		k.eventKeeper.UpdateMovingPrice(ctx, netuid, params.SubnetMovingAlpha, halvingBlocks)
+		k.eventKeeper.UpdateMovingPrice(ctx, netuid, k.GetAlphaPriceTWAP(ctx, netuid), params.SubnetMovingAlpha, halvingBlocks)
 
 		k.Logger(ctx).Info("Successfully synchronized moving price from contract",
 			"netuid", netuid,
@@ -223,7 +237,7 @@ func (k Keeper) SyncAMMPoolState(ctx sdk.Context, netuid uint16) error {
 	return nil
 }
 
-// SyncAllAMMPools Sync the AMM pool state of all active subnets
+// SyncAllAMMPools reconciles the AMM pool state of all active subnets
 func (k Keeper) SyncAllAMMPools(ctx sdk.Context) {
 	k.Logger(ctx).Debug("Starting to sync all AMM pools")
 
@@ -311,10 +325,11 @@ func (k Keeper) SyncChainStateToContract(ctx sdk.Context, netuid uint16, reward
 		}
 		whetuAddr := common.HexToAddress(whetuAddress)
 
-		// 1. Mint the Cosmos native HETU token
+		// 1. Mint the Cosmos native HETU token under the supply cap
 		params := k.GetParams(ctx)
 		minted := sdk.NewCoins(sdk.NewCoin(params.MintDenom, reward.TaoIn))
-		if err := k.bankKeeper.MintCoins(ctx, blockinflationtypes.ModuleName, minted); err != nil {
+		issuance := k.GetTotalIssuance(ctx)
+		if err := k.MintSupply(ctx, blockinflationtypes.MinterBlockInflation, minted[0]); err != nil {
 			return fmt.Errorf("failed to mint HETU tokens: %w", err)
 		}
 
@@ -323,6 +338,7 @@ func (k Keeper) SyncChainStateToContract(ctx sdk.Context, netuid uint16, reward
 		defer func() {
 			if rollback {
 				_ = k.bankKeeper.BurnCoins(ctx, blockinflationtypes.ModuleName, minted)
+				k.SetTotalIssuance(ctx, issuance)
 			}
 		}()
 
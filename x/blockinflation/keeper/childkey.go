@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// splitChildKeyDividends pays the parent keys of each hotkey the share of its
+// alpha dividend earned with the stake weight they lent it, pro rata to their
+// contribution to its effective stake. The hotkey keeps its child key take out
+// of each parent share, so the take is paid out with its own dividend.
+func (k Keeper) splitChildKeyDividends(ctx sdk.Context, netuid uint16, dividends map[string]math.Int) map[string]math.Int {
+	hotkeys := make([]string, 0, len(dividends))
+	for hotkey := range dividends {
+		hotkeys = append(hotkeys, hotkey)
+	}
+	sort.Strings(hotkeys)
+
+	split := make(map[string]math.Int, len(dividends))
+	for _, hotkey := range hotkeys {
+		split[hotkey] = dividends[hotkey]
+	}
+
+	for _, hotkey := range hotkeys {
+		dividend := dividends[hotkey]
+		if !dividend.IsPositive() {
+			continue
+		}
+		own, contributions := k.eventKeeper.GetStakeContributions(ctx, netuid, hotkey)
+		if len(contributions) == 0 {
+			continue
+		}
+		total := own
+		for _, contribution := range contributions {
+			total = total.Add(contribution.Amount)
+		}
+		if !total.IsPositive() {
+			continue
+		}
+
+		take := k.eventKeeper.GetChildKeyTake(ctx, netuid, hotkey)
+		for _, contribution := range contributions {
+			share := dividend.Mul(contribution.Amount).Quo(total)
+			childTake := take.MulInt(share).TruncateInt()
+			parentDividend := share.Sub(childTake)
+			if !parentDividend.IsPositive() {
+				continue
+			}
+
+			parent := common.HexToAddress(contribution.Parent).Hex()
+			if existing, found := split[parent]; found {
+				split[parent] = existing.Add(parentDividend)
+			} else {
+				split[parent] = parentDividend
+			}
+			split[hotkey] = split[hotkey].Sub(parentDividend)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					"childkey_dividend",
+					sdk.NewAttribute("netuid", fmt.Sprintf("%d", netuid)),
+					sdk.NewAttribute("child", hotkey),
+					sdk.NewAttribute("parent", parent),
+					sdk.NewAttribute("amount", parentDividend.String()),
+					sdk.NewAttribute("child_take", childTake.String()),
+				),
+			)
+		}
+	}
+	return split
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
+	eventtypes "github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+func TestSplitChildKeyDividends(t *testing.T) {
+	k, ek, ctx := setupCoinbaseKeeper(t, blockinflationtypes.DefaultParams())
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	parent := common.HexToAddress("0x00000000000000000000000000000000000000a1").Hex()
+	child := common.HexToAddress("0x00000000000000000000000000000000000000c1").Hex()
+	ek.SetValidatorStake(ctx, eventtypes.ValidatorStake{Netuid: 1, Validator: parent, Amount: "1000"})
+	ek.SetValidatorStake(ctx, eventtypes.ValidatorStake{Netuid: 1, Validator: child, Amount: "200"})
+
+	dividends := map[string]math.Int{
+		parent: math.NewInt(100),
+		child:  math.NewInt(1000),
+	}
+
+	// without child keys the dividends are left untouched
+	require.Equal(t, dividends, k.splitChildKeyDividends(ctx, 1, dividends))
+	require.Equal(t, map[string]math.Int{parent: math.NewInt(1000), child: math.NewInt(200)}, k.getStakeMap(ctx, 1, []string{parent, child}))
+
+	// the parent lends 300 of its stake weight to the child, which keeps a 10% take
+	ek.SetChildKeys(ctx, eventtypes.ChildKeys{
+		Parent:   parent,
+		Netuid:   1,
+		Children: []eventtypes.ChildKey{{Child: child, Proportion: math.LegacyNewDecWithPrec(3, 1)}},
+	})
+	ek.SetChildKeyTake(ctx, eventtypes.ChildKeyTake{Child: child, Netuid: 1, Take: math.LegacyNewDecWithPrec(1, 1)})
+	require.Equal(t, map[string]math.Int{parent: math.NewInt(700), child: math.NewInt(500)}, k.getStakeMap(ctx, 1, []string{parent, child}))
+
+	// 3/5 of the child dividend was earned with the parent stake: 600, of
+	// which the child keeps 60
+	split := k.splitChildKeyDividends(ctx, 1, dividends)
+	require.Equal(t, math.NewInt(640), split[parent])
+	require.Equal(t, math.NewInt(460), split[child])
+	require.True(t, hasEvent(ctx.EventManager().Events(), "childkey_dividend"))
+
+	// the dividends of this block are unchanged
+	require.Equal(t, math.NewInt(1000), dividends[child])
+}
@@ -2,21 +2,18 @@ package keeper
 
 import (
 	"fmt"
-	stdmath "math"
 	"sort"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-
-	"math/big"
+	"github.com/ethereum/go-ethereum/common"
 
 	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
 	stakeworktypes "github.com/hetu-project/hetu/v1/x/stakework/types"
 )
 
 // CalculateAlphaEmission calculates the Alpha emission for a subnet based on its Alpha issuance
-// This uses the same logarithmic decay algorithm as CalculateBlockEmission
-// Improved version with high-precision calculations to avoid floating-point precision issues
+// This uses the same halving algorithm as CalculateBlockEmission against twice the total supply
 func (k Keeper) CalculateAlphaEmission(ctx sdk.Context, netuid uint16) (math.Int, error) {
 	params := k.GetParams(ctx)
 
@@ -40,97 +37,24 @@ func (k Keeper) CalculateAlphaEmission(ctx sdk.Context, netuid uint16) (math.Int
 		return math.ZeroInt(), nil
 	}
 
-	// Use high-precision math.LegacyDec calculations instead of float64
-	alphaIssuanceDec := alphaIssuance.ToLegacyDec()
-	totalSupplyDec := params.TotalSupply.ToLegacyDec()
-	defaultBlockEmissionDec := params.DefaultBlockEmission.ToLegacyDec()
-
-	// Add detailed logs
-	k.Logger(ctx).Debug("Alpha emission calculation parameters",
-		"netuid", netuid,
-		"alpha_issuance_dec", alphaIssuanceDec.String(),
-		"total_supply_dec", totalSupplyDec.String(),
-		"default_block_emission_dec", defaultBlockEmissionDec.String())
-
-	// Calculate the ratio: alpha_issuance / (2 * total_supply)
-	twoTimesTotalSupply := totalSupplyDec.Mul(math.LegacyNewDec(2))
-	ratio := alphaIssuanceDec.Quo(twoTimesTotalSupply)
-
-	// Add detailed logs
-	k.Logger(ctx).Debug("Alpha emission ratio calculation",
-		"netuid", netuid,
-		"two_times_total_supply", twoTimesTotalSupply.String(),
-		"ratio", ratio.String())
-
-	// If ratio >= 1.0, return 0
-	if ratio.GTE(math.LegacyOneDec()) {
-		k.Logger(ctx).Debug("Ratio >= 1.0, returning zero emission",
-			"netuid", netuid,
-			"ratio", ratio.String())
-		return math.ZeroInt(), nil
-	}
-
-	// Calculate log2(1 / (1 - ratio)) using high-precision arithmetic
-	// logArg = 1 / (1 - ratio)
-	oneMinusRatio := math.LegacyOneDec().Sub(ratio)
-	if oneMinusRatio.LTE(math.LegacyZeroDec()) {
-		k.Logger(ctx).Debug("1 - ratio <= 0, returning zero emission",
+	// Alpha emission halves against twice the total supply
+	alphaSupply := params.TotalSupply.MulRaw(2)
+	if alphaIssuance.GTE(alphaSupply) {
+		k.Logger(ctx).Debug("Alpha issuance reached the alpha supply, returning zero emission",
 			"netuid", netuid,
-			"one_minus_ratio", oneMinusRatio.String())
+			"alpha_issuance", alphaIssuance.String(),
+			"alpha_supply", alphaSupply.String())
 		return math.ZeroInt(), nil
 	}
 
-	logArg := math.LegacyOneDec().Quo(oneMinusRatio)
-
-	// Add detailed logs
-	k.Logger(ctx).Debug("Log argument calculation",
-		"netuid", netuid,
-		"one_minus_ratio", oneMinusRatio.String(),
-		"log_arg", logArg.String())
-
-	// Convert to float64 for log2 calculation (this is the only place we need float64)
-	logArgFloat := logArg.MustFloat64()
-	logResult := stdmath.Log2(logArgFloat)
-
-	// Floor the log result
-	flooredLog := stdmath.Floor(logResult)
-	flooredLogInt := int64(flooredLog)
+	halvingIndex := blockinflationtypes.HalvingIndex(alphaIssuance, alphaSupply)
+	alphaEmissionInt := blockinflationtypes.HalveEmission(params.DefaultBlockEmission, halvingIndex)
 
-	// Add detailed logs
-	k.Logger(ctx).Debug("Log calculation",
-		"netuid", netuid,
-		"log_arg_float", logArgFloat,
-		"log_result", logResult,
-		"floored_log", flooredLogInt)
-
-	// Calculate 2^flooredLog
-	multiplier := stdmath.Pow(2.0, float64(flooredLogInt))
-
-	// Calculate block emission percentage: 1 / multiplier
-	blockEmissionPercentage := math.LegacyOneDec().Quo(math.LegacyNewDecWithPrec(int64(multiplier*1000), 3))
-
-	// Add detailed logs
-	k.Logger(ctx).Debug("Emission percentage calculation",
-		"netuid", netuid,
-		"multiplier", multiplier,
-		"block_emission_percentage", blockEmissionPercentage.String())
-
-	// Calculate actual Alpha emission using high-precision arithmetic
-	alphaEmission := defaultBlockEmissionDec.Mul(blockEmissionPercentage)
-
-	// Convert back to math.Int with proper rounding
-	alphaEmissionInt := alphaEmission.TruncateInt()
-
-	k.Logger(ctx).Debug("calculated Alpha emission (high-precision)",
+	k.Logger(ctx).Debug("calculated Alpha emission",
 		"netuid", netuid,
 		"alpha_issuance", alphaIssuance.String(),
-		"total_supply", params.TotalSupply.String(),
-		"ratio", ratio.String(),
-		"log_arg", logArg.String(),
-		"log_result", fmt.Sprintf("%.6f", logResult),
-		"floored_log", flooredLogInt,
-		"multiplier", fmt.Sprintf("%.6f", multiplier),
-		"emission_percentage", blockEmissionPercentage.String(),
+		"alpha_supply", alphaSupply.String(),
+		"halving_index", halvingIndex,
 		"alpha_emission", alphaEmissionInt.String(),
 	)
 
@@ -139,22 +63,29 @@ func (k Keeper) CalculateAlphaEmission(ctx sdk.Context, netuid uint16) (math.Int
 
 // RunCoinbase executes the coinbase logic for distributing rewards to subnets
 // This is equivalent to the run_coinbase.rs function
+//
+// The injection, epoch and payout of each subnet run in their own cache
+// context and are only committed when all of them succeed. A subnet that
+// fails is rolled back completely and its emission is suspended until
+// governance resumes it, so a failure halfway through cannot leave the
+// reserves, pending emission and alpha payouts of the subnet out of sync.
+// The share of the emission that no subnet receives is taken back.
 func (k Keeper) RunCoinbase(ctx sdk.Context, blockEmission math.Int) error {
 	// --- 0. Get current block
 	currentBlock := ctx.BlockHeight()
 	k.Logger(ctx).Debug("Current block", "block", currentBlock)
-	// --- 1. Get all netuids (filter out root)
+	// --- 1. Get all netuids (the root network is filtered out)
 	allSubnets := k.eventKeeper.GetAllSubnetNetuids(ctx)
 	k.Logger(ctx).Debug("All subnet netuids", "subnets", allSubnets)
 
-	// Filter out subnets with no first emission block number
-	subnetsToEmitTo := k.eventKeeper.GetSubnetsToEmitTo(ctx)
+	// Filter out subnets with no first emission block number and subnets with suspended emission
+	subnetsToEmitTo := k.filterSuspendedSubnets(ctx, k.eventKeeper.GetSubnetsToEmitTo(ctx))
 	k.Logger(ctx).Debug("Subnets to emit to", "subnets", subnetsToEmitTo)
 
-	// If no subnets to emit to, return early
+	// If no subnets to emit to, take back the emission and return early
 	if len(subnetsToEmitTo) == 0 {
 		k.Logger(ctx).Info("No subnets to emit to, skipping coinbase")
-		return nil
+		return k.unmintSubnetEmission(ctx, blockEmission)
 	}
 
 	// --- 2. Get sum of moving prices
@@ -172,269 +103,321 @@ func (k Keeper) RunCoinbase(ctx sdk.Context, blockEmission math.Int) error {
 	}
 	k.Logger(ctx).Debug("Total moving prices", "total", totalMovingPrices)
 
+	// --- 2a. Root network: run the root epoch and pay the root dividends in HETU,
+	// the rest of the emission goes to the subnets
+	subnetEmission := blockEmission
+	if k.GetParams(ctx).SubnetEmissionMode != blockinflationtypes.EmissionModePrice {
+		subnetEmission = k.runRootNetwork(ctx, blockEmission, subnetsToEmitTo)
+	}
+
 	// --- 3. Calculate subnet terms (tao_in, alpha_in, alpha_out)
-	rewards, err := k.CalculateSubnetRewards(ctx, blockEmission, subnetsToEmitTo)
+	rewards, err := k.CalculateSubnetRewards(ctx, subnetEmission, subnetsToEmitTo)
 	if err != nil {
 		k.Logger(ctx).Error("failed to calculate subnet rewards", "error", err)
 		return err
 	}
 
-	// --- 4. Injection - Add rewards to subnet pools
-	// Apply the calculated rewards (alpha_in, alpha_out, tao_in)
-	if err := k.ApplySubnetRewards(ctx, rewards); err != nil {
-		k.Logger(ctx).Error("failed to apply subnet rewards", "error", err)
+	// --- 4-8. Inject, drain and pay out each subnet atomically
+	emitted := make([]uint16, 0, len(subnetsToEmitTo))
+	suspendedEmission := math.ZeroInt()
+	for _, netuid := range subnetsToEmitTo {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.runSubnetCoinbase(cacheCtx, netuid, rewards[netuid]); err != nil {
+			k.SuspendSubnetEmission(ctx, netuid, err.Error())
+			suspendedEmission = suspendedEmission.Add(rewards[netuid].TaoIn)
+			continue
+		}
+		write()
+		emitted = append(emitted, netuid)
+	}
+
+	// The share of the subnets suspended in this block is not emitted
+	if err := k.unmintSubnetEmission(ctx, suspendedEmission); err != nil {
 		return err
 	}
 
-	// --- New: Sync the chain state to the contract, only inject the HETU token
-	for netuid, reward := range rewards {
-		if err := k.SyncChainStateToContract(ctx, netuid, reward); err != nil {
-			k.Logger(ctx).Error("Failed to sync chain state to contract",
+	// --- 9. Reconcile the AMM pool state with the contracts
+	for _, netuid := range emitted {
+		if err := k.SyncAMMPoolState(ctx, netuid); err != nil {
+			k.Logger(ctx).Error("Failed to sync AMM pool state",
 				"netuid", netuid,
 				"error", err,
 			)
-			// Continue to process other subnets, don't interrupt the entire process because of the synchronization failure
 		}
 	}
 
+	// Emit event for coinbase execution
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"coinbase_executed",
+			sdk.NewAttribute("block_height", fmt.Sprintf("%d", currentBlock)),
+			sdk.NewAttribute("block_emission", blockEmission.String()),
+			sdk.NewAttribute("subnets_count", fmt.Sprintf("%d", len(emitted))),
+			sdk.NewAttribute("suspended_count", fmt.Sprintf("%d", len(subnetsToEmitTo)-len(emitted))),
+			sdk.NewAttribute("total_moving_prices", totalMovingPrices.String()),
+		),
+	)
+
+	k.Logger(ctx).Info("Coinbase executed successfully",
+		"block", currentBlock,
+		"emission", blockEmission.String(),
+		"subnets", len(emitted),
+		"suspended", len(subnetsToEmitTo)-len(emitted),
+	)
+
+	return nil
+}
+
+// unmintSubnetEmission takes back the part of the subnet emission minted for
+// this block that no subnet receives. It is burned without counting as burned
+// supply and removed from the pending subnet rewards, so it is left out of
+// the mint of the block rather than accrued for subnets that cannot be paid.
+func (k Keeper) unmintSubnetEmission(ctx sdk.Context, amount math.Int) error {
+	pending := k.GetPendingSubnetRewards(ctx)
+	amount = math.MinInt(amount, pending.Amount)
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	coin := sdk.NewCoin(pending.Denom, amount)
+	if err := k.bankKeeper.BurnCoins(ctx, blockinflationtypes.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return fmt.Errorf("failed to burn unemitted subnet emission: %w", err)
+	}
+	k.decreaseTotalIssuance(ctx, coin)
+	k.SetPendingSubnetRewards(ctx, pending.Sub(coin))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"subnet_emission_unminted",
+			sdk.NewAttribute("amount", amount.String()),
+		),
+	)
+	return nil
+}
+
+// runSubnetCoinbase injects the rewards of this block into a subnet, updates
+// its moving price and drains its pending emission when its epoch is due. Any
+// error aborts the whole subnet step, the caller discards its state changes.
+func (k Keeper) runSubnetCoinbase(ctx sdk.Context, netuid uint16, reward blockinflationtypes.SubnetRewards) error {
+	rewards := map[uint16]blockinflationtypes.SubnetRewards{netuid: reward}
+
+	// --- 4. Injection - Add rewards to subnet pools
+	// Apply the calculated rewards (alpha_in, alpha_out, tao_in)
+	if err := k.ApplySubnetRewards(ctx, rewards); err != nil {
+		return fmt.Errorf("failed to apply subnet rewards: %w", err)
+	}
+
+	// --- New: Sync the chain state to the contract, only inject the HETU token
+	// The sync mints before calling the contracts, so it runs in its own cache
+	// and a partial sync is discarded rather than committed with the subnet step
+	syncCtx, writeSync := ctx.CacheContext()
+	if err := k.SyncChainStateToContract(syncCtx, netuid, reward); err != nil {
+		k.Logger(ctx).Error("Failed to sync chain state to contract",
+			"netuid", netuid,
+			"error", err,
+		)
+		// Don't interrupt the subnet because of the synchronization failure, the pool is reconciled with the contract below
+	} else {
+		writeSync()
+	}
+
 	// --- 5. Calculate owner cuts and update alpha_out
 	// Calculate owner cuts and subtract from alpha_out
 	if err := k.CalculateOwnerCuts(ctx, rewards); err != nil {
-		k.Logger(ctx).Error("failed to calculate owner cuts", "error", err)
-		return err
+		return fmt.Errorf("failed to calculate owner cuts: %w", err)
 	}
 
 	// --- 6. Add alpha_out to pending emission for each subnet
-	// Since there's no root subnet, add alpha_out to pending emission for each subnet
+	// Root stakers are paid in HETU, so all of alpha_out goes to the pending emission of each subnet
 	if err := k.AddToPendingEmission(ctx, rewards); err != nil {
-		k.Logger(ctx).Error("failed to add to pending emission", "error", err)
-		return err
+		return fmt.Errorf("failed to add to pending emission: %w", err)
+	}
+
+	subnet, exists := k.eventKeeper.GetSubnet(ctx, netuid)
+	if !exists {
+		return fmt.Errorf("subnet not found: %d", netuid)
 	}
 
 	// --- 7. Update moving prices after using them in the emission calculation
-	// Only update price EMA for subnets that we emit to
-	for _, netuid := range subnetsToEmitTo {
-		// Get subnet to access EMAPriceHalvingBlocks
-		subnet, exists := k.eventKeeper.GetSubnet(ctx, netuid)
-		if !exists {
-			k.Logger(ctx).Error("subnet not found for moving price update", "netuid", netuid)
-			continue
-		}
+	// Get moving alpha from blockinflation params
+	params := k.GetParams(ctx)
+	movingAlpha := params.SubnetMovingAlpha
+	halvingBlocks := subnet.EMAPriceHalvingBlocks
+
+	// Feed the clamped TWAP rather than the spot price into the EMA, so that
+	// a swap right before the block cannot skew the next tao_in split
+	price := k.ObserveAlphaPrice(ctx, netuid)
 
-		// Get moving alpha from blockinflation params
-		params := k.GetParams(ctx)
-		movingAlpha := params.SubnetMovingAlpha
-		halvingBlocks := subnet.EMAPriceHalvingBlocks
+	// Update moving prices after using them above
+	k.eventKeeper.UpdateMovingPrice(ctx, netuid, price, movingAlpha, halvingBlocks)
 
-		// Update moving prices after using them above
-		k.eventKeeper.UpdateMovingPrice(ctx, netuid, movingAlpha, halvingBlocks)
+	// --- 8. Drain pending emission through the subnet based on tempo (epoch)
+	epochParams, err := stakeworktypes.ParseEpochParams(subnet.Params)
+	if err != nil {
+		return fmt.Errorf("failed to parse epoch parameters: %w", err)
+	}
+	if !k.stakeworkKeeper.ShouldRunEpoch(ctx, netuid, epochParams.Tempo) {
+		blocks := k.eventKeeper.GetBlocksSinceLastStep(ctx, netuid)
+		k.eventKeeper.SetBlocksSinceLastStep(ctx, netuid, blocks+1)
+		k.Logger(ctx).Debug("Not epoch, increment counter", "netuid", netuid, "blocks_since_last", blocks+1)
+		return nil
 	}
-	k.Logger(ctx).Debug("Moving prices updated")
 
-	// --- 7. Drain pending emission through the subnet based on tempo (epoch)
-	for _, netuid := range subnetsToEmitTo {
-		subnet, exists := k.eventKeeper.GetSubnet(ctx, netuid)
-		if !exists {
-			k.Logger(ctx).Error("subnet not found", "netuid", netuid)
-			continue
-		}
-		params, err := stakeworktypes.ParseEpochParams(subnet.Params)
-		if err != nil {
-			k.Logger(ctx).Error("failed to parse epoch parameters", "netuid", netuid, "err", err)
-			continue
-		}
-		tempo := params.Tempo
-		if k.stakeworkKeeper.ShouldRunEpoch(ctx, netuid, tempo) {
-			k.Logger(ctx).Debug("Epoch triggered", "netuid", netuid, "block", ctx.BlockHeight())
-
-			// Reset counters
-			k.eventKeeper.SetBlocksSinceLastStep(ctx, netuid, 0)
-			k.eventKeeper.SetLastMechanismStepBlock(ctx, netuid, ctx.BlockHeight())
-
-			// Extract and clear pending emission and owner cut
-			pendingAlpha := k.eventKeeper.GetPendingEmission(ctx, netuid)
-			k.eventKeeper.SetPendingEmission(ctx, netuid, math.ZeroInt())
-			ownerCut := k.eventKeeper.GetPendingOwnerCut(ctx, netuid)
-			k.eventKeeper.SetPendingOwnerCut(ctx, netuid, math.ZeroInt())
-
-			k.Logger(ctx).Debug("Draining pending emission", "netuid", netuid, "pending_alpha", pendingAlpha.String(), "owner_cut", ownerCut.String())
-
-			// Run epoch consensus
-			epochResult, err := k.stakeworkKeeper.RunEpoch(ctx, netuid, pendingAlpha)
-			if err != nil {
-				k.Logger(ctx).Error("RunEpoch failed", "netuid", netuid, "error", err)
-				continue
-			}
+	return k.drainPendingEmission(ctx, netuid, subnet.Owner)
+}
 
-			// Calculate incentive sum
-			incentiveSum := math.ZeroInt()
-			for _, v := range epochResult.Incentive {
-				incentiveSum = incentiveSum.Add(v)
-			}
+// drainPendingEmission runs the epoch of a subnet and pays out its pending
+// emission to the validators, miners and the subnet owner
+func (k Keeper) drainPendingEmission(ctx sdk.Context, netuid uint16, owner string) error {
+	k.Logger(ctx).Debug("Epoch triggered", "netuid", netuid, "block", ctx.BlockHeight())
 
-			// Calculate validator-allocatable alpha
-			var pendingValidatorAlpha math.Int
-			if !incentiveSum.IsZero() {
-				// Allocate half to the validator
-				pendingValidatorAlpha = pendingAlpha.QuoRaw(2)
-			} else {
-				// Allocate all to the validator
-				pendingValidatorAlpha = pendingAlpha
-			}
+	// Reset counters
+	k.eventKeeper.SetBlocksSinceLastStep(ctx, netuid, 0)
+	k.eventKeeper.SetLastMechanismStepBlock(ctx, netuid, ctx.BlockHeight())
 
-			// Build dividend account list
-			dividendAccounts := make([]string, len(epochResult.Accounts))
-			copy(dividendAccounts, epochResult.Accounts)
+	// Extract and clear pending emission and owner cut
+	pendingAlpha := k.eventKeeper.GetPendingEmission(ctx, netuid)
+	k.eventKeeper.SetPendingEmission(ctx, netuid, math.ZeroInt())
+	ownerCut := k.eventKeeper.GetPendingOwnerCut(ctx, netuid)
+	k.eventKeeper.SetPendingOwnerCut(ctx, netuid, math.ZeroInt())
 
-			// Get subnet stake amounts
-			stakeMap := k.getStakeMap(ctx, netuid, dividendAccounts)
-			k.Logger(ctx).Debug("Stake map", "netuid", netuid, "stake_map", stakeMap)
+	k.Logger(ctx).Debug("Draining pending emission", "netuid", netuid, "pending_alpha", pendingAlpha.String(), "owner_cut", ownerCut.String())
 
-			// Calculate dividends
-			dividends := make(map[string]math.Int)
-			for i, addr := range epochResult.Accounts {
-				dividends[addr] = epochResult.Dividend[i]
-			}
-			totalAlphaDivs := math.ZeroInt()
-			for _, v := range dividends {
-				totalAlphaDivs = totalAlphaDivs.Add(v)
-			}
+	// Record the subnet price and emission of this tempo
+	k.eventKeeper.RecordPriceSnapshot(ctx, netuid, pendingAlpha, ownerCut)
 
-			// Dividend allocation (no parent-child relationship, direct allocation, weight by subnet stake)
-			alphaDividends := make(map[string]math.Int)
-			if !totalAlphaDivs.IsZero() && !pendingValidatorAlpha.IsZero() {
-				// Allocate proportionally
-				for addr, d := range dividends {
-					// Calculate the allocation ratio: d / totalAlphaDivs * pendingValidatorAlpha
-					ratio := math.LegacyNewDecFromInt(d).QuoInt(totalAlphaDivs)
-					alloc := ratio.MulInt(pendingValidatorAlpha).TruncateInt()
-					alphaDividends[addr] = alloc
-				}
-
-				// Check if there are any remaining undistributed rewards
-				allocated := math.ZeroInt()
-				for _, amount := range alphaDividends {
-					allocated = allocated.Add(amount)
-				}
-
-				// If there are remaining, allocate in address order
-				if allocated.LT(pendingValidatorAlpha) {
-					remainder := pendingValidatorAlpha.Sub(allocated)
-					addrs := make([]string, 0, len(dividends))
-					for a := range dividends {
-						addrs = append(addrs, a)
-					}
-					sort.Strings(addrs)
-
-					// Allocate 1 unit to each address until finished
-					oneUnit := math.NewInt(1)
-					for i := 0; i < len(addrs) && !remainder.IsZero(); i++ {
-						alphaDividends[addrs[i]] = alphaDividends[addrs[i]].Add(oneUnit)
-						remainder = remainder.Sub(oneUnit)
-					}
-				}
-			}
-			k.Logger(ctx).Debug("Alpha dividends", "netuid", netuid, "alpha_dividends", alphaDividends)
+	// Run epoch consensus
+	epochResult, err := k.stakeworkKeeper.RunEpoch(ctx, netuid, pendingAlpha)
+	if err != nil {
+		return fmt.Errorf("RunEpoch failed: %w", err)
+	}
 
-			// Incentive allocation
-			incentives := make(map[string]math.Int)
-			for i, addr := range epochResult.Accounts {
-				incentives[addr] = epochResult.Incentive[i]
-			}
-			k.Logger(ctx).Debug("Incentives", "netuid", netuid, "incentives", incentives)
-
-			// Log distribution
-			for addr, amount := range alphaDividends {
-				k.Logger(ctx).Info("Alpha dividend distributed", "netuid", netuid, "account", addr, "amount", amount.String())
-				if err := k.MintAlphaTokens(ctx, netuid, addr, amount.BigInt()); err != nil {
-					k.Logger(ctx).Error("Failed to mint alpha tokens for validator dividend",
-						"netuid", netuid,
-						"address", addr,
-						"amount", amount.String(),
-						"error", err,
-					)
-				}
-			}
-			for addr, amount := range incentives {
-				if err := k.MintAlphaTokens(ctx, netuid, addr, amount.BigInt()); err != nil {
-					k.Logger(ctx).Error("Failed to mint alpha tokens for incentives",
-						"netuid", netuid,
-						"address", addr,
-						"amount", amount.String(),
-						"error", err,
-					)
-				}
+	// Calculate incentive sum
+	incentiveSum := math.ZeroInt()
+	for _, v := range epochResult.Incentive {
+		incentiveSum = incentiveSum.Add(v)
+	}
+
+	// Calculate validator-allocatable alpha
+	var pendingValidatorAlpha math.Int
+	if !incentiveSum.IsZero() {
+		// Allocate half to the validator
+		pendingValidatorAlpha = pendingAlpha.QuoRaw(2)
+	} else {
+		// Allocate all to the validator
+		pendingValidatorAlpha = pendingAlpha
+	}
+
+	// Build dividend account list
+	dividendAccounts := make([]string, len(epochResult.Accounts))
+	copy(dividendAccounts, epochResult.Accounts)
+
+	// Get subnet effective stake amounts, including the stake lent by parent keys
+	stakeMap := k.getStakeMap(ctx, netuid, dividendAccounts)
+	k.Logger(ctx).Debug("Stake map", "netuid", netuid, "stake_map", stakeMap)
+
+	// Calculate dividends
+	dividends := make(map[string]math.Int)
+	for i, addr := range epochResult.Accounts {
+		dividends[addr] = epochResult.Dividend[i]
+	}
+	totalAlphaDivs := math.ZeroInt()
+	for _, v := range dividends {
+		totalAlphaDivs = totalAlphaDivs.Add(v)
+	}
+
+	// Dividend allocation, weight by subnet stake
+	alphaDividends := make(map[string]math.Int)
+	if !totalAlphaDivs.IsZero() && !pendingValidatorAlpha.IsZero() {
+		// Allocate proportionally
+		for addr, d := range dividends {
+			// Calculate the allocation ratio: d / totalAlphaDivs * pendingValidatorAlpha
+			ratio := math.LegacyNewDecFromInt(d).QuoInt(totalAlphaDivs)
+			alloc := ratio.MulInt(pendingValidatorAlpha).TruncateInt()
+			alphaDividends[addr] = alloc
+		}
+
+		// Check if there are any remaining undistributed rewards
+		allocated := math.ZeroInt()
+		for _, amount := range alphaDividends {
+			allocated = allocated.Add(amount)
+		}
+
+		// If there are remaining, allocate in address order
+		if allocated.LT(pendingValidatorAlpha) {
+			remainder := pendingValidatorAlpha.Sub(allocated)
+			addrs := make([]string, 0, len(dividends))
+			for a := range dividends {
+				addrs = append(addrs, a)
 			}
-			if ownerCut.IsPositive() {
-				k.Logger(ctx).Info("Owner cut distributed", "netuid", netuid, "amount", ownerCut.String())
-				if err := k.MintAlphaTokens(ctx, netuid, subnet.Owner, ownerCut.BigInt()); err != nil {
-					k.Logger(ctx).Error("Failed to mint alpha tokens for subnet owner",
-						"netuid", netuid,
-						"owner", subnet.Owner,
-						"amount", ownerCut.String(),
-						"error", err,
-					)
-				}
+			sort.Strings(addrs)
+
+			// Allocate 1 unit to each address until finished
+			oneUnit := math.NewInt(1)
+			for i := 0; i < len(addrs) && !remainder.IsZero(); i++ {
+				alphaDividends[addrs[i]] = alphaDividends[addrs[i]].Add(oneUnit)
+				remainder = remainder.Sub(oneUnit)
 			}
-		} else {
-			blocks := k.eventKeeper.GetBlocksSinceLastStep(ctx, netuid)
-			k.eventKeeper.SetBlocksSinceLastStep(ctx, netuid, blocks+1)
-			k.Logger(ctx).Debug("Not epoch, increment counter", "netuid", netuid, "blocks_since_last", blocks+1)
 		}
 	}
 
-	// --- 8. Drain pending emission (placeholder)
-	// TODO: Implement epoch-based emission draining
-	k.Logger(ctx).Debug("Pending emission drained")
+	// Pay the parent keys their share of the dividends earned with their stake weight
+	alphaDividends = k.splitChildKeyDividends(ctx, netuid, alphaDividends)
+	// Credit the delegators their share of the dividends after the validator take
+	alphaDividends = k.splitDelegatorDividends(ctx, netuid, alphaDividends)
+	k.Logger(ctx).Debug("Alpha dividends", "netuid", netuid, "alpha_dividends", alphaDividends)
 
-	// --- 9. Sync the AMM pool state
-	for _, netuid := range subnetsToEmitTo {
-		if err := k.SyncAMMPoolState(ctx, netuid); err != nil {
-			k.Logger(ctx).Error("Failed to sync AMM pool state",
-				"netuid", netuid,
-				"error", err,
-			)
-		}
+	// Incentive allocation
+	incentives := make(map[string]math.Int)
+	for i, addr := range epochResult.Accounts {
+		incentives[addr] = epochResult.Incentive[i]
 	}
+	k.Logger(ctx).Debug("Incentives", "netuid", netuid, "incentives", incentives)
 
-	// Emit event for coinbase execution
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"coinbase_executed",
-			sdk.NewAttribute("block_height", fmt.Sprintf("%d", currentBlock)),
-			sdk.NewAttribute("block_emission", blockEmission.String()),
-			sdk.NewAttribute("subnets_count", fmt.Sprintf("%d", len(subnetsToEmitTo))),
-			sdk.NewAttribute("total_moving_prices", totalMovingPrices.String()),
-		),
-	)
-
-	k.Logger(ctx).Info("Coinbase executed successfully",
-		"block", currentBlock,
-		"emission", blockEmission.String(),
-		"subnets", len(subnetsToEmitTo),
-	)
+	// Pay out in address order, the first failure aborts the whole subnet
+	dividendAddrs := make([]string, 0, len(alphaDividends))
+	for addr := range alphaDividends {
+		dividendAddrs = append(dividendAddrs, addr)
+	}
+	sort.Strings(dividendAddrs)
+	for _, addr := range dividendAddrs {
+		amount := alphaDividends[addr]
+		k.Logger(ctx).Info("Alpha dividend distributed", "netuid", netuid, "account", addr, "amount", amount.String())
+		if err := k.MintAlphaTokens(ctx, netuid, addr, amount.BigInt()); err != nil {
+			return fmt.Errorf("failed to mint alpha dividend of %s for %s: %w", amount, addr, err)
+		}
+	}
+	incentiveAddrs := make([]string, 0, len(incentives))
+	for addr := range incentives {
+		incentiveAddrs = append(incentiveAddrs, addr)
+	}
+	sort.Strings(incentiveAddrs)
+	for _, addr := range incentiveAddrs {
+		amount := incentives[addr]
+		if err := k.MintAlphaTokens(ctx, netuid, addr, amount.BigInt()); err != nil {
+			return fmt.Errorf("failed to mint alpha incentive of %s for %s: %w", amount, addr, err)
+		}
+	}
+	if ownerCut.IsPositive() {
+		k.Logger(ctx).Info("Owner cut distributed", "netuid", netuid, "amount", ownerCut.String())
+		if err := k.MintAlphaTokens(ctx, netuid, owner, ownerCut.BigInt()); err != nil {
+			return fmt.Errorf("failed to mint owner cut of %s for %s: %w", ownerCut, owner, err)
+		}
+	}
 
 	return nil
 }
 
-// getStakeMap retrieves stake amount for each account in this subnet (no parent subnet)
-func (k Keeper) getStakeMap(ctx sdk.Context, netuid uint16, accounts []string) map[string]uint64 {
-	stakeMap := map[string]uint64{}
-	stakes := k.eventKeeper.GetAllValidatorStakesByNetuid(ctx, netuid)
+// getStakeMap retrieves the effective stake of each account in this subnet
+func (k Keeper) getStakeMap(ctx sdk.Context, netuid uint16, accounts []string) map[string]math.Int {
+	effective := k.eventKeeper.GetEffectiveStakes(ctx, netuid)
+	stakeMap := make(map[string]math.Int, len(accounts))
 	for _, acc := range accounts {
-		stakeMap[acc] = 0
-		for _, s := range stakes {
-			if s.Validator == acc {
-				if amount, ok := new(big.Int).SetString(s.Amount, 10); ok {
-					stakeMap[acc] += amount.Uint64()
-				} else {
-					k.Logger(ctx).Error("invalid stake amount string",
-						"netuid", netuid,
-						"validator", acc,
-						"amount", s.Amount)
-				}
-			}
+		stake, found := effective[common.HexToAddress(acc).Hex()]
+		if !found {
+			stake = math.ZeroInt()
 		}
+		stakeMap[acc] = stake
 	}
 	return stakeMap
 }
@@ -471,7 +454,7 @@ func (k Keeper) GetSubnetEmissionData(ctx sdk.Context, netuid uint16) (blockinfl
 		AlphaIn:                reward.AlphaIn,
 		AlphaOut:               reward.AlphaOut,
 		OwnerCut:               reward.OwnerCut,
-		RootDivs:               math.ZeroInt(), // No root dividends - no root subnet
+		RootDivs:               math.ZeroInt(), // Root dividends are paid in HETU, not alpha
 		SubnetAlphaInEmission:  k.eventKeeper.GetSubnetAlphaInEmission(ctx, netuid),
 		SubnetAlphaOutEmission: k.eventKeeper.GetSubnetAlphaOutEmission(ctx, netuid),
 		SubnetTaoInEmission:    k.eventKeeper.GetSubnetTaoInEmission(ctx, netuid),
@@ -508,7 +491,7 @@ func (k Keeper) GetAllSubnetEmissionData(ctx sdk.Context) []blockinflationtypes.
 				AlphaIn:                reward.AlphaIn,
 				AlphaOut:               reward.AlphaOut,
 				OwnerCut:               reward.OwnerCut,
-				RootDivs:               math.ZeroInt(), // No root dividends - no root subnet
+				RootDivs:               math.ZeroInt(), // Root dividends are paid in HETU, not alpha
 				SubnetAlphaInEmission:  k.eventKeeper.GetSubnetAlphaInEmission(ctx, netuid),
 				SubnetAlphaOutEmission: k.eventKeeper.GetSubnetAlphaOutEmission(ctx, netuid),
 				SubnetTaoInEmission:    k.eventKeeper.GetSubnetTaoInEmission(ctx, netuid),
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// splitDelegatorDividends credits the delegators of each validator their share
+// of its alpha dividend as claimable dividends. The validator first keeps its
+// take, the rest is split pro rata between its own stake and the stake
+// delegated to it. The validator keeps its own share and the rounding dust.
+func (k Keeper) splitDelegatorDividends(ctx sdk.Context, netuid uint16, dividends map[string]math.Int) map[string]math.Int {
+	validators := make([]string, 0, len(dividends))
+	for validator := range dividends {
+		validators = append(validators, validator)
+	}
+	sort.Strings(validators)
+
+	split := make(map[string]math.Int, len(dividends))
+	for _, validator := range validators {
+		split[validator] = dividends[validator]
+	}
+
+	for _, validator := range validators {
+		dividend := dividends[validator]
+		if !dividend.IsPositive() {
+			continue
+		}
+		delegations := k.eventKeeper.GetDelegationsByValidator(ctx, netuid, common.HexToAddress(validator).Hex())
+		if len(delegations) == 0 {
+			continue
+		}
+
+		delegated := math.ZeroInt()
+		amounts := make([]math.Int, len(delegations))
+		for i, delegation := range delegations {
+			amount, ok := math.NewIntFromString(delegation.Amount)
+			if !ok || !amount.IsPositive() {
+				amount = math.ZeroInt()
+			}
+			amounts[i] = amount
+			delegated = delegated.Add(amount)
+		}
+		if !delegated.IsPositive() {
+			continue
+		}
+		own, _ := k.eventKeeper.GetStakeContributions(ctx, netuid, validator)
+		total := own.Add(delegated)
+
+		take := k.eventKeeper.GetValidatorTake(ctx, netuid, validator)
+		remaining := dividend.Sub(take.MulInt(dividend).TruncateInt())
+		paid := math.ZeroInt()
+		for i, delegation := range delegations {
+			share := remaining.Mul(amounts[i]).Quo(total)
+			if !share.IsPositive() {
+				continue
+			}
+			k.eventKeeper.AddClaimableDividend(ctx, netuid, delegation.Staker, share)
+			paid = paid.Add(share)
+		}
+		split[validator] = dividend.Sub(paid)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"delegator_dividends",
+				sdk.NewAttribute("netuid", fmt.Sprintf("%d", netuid)),
+				sdk.NewAttribute("validator", validator),
+				sdk.NewAttribute("take", take.String()),
+				sdk.NewAttribute("delegators", fmt.Sprintf("%d", len(delegations))),
+				sdk.NewAttribute("amount", paid.String()),
+			),
+		)
+	}
+	return split
+}
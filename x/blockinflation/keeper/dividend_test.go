@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
+	eventtypes "github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+func TestSplitDelegatorDividends(t *testing.T) {
+	k, ek, ctx := setupCoinbaseKeeper(t, blockinflationtypes.DefaultParams())
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	validator := common.HexToAddress("0x00000000000000000000000000000000000000a1").Hex()
+	alice := common.HexToAddress("0x00000000000000000000000000000000000000d1").Hex()
+	bob := common.HexToAddress("0x00000000000000000000000000000000000000d2").Hex()
+	ek.SetValidatorStake(ctx, eventtypes.ValidatorStake{Netuid: 1, Validator: validator, Amount: "500"})
+
+	dividends := map[string]math.Int{validator: math.NewInt(1000)}
+
+	// without delegations the validator keeps its whole dividend
+	require.Equal(t, dividends, k.splitDelegatorDividends(ctx, 1, dividends))
+
+	// 500 own stake, 300 and 200 delegated, with a 10% take
+	ek.SetDelegation(ctx, eventtypes.Delegation{Netuid: 1, Validator: validator, Staker: alice, Amount: "300"})
+	ek.SetDelegation(ctx, eventtypes.Delegation{Netuid: 1, Validator: validator, Staker: bob, Amount: "200"})
+	ek.SetValidatorTake(ctx, eventtypes.ValidatorTake{Validator: validator, Netuid: 1, Take: math.LegacyNewDecWithPrec(1, 1)})
+
+	// 900 is left after the take: 270 for alice, 180 for bob
+	split := k.splitDelegatorDividends(ctx, 1, dividends)
+	require.Equal(t, math.NewInt(550), split[validator])
+	require.Equal(t, math.NewInt(270), ek.GetClaimableDividend(ctx, 1, alice))
+	require.Equal(t, math.NewInt(180), ek.GetClaimableDividend(ctx, 1, bob))
+	require.True(t, hasEvent(ctx.EventManager().Events(), "delegator_dividends"))
+
+	// the delegator dividends accumulate until they are claimed
+	k.splitDelegatorDividends(ctx, 1, dividends)
+	require.Equal(t, math.NewInt(540), ek.GetClaimableDividend(ctx, 1, alice))
+}
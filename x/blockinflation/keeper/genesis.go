@@ -14,15 +14,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data *blockinf
 		panic("blockinflation: nil genesis state")
 	}
 
-	// Validate parameters
-	if data.Params.MintDenom == "" {
-		panic("blockinflation: params.mint_denom must not be empty")
-	}
-	// TODO: If Params exposes a Validate() or ValidateBasic(), call it here.
-	// if err := data.Params.Validate(); err != nil { panic(fmt.Errorf("invalid blockinflation params: %w", err)) }
-
 	// Set parameters
-	k.SetParams(ctx, data.Params)
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(fmt.Errorf("blockinflation: invalid params: %w", err))
+	}
 
 	// Debug: Params being set
 	k.Logger(ctx).Debug("InitGenesis: setting params", "params", data.Params)
@@ -67,6 +62,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data *blockinf
 	}
 	k.SetPendingSubnetRewards(ctx, data.PendingSubnetRewards)
 
+	// Set suspended subnets
+	for _, suspension := range data.SuspendedSubnets {
+		k.SetSubnetEmissionSuspension(ctx, suspension)
+	}
+
+	// Set subnet costs
+	for _, costs := range data.SubnetCosts {
+		k.SetSubnetCosts(ctx, costs)
+	}
+
 	k.Logger(ctx).Info("blockinflation: initialized genesis state",
 		"total_issuance", data.TotalIssuance.String(),
 		"total_burned", data.TotalBurned.String(),
@@ -81,5 +86,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) *blockinflat
 		TotalIssuance:        k.GetTotalIssuance(ctx),
 		TotalBurned:          k.GetTotalBurned(ctx),
 		PendingSubnetRewards: k.GetPendingSubnetRewards(ctx),
+		SuspendedSubnets:     k.GetAllSubnetEmissionSuspensions(ctx),
+		SubnetCosts:          k.GetAllSubnetCosts(ctx),
 	}
 }
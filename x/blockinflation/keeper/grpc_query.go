@@ -2,11 +2,13 @@ package keeper
 
 import (
 	"context"
+	stdmath "math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
 	pb "github.com/hetu-project/hetu/v1/x/blockinflation/types/generated"
 )
 
@@ -20,15 +22,24 @@ func (k Keeper) Params(c context.Context, req *pb.QueryParamsRequest) (*pb.Query
 	params := k.GetParams(ctx)
 
 	protoParams := &pb.Params{
-		EnableBlockInflation: params.EnableBlockInflation,
-		MintDenom:            params.MintDenom,
-		TotalSupply:          params.TotalSupply.String(),
-		DefaultBlockEmission: params.DefaultBlockEmission.String(),
-		SubnetRewardBase:     params.SubnetRewardBase.String(),
-		SubnetRewardK:        params.SubnetRewardK.String(),
-		SubnetRewardMaxRatio: params.SubnetRewardMaxRatio.String(),
-		SubnetMovingAlpha:    params.SubnetMovingAlpha.String(),
-		SubnetOwnerCut:       params.SubnetOwnerCut.String(),
+		EnableBlockInflation:   params.EnableBlockInflation,
+		MintDenom:              params.MintDenom,
+		TotalSupply:            params.TotalSupply.String(),
+		DefaultBlockEmission:   params.DefaultBlockEmission.String(),
+		SubnetRewardBase:       params.SubnetRewardBase.String(),
+		SubnetRewardK:          params.SubnetRewardK.String(),
+		SubnetRewardMaxRatio:   params.SubnetRewardMaxRatio.String(),
+		SubnetMovingAlpha:      params.SubnetMovingAlpha.String(),
+		SubnetOwnerCut:         params.SubnetOwnerCut.String(),
+		PriceTwapWindow:        params.PriceTwapWindow,
+		MaxPriceChangePerBlock: params.MaxPriceChangePerBlock.String(),
+		SubnetEmissionMode:     params.SubnetEmissionMode,
+		RootWeightRatio:        params.RootWeightRatio.String(),
+		RootDividendRatio:      params.RootDividendRatio.String(),
+		RootTempo:              params.RootTempo,
+		ActiveMinter:           params.ActiveMinter,
+		LockCostPolicy:         params.LockCostPolicy,
+		RegistrationCostPolicy: params.RegistrationCostPolicy,
 	}
 
 	return &pb.QueryParamsResponse{Params: protoParams}, nil
@@ -52,3 +63,151 @@ func (k Keeper) PendingSubnetRewards(c context.Context, req *pb.QueryPendingSubn
 		PendingSubnetRewards: protoCoin,
 	}, nil
 }
+
+// SuspendedSubnets implements the generated QueryServer.SuspendedSubnets method
+func (k Keeper) SuspendedSubnets(c context.Context, req *pb.QuerySuspendedSubnetsRequest) (*pb.QuerySuspendedSubnetsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	suspensions := k.GetAllSubnetEmissionSuspensions(ctx)
+
+	protoSuspensions := make([]*pb.SubnetEmissionSuspension, 0, len(suspensions))
+	for _, suspension := range suspensions {
+		protoSuspensions = append(protoSuspensions, toProtoSubnetEmissionSuspension(suspension))
+	}
+
+	return &pb.QuerySuspendedSubnetsResponse{Suspensions: protoSuspensions}, nil
+}
+
+// SuspendedSubnet implements the generated QueryServer.SuspendedSubnet method
+func (k Keeper) SuspendedSubnet(c context.Context, req *pb.QuerySuspendedSubnetRequest) (*pb.QuerySuspendedSubnetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Netuid > stdmath.MaxUint16 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid netuid %d", req.Netuid)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	suspension, found := k.GetSubnetEmissionSuspension(ctx, uint16(req.Netuid))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "emission of subnet %d is not suspended", req.Netuid)
+	}
+
+	return &pb.QuerySuspendedSubnetResponse{Suspension: toProtoSubnetEmissionSuspension(suspension)}, nil
+}
+
+// SupplyBreakdown implements the generated QueryServer.SupplyBreakdown method
+func (k Keeper) SupplyBreakdown(c context.Context, req *pb.QuerySupplyBreakdownRequest) (*pb.QuerySupplyBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	breakdown, pageRes, err := k.GetSupplyBreakdown(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.QuerySupplyBreakdownResponse{
+		ActiveMinter:  breakdown.ActiveMinter,
+		Denom:         breakdown.Denom,
+		SupplyCap:     breakdown.SupplyCap.String(),
+		TotalSupply:   breakdown.TotalSupply.String(),
+		Issued:        breakdown.Issued.String(),
+		Remaining:     breakdown.Remaining.String(),
+		Burned:        breakdown.Burned.String(),
+		LockedInAmm:   breakdown.LockedInAMM.String(),
+		Vesting:       breakdown.Vesting.String(),
+		CommunityPool: breakdown.CommunityPool.String(),
+		Circulating:   breakdown.Circulating.String(),
+		Pagination:    pageRes,
+	}, nil
+}
+
+// BlockEmissionSchedule implements the generated QueryServer.BlockEmissionSchedule method
+func (k Keeper) BlockEmissionSchedule(c context.Context, req *pb.QueryBlockEmissionScheduleRequest) (*pb.QueryBlockEmissionScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	schedule, err := k.GetBlockEmissionSchedule(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	halvings := make([]*pb.Halving, 0, len(schedule.Halvings))
+	for _, halving := range schedule.Halvings {
+		halvings = append(halvings, &pb.Halving{
+			Index:           halving.Index,
+			Threshold:       halving.Threshold.String(),
+			BlockEmission:   halving.BlockEmission.String(),
+			EstimatedHeight: halving.EstimatedHeight,
+		})
+	}
+
+	return &pb.QueryBlockEmissionScheduleResponse{
+		Denom:                schedule.Denom,
+		TotalSupply:          schedule.TotalSupply.String(),
+		TotalIssuance:        schedule.TotalIssuance.String(),
+		CurrentHalving:       schedule.CurrentHalving,
+		CurrentBlockEmission: schedule.CurrentBlockEmission.String(),
+		Halvings:             halvings,
+	}, nil
+}
+
+// AllSubnetCosts implements the generated QueryServer.AllSubnetCosts method
+func (k Keeper) AllSubnetCosts(c context.Context, req *pb.QueryAllSubnetCostsRequest) (*pb.QueryAllSubnetCostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	allCosts := k.GetAllSubnetCosts(ctx)
+
+	protoCosts := make([]*pb.SubnetCosts, 0, len(allCosts))
+	for _, costs := range allCosts {
+		protoCosts = append(protoCosts, toProtoSubnetCosts(costs))
+	}
+
+	return &pb.QueryAllSubnetCostsResponse{
+		Denom:       k.GetParams(ctx).MintDenom,
+		SubnetCosts: protoCosts,
+	}, nil
+}
+
+// SubnetCosts implements the generated QueryServer.SubnetCosts method
+func (k Keeper) SubnetCosts(c context.Context, req *pb.QuerySubnetCostsRequest) (*pb.QuerySubnetCostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Netuid > stdmath.MaxUint16 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid netuid %d", req.Netuid)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &pb.QuerySubnetCostsResponse{
+		Denom:       k.GetParams(ctx).MintDenom,
+		SubnetCosts: toProtoSubnetCosts(k.GetSubnetCosts(ctx, uint16(req.Netuid))),
+	}, nil
+}
+
+func toProtoSubnetEmissionSuspension(suspension blockinflationtypes.SubnetEmissionSuspension) *pb.SubnetEmissionSuspension {
+	return &pb.SubnetEmissionSuspension{
+		Netuid:          uint32(suspension.Netuid),
+		Reason:          suspension.Reason,
+		SuspendedHeight: suspension.SuspendedHeight,
+	}
+}
+
+func toProtoSubnetCosts(costs blockinflationtypes.SubnetCosts) *pb.SubnetCosts {
+	return &pb.SubnetCosts{
+		Netuid:        uint32(costs.Netuid),
+		Burned:        costs.Burned.String(),
+		Recycled:      costs.Recycled.String(),
+		CommunityPool: costs.CommunityPool.String(),
+	}
+}
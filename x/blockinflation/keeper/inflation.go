@@ -2,15 +2,16 @@ package keeper
 
 import (
 	"fmt"
-	stdmath "math"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
 )
 
-// CalculateBlockEmission calculates the block emission based on Bittensor's algorithm
-// Improved version with high-precision calculations to avoid floating-point precision issues
+// CalculateBlockEmission calculates the block emission based on Bittensor's algorithm.
+// The emission halves every time the total issuance covers half of the supply
+// left, the halving index is found by comparing the total issuance against the
+// integer halving thresholds so the result doesn't depend on float rounding.
 func (k Keeper) CalculateBlockEmission(ctx sdk.Context) (math.Int, error) {
 	params := k.GetParams(ctx)
 	totalIssuance := k.GetTotalIssuance(ctx)
@@ -20,57 +21,43 @@ func (k Keeper) CalculateBlockEmission(ctx sdk.Context) (math.Int, error) {
 		return math.ZeroInt(), nil
 	}
 
-	// Use high-precision math.LegacyDec calculations instead of float64
-	totalIssuanceDec := totalIssuance.Amount.ToLegacyDec()
-	totalSupplyDec := params.TotalSupply.ToLegacyDec()
-	defaultBlockEmissionDec := params.DefaultBlockEmission.ToLegacyDec()
+	halvingIndex := blockinflationtypes.HalvingIndex(totalIssuance.Amount, params.TotalSupply)
+	blockEmission := blockinflationtypes.HalveEmission(params.DefaultBlockEmission, halvingIndex)
 
-	// Calculate the ratio: total_issuance / total_supply
-	ratio := totalIssuanceDec.Quo(totalSupplyDec)
-
-	// If ratio >= 1.0, return 0
-	if ratio.GTE(math.LegacyOneDec()) {
-		return math.ZeroInt(), nil
-	}
-
-	// Calculate log2(1 / (1 - ratio)) using high-precision arithmetic
-	// logArg = 1 / (1 - ratio)
-	oneMinusRatio := math.LegacyOneDec().Sub(ratio)
-	if oneMinusRatio.LTE(math.LegacyZeroDec()) {
-		return math.ZeroInt(), nil
-	}
-
-	logArg := math.LegacyOneDec().Quo(oneMinusRatio)
+	k.Logger(ctx).Debug("calculated block emission",
+		"total_issuance", totalIssuance.String(),
+		"total_supply", params.TotalSupply.String(),
+		"halving_index", halvingIndex,
+		"block_emission", blockEmission.String(),
+	)
 
-	// Convert to float64 for log2 calculation (this is the only place we need float64)
-	logArgFloat := logArg.MustFloat64()
-	logResult := stdmath.Log2(logArgFloat)
+	return blockEmission, nil
+}
 
-	// Floor the log result
-	flooredLog := stdmath.Floor(logResult)
-	flooredLogInt := int64(flooredLog)
+// GetBlockEmissionSchedule returns the current block emission and the upcoming
+// halvings with their estimated heights
+func (k Keeper) GetBlockEmissionSchedule(ctx sdk.Context) (blockinflationtypes.BlockEmissionSchedule, error) {
+	params := k.GetParams(ctx)
+	totalIssuance := k.GetTotalIssuance(ctx).Amount
 
-	// Calculate actual block emission by halving default emission flooredLogInt times.
-	// This avoids float math and overflow.
-	blockEmission := defaultBlockEmissionDec
-	for i := int64(0); i < flooredLogInt; i++ {
-		blockEmission = blockEmission.Quo(math.LegacyNewDec(2))
+	blockEmission, err := k.CalculateBlockEmission(ctx)
+	if err != nil {
+		return blockinflationtypes.BlockEmissionSchedule{}, err
 	}
 
-	// Convert back to math.Int with proper rounding
-	blockEmissionInt := blockEmission.TruncateInt()
-
-	k.Logger(ctx).Debug("calculated block emission (high-precision)",
-		"total_issuance", totalIssuance.String(),
-		"total_supply", params.TotalSupply.String(),
-		"ratio", ratio.String(),
-		"log_arg", logArg.String(),
-		"log_result", fmt.Sprintf("%.6f", logResult),
-		"floored_log", flooredLogInt,
-		"block_emission", blockEmissionInt.String(),
-	)
+	currentHalving := uint64(0)
+	if totalIssuance.LT(params.TotalSupply) {
+		currentHalving = blockinflationtypes.HalvingIndex(totalIssuance, params.TotalSupply)
+	}
 
-	return blockEmissionInt, nil
+	return blockinflationtypes.BlockEmissionSchedule{
+		Denom:                params.MintDenom,
+		TotalSupply:          params.TotalSupply,
+		TotalIssuance:        totalIssuance,
+		CurrentHalving:       currentHalving,
+		CurrentBlockEmission: blockEmission,
+		Halvings:             blockinflationtypes.HalvingSchedule(totalIssuance, params.TotalSupply, params.DefaultBlockEmission, ctx.BlockHeight()),
+	}, nil
 }
 
 // MintAndAllocateBlockInflation mints coins and allocates them to fee collector
@@ -82,12 +69,22 @@ func (k Keeper) MintAndAllocateBlockInflation(ctx sdk.Context) error {
 		return nil
 	}
 
+	// Skip if another module is the active minter of the supply policy
+	if params.ActiveMinter != blockinflationtypes.MinterBlockInflation {
+		return nil
+	}
+
 	// Calculate block emission
 	blockEmission, err := k.CalculateBlockEmission(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to calculate block emission: %w", err)
 	}
 
+	// Never emit past the supply cap
+	if remaining := k.RemainingSupply(ctx); blockEmission.GT(remaining) {
+		blockEmission = remaining
+	}
+
 	// Skip if no emission
 	if !blockEmission.IsPositive() {
 		return nil
@@ -113,36 +110,16 @@ func (k Keeper) MintAndAllocateBlockInflation(ctx sdk.Context) error {
 	// Calculate remaining amount for fee collector
 	feeCollectorAmount := blockEmission.Sub(subnetRewardAmount)
 
-	// Create minted coin
-	mintedCoin := sdk.Coin{
-		Denom:  params.MintDenom,
-		Amount: blockEmission,
-	}
-	// Mint coins
-	if err := k.bankKeeper.MintCoins(ctx, blockinflationtypes.ModuleName, sdk.Coins{mintedCoin}); err != nil {
-		return fmt.Errorf("failed to mint coins: %w", err)
-	}
-	// Add subnet reward to pending pool
-	if subnetRewardAmount.IsPositive() {
-		subnetRewardCoin := sdk.Coin{
-			Denom:  params.MintDenom,
-			Amount: subnetRewardAmount,
-		}
-		k.AddToPendingSubnetRewards(ctx, subnetRewardCoin)
-
-		// Execute coinbase logic to distribute rewards to subnets
-		if err := k.RunCoinbase(ctx, subnetRewardAmount); err != nil {
-			k.Logger(ctx).Error("failed to execute coinbase", "error", err)
-			// Don't return error here to avoid blocking inflation
-		}
-	}
-
-	// Send remaining amount to fee collector
+	// Mint and send remaining amount to fee collector. It is minted before the
+	// coinbase so that the subnet mints can't take its share of the cap.
 	if feeCollectorAmount.IsPositive() {
 		feeCollectorCoin := sdk.Coin{
 			Denom:  params.MintDenom,
 			Amount: feeCollectorAmount,
 		}
+		if err := k.MintSupply(ctx, blockinflationtypes.MinterBlockInflation, feeCollectorCoin); err != nil {
+			return err
+		}
 		if err := k.bankKeeper.SendCoinsFromModuleToModule(
 			ctx,
 			blockinflationtypes.ModuleName,
@@ -153,13 +130,37 @@ func (k Keeper) MintAndAllocateBlockInflation(ctx sdk.Context) error {
 		}
 	}
 
-	// Update total issuance
-	currentIssuance := k.GetTotalIssuance(ctx)
-	if currentIssuance.Denom != mintedCoin.Denom {
-		return fmt.Errorf("denom mismatch: totalIssuance=%s minted=%s", currentIssuance.Denom, mintedCoin.Denom)
+	// Mint the subnet reward together with the coinbase, so that the subnet
+	// share is only minted if the coinbase succeeds
+	mintedAmount := feeCollectorAmount
+	if subnetRewardAmount.IsPositive() {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.mintAndRunCoinbase(cacheCtx, subnetRewardAmount); err != nil {
+			k.Logger(ctx).Error("failed to execute coinbase, subnet reward not minted",
+				"subnet_reward_amount", subnetRewardAmount.String(),
+				"error", err,
+			)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					"coinbase_failed",
+					sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+					sdk.NewAttribute("subnet_reward_amount", subnetRewardAmount.String()),
+					sdk.NewAttribute("reason", err.Error()),
+				),
+			)
+			subnetRewardAmount = math.ZeroInt()
+		} else {
+			write()
+			mintedAmount = mintedAmount.Add(subnetRewardAmount)
+		}
+	}
+
+	// Create minted coin
+	mintedCoin := sdk.Coin{
+		Denom:  params.MintDenom,
+		Amount: mintedAmount,
 	}
-	newIssuance := sdk.NewCoin(currentIssuance.Denom, currentIssuance.Amount.Add(mintedCoin.Amount))
-	k.SetTotalIssuance(ctx, newIssuance)
+	newIssuance := k.GetTotalIssuance(ctx)
 
 	k.Logger(ctx).Info("minted and allocated block inflation",
 		"block_height", ctx.BlockHeight(),
@@ -188,3 +189,16 @@ func (k Keeper) MintAndAllocateBlockInflation(ctx sdk.Context) error {
 
 	return nil
 }
+
+// mintAndRunCoinbase mints the subnet reward into the pending subnet rewards
+// and distributes it to the subnets
+func (k Keeper) mintAndRunCoinbase(ctx sdk.Context, subnetRewardAmount math.Int) error {
+	subnetRewardCoin := sdk.NewCoin(k.GetParams(ctx).MintDenom, subnetRewardAmount)
+	if err := k.MintSupply(ctx, blockinflationtypes.MinterBlockInflation, subnetRewardCoin); err != nil {
+		return err
+	}
+	k.AddToPendingSubnetRewards(ctx, subnetRewardCoin)
+
+	// Execute coinbase logic to distribute rewards to subnets
+	return k.RunCoinbase(ctx, subnetRewardAmount)
+}
@@ -12,8 +12,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
-	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 
 	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
@@ -565,53 +563,15 @@ func TestCalculateBlockEmission_AlgorithmLogic(t *testing.T) {
 	}
 }
 
-// calculateBlockEmissionDirect
+// calculateBlockEmissionDirect mirrors CalculateBlockEmission without a keeper
 func calculateBlockEmissionDirect(totalIssuance, totalSupply, defaultEmission math.Int) math.Int {
 	// Check if total supply is reached
 	if totalIssuance.GTE(totalSupply) {
 		return math.ZeroInt()
 	}
 
-	// Use high-precision calculation
-	totalIssuanceDec := totalIssuance.ToLegacyDec()
-	totalSupplyDec := totalSupply.ToLegacyDec()
-	defaultEmissionDec := defaultEmission.ToLegacyDec()
-
-	// Calculate ratio
-	ratio := totalIssuanceDec.Quo(totalSupplyDec)
-
-	// If ratio >= 1.0, return 0
-	if ratio.GTE(math.LegacyOneDec()) {
-		return math.ZeroInt()
-	}
-
-	// Calculate log2(1 / (1 - ratio))
-	oneMinusRatio := math.LegacyOneDec().Sub(ratio)
-	if oneMinusRatio.LTE(math.LegacyZeroDec()) {
-		return math.ZeroInt()
-	}
-
-	logArg := math.LegacyOneDec().Quo(oneMinusRatio)
-
-	// Convert to float64 for log2 calculation
-	logArgFloat := logArg.MustFloat64()
-	logResult := stdmath.Log2(logArgFloat)
-
-	// Floor the log result
-	flooredLog := stdmath.Floor(logResult)
-	flooredLogInt := int64(flooredLog)
-
-	// Calculate 2^flooredLog
-	multiplier := stdmath.Pow(2.0, float64(flooredLogInt))
-
-	// Calculate block emission percentage: 1 / multiplier
-	blockEmissionPercentage := math.LegacyOneDec().Quo(math.LegacyNewDecWithPrec(int64(multiplier*1000), 3))
-
-	// Calculate actual block emission
-	blockEmission := defaultEmissionDec.Mul(blockEmissionPercentage)
-
-	// Convert back to math.Int with proper rounding
-	return blockEmission.TruncateInt()
+	halvingIndex := blockinflationtypes.HalvingIndex(totalIssuance, totalSupply)
+	return blockinflationtypes.HalveEmission(defaultEmission, halvingIndex)
 }
 
 // TestYumaSubnetRewardRatioAndDistribution
@@ -646,6 +606,9 @@ func TestYumaSubnetRewardRatioAndDistribution(t *testing.T) {
 			math.LegacyNewDecWithPrec(int64(p.k*100), 2),
 			math.LegacyNewDecWithPrec(int64(p.maxRatio*100), 2),
 			math.LegacyNewDec(0), math.LegacyNewDec(0),
+			0, math.LegacyNewDec(0),
+			blockinflationtypes.EmissionModePrice, math.LegacyNewDec(0), math.LegacyNewDec(0), 1,
+			blockinflationtypes.MinterBlockInflation, blockinflationtypes.CostPolicyBurn, blockinflationtypes.CostPolicyBurn,
 		)
 		ratio := blockinflationtypes.CalculateSubnetRewardRatio(params, uint64(p.subnetCnt)).MustFloat64()
 		t.Logf("base=%.2f, k=%.2f, max=%.2f, subnet_count=%d => subnet_reward_ratio=%.4f",
@@ -661,6 +624,9 @@ func TestYumaSubnetRewardRatioAndDistribution(t *testing.T) {
 				math.LegacyNewDecWithPrec(int64(p.k*100), 2),
 				math.LegacyNewDecWithPrec(int64(p.maxRatio*100), 2),
 				math.LegacyNewDec(0), math.LegacyNewDec(0),
+				0, math.LegacyNewDec(0),
+				blockinflationtypes.EmissionModePrice, math.LegacyNewDec(0), math.LegacyNewDec(0), 1,
+				blockinflationtypes.MinterBlockInflation, blockinflationtypes.CostPolicyBurn, blockinflationtypes.CostPolicyBurn,
 			)
 			ratio := blockinflationtypes.CalculateSubnetRewardRatio(params, uint64(p.subnetCnt)).MustFloat64()
 			subnetReward := emission.ToLegacyDec().Mul(math.LegacyNewDecWithPrec(int64(ratio*10000), 4)).TruncateInt()
@@ -683,22 +649,33 @@ func TestYumaSubnetRewardRatioAndDistribution(t *testing.T) {
 
 // Helper function: create test keeper
 func createTestKeeper(t *testing.T) Keeper {
-	subspace := createTestSubspace()
 	k := Keeper{
-		subspace: subspace,
+		cdc:      codec.NewProtoCodec(types.NewInterfaceRegistry()),
+		storeKey: storetypes.NewKVStoreKey(blockinflationtypes.StoreKey),
 		// Add other necessary fields for testing
 	}
 	return k
 }
 
-// Helper function: create test subspace
-func createTestSubspace() paramstypes.Subspace {
-	storeKey := storetypes.NewKVStoreKey("params")
-	tstoreKey := storetypes.NewTransientStoreKey("tparams")
-	interfaceReg := types.NewInterfaceRegistry()
-	protoCdc := codec.NewProtoCodec(interfaceReg)
-	legacyAmino := codec.NewLegacyAmino()
-	paramsKeeper := paramskeeper.NewKeeper(protoCdc, legacyAmino, storeKey, tstoreKey)
-	subspace := paramsKeeper.Subspace("blockinflation").WithKeyTable(blockinflationtypes.ParamKeyTable())
-	return subspace
+func TestGetBlockEmissionSchedule(t *testing.T) {
+	params := blockinflationtypes.DefaultParams()
+	params.TotalSupply = math.NewInt(1000)
+	params.DefaultBlockEmission = math.NewInt(100)
+	k, _, ctx := setupCoinbaseKeeper(t, params)
+	ctx = ctx.WithBlockHeight(10)
+
+	// exactly at the second halving threshold
+	k.SetTotalIssuance(ctx, sdk.NewCoin("ahetu", math.NewInt(750)))
+	schedule, err := k.GetBlockEmissionSchedule(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), schedule.CurrentHalving)
+	require.Equal(t, math.NewInt(25), schedule.CurrentBlockEmission)
+	require.Equal(t, uint64(3), schedule.Halvings[0].Index)
+	require.Equal(t, math.NewInt(875), schedule.Halvings[0].Threshold)
+	require.Equal(t, int64(15), schedule.Halvings[0].EstimatedHeight)
+
+	k.SetTotalIssuance(ctx, sdk.NewCoin("ahetu", math.NewInt(749)))
+	emission, err := k.CalculateBlockEmission(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(50), emission)
 }
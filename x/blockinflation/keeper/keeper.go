@@ -10,7 +10,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
 	pb "github.com/hetu-project/hetu/v1/x/blockinflation/types/generated"
 )
@@ -23,13 +22,16 @@ type (
 		storeKey         storetypes.StoreKey
 		memKey           storetypes.StoreKey
 		accountKeeper    blockinflationtypes.AccountKeeper
+		accountQuerier   blockinflationtypes.AccountQuerier
 		bankKeeper       blockinflationtypes.BankKeeper
 		eventKeeper      blockinflationtypes.EventKeeper
 		stakeworkKeeper  blockinflationtypes.StakeworkKeeper
 		erc20Keeper      blockinflationtypes.ERC20Keeper
 		evmKeeper        blockinflationtypes.EVMKeeper
+		distrKeeper      blockinflationtypes.DistrKeeper
 		feeCollectorName string
-		subspace         paramstypes.Subspace
+		// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+		authority sdk.AccAddress
 
 		pb.UnimplementedQueryServer
 	}
@@ -40,26 +42,35 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	memKey storetypes.StoreKey,
 	ak blockinflationtypes.AccountKeeper,
+	aq blockinflationtypes.AccountQuerier,
 	bk blockinflationtypes.BankKeeper,
 	ek blockinflationtypes.EventKeeper,
 	stakeworkKeeper blockinflationtypes.StakeworkKeeper,
 	erc20Keeper blockinflationtypes.ERC20Keeper,
 	evmKeeper blockinflationtypes.EVMKeeper,
+	distrKeeper blockinflationtypes.DistrKeeper,
 	feeCollectorName string,
-	subspace paramstypes.Subspace,
+	authority sdk.AccAddress,
 ) *Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
 	return &Keeper{
 		cdc:              cdc,
 		storeKey:         storeKey,
 		memKey:           memKey,
 		accountKeeper:    ak,
+		accountQuerier:   aq,
 		bankKeeper:       bk,
 		eventKeeper:      ek,
 		stakeworkKeeper:  stakeworkKeeper,
 		erc20Keeper:      erc20Keeper,
 		evmKeeper:        evmKeeper,
+		distrKeeper:      distrKeeper,
 		feeCollectorName: feeCollectorName,
-		subspace:         subspace,
+		authority:        authority,
 	}
 }
 
@@ -67,25 +78,34 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", blockinflationtypes.ModuleName))
 }
 
-// GetParams returns the current blockinflation module parameters
-func (k Keeper) GetParams(ctx sdk.Context) blockinflationtypes.Params {
-	var params blockinflationtypes.Params
-
-	defer func() {
-		if r := recover(); r != nil {
-			k.Logger(ctx).Warn("Panic in GetParamSet, writing default params", "panic", r)
-			// Write default parameters to KVStore
-			k.SetParams(ctx, blockinflationtypes.DefaultParams())
-			params = blockinflationtypes.DefaultParams()
-		}
-	}()
-	k.subspace.GetParamSet(ctx, &params)
+// GetParams returns the current blockinflation module parameters, or the
+// default parameters if none have been set yet.
+func (k Keeper) GetParams(ctx sdk.Context) (params blockinflationtypes.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(blockinflationtypes.ParamsKey)
+	if len(bz) == 0 {
+		return blockinflationtypes.DefaultParams()
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
-// SetParams sets the blockinflation module parameters
-func (k Keeper) SetParams(ctx sdk.Context, params blockinflationtypes.Params) {
-	k.subspace.SetParamSet(ctx, &params)
+// SetParams sets the blockinflation module parameters in a single key
+func (k Keeper) SetParams(ctx sdk.Context, params blockinflationtypes.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(blockinflationtypes.ParamsKey, bz)
+
+	return nil
 }
 
 // GetTotalIssuance returns the total issuance
@@ -197,6 +217,20 @@ func (k Keeper) BurnTokens(ctx sdk.Context, amount sdk.Coin) error {
 	k.SetTotalBurned(ctx, newBurned)
 
 	// Update total issuance (decrease) with underflow guard
+	newIssuance := k.decreaseTotalIssuance(ctx, amount)
+
+	k.Logger(ctx).Info("burned tokens",
+		"amount", amount.String(),
+		"total_burned", newBurned.String(),
+		"total_issuance", newIssuance.String(),
+	)
+
+	return nil
+}
+
+// decreaseTotalIssuance removes burned tokens from the total issuance, so that
+// they can be emitted again, and returns the new total issuance
+func (k Keeper) decreaseTotalIssuance(ctx sdk.Context, amount sdk.Coin) sdk.Coin {
 	currentIssuance := k.GetTotalIssuance(ctx)
 	var newIssuance sdk.Coin
 	if currentIssuance.Amount.LT(amount.Amount) {
@@ -209,12 +243,5 @@ func (k Keeper) BurnTokens(ctx sdk.Context, amount sdk.Coin) error {
 		newIssuance = currentIssuance.Sub(amount)
 	}
 	k.SetTotalIssuance(ctx, newIssuance)
-
-	k.Logger(ctx).Info("burned tokens",
-		"amount", amount.String(),
-		"total_burned", newBurned.String(),
-		"total_issuance", newIssuance.String(),
-	)
-
-	return nil
+	return newIssuance
 }
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
+)
+
+var _ blockinflationtypes.MsgServer = &Keeper{}
+
+// UpdateParams defines a method for updating blockinflation params
+func (k Keeper) UpdateParams(goCtx context.Context, req *blockinflationtypes.MsgUpdateParams) (*blockinflationtypes.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, errorsmod.Wrapf(err, "error setting params")
+	}
+
+	return &blockinflationtypes.MsgUpdateParamsResponse{}, nil
+}
+
+// ResumeSubnetEmission defines a method for resuming the suspended emission of a subnet
+func (k Keeper) ResumeSubnetEmission(goCtx context.Context, req *blockinflationtypes.MsgResumeSubnetEmission) (*blockinflationtypes.MsgResumeSubnetEmissionResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ResumeSuspendedSubnet(ctx, uint16(req.Netuid)); err != nil {
+		return nil, errorsmod.Wrapf(err, "error resuming subnet emission")
+	}
+
+	return &blockinflationtypes.MsgResumeSubnetEmissionResponse{}, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	k, _, ctx := setupCoinbaseKeeper(t, blockinflationtypes.DefaultParams())
+	k.authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	params := blockinflationtypes.DefaultParams()
+	params.SubnetOwnerCut = math.LegacyNewDecWithPrec(15, 2)
+	params.SubnetMovingAlpha = math.LegacyNewDecWithPrec(1, 5)
+
+	// only the gov authority can update the params
+	_, err := k.UpdateParams(ctx, blockinflationtypes.NewMsgUpdateParams(authtypes.NewModuleAddress("other").String(), params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.Equal(t, blockinflationtypes.DefaultParams(), k.GetParams(ctx))
+
+	_, err = k.UpdateParams(ctx, blockinflationtypes.NewMsgUpdateParams(k.authority.String(), params))
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+
+	// invalid params are rejected and the current params kept
+	invalid := params
+	invalid.SubnetOwnerCut = math.LegacyNewDec(2)
+	_, err = k.UpdateParams(ctx, blockinflationtypes.NewMsgUpdateParams(k.authority.String(), invalid))
+	require.Error(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+}
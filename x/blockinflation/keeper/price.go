@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
+)
+
+func priceObservationKey(netuid uint16, height int64) []byte {
+	key := make([]byte, 10)
+	binary.BigEndian.PutUint16(key, netuid)
+	binary.BigEndian.PutUint64(key[2:], uint64(height))
+	return key
+}
+
+// ObserveAlphaPrice records the alpha price of a subnet for the current block
+// and returns the time-weighted average price to feed into the moving price.
+//
+// The spot price is clamped to MaxPriceChangePerBlock of the previous
+// observation, so that a swap right before the block only moves the observed
+// price by a bounded amount, and the result is averaged over the observations
+// of the last PriceTwapWindow blocks.
+func (k Keeper) ObserveAlphaPrice(ctx sdk.Context, netuid uint16) math.LegacyDec {
+	params := k.GetParams(ctx)
+	height := ctx.BlockHeight()
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), blockinflationtypes.PriceObservationPrefix)
+
+	spot := k.eventKeeper.GetAlphaPrice(ctx, netuid)
+	observed := spot
+
+	if previous, found := k.getLastPriceObservation(ctx, netuid, height); found && params.MaxPriceChangePerBlock.IsPositive() {
+		maxChange := previous.Mul(params.MaxPriceChangePerBlock)
+		upper := previous.Add(maxChange)
+		lower := previous.Sub(maxChange)
+		if observed.GT(upper) {
+			observed = upper
+		} else if observed.LT(lower) {
+			observed = lower
+		}
+
+		if !observed.Equal(spot) {
+			k.Logger(ctx).Info("Clamped alpha price change",
+				"netuid", netuid,
+				"spot_price", spot.String(),
+				"previous_price", previous.String(),
+				"observed_price", observed.String())
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					"alpha_price_clamped",
+					sdk.NewAttribute("netuid", fmt.Sprintf("%d", netuid)),
+					sdk.NewAttribute("block_height", fmt.Sprintf("%d", height)),
+					sdk.NewAttribute("spot_price", spot.String()),
+					sdk.NewAttribute("previous_price", previous.String()),
+					sdk.NewAttribute("observed_price", observed.String()),
+					sdk.NewAttribute("max_price_change_per_block", params.MaxPriceChangePerBlock.String()),
+				),
+			)
+		}
+	}
+
+	bz, err := observed.Marshal()
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal alpha price observation", "netuid", netuid, "err", err)
+		return observed
+	}
+	store.Set(priceObservationKey(netuid, height), bz)
+
+	// Keep the last observation when the TWAP is disabled, the clamp still
+	// needs it in the next block.
+	window := params.PriceTwapWindow
+	if window == 0 {
+		window = 1
+	}
+	k.prunePriceObservations(ctx, netuid, height-int64(window))
+
+	if params.PriceTwapWindow == 0 {
+		return observed
+	}
+	return k.GetAlphaPriceTWAP(ctx, netuid)
+}
+
+// GetAlphaPriceTWAP returns the average of the alpha price observations of a
+// subnet over the last PriceTwapWindow blocks, or the spot price when there
+// are none.
+func (k Keeper) GetAlphaPriceTWAP(ctx sdk.Context, netuid uint16) math.LegacyDec {
+	window := k.GetParams(ctx).PriceTwapWindow
+	height := ctx.BlockHeight()
+	start := height - int64(window) + 1
+	if start < 0 {
+		start = 0
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), blockinflationtypes.PriceObservationPrefix)
+	iterator := store.Iterator(priceObservationKey(netuid, start), priceObservationKey(netuid, height+1))
+	defer iterator.Close()
+
+	sum := math.LegacyZeroDec()
+	count := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		var price math.LegacyDec
+		if err := price.Unmarshal(iterator.Value()); err != nil {
+			continue
+		}
+		sum = sum.Add(price)
+		count++
+	}
+
+	if count == 0 {
+		return k.eventKeeper.GetAlphaPrice(ctx, netuid)
+	}
+	return sum.QuoInt64(count)
+}
+
+// getLastPriceObservation returns the latest alpha price observation of a
+// subnet before the given height.
+func (k Keeper) getLastPriceObservation(ctx sdk.Context, netuid uint16, height int64) (math.LegacyDec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), blockinflationtypes.PriceObservationPrefix)
+	iterator := store.ReverseIterator(priceObservationKey(netuid, 0), priceObservationKey(netuid, height))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var price math.LegacyDec
+		if err := price.Unmarshal(iterator.Value()); err != nil {
+			continue
+		}
+		return price, true
+	}
+	return math.LegacyDec{}, false
+}
+
+// prunePriceObservations deletes the alpha price observations of a subnet up
+// to and including the given height.
+func (k Keeper) prunePriceObservations(ctx sdk.Context, netuid uint16, height int64) {
+	if height < 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), blockinflationtypes.PriceObservationPrefix)
+	iterator := store.Iterator(priceObservationKey(netuid, 0), priceObservationKey(netuid, height+1))
+	var pruned [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		pruned = append(pruned, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range pruned {
+		store.Delete(key)
+	}
+}
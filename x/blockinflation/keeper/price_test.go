@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+
+	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
+	eventkeeper "github.com/hetu-project/hetu/v1/x/event/keeper"
+	eventtypes "github.com/hetu-project/hetu/v1/x/event/types"
+	stakeworktypes "github.com/hetu-project/hetu/v1/x/stakework/types"
+)
+
+// noEpochStakework never runs an epoch, pending emission just accumulates
+type noEpochStakework struct{}
+
+func (noEpochStakework) ShouldRunEpoch(sdk.Context, uint16, uint64) bool { return false }
+
+func (noEpochStakework) RunEpoch(sdk.Context, uint16, math.Int) (*stakeworktypes.EpochResult, error) {
+	return nil, nil
+}
+
+func (noEpochStakework) RunRootEpoch(sdk.Context, []uint16, math.LegacyDec) (*stakeworktypes.RootEpochResult, error) {
+	return &stakeworktypes.RootEpochResult{}, nil
+}
+
+// setupCoinbaseKeeper returns a keeper backed by a real event keeper with two
+// dynamic subnets priced at 0.1, emitting since block 1.
+func setupCoinbaseKeeper(t *testing.T, params blockinflationtypes.Params) (Keeper, *eventkeeper.Keeper, sdk.Context) {
+	keys := storetypes.NewKVStoreKeys(blockinflationtypes.StoreKey, eventtypes.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(keys, nil, nil).WithBlockHeight(1)
+
+	interfaceReg := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceReg)
+
+	ek := eventkeeper.NewKeeper(cdc, keys[eventtypes.StoreKey], abi.ABI{}, abi.ABI{}, abi.ABI{}, abi.ABI{}, abi.ABI{}, abi.ABI{}, abi.ABI{}, abi.ABI{},
+		authtypes.NewModuleAddress(govtypes.ModuleName))
+	k := Keeper{
+		cdc:             cdc,
+		storeKey:        keys[blockinflationtypes.StoreKey],
+		eventKeeper:     ek,
+		stakeworkKeeper: noEpochStakework{},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	for _, netuid := range []uint16{1, 2} {
+		ek.SetSubnet(ctx, eventtypes.Subnet{Netuid: netuid, Mechanism: 1, FirstEmissionBlock: 1, EMAPriceHalvingBlocks: 10})
+		ek.SetSubnetTaoIn(ctx, netuid, math.NewInt(100).Mul(math.NewInt(1e18)))
+		ek.SetSubnetAlphaIn(ctx, netuid, math.NewInt(1000).Mul(math.NewInt(1e18)))
+		ek.SetSubnetMovingPrice(ctx, netuid, math.LegacyNewDecWithPrec(1, 1))
+	}
+	return k, ek, ctx
+}
+
+// runSandwich runs the coinbase for ten honest blocks, then once with a swap
+// that triples the TAO of subnet 1 right before the block and is sold back
+// right after. It returns the tao_in share of subnet 1 in the next block with
+// and without the attack, and the events of the attacked block.
+func runSandwich(t *testing.T, params blockinflationtypes.Params) (math.LegacyDec, math.LegacyDec, sdk.Events) {
+	k, ek, ctx := setupCoinbaseKeeper(t, params)
+	emission := math.NewInt(1e18)
+
+	for height := int64(2); height <= 11; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		require.NoError(t, k.RunCoinbase(ctx, emission))
+	}
+
+	taoInShare := func(ctx sdk.Context) math.LegacyDec {
+		rewards, err := k.CalculateSubnetRewards(ctx, emission, []uint16{1, 2})
+		require.NoError(t, err)
+		return rewards[1].TaoIn.ToLegacyDec().Quo(rewards[1].TaoIn.Add(rewards[2].TaoIn).ToLegacyDec())
+	}
+
+	ctx = ctx.WithBlockHeight(12)
+	honestCtx, _ := ctx.CacheContext()
+	require.NoError(t, k.RunCoinbase(honestCtx, emission))
+
+	attackCtx, _ := ctx.CacheContext()
+	attackCtx = attackCtx.WithEventManager(sdk.NewEventManager())
+	taoIn := ek.GetSubnetTaoIn(attackCtx, 1)
+	alphaIn := ek.GetSubnetAlphaIn(attackCtx, 1)
+	// constant product swap: 3x the TAO for a third of the alpha, price x9
+	ek.SetSubnetTaoIn(attackCtx, 1, taoIn.MulRaw(3))
+	ek.SetSubnetAlphaIn(attackCtx, 1, alphaIn.QuoRaw(3))
+	require.NoError(t, k.RunCoinbase(attackCtx, emission))
+	events := attackCtx.EventManager().Events()
+	ek.SetSubnetTaoIn(attackCtx, 1, ek.GetSubnetTaoIn(attackCtx, 1).Sub(taoIn.MulRaw(2)))
+	ek.SetSubnetAlphaIn(attackCtx, 1, ek.GetSubnetAlphaIn(attackCtx, 1).Add(alphaIn.Sub(alphaIn.QuoRaw(3))))
+
+	honestCtx = honestCtx.WithBlockHeight(13)
+	attackCtx = attackCtx.WithBlockHeight(13)
+	return taoInShare(honestCtx), taoInShare(attackCtx), events
+}
+
+func hasEvent(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRunCoinbaseSandwich(t *testing.T) {
+	unprotected := blockinflationtypes.DefaultParams()
+	unprotected.SubnetMovingAlpha = math.LegacyNewDecWithPrec(5, 1)
+	unprotected.PriceTwapWindow = 0
+	unprotected.MaxPriceChangePerBlock = math.LegacyZeroDec()
+
+	honest, attacked, events := runSandwich(t, unprotected)
+	require.False(t, hasEvent(events, "alpha_price_clamped"))
+	skew := attacked.Sub(honest)
+	// the spot price is fed into the EMA, the attack moves the split
+	require.True(t, skew.GT(math.LegacyNewDecWithPrec(1, 1)), "skew %s", skew)
+
+	protected := unprotected
+	protected.PriceTwapWindow = 30
+	protected.MaxPriceChangePerBlock = math.LegacyNewDecWithPrec(5, 2)
+
+	honest, attacked, events = runSandwich(t, protected)
+	require.True(t, hasEvent(events, "alpha_price_clamped"))
+	// the clamped TWAP barely moves
+	require.True(t, attacked.Sub(honest).Abs().LT(skew.QuoInt64(100)), "skew %s", attacked.Sub(honest))
+}
+
+func TestObserveAlphaPrice(t *testing.T) {
+	params := blockinflationtypes.DefaultParams()
+	params.PriceTwapWindow = 3
+	params.MaxPriceChangePerBlock = math.LegacyNewDecWithPrec(10, 2)
+	k, ek, ctx := setupCoinbaseKeeper(t, params)
+
+	setPrice := func(ctx sdk.Context, taoIn int64) {
+		ek.SetSubnetTaoIn(ctx, 1, math.NewInt(taoIn))
+		ek.SetSubnetAlphaIn(ctx, 1, math.NewInt(1000))
+	}
+
+	// the first observation is the spot price
+	ctx = ctx.WithBlockHeight(10)
+	setPrice(ctx, 100)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), k.ObserveAlphaPrice(ctx, 1))
+
+	// a jump is clamped to 10% of the previous observation
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	setPrice(ctx, 500)
+	require.Equal(t, math.LegacyNewDecWithPrec(105, 3), k.ObserveAlphaPrice(ctx, 1))
+	require.True(t, hasEvent(ctx.EventManager().Events(), "alpha_price_clamped"))
+
+	ctx = ctx.WithBlockHeight(12)
+	twap := k.ObserveAlphaPrice(ctx, 1)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1).Add(math.LegacyNewDecWithPrec(11, 2)).Add(math.LegacyNewDecWithPrec(121, 3)).QuoInt64(3), twap)
+
+	// observations older than the window are pruned
+	ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	setPrice(ctx, 50)
+	require.Equal(t, math.LegacyNewDecWithPrec(1089, 4), k.ObserveAlphaPrice(ctx, 1))
+	_, found := k.getLastPriceObservation(ctx, 1, 20)
+	require.False(t, found)
+	require.Equal(t, math.LegacyNewDecWithPrec(1089, 4), k.GetAlphaPriceTWAP(ctx, 1))
+
+	// subnets are observed independently
+	require.Equal(t, ek.GetAlphaPrice(ctx, 2), k.GetAlphaPriceTWAP(ctx, 2))
+}
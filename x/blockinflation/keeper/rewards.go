@@ -18,17 +18,12 @@ func (k Keeper) CalculateSubnetRewards(ctx sdk.Context, blockEmission math.Int,
 		"subnets_count", len(subnetsToEmitTo),
 		"subnets", fmt.Sprintf("%v", subnetsToEmitTo))
 
-	// Step 1: Calculate total moving prices
-	totalMovingPrices := math.LegacyZeroDec()
-	for _, netuid := range subnetsToEmitTo {
-		movingPrice := k.eventKeeper.GetMovingAlphaPrice(ctx, netuid)
-		k.Logger(ctx).Debug("Individual subnet moving price",
-			"netuid", netuid,
-			"moving_price", movingPrice.String())
-		totalMovingPrices = totalMovingPrices.Add(movingPrice)
-	}
+	// Step 1: Calculate the emission share of each subnet (by moving price, root weights or both)
+	shares := k.subnetEmissionShares(ctx, subnetsToEmitTo)
 
-	k.Logger(ctx).Debug("Total moving prices calculated", "total", totalMovingPrices.String())
+	k.Logger(ctx).Debug("Subnet emission shares calculated",
+		"mode", k.GetParams(ctx).SubnetEmissionMode,
+		"shares", fmt.Sprintf("%v", shares))
 
 	// Step 2: Calculate rewards for each subnet
 	for _, netuid := range subnetsToEmitTo {
@@ -55,12 +50,12 @@ func (k Keeper) CalculateSubnetRewards(ctx sdk.Context, blockEmission math.Int,
 
 		// Calculate TAO reward (tao_in)
 		var taoIn math.Int
-		if totalMovingPrices.IsZero() {
-			k.Logger(ctx).Debug("Total moving prices is zero, tao_in will be zero",
+		if shares[netuid].IsZero() {
+			k.Logger(ctx).Debug("Emission share is zero, tao_in will be zero",
 				"netuid", netuid)
 			taoIn = math.ZeroInt()
 		} else {
-			taoInRatio := movingPrice.Quo(totalMovingPrices)
+			taoInRatio := shares[netuid]
 			taoIn = math.LegacyNewDecFromInt(blockEmission).Mul(taoInRatio).TruncateInt()
 
 			k.Logger(ctx).Debug("Calculated tao_in",
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
+	stakeworktypes "github.com/hetu-project/hetu/v1/x/stakework/types"
+)
+
+// rootKappa is the majority threshold of the root network consensus, the same
+// as the default kappa of the subnets
+func rootKappa() math.LegacyDec {
+	return math.LegacyNewDecWithPrec(5, 1)
+}
+
+// GetRootEpochResult returns the result of the last root epoch
+func (k Keeper) GetRootEpochResult(ctx sdk.Context) (stakeworktypes.RootEpochResult, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(blockinflationtypes.RootEpochResultKey)
+	if bz == nil {
+		return stakeworktypes.RootEpochResult{}, false
+	}
+
+	var result stakeworktypes.RootEpochResult
+	if err := json.Unmarshal(bz, &result); err != nil {
+		k.Logger(ctx).Error("failed to unmarshal root epoch result", "err", err)
+		return stakeworktypes.RootEpochResult{}, false
+	}
+	return result, true
+}
+
+// SetRootEpochResult stores the result of the last root epoch
+func (k Keeper) SetRootEpochResult(ctx sdk.Context, result stakeworktypes.RootEpochResult) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := json.Marshal(result)
+	store.Set(blockinflationtypes.RootEpochResultKey, bz)
+}
+
+// runRootNetwork runs the root epoch every RootTempo blocks and pays the root
+// dividends of this block in HETU. It returns the emission left for the
+// subnets.
+func (k Keeper) runRootNetwork(ctx sdk.Context, blockEmission math.Int, subnets []uint16) math.Int {
+	params := k.GetParams(ctx)
+
+	// --- 1. Run the root epoch, the shares are kept until the next one
+	result, found := k.GetRootEpochResult(ctx)
+	if !found || k.stakeworkKeeper.ShouldRunEpoch(ctx, stakeworktypes.RootNetuid, params.RootTempo) {
+		epochResult, err := k.stakeworkKeeper.RunRootEpoch(ctx, subnets, rootKappa())
+		if err != nil {
+			k.Logger(ctx).Error("RunRootEpoch failed", "error", err)
+		} else {
+			result = *epochResult
+			found = true
+			k.SetRootEpochResult(ctx, result)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					"root_epoch",
+					sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+					sdk.NewAttribute("subnets_count", fmt.Sprintf("%d", len(result.Netuids))),
+					sdk.NewAttribute("validators_count", fmt.Sprintf("%d", len(result.Accounts))),
+				),
+			)
+		}
+	}
+	if !found {
+		return blockEmission
+	}
+
+	// --- 2. Pay the root dividends out of the block emission
+	totalDividends := math.LegacyZeroDec()
+	for _, d := range result.Dividends {
+		totalDividends = totalDividends.Add(d)
+	}
+	if !totalDividends.IsPositive() || !params.RootDividendRatio.IsPositive() {
+		return blockEmission
+	}
+
+	rootEmission := params.RootDividendRatio.MulInt(blockEmission).TruncateInt()
+	paid := math.ZeroInt()
+	for i, account := range result.Accounts {
+		amount := result.Dividends[i].Quo(totalDividends).MulInt(rootEmission).TruncateInt()
+		if !amount.IsPositive() || !common.IsHexAddress(account) {
+			continue
+		}
+
+		recipient := sdk.AccAddress(common.HexToAddress(account).Bytes())
+		coins := sdk.NewCoins(sdk.NewCoin(params.MintDenom, amount))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, blockinflationtypes.ModuleName, recipient, coins); err != nil {
+			k.Logger(ctx).Error("Failed to pay root dividend",
+				"account", account,
+				"amount", amount.String(),
+				"error", err,
+			)
+			continue
+		}
+		paid = paid.Add(amount)
+	}
+
+	if paid.IsPositive() {
+		pending := k.GetPendingSubnetRewards(ctx)
+		if pending.Amount.GTE(paid) {
+			k.SetPendingSubnetRewards(ctx, sdk.NewCoin(pending.Denom, pending.Amount.Sub(paid)))
+		} else {
+			k.SetPendingSubnetRewards(ctx, sdk.NewCoin(pending.Denom, math.ZeroInt()))
+		}
+	}
+
+	k.Logger(ctx).Debug("Root dividends paid",
+		"root_emission", rootEmission.String(),
+		"paid", paid.String())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"root_dividends_paid",
+			sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+			sdk.NewAttribute("root_emission", rootEmission.String()),
+			sdk.NewAttribute("paid", paid.String()),
+		),
+	)
+
+	return blockEmission.Sub(paid)
+}
+
+// subnetEmissionShares returns the share of the block emission each subnet
+// receives as tao_in under the configured SubnetEmissionMode.
+func (k Keeper) subnetEmissionShares(ctx sdk.Context, subnets []uint16) map[uint16]math.LegacyDec {
+	params := k.GetParams(ctx)
+
+	// Price based shares
+	priceShares := make(map[uint16]math.LegacyDec, len(subnets))
+	totalMovingPrices := math.LegacyZeroDec()
+	for _, netuid := range subnets {
+		movingPrice := k.eventKeeper.GetMovingAlphaPrice(ctx, netuid)
+		priceShares[netuid] = movingPrice
+		totalMovingPrices = totalMovingPrices.Add(movingPrice)
+	}
+	for _, netuid := range subnets {
+		if totalMovingPrices.IsZero() {
+			priceShares[netuid] = math.LegacyZeroDec()
+		} else {
+			priceShares[netuid] = priceShares[netuid].Quo(totalMovingPrices)
+		}
+	}
+	if params.SubnetEmissionMode == blockinflationtypes.EmissionModePrice {
+		return priceShares
+	}
+
+	// Root based shares, renormalized over the subnets emitted to
+	result, found := k.GetRootEpochResult(ctx)
+	if !found {
+		return priceShares
+	}
+	rootShares := make(map[uint16]math.LegacyDec, len(subnets))
+	for _, netuid := range subnets {
+		rootShares[netuid] = math.LegacyZeroDec()
+	}
+	totalRootShares := math.LegacyZeroDec()
+	for i, netuid := range result.Netuids {
+		if _, ok := rootShares[netuid]; ok {
+			rootShares[netuid] = result.Shares[i]
+			totalRootShares = totalRootShares.Add(result.Shares[i])
+		}
+	}
+	if totalRootShares.IsZero() {
+		k.Logger(ctx).Debug("No root weights in consensus, falling back to price based shares")
+		return priceShares
+	}
+	for _, netuid := range subnets {
+		rootShares[netuid] = rootShares[netuid].Quo(totalRootShares)
+	}
+	if params.SubnetEmissionMode == blockinflationtypes.EmissionModeRoot {
+		return rootShares
+	}
+
+	// Blend of both
+	ratio := params.RootWeightRatio
+	shares := make(map[uint16]math.LegacyDec, len(subnets))
+	for _, netuid := range subnets {
+		shares[netuid] = math.LegacyOneDec().Sub(ratio).Mul(priceShares[netuid]).Add(ratio.Mul(rootShares[netuid]))
+	}
+	return shares
+}
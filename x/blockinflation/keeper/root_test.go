@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
+	eventkeeper "github.com/hetu-project/hetu/v1/x/event/keeper"
+	eventtypes "github.com/hetu-project/hetu/v1/x/event/types"
+	stakeworkkeeper "github.com/hetu-project/hetu/v1/x/stakework/keeper"
+	stakeworktypes "github.com/hetu-project/hetu/v1/x/stakework/types"
+)
+
+// recordingBank records the coins sent from the module to accounts
+type recordingBank struct {
+	blockinflationtypes.BankKeeper
+	sent map[string]math.Int
+}
+
+func (b *recordingBank) SendCoinsFromModuleToAccount(_ context.Context, _ string, recipient sdk.AccAddress, amt sdk.Coins) error {
+	addr := common.BytesToAddress(recipient).Hex()
+	if _, ok := b.sent[addr]; !ok {
+		b.sent[addr] = math.ZeroInt()
+	}
+	b.sent[addr] = b.sent[addr].Add(amt.AmountOf(blockinflationtypes.DefaultParams().MintDenom))
+	return nil
+}
+
+var (
+	rootValidatorA = common.HexToAddress("0x00000000000000000000000000000000000000aa").Hex()
+	rootValidatorB = common.HexToAddress("0x00000000000000000000000000000000000000bb").Hex()
+)
+
+// setupRootKeeper returns a coinbase keeper with a real stakework keeper and
+// two root validators: A with 60% of the root stake weighting subnet 1 and 2
+// at 3:1, B with 40% weighting them evenly.
+func setupRootKeeper(t *testing.T, params blockinflationtypes.Params) (Keeper, *eventkeeper.Keeper, *recordingBank, sdk.Context) {
+	k, ek, ctx := setupCoinbaseKeeper(t, params)
+	bank := &recordingBank{sent: map[string]math.Int{}}
+	k.bankKeeper = bank
+	k.stakeworkKeeper = stakeworkkeeper.NewKeeper(nil, storetypes.NewKVStoreKey(stakeworktypes.StoreKey), ek)
+
+	ek.SetSubnet(ctx, eventtypes.Subnet{Netuid: stakeworktypes.RootNetuid})
+	ek.SetValidatorStake(ctx, eventtypes.ValidatorStake{Netuid: stakeworktypes.RootNetuid, Validator: rootValidatorA, Amount: "60"})
+	ek.SetValidatorStake(ctx, eventtypes.ValidatorStake{Netuid: stakeworktypes.RootNetuid, Validator: rootValidatorB, Amount: "40"})
+	ek.SetValidatorWeight(ctx, stakeworktypes.RootNetuid, rootValidatorA, map[string]uint64{
+		stakeworktypes.RootWeightDest(1): 3,
+		stakeworktypes.RootWeightDest(2): 1,
+	})
+	ek.SetValidatorWeight(ctx, stakeworktypes.RootNetuid, rootValidatorB, map[string]uint64{
+		stakeworktypes.RootWeightDest(1): 1,
+		stakeworktypes.RootWeightDest(2): 1,
+	})
+	return k, ek, bank, ctx
+}
+
+func taoInShare(t *testing.T, k Keeper, ctx sdk.Context) math.LegacyDec {
+	rewards, err := k.CalculateSubnetRewards(ctx, math.NewInt(1e18), []uint16{1, 2})
+	require.NoError(t, err)
+	return rewards[1].TaoIn.ToLegacyDec().Quo(rewards[1].TaoIn.Add(rewards[2].TaoIn).ToLegacyDec())
+}
+
+func TestRunCoinbaseRootNetwork(t *testing.T) {
+	params := blockinflationtypes.DefaultParams()
+	params.SubnetEmissionMode = blockinflationtypes.EmissionModeRoot
+	k, ek, bank, ctx := setupRootKeeper(t, params)
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+
+	// the first coinbase runs the root epoch
+	emission := math.NewInt(1e18)
+	require.NoError(t, k.RunCoinbase(ctx, emission))
+	require.True(t, hasEvent(ctx.EventManager().Events(), "root_epoch"))
+	require.True(t, hasEvent(ctx.EventManager().Events(), "root_dividends_paid"))
+
+	// consensus clips subnet 1 at 0.75 and subnet 2 at 0.25: ranks 0.65 and 0.25
+	result, found := k.GetRootEpochResult(ctx)
+	require.True(t, found)
+	require.Equal(t, []uint16{1, 2}, result.Netuids)
+	require.Equal(t, math.LegacyNewDecWithPrec(65, 2).Quo(math.LegacyNewDecWithPrec(9, 1)), result.Shares[0])
+	require.Equal(t, []string{rootValidatorA, rootValidatorB}, result.Accounts)
+
+	// A kept all of its weight in consensus, B three quarters
+	rootEmission := params.RootDividendRatio.MulInt(emission).TruncateInt()
+	require.Equal(t, result.Dividends[0].MulInt(rootEmission).TruncateInt(), bank.sent[rootValidatorA])
+	require.Equal(t, result.Dividends[1].MulInt(rootEmission).TruncateInt(), bank.sent[rootValidatorB])
+	require.True(t, bank.sent[rootValidatorA].GT(bank.sent[rootValidatorB].MulRaw(2).SubRaw(2)))
+	require.True(t, bank.sent[rootValidatorA].Add(bank.sent[rootValidatorB]).LTE(rootEmission))
+
+	// the tao_in split follows the root shares instead of the even prices
+	require.True(t, taoInShare(t, k, ctx).Sub(result.Shares[0]).Abs().LT(math.LegacyNewDecWithPrec(1, 9)))
+
+	// the shares are kept until the next root epoch
+	ek.SetValidatorWeight(ctx, stakeworktypes.RootNetuid, rootValidatorA, map[string]uint64{
+		stakeworktypes.RootWeightDest(2): 1,
+	})
+	ctx = ctx.WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.RunCoinbase(ctx, emission))
+	require.False(t, hasEvent(ctx.EventManager().Events(), "root_epoch"))
+	kept, _ := k.GetRootEpochResult(ctx)
+	require.Equal(t, result.Shares, kept.Shares)
+}
+
+func TestSubnetEmissionShares(t *testing.T) {
+	params := blockinflationtypes.DefaultParams()
+	k, _, bank, ctx := setupRootKeeper(t, params)
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+
+	// price mode behaves as before: no root epoch, no dividends, even split
+	require.NoError(t, k.RunCoinbase(ctx, math.NewInt(1e18)))
+	require.False(t, hasEvent(ctx.EventManager().Events(), "root_epoch"))
+	require.Empty(t, bank.sent)
+	_, found := k.GetRootEpochResult(ctx)
+	require.False(t, found)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), taoInShare(t, k, ctx))
+
+	// root mode without a root epoch yet falls back to the prices
+	params.SubnetEmissionMode = blockinflationtypes.EmissionModeRoot
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), k.subnetEmissionShares(ctx, []uint16{1, 2})[1])
+
+	k.SetRootEpochResult(ctx, stakeworktypes.RootEpochResult{
+		Netuids: []uint16{1, 2, 3},
+		Shares:  []math.LegacyDec{math.LegacyNewDecWithPrec(6, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(2, 1)},
+	})
+	// shares are renormalized over the subnets emitted to
+	require.Equal(t, math.LegacyNewDecWithPrec(75, 2), k.subnetEmissionShares(ctx, []uint16{1, 2})[1])
+
+	params.SubnetEmissionMode = blockinflationtypes.EmissionModeBlend
+	params.RootWeightRatio = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, k.SetParams(ctx, params))
+	shares := k.subnetEmissionShares(ctx, []uint16{1, 2})
+	require.Equal(t, math.LegacyNewDecWithPrec(625, 3), shares[1])
+	require.Equal(t, math.LegacyNewDecWithPrec(375, 3), shares[2])
+}
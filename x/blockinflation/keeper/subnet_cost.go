@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
+	eventtypes "github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+func subnetCostsKey(netuid uint16) []byte {
+	key := make([]byte, 2)
+	binary.BigEndian.PutUint16(key, netuid)
+	return key
+}
+
+// GetSubnetCosts returns the registration and lock costs paid for a subnet
+func (k Keeper) GetSubnetCosts(ctx sdk.Context, netuid uint16) blockinflationtypes.SubnetCosts {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), blockinflationtypes.SubnetCostsPrefix)
+	bz := store.Get(subnetCostsKey(netuid))
+	if bz == nil {
+		return blockinflationtypes.NewSubnetCosts(netuid)
+	}
+
+	var costs blockinflationtypes.SubnetCosts
+	if err := json.Unmarshal(bz, &costs); err != nil {
+		k.Logger(ctx).Error("failed to unmarshal subnet costs", "netuid", netuid, "err", err)
+		return blockinflationtypes.NewSubnetCosts(netuid)
+	}
+	return costs
+}
+
+// SetSubnetCosts stores the registration and lock costs paid for a subnet
+func (k Keeper) SetSubnetCosts(ctx sdk.Context, costs blockinflationtypes.SubnetCosts) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), blockinflationtypes.SubnetCostsPrefix)
+	bz, _ := json.Marshal(costs)
+	store.Set(subnetCostsKey(costs.Netuid), bz)
+}
+
+// GetAllSubnetCosts returns the costs of all subnets ordered by netuid
+func (k Keeper) GetAllSubnetCosts(ctx sdk.Context) []blockinflationtypes.SubnetCosts {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), blockinflationtypes.SubnetCostsPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	allCosts := []blockinflationtypes.SubnetCosts{}
+	for ; iterator.Valid(); iterator.Next() {
+		var costs blockinflationtypes.SubnetCosts
+		if err := json.Unmarshal(iterator.Value(), &costs); err != nil {
+			k.Logger(ctx).Error("failed to unmarshal subnet costs", "key", iterator.Key(), "err", err)
+			continue
+		}
+		allCosts = append(allCosts, costs)
+	}
+	return allCosts
+}
+
+// ApplySubnetCost applies a registration or lock cost paid to the contracts to
+// the supply. The HETU paid is taken out of the balance of the contract
+// holding it, the cost policy of the source then decides whether it is burned
+// and removed from the total issuance to be emitted again, or moved into the
+// pending subnet rewards or the community pool.
+func (k Keeper) ApplySubnetCost(ctx sdk.Context, netuid uint16, source string, payer sdk.AccAddress, amount math.Int) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	var policy string
+	switch source {
+	case eventtypes.SubnetCostLock:
+		policy = params.LockCostPolicy
+	case eventtypes.SubnetCostRegistration:
+		policy = params.RegistrationCostPolicy
+	default:
+		return fmt.Errorf("unknown subnet cost source %q", source)
+	}
+
+	// The pending subnet rewards are only paid out by the active block inflation
+	if policy == blockinflationtypes.CostPolicyRecycle && !k.IsActiveMinter(ctx, blockinflationtypes.MinterBlockInflation) {
+		policy = blockinflationtypes.CostPolicyBurn
+	}
+
+	coin := sdk.NewCoin(params.MintDenom, amount)
+	coins := sdk.NewCoins(coin)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, blockinflationtypes.ModuleName, coins); err != nil {
+		return fmt.Errorf("failed to collect subnet cost from %s: %w", payer, err)
+	}
+
+	costs := k.GetSubnetCosts(ctx, netuid)
+	switch policy {
+	case blockinflationtypes.CostPolicyBurn:
+		if err := k.bankKeeper.BurnCoins(ctx, blockinflationtypes.ModuleName, coins); err != nil {
+			return fmt.Errorf("failed to burn subnet cost: %w", err)
+		}
+		k.decreaseTotalIssuance(ctx, coin)
+		k.SetTotalBurned(ctx, k.GetTotalBurned(ctx).Add(coin))
+		costs.Burned = costs.Burned.Add(amount)
+	case blockinflationtypes.CostPolicyRecycle:
+		k.AddToPendingSubnetRewards(ctx, coin)
+		costs.Recycled = costs.Recycled.Add(amount)
+	case blockinflationtypes.CostPolicyCommunityPool:
+		moduleAddr := k.accountKeeper.GetModuleAddress(blockinflationtypes.ModuleName)
+		if err := k.distrKeeper.FundCommunityPool(ctx, coins, moduleAddr); err != nil {
+			return fmt.Errorf("failed to fund community pool: %w", err)
+		}
+		costs.CommunityPool = costs.CommunityPool.Add(amount)
+	default:
+		return fmt.Errorf("unknown cost policy %q", policy)
+	}
+	k.SetSubnetCosts(ctx, costs)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"subnet_cost_applied",
+			sdk.NewAttribute("netuid", fmt.Sprintf("%d", netuid)),
+			sdk.NewAttribute("source", source),
+			sdk.NewAttribute("policy", policy),
+			sdk.NewAttribute("amount", amount.String()),
+			sdk.NewAttribute("total_issuance", k.GetTotalIssuance(ctx).Amount.String()),
+		),
+	)
+
+	return nil
+}
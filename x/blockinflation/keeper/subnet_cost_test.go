@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
+	eventtypes "github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+func TestApplySubnetCost(t *testing.T) {
+	params := blockinflationtypes.DefaultParams()
+	k, _, ctx := setupCoinbaseKeeper(t, params)
+	bank := &supplyBank{supply: sdk.NewCoins(sdk.NewInt64Coin("ahetu", 1000))}
+	pool := &communityPool{}
+	k.bankKeeper = bank
+	k.accountKeeper = supplyAccounts{}
+	k.distrKeeper = pool
+	k.SetTotalIssuance(ctx, sdk.NewInt64Coin("ahetu", 1000))
+	contract := sdk.AccAddress(common.HexToAddress("0x00000000000000000000000000000000000000d4").Bytes())
+
+	// burned costs are collected from the contract, burned and removed from
+	// the total issuance to be emitted again
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.ApplySubnetCost(ctx, 1, eventtypes.SubnetCostLock, contract, math.NewInt(100)))
+	require.True(t, hasEvent(ctx.EventManager().Events(), "subnet_cost_applied"))
+	require.Equal(t, math.NewInt(900), k.GetTotalIssuance(ctx).Amount)
+	require.Equal(t, math.NewInt(900), bank.GetSupply(ctx, "ahetu").Amount)
+	require.Equal(t, math.NewInt(100), k.GetTotalBurned(ctx).Amount)
+	require.Equal(t, math.NewInt(100), k.GetSubnetCosts(ctx, 1).Burned)
+
+	// recycled costs are moved into the pending subnet rewards without minting
+	params.RegistrationCostPolicy = blockinflationtypes.CostPolicyRecycle
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.ApplySubnetCost(ctx, 1, eventtypes.SubnetCostRegistration, contract, math.NewInt(50)))
+	require.Equal(t, math.NewInt(900), k.GetTotalIssuance(ctx).Amount)
+	require.Equal(t, math.NewInt(900), bank.GetSupply(ctx, "ahetu").Amount)
+	require.Equal(t, math.NewInt(50), k.GetPendingSubnetRewards(ctx).Amount)
+	require.Equal(t, math.NewInt(50), k.GetSubnetCosts(ctx, 1).Recycled)
+
+	params.LockCostPolicy = blockinflationtypes.CostPolicyCommunityPool
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.ApplySubnetCost(ctx, 2, eventtypes.SubnetCostLock, contract, math.NewInt(30)))
+	require.Equal(t, math.NewInt(900), k.GetTotalIssuance(ctx).Amount)
+	require.Equal(t, math.NewInt(900), bank.GetSupply(ctx, "ahetu").Amount)
+	require.Equal(t, math.NewInt(30), pool.pool.AmountOf("ahetu").TruncateInt())
+	require.Equal(t, math.NewInt(30), k.GetSubnetCosts(ctx, 2).CommunityPool)
+
+	// only the active minter pays out the pending subnet rewards, recycled
+	// costs are burned otherwise
+	params.ActiveMinter = blockinflationtypes.MinterInflation
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.ApplySubnetCost(ctx, 1, eventtypes.SubnetCostRegistration, contract, math.NewInt(20)))
+	require.Equal(t, math.NewInt(880), k.GetTotalIssuance(ctx).Amount)
+	require.Equal(t, math.NewInt(880), bank.GetSupply(ctx, "ahetu").Amount)
+	require.Equal(t, math.NewInt(120), k.GetSubnetCosts(ctx, 1).Burned)
+	require.Equal(t, math.NewInt(200), bank.collected.AmountOf("ahetu"))
+
+	require.Error(t, k.ApplySubnetCost(ctx, 1, "unknown", contract, math.NewInt(20)))
+
+	allCosts := k.GetAllSubnetCosts(ctx)
+	require.Len(t, allCosts, 2)
+	require.Equal(t, uint16(1), allCosts[0].Netuid)
+	require.Equal(t, math.NewInt(120), allCosts[0].Burned)
+	require.True(t, allCosts[1].Burned.IsZero())
+}
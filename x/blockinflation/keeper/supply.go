@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
+)
+
+// vestingAccount is implemented by the accounts that still hold unvested coins
+type vestingAccount interface {
+	GetVestingCoins(blockTime time.Time) sdk.Coins
+}
+
+// IsActiveMinter returns whether the given module is allowed to mint new supply
+func (k Keeper) IsActiveMinter(ctx sdk.Context, minter string) bool {
+	return k.GetParams(ctx).ActiveMinter == minter
+}
+
+// RemainingSupply returns the amount that can still be issued before the supply cap
+func (k Keeper) RemainingSupply(ctx sdk.Context) math.Int {
+	remaining := k.GetParams(ctx).TotalSupply.Sub(k.GetTotalIssuance(ctx).Amount)
+	if remaining.IsNegative() {
+		return math.ZeroInt()
+	}
+	return remaining
+}
+
+// MintSupply mints new supply of the mint denom into the module account of the
+// minter and records it in the total issuance. Every mint of new supply goes
+// through here, so only the active minter can mint and the total issuance
+// never exceeds the supply cap.
+func (k Keeper) MintSupply(ctx sdk.Context, minter string, amount sdk.Coin) error {
+	params := k.GetParams(ctx)
+	if params.ActiveMinter != minter {
+		return errorsmod.Wrapf(blockinflationtypes.ErrInactiveMinter, "%s cannot mint, the active minter is %s", minter, params.ActiveMinter)
+	}
+	if amount.Denom != params.MintDenom {
+		return fmt.Errorf("invalid mint denom %s, expected %s", amount.Denom, params.MintDenom)
+	}
+	if !amount.Amount.IsPositive() {
+		return nil
+	}
+
+	issuance := k.GetTotalIssuance(ctx)
+	newIssuance := issuance.Amount.Add(amount.Amount)
+	if newIssuance.GT(params.TotalSupply) {
+		return errorsmod.Wrapf(blockinflationtypes.ErrSupplyCapExceeded,
+			"minting %s would issue %s, cap is %s", amount, newIssuance, params.TotalSupply)
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, minter, sdk.NewCoins(amount)); err != nil {
+		return fmt.Errorf("failed to mint coins: %w", err)
+	}
+	k.SetTotalIssuance(ctx, sdk.NewCoin(params.MintDenom, newIssuance))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"supply_minted",
+			sdk.NewAttribute("minter", minter),
+			sdk.NewAttribute("amount", amount.Amount.String()),
+			sdk.NewAttribute("total_issuance", newIssuance.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetSupplyBreakdown returns the supply of the mint denom split by where it is
+// held. The vesting coins are summed up over a page of the accounts only, so
+// the vesting and circulating amounts are those of the page.
+func (k Keeper) GetSupplyBreakdown(ctx sdk.Context, pageReq *query.PageRequest) (blockinflationtypes.SupplyBreakdown, *query.PageResponse, error) {
+	params := k.GetParams(ctx)
+	denom := params.MintDenom
+
+	lockedInAMM := math.ZeroInt()
+	for _, netuid := range k.eventKeeper.GetAllSubnetNetuids(ctx) {
+		lockedInAMM = lockedInAMM.Add(k.eventKeeper.GetSubnetTAO(ctx, netuid))
+	}
+
+	accounts, err := k.accountQuerier.Accounts(ctx, &authtypes.QueryAccountsRequest{Pagination: pageReq})
+	if err != nil {
+		return blockinflationtypes.SupplyBreakdown{}, nil, fmt.Errorf("failed to query accounts: %w", err)
+	}
+	vesting := math.ZeroInt()
+	for _, packed := range accounts.Accounts {
+		var account sdk.AccountI
+		if err := k.cdc.UnpackAny(packed, &account); err != nil {
+			return blockinflationtypes.SupplyBreakdown{}, nil, fmt.Errorf("failed to unpack account: %w", err)
+		}
+		if va, ok := account.(vestingAccount); ok {
+			vesting = vesting.Add(va.GetVestingCoins(ctx.BlockTime()).AmountOf(denom))
+		}
+	}
+
+	res, err := k.distrKeeper.CommunityPool(ctx, &distrtypes.QueryCommunityPoolRequest{})
+	if err != nil {
+		return blockinflationtypes.SupplyBreakdown{}, nil, fmt.Errorf("failed to query community pool: %w", err)
+	}
+	communityPool := res.Pool.AmountOf(denom).TruncateInt()
+
+	totalSupply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	circulating := totalSupply.Sub(lockedInAMM).Sub(vesting).Sub(communityPool)
+	if circulating.IsNegative() {
+		circulating = math.ZeroInt()
+	}
+
+	return blockinflationtypes.SupplyBreakdown{
+		ActiveMinter:  params.ActiveMinter,
+		Denom:         denom,
+		SupplyCap:     params.TotalSupply,
+		TotalSupply:   totalSupply,
+		Issued:        k.GetTotalIssuance(ctx).Amount,
+		Remaining:     k.RemainingSupply(ctx),
+		Burned:        k.GetTotalBurned(ctx).Amount,
+		LockedInAMM:   lockedInAMM,
+		Vesting:       vesting,
+		CommunityPool: communityPool,
+		Circulating:   circulating,
+	}, accounts.Pagination, nil
+}
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+
+	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
+)
+
+// supplyBank tracks the supply minted and burned by the modules
+type supplyBank struct {
+	blockinflationtypes.BankKeeper
+	supply    sdk.Coins
+	collected sdk.Coins
+}
+
+func (b *supplyBank) MintCoins(_ context.Context, _ string, amt sdk.Coins) error {
+	b.supply = b.supply.Add(amt...)
+	return nil
+}
+
+func (b *supplyBank) BurnCoins(_ context.Context, _ string, amt sdk.Coins) error {
+	b.supply = b.supply.Sub(amt...)
+	return nil
+}
+
+func (b *supplyBank) SendCoinsFromModuleToModule(context.Context, string, string, sdk.Coins) error {
+	return nil
+}
+
+func (b *supplyBank) SendCoinsFromAccountToModule(_ context.Context, _ sdk.AccAddress, _ string, amt sdk.Coins) error {
+	b.collected = b.collected.Add(amt...)
+	return nil
+}
+
+func (b *supplyBank) GetSupply(_ context.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.supply.AmountOf(denom))
+}
+
+// stubVestingAccount is an account with a fixed amount of unvested coins
+type stubVestingAccount struct {
+	*authtypes.BaseAccount
+	vesting sdk.Coins
+}
+
+func (a stubVestingAccount) GetVestingCoins(time.Time) sdk.Coins { return a.vesting }
+
+type supplyAccounts struct {
+	blockinflationtypes.AccountKeeper
+	accounts []sdk.AccountI
+}
+
+// Accounts pages through the accounts by offset
+func (a supplyAccounts) Accounts(_ context.Context, req *authtypes.QueryAccountsRequest) (*authtypes.QueryAccountsResponse, error) {
+	start, end := 0, len(a.accounts)
+	if req.Pagination != nil && req.Pagination.Limit > 0 {
+		start = min(int(req.Pagination.Offset), end)
+		end = min(start+int(req.Pagination.Limit), end)
+	}
+
+	res := &authtypes.QueryAccountsResponse{Pagination: &query.PageResponse{Total: uint64(len(a.accounts))}}
+	for _, account := range a.accounts[start:end] {
+		packed, err := codectypes.NewAnyWithValue(account)
+		if err != nil {
+			return nil, err
+		}
+		res.Accounts = append(res.Accounts, packed)
+	}
+	return res, nil
+}
+
+func (a supplyAccounts) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+type communityPool struct {
+	pool sdk.DecCoins
+}
+
+func (p *communityPool) CommunityPool(context.Context, *distrtypes.QueryCommunityPoolRequest) (*distrtypes.QueryCommunityPoolResponse, error) {
+	return &distrtypes.QueryCommunityPoolResponse{Pool: p.pool}, nil
+}
+
+func (p *communityPool) FundCommunityPool(_ context.Context, amount sdk.Coins, _ sdk.AccAddress) error {
+	p.pool = p.pool.Add(sdk.NewDecCoinsFromCoins(amount...)...)
+	return nil
+}
+
+func TestMintSupply(t *testing.T) {
+	params := blockinflationtypes.DefaultParams()
+	params.TotalSupply = math.NewInt(1000)
+	k, _, ctx := setupCoinbaseKeeper(t, params)
+	bank := &supplyBank{}
+	k.bankKeeper = bank
+
+	err := k.MintSupply(ctx, blockinflationtypes.MinterInflation, sdk.NewInt64Coin("ahetu", 100))
+	require.ErrorIs(t, err, blockinflationtypes.ErrInactiveMinter)
+
+	err = k.MintSupply(ctx, blockinflationtypes.MinterBlockInflation, sdk.NewInt64Coin("stake", 100))
+	require.Error(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.MintSupply(ctx, blockinflationtypes.MinterBlockInflation, sdk.NewInt64Coin("ahetu", 900)))
+	require.True(t, hasEvent(ctx.EventManager().Events(), "supply_minted"))
+	require.Equal(t, math.NewInt(900), k.GetTotalIssuance(ctx).Amount)
+	require.Equal(t, math.NewInt(100), k.RemainingSupply(ctx))
+
+	err = k.MintSupply(ctx, blockinflationtypes.MinterBlockInflation, sdk.NewInt64Coin("ahetu", 101))
+	require.ErrorIs(t, err, blockinflationtypes.ErrSupplyCapExceeded)
+	require.Equal(t, math.NewInt(900), bank.GetSupply(ctx, "ahetu").Amount)
+
+	// switching the active minter hands the remaining supply over
+	params.ActiveMinter = blockinflationtypes.MinterInflation
+	require.NoError(t, k.SetParams(ctx, params))
+	require.True(t, k.IsActiveMinter(ctx, blockinflationtypes.MinterInflation))
+	require.NoError(t, k.MintSupply(ctx, blockinflationtypes.MinterInflation, sdk.NewInt64Coin("ahetu", 100)))
+	require.True(t, k.RemainingSupply(ctx).IsZero())
+}
+
+func TestMintAndAllocateBlockInflationSupplyCap(t *testing.T) {
+	params := blockinflationtypes.DefaultParams()
+	params.SubnetOwnerCut = math.LegacyZeroDec()
+	// the halving emission at 99% issuance is 1000/64, above the remaining supply
+	params.TotalSupply = math.NewInt(1000)
+	params.DefaultBlockEmission = math.NewInt(1000)
+	k, _, ctx := setupCoinbaseKeeper(t, params)
+	bank := &supplyBank{}
+	k.bankKeeper = bank
+	ctx = ctx.WithBlockHeight(2)
+
+	// only the remaining supply is emitted close to the cap
+	k.SetTotalIssuance(ctx, sdk.NewCoin("ahetu", math.NewInt(990)))
+	require.NoError(t, k.MintAndAllocateBlockInflation(ctx))
+	require.Equal(t, params.TotalSupply, k.GetTotalIssuance(ctx).Amount)
+	require.Equal(t, math.NewInt(10), bank.GetSupply(ctx, "ahetu").Amount)
+
+	require.NoError(t, k.MintAndAllocateBlockInflation(ctx.WithBlockHeight(3)))
+	require.Equal(t, math.NewInt(10), bank.GetSupply(ctx, "ahetu").Amount)
+
+	// nothing is emitted while x/inflation is the active minter
+	k.SetTotalIssuance(ctx, sdk.NewCoin("ahetu", math.ZeroInt()))
+	params.ActiveMinter = blockinflationtypes.MinterInflation
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.MintAndAllocateBlockInflation(ctx.WithBlockHeight(4)))
+	require.True(t, k.GetTotalIssuance(ctx).IsZero())
+}
+
+func TestGetSupplyBreakdown(t *testing.T) {
+	k, ek, ctx := setupCoinbaseKeeper(t, blockinflationtypes.DefaultParams())
+	bank := &supplyBank{supply: sdk.NewCoins(sdk.NewCoin("ahetu", math.NewInt(1000).Mul(math.NewInt(1e18))))}
+	k.bankKeeper = bank
+	accounts := supplyAccounts{accounts: []sdk.AccountI{
+		authtypes.NewBaseAccountWithAddress(sdk.AccAddress("plain")),
+		stubVestingAccount{
+			BaseAccount: authtypes.NewBaseAccountWithAddress(sdk.AccAddress("vesting")),
+			vesting:     sdk.NewCoins(sdk.NewCoin("ahetu", math.NewInt(300).Mul(math.NewInt(1e18)))),
+		},
+	}}
+	k.accountKeeper = accounts
+	k.accountQuerier = accounts
+	k.distrKeeper = &communityPool{pool: sdk.NewDecCoins(sdk.NewDecCoin("ahetu", math.NewInt(50).Mul(math.NewInt(1e18))))}
+	k.SetTotalIssuance(ctx, sdk.NewCoin("ahetu", math.NewInt(1000).Mul(math.NewInt(1e18))))
+
+	breakdown, pageRes, err := k.GetSupplyBreakdown(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), pageRes.Total)
+
+	lockedInAMM := ek.GetSubnetTAO(ctx, 1).Add(ek.GetSubnetTAO(ctx, 2))
+	require.Equal(t, blockinflationtypes.MinterBlockInflation, breakdown.ActiveMinter)
+	require.Equal(t, lockedInAMM, breakdown.LockedInAMM)
+	require.Equal(t, math.NewInt(300).Mul(math.NewInt(1e18)), breakdown.Vesting)
+	require.Equal(t, math.NewInt(50).Mul(math.NewInt(1e18)), breakdown.CommunityPool)
+	require.Equal(t, math.NewInt(450).Mul(math.NewInt(1e18)), breakdown.Circulating)
+	require.Equal(t, breakdown.SupplyCap.Sub(breakdown.Issued), breakdown.Remaining)
+
+	// the vesting coins are only summed up over the accounts of the page
+	breakdown, _, err = k.GetSupplyBreakdown(ctx, &query.PageRequest{Limit: 1})
+	require.NoError(t, err)
+	require.True(t, breakdown.Vesting.IsZero())
+	require.Equal(t, math.NewInt(750).Mul(math.NewInt(1e18)), breakdown.Circulating)
+
+	breakdown, _, err = k.GetSupplyBreakdown(ctx, &query.PageRequest{Offset: 1, Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(300).Mul(math.NewInt(1e18)), breakdown.Vesting)
+}
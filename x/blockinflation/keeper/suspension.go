@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
+)
+
+func subnetEmissionSuspensionKey(netuid uint16) []byte {
+	key := make([]byte, 2)
+	binary.BigEndian.PutUint16(key, netuid)
+	return key
+}
+
+// GetSubnetEmissionSuspension returns the emission suspension of a subnet
+func (k Keeper) GetSubnetEmissionSuspension(ctx sdk.Context, netuid uint16) (blockinflationtypes.SubnetEmissionSuspension, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), blockinflationtypes.SubnetEmissionSuspensionPrefix)
+	bz := store.Get(subnetEmissionSuspensionKey(netuid))
+	if bz == nil {
+		return blockinflationtypes.SubnetEmissionSuspension{}, false
+	}
+
+	var suspension blockinflationtypes.SubnetEmissionSuspension
+	if err := json.Unmarshal(bz, &suspension); err != nil {
+		k.Logger(ctx).Error("failed to unmarshal subnet emission suspension", "netuid", netuid, "err", err)
+		return blockinflationtypes.SubnetEmissionSuspension{}, false
+	}
+	return suspension, true
+}
+
+// SetSubnetEmissionSuspension stores the emission suspension of a subnet
+func (k Keeper) SetSubnetEmissionSuspension(ctx sdk.Context, suspension blockinflationtypes.SubnetEmissionSuspension) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), blockinflationtypes.SubnetEmissionSuspensionPrefix)
+	bz, _ := json.Marshal(suspension)
+	store.Set(subnetEmissionSuspensionKey(suspension.Netuid), bz)
+}
+
+// IsSubnetEmissionSuspended returns whether the emission of a subnet is suspended
+func (k Keeper) IsSubnetEmissionSuspended(ctx sdk.Context, netuid uint16) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), blockinflationtypes.SubnetEmissionSuspensionPrefix)
+	return store.Has(subnetEmissionSuspensionKey(netuid))
+}
+
+// GetAllSubnetEmissionSuspensions returns the suspensions of all subnets ordered by netuid
+func (k Keeper) GetAllSubnetEmissionSuspensions(ctx sdk.Context) []blockinflationtypes.SubnetEmissionSuspension {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), blockinflationtypes.SubnetEmissionSuspensionPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	suspensions := []blockinflationtypes.SubnetEmissionSuspension{}
+	for ; iterator.Valid(); iterator.Next() {
+		var suspension blockinflationtypes.SubnetEmissionSuspension
+		if err := json.Unmarshal(iterator.Value(), &suspension); err != nil {
+			k.Logger(ctx).Error("failed to unmarshal subnet emission suspension", "key", iterator.Key(), "err", err)
+			continue
+		}
+		suspensions = append(suspensions, suspension)
+	}
+	return suspensions
+}
+
+// SuspendSubnetEmission stops the emission of a subnet after its coinbase
+// failed. The subnet gets no emission until governance resumes it.
+func (k Keeper) SuspendSubnetEmission(ctx sdk.Context, netuid uint16, reason string) {
+	k.SetSubnetEmissionSuspension(ctx, blockinflationtypes.SubnetEmissionSuspension{
+		Netuid:          netuid,
+		Reason:          reason,
+		SuspendedHeight: ctx.BlockHeight(),
+	})
+
+	k.Logger(ctx).Error("Subnet emission suspended",
+		"netuid", netuid,
+		"reason", reason,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"subnet_emission_suspended",
+			sdk.NewAttribute("netuid", fmt.Sprintf("%d", netuid)),
+			sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+}
+
+// ResumeSuspendedSubnet lifts the emission suspension of a subnet
+func (k Keeper) ResumeSuspendedSubnet(ctx sdk.Context, netuid uint16) error {
+	suspension, found := k.GetSubnetEmissionSuspension(ctx, netuid)
+	if !found {
+		return fmt.Errorf("emission of subnet %d is not suspended", netuid)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), blockinflationtypes.SubnetEmissionSuspensionPrefix)
+	store.Delete(subnetEmissionSuspensionKey(netuid))
+
+	k.Logger(ctx).Info("Subnet emission resumed",
+		"netuid", netuid,
+		"suspended_height", suspension.SuspendedHeight,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"subnet_emission_resumed",
+			sdk.NewAttribute("netuid", fmt.Sprintf("%d", netuid)),
+			sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+			sdk.NewAttribute("suspended_height", fmt.Sprintf("%d", suspension.SuspendedHeight)),
+		),
+	)
+
+	return nil
+}
+
+// filterSuspendedSubnets drops the subnets whose emission is suspended
+func (k Keeper) filterSuspendedSubnets(ctx sdk.Context, subnets []uint16) []uint16 {
+	active := make([]uint16, 0, len(subnets))
+	for _, netuid := range subnets {
+		if k.IsSubnetEmissionSuspended(ctx, netuid) {
+			k.Logger(ctx).Debug("Skipping subnet with suspended emission", "netuid", netuid)
+			continue
+		}
+		active = append(active, netuid)
+	}
+	return active
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	blockinflationtypes "github.com/hetu-project/hetu/v1/x/blockinflation/types"
+	stakeworktypes "github.com/hetu-project/hetu/v1/x/stakework/types"
+)
+
+// failingEpochStakework runs an epoch every block and fails the epoch of one subnet
+type failingEpochStakework struct {
+	noEpochStakework
+	netuid uint16
+}
+
+func (failingEpochStakework) ShouldRunEpoch(sdk.Context, uint16, uint64) bool { return true }
+
+func (s failingEpochStakework) RunEpoch(_ sdk.Context, netuid uint16, _ math.Int) (*stakeworktypes.EpochResult, error) {
+	if netuid == s.netuid {
+		return nil, errors.New("epoch failed")
+	}
+	return &stakeworktypes.EpochResult{Netuid: netuid}, nil
+}
+
+func TestRunCoinbaseSuspendsFailedSubnet(t *testing.T) {
+	params := blockinflationtypes.DefaultParams()
+	params.SubnetOwnerCut = math.LegacyZeroDec()
+	k, ek, ctx := setupCoinbaseKeeper(t, params)
+	k.stakeworkKeeper = failingEpochStakework{netuid: 1}
+	k.authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+	emission := math.NewInt(1e18)
+
+	taoIn1, taoIn2 := ek.GetSubnetTaoIn(ctx, 1), ek.GetSubnetTaoIn(ctx, 2)
+
+	// the emission of the block is minted into the pending subnet rewards
+	minted := sdk.NewCoin(params.MintDenom, emission)
+	bank := &supplyBank{supply: sdk.NewCoins(minted)}
+	k.bankKeeper = bank
+	k.SetTotalIssuance(ctx, minted)
+	k.SetPendingSubnetRewards(ctx, minted)
+
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	cacheCtx, _ := ctx.CacheContext()
+	rewards, err := k.CalculateSubnetRewards(cacheCtx, emission, []uint16{1, 2})
+	require.NoError(t, err)
+	require.True(t, rewards[1].TaoIn.IsPositive())
+	require.NoError(t, k.RunCoinbase(ctx, emission))
+	require.True(t, hasEvent(ctx.EventManager().Events(), "subnet_emission_suspended"))
+
+	// the share of the failed subnet is taken back from the mint
+	unminted := emission.Sub(rewards[1].TaoIn)
+	require.Equal(t, unminted, k.GetPendingSubnetRewards(ctx).Amount)
+	require.Equal(t, unminted, k.GetTotalIssuance(ctx).Amount)
+	require.Equal(t, unminted, bank.supply.AmountOf(params.MintDenom))
+	require.True(t, k.GetTotalBurned(ctx).IsZero())
+
+	// the failed subnet is rolled back completely
+	require.Equal(t, taoIn1, ek.GetSubnetTaoIn(ctx, 1))
+	require.True(t, ek.GetPendingEmission(ctx, 1).IsZero())
+	require.Zero(t, ek.GetBlocksSinceLastStep(ctx, 1))
+	suspension, found := k.GetSubnetEmissionSuspension(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, int64(2), suspension.SuspendedHeight)
+	require.Contains(t, suspension.Reason, "epoch failed")
+
+	// the other subnet is emitted to and drained
+	require.True(t, ek.GetSubnetTaoIn(ctx, 2).GT(taoIn2))
+	require.True(t, ek.GetPendingEmission(ctx, 2).IsZero())
+	require.False(t, k.IsSubnetEmissionSuspended(ctx, 2))
+
+	// the suspended subnet gets no emission in the following blocks
+	ctx = ctx.WithBlockHeight(3)
+	require.NoError(t, k.RunCoinbase(ctx, emission))
+	require.Equal(t, taoIn1, ek.GetSubnetTaoIn(ctx, 1))
+	require.Equal(t, []blockinflationtypes.SubnetEmissionSuspension{suspension}, k.GetAllSubnetEmissionSuspensions(ctx))
+
+	// only governance can resume the emission
+	_, err = k.ResumeSubnetEmission(ctx, blockinflationtypes.NewMsgResumeSubnetEmission(authtypes.NewModuleAddress("other").String(), 1))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = k.ResumeSubnetEmission(ctx, blockinflationtypes.NewMsgResumeSubnetEmission(k.authority.String(), 1))
+	require.NoError(t, err)
+	require.True(t, hasEvent(ctx.EventManager().Events(), "subnet_emission_resumed"))
+	require.False(t, k.IsSubnetEmissionSuspended(ctx, 1))
+	require.Empty(t, k.GetAllSubnetEmissionSuspensions(ctx))
+
+	// resuming a subnet that is not suspended fails
+	_, err = k.ResumeSubnetEmission(ctx, blockinflationtypes.NewMsgResumeSubnetEmission(k.authority.String(), 1))
+	require.Error(t, err)
+}
@@ -0,0 +1,28 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2types "github.com/hetu-project/hetu/v1/x/blockinflation/migrations/v2/types"
+	"github.com/hetu-project/hetu/v1/x/blockinflation/types"
+)
+
+// MigrateStore migrates the x/blockinflation module state from the consensus
+// version 1 to version 2. Specifically, it writes the defaults of the params
+// added since version 1 to the params subspace, keeping the values of the
+// params already set. Params missing from the subspace are otherwise only
+// defaulted in memory and cannot be read back by the params module.
+func MigrateStore(ctx sdk.Context, legacySubspace types.Subspace) error {
+	if !legacySubspace.HasKeyTable() {
+		legacySubspace = legacySubspace.WithKeyTable(v2types.ParamKeyTable())
+	}
+
+	params := v2types.DefaultParams()
+	legacySubspace.GetParamSetIfExists(ctx, &params)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	legacySubspace.SetParamSet(ctx, &params)
+	return nil
+}
@@ -0,0 +1,40 @@
+package v2_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/encoding"
+	v2 "github.com/hetu-project/hetu/v1/x/blockinflation/migrations/v2"
+	v2types "github.com/hetu-project/hetu/v1/x/blockinflation/migrations/v2/types"
+	"github.com/hetu-project/hetu/v1/x/blockinflation/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	storeKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	tKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	subspace := paramstypes.NewSubspace(encCfg.Codec, encCfg.Amino, storeKey, tKey, types.ModuleName).WithKeyTable(v2types.ParamKeyTable())
+
+	// version 1 params predate the price and root emission params
+	subspace.Set(ctx, v2types.KeyEnableBlockInflation, false)
+	subspace.Set(ctx, v2types.KeyMintDenom, "ahetu")
+	require.False(t, subspace.Has(ctx, v2types.KeyRootTempo))
+
+	require.NoError(t, v2.MigrateStore(ctx, subspace))
+
+	for _, pair := range (&v2types.V2Params{}).ParamSetPairs() {
+		require.True(t, subspace.Has(ctx, pair.Key), string(pair.Key))
+	}
+	var params v2types.V2Params
+	subspace.GetParamSet(ctx, &params)
+	expParams := v2types.DefaultParams()
+	expParams.EnableBlockInflation = false
+	expParams.MintDenom = "ahetu"
+	require.Equal(t, expParams, params)
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/hetu-project/hetu/v1/x/blockinflation/types"
+)
+
+var _ types.LegacyParams = &V2Params{}
+
+// Parameter keys of the x/params subspace
+var (
+	KeyEnableBlockInflation   = []byte("EnableBlockInflation")
+	KeyMintDenom              = []byte("MintDenom")
+	KeyTotalSupply            = []byte("TotalSupply")
+	KeyDefaultBlockEmission   = []byte("DefaultBlockEmission")
+	KeySubnetRewardBase       = []byte("SubnetRewardBase")
+	KeySubnetRewardK          = []byte("SubnetRewardK")
+	KeySubnetRewardMaxRatio   = []byte("SubnetRewardMaxRatio")
+	KeySubnetMovingAlpha      = []byte("SubnetMovingAlpha")
+	KeySubnetOwnerCut         = []byte("SubnetOwnerCut")
+	KeyPriceTwapWindow        = []byte("PriceTwapWindow")
+	KeyMaxPriceChangePerBlock = []byte("MaxPriceChangePerBlock")
+	KeySubnetEmissionMode     = []byte("SubnetEmissionMode")
+	KeyRootWeightRatio        = []byte("RootWeightRatio")
+	KeyRootDividendRatio      = []byte("RootDividendRatio")
+	KeyRootTempo              = []byte("RootTempo")
+)
+
+// V2Params defines the blockinflation parameters as managed by the x/params
+// subspace up to consensus version 2.
+type V2Params struct {
+	EnableBlockInflation   bool           `json:"enable_block_inflation" yaml:"enable_block_inflation"`
+	MintDenom              string         `json:"mint_denom" yaml:"mint_denom"`
+	TotalSupply            math.Int       `json:"total_supply" yaml:"total_supply"`
+	DefaultBlockEmission   math.Int       `json:"default_block_emission" yaml:"default_block_emission"`
+	SubnetRewardBase       math.LegacyDec `json:"subnet_reward_base" yaml:"subnet_reward_base"`
+	SubnetRewardK          math.LegacyDec `json:"subnet_reward_k" yaml:"subnet_reward_k"`
+	SubnetRewardMaxRatio   math.LegacyDec `json:"subnet_reward_max_ratio" yaml:"subnet_reward_max_ratio"`
+	SubnetMovingAlpha      math.LegacyDec `json:"subnet_moving_alpha" yaml:"subnet_moving_alpha"`
+	SubnetOwnerCut         math.LegacyDec `json:"subnet_owner_cut" yaml:"subnet_owner_cut"`
+	PriceTwapWindow        uint64         `json:"price_twap_window" yaml:"price_twap_window"`
+	MaxPriceChangePerBlock math.LegacyDec `json:"max_price_change_per_block" yaml:"max_price_change_per_block"`
+	SubnetEmissionMode     string         `json:"subnet_emission_mode" yaml:"subnet_emission_mode"`
+	RootWeightRatio        math.LegacyDec `json:"root_weight_ratio" yaml:"root_weight_ratio"`
+	RootDividendRatio      math.LegacyDec `json:"root_dividend_ratio" yaml:"root_dividend_ratio"`
+	RootTempo              uint64         `json:"root_tempo" yaml:"root_tempo"`
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&V2Params{})
+}
+
+// DefaultParams returns the default parameters in their legacy layout
+func DefaultParams() V2Params {
+	return FromParams(types.DefaultParams())
+}
+
+// FromParams converts module parameters to their legacy layout
+func FromParams(p types.Params) V2Params {
+	return V2Params{
+		EnableBlockInflation:   p.EnableBlockInflation,
+		MintDenom:              p.MintDenom,
+		TotalSupply:            p.TotalSupply,
+		DefaultBlockEmission:   p.DefaultBlockEmission,
+		SubnetRewardBase:       p.SubnetRewardBase,
+		SubnetRewardK:          p.SubnetRewardK,
+		SubnetRewardMaxRatio:   p.SubnetRewardMaxRatio,
+		SubnetMovingAlpha:      p.SubnetMovingAlpha,
+		SubnetOwnerCut:         p.SubnetOwnerCut,
+		PriceTwapWindow:        p.PriceTwapWindow,
+		MaxPriceChangePerBlock: p.MaxPriceChangePerBlock,
+		SubnetEmissionMode:     p.SubnetEmissionMode,
+		RootWeightRatio:        p.RootWeightRatio,
+		RootDividendRatio:      p.RootDividendRatio,
+		RootTempo:              p.RootTempo,
+	}
+}
+
+// ToParams converts the legacy parameters to the module parameters
+func (p V2Params) ToParams() types.Params {
+	return types.NewParams(
+		p.EnableBlockInflation,
+		p.MintDenom,
+		p.TotalSupply,
+		p.DefaultBlockEmission,
+		p.SubnetRewardBase,
+		p.SubnetRewardK,
+		p.SubnetRewardMaxRatio,
+		p.SubnetMovingAlpha,
+		p.SubnetOwnerCut,
+		p.PriceTwapWindow,
+		p.MaxPriceChangePerBlock,
+		p.SubnetEmissionMode,
+		p.RootWeightRatio,
+		p.RootDividendRatio,
+		p.RootTempo,
+		types.MinterBlockInflation,
+		types.CostPolicyBurn,
+		types.CostPolicyBurn,
+	)
+}
+
+// Validate performs basic validation on the legacy parameters.
+func (p V2Params) Validate() error {
+	return p.ToParams().Validate()
+}
+
+// Implements params.ParamSet. The values are validated as a whole by
+// Validate, the pairs only check the value types.
+func (p *V2Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnableBlockInflation, &p.EnableBlockInflation, validateType[bool]),
+		paramtypes.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateType[string]),
+		paramtypes.NewParamSetPair(KeyTotalSupply, &p.TotalSupply, validateType[math.Int]),
+		paramtypes.NewParamSetPair(KeyDefaultBlockEmission, &p.DefaultBlockEmission, validateType[math.Int]),
+		paramtypes.NewParamSetPair(KeySubnetRewardBase, &p.SubnetRewardBase, validateType[math.LegacyDec]),
+		paramtypes.NewParamSetPair(KeySubnetRewardK, &p.SubnetRewardK, validateType[math.LegacyDec]),
+		paramtypes.NewParamSetPair(KeySubnetRewardMaxRatio, &p.SubnetRewardMaxRatio, validateType[math.LegacyDec]),
+		paramtypes.NewParamSetPair(KeySubnetMovingAlpha, &p.SubnetMovingAlpha, validateType[math.LegacyDec]),
+		paramtypes.NewParamSetPair(KeySubnetOwnerCut, &p.SubnetOwnerCut, validateType[math.LegacyDec]),
+		paramtypes.NewParamSetPair(KeyPriceTwapWindow, &p.PriceTwapWindow, validateType[uint64]),
+		paramtypes.NewParamSetPair(KeyMaxPriceChangePerBlock, &p.MaxPriceChangePerBlock, validateType[math.LegacyDec]),
+		paramtypes.NewParamSetPair(KeySubnetEmissionMode, &p.SubnetEmissionMode, validateType[string]),
+		paramtypes.NewParamSetPair(KeyRootWeightRatio, &p.RootWeightRatio, validateType[math.LegacyDec]),
+		paramtypes.NewParamSetPair(KeyRootDividendRatio, &p.RootDividendRatio, validateType[math.LegacyDec]),
+		paramtypes.NewParamSetPair(KeyRootTempo, &p.RootTempo, validateType[uint64]),
+	}
+}
+
+func validateType[T any](i interface{}) error {
+	if _, ok := i.(T); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
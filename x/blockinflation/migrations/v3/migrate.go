@@ -0,0 +1,41 @@
+package v3
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2types "github.com/hetu-project/hetu/v1/x/blockinflation/migrations/v2/types"
+	"github.com/hetu-project/hetu/v1/x/blockinflation/types"
+)
+
+// MigrateStore migrates the x/blockinflation module state from the consensus
+// version 2 to version 3. Specifically, it takes the parameters that are
+// currently stored and managed by the Cosmos SDK params module and stores
+// them directly into the x/blockinflation module state.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	legacySubspace types.Subspace,
+	cdc codec.BinaryCodec,
+) error {
+	if !legacySubspace.HasKeyTable() {
+		legacySubspace = legacySubspace.WithKeyTable(v2types.ParamKeyTable())
+	}
+
+	legacyParams := v2types.DefaultParams()
+	legacySubspace.GetParamSetIfExists(ctx, &legacyParams)
+
+	params := legacyParams.ToParams()
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+	return nil
+}
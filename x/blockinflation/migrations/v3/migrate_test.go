@@ -0,0 +1,42 @@
+package v3_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/encoding"
+	v2types "github.com/hetu-project/hetu/v1/x/blockinflation/migrations/v2/types"
+	v3 "github.com/hetu-project/hetu/v1/x/blockinflation/migrations/v3"
+	"github.com/hetu-project/hetu/v1/x/blockinflation/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	paramsKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	tKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{types.StoreKey: storeKey, paramstypes.StoreKey: paramsKey},
+		map[string]*storetypes.TransientStoreKey{paramstypes.TStoreKey: tKey},
+		nil,
+	)
+	subspace := paramstypes.NewSubspace(encCfg.Codec, encCfg.Amino, paramsKey, tKey, types.ModuleName)
+
+	legacyParams := v2types.DefaultParams()
+	legacyParams.SubnetOwnerCut = math.LegacyNewDecWithPrec(15, 2)
+	legacyParams.SubnetMovingAlpha = math.LegacyNewDecWithPrec(1, 5)
+	subspace.WithKeyTable(v2types.ParamKeyTable()).SetParamSet(ctx, &legacyParams)
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, subspace, encCfg.Codec))
+
+	bz := ctx.KVStore(storeKey).Get(types.ParamsKey)
+	require.NotNil(t, bz)
+	var params types.Params
+	encCfg.Codec.MustUnmarshal(bz, &params)
+	require.Equal(t, legacyParams.ToParams(), params)
+}
@@ -0,0 +1,42 @@
+package v4
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hetu-project/hetu/v1/x/blockinflation/types"
+)
+
+// MigrateStore migrates the x/blockinflation module state from the consensus
+// version 3 to version 4. Specifically, it sets the active minter of the
+// supply policy to the block inflation module, which was the only module
+// minting before version 4.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	// The params are validated by the last migration, as the params of
+	// version 4 miss the fields added by the later versions.
+	if params.ActiveMinter == "" {
+		params.ActiveMinter = types.MinterBlockInflation
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
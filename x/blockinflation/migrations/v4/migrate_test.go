@@ -0,0 +1,36 @@
+package v4_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/encoding"
+	v4 "github.com/hetu-project/hetu/v1/x/blockinflation/migrations/v4"
+	"github.com/hetu-project/hetu/v1/x/blockinflation/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+
+	// params stored by consensus version 3 have no active minter, nor the cost
+	// policies of version 5
+	oldParams := types.DefaultParams()
+	oldParams.SubnetOwnerCut = math.LegacyNewDecWithPrec(15, 2)
+	oldParams.ActiveMinter = ""
+	oldParams.LockCostPolicy = ""
+	oldParams.RegistrationCostPolicy = ""
+	ctx.KVStore(storeKey).Set(types.ParamsKey, encCfg.Codec.MustMarshal(&oldParams))
+
+	require.NoError(t, v4.MigrateStore(ctx, storeKey, encCfg.Codec))
+
+	var params types.Params
+	encCfg.Codec.MustUnmarshal(ctx.KVStore(storeKey).Get(types.ParamsKey), &params)
+	require.Equal(t, types.MinterBlockInflation, params.ActiveMinter)
+	require.Equal(t, oldParams.SubnetOwnerCut, params.SubnetOwnerCut)
+}
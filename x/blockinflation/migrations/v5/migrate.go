@@ -0,0 +1,46 @@
+package v5
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hetu-project/hetu/v1/x/blockinflation/types"
+)
+
+// MigrateStore migrates the x/blockinflation module state from the consensus
+// version 4 to version 5. Specifically, it sets the lock and registration cost
+// policies to burn, as the costs paid to the contracts were already out of
+// circulation before version 5.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	if params.LockCostPolicy == "" {
+		params.LockCostPolicy = types.CostPolicyBurn
+	}
+	if params.RegistrationCostPolicy == "" {
+		params.RegistrationCostPolicy = types.CostPolicyBurn
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
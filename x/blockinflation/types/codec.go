@@ -3,6 +3,8 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
 var (
@@ -15,13 +17,32 @@ var (
 	AminoCdc = codec.NewAminoCodec(amino)
 )
 
+const (
+	// Amino names
+	updateParamsName         = "hetu/blockinflation/MsgUpdateParams"
+	resumeSubnetEmissionName = "hetu/blockinflation/MsgResumeSubnetEmission"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
 // RegisterLegacyAminoCodec registers the necessary x/blockinflation interfaces and concrete types
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
-	// Register concrete types here if needed
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgResumeSubnetEmission{}, resumeSubnetEmissionName, nil)
 }
 
 // RegisterInterfaces registers the x/blockinflation interfaces types with the interface registry
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
-	// Register interfaces here if needed
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgResumeSubnetEmission{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -16,3 +16,12 @@ type SubnetEmissionData struct {
 	SubnetAlphaOutEmission math.Int `json:"subnet_alpha_out_emission" yaml:"subnet_alpha_out_emission"`
 	SubnetTaoInEmission    math.Int `json:"subnet_tao_in_emission" yaml:"subnet_tao_in_emission"`
 }
+
+// SubnetEmissionSuspension records why the emission of a subnet was suspended.
+// A subnet is suspended when its coinbase step fails and stays suspended until
+// governance resumes it.
+type SubnetEmissionSuspension struct {
+	Netuid          uint16 `json:"netuid" yaml:"netuid"`
+	Reason          string `json:"reason" yaml:"reason"`
+	SuspendedHeight int64  `json:"suspended_height" yaml:"suspended_height"`
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInactiveMinter    = errorsmod.Register(ModuleName, 2, "minter is not the active minter")
+	ErrSupplyCapExceeded = errorsmod.Register(ModuleName, 3, "supply cap exceeded")
+)
@@ -7,6 +7,9 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -26,15 +29,27 @@ type AccountKeeper interface {
 	GetSequence(ctx context.Context, addr sdk.AccAddress) (uint64, error)
 }
 
+// AccountQuerier defines the expected interface needed to page through the accounts.
+type AccountQuerier interface {
+	Accounts(ctx context.Context, req *authtypes.QueryAccountsRequest) (*authtypes.QueryAccountsResponse, error)
+}
+
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx context.Context, name string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 	GetSupply(ctx context.Context, denom string) sdk.Coin
 }
 
+// DistrKeeper defines the expected interface needed to query and fund the community pool
+type DistrKeeper interface {
+	CommunityPool(ctx context.Context, req *distrtypes.QueryCommunityPoolRequest) (*distrtypes.QueryCommunityPoolResponse, error)
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 // EventKeeper defines the expected interface for the event module keeper
 // Deprecated: Use eventtypes.EventKeeper directly
 type EventKeeper = eventtypes.EventKeeper
@@ -43,6 +58,7 @@ type EventKeeper = eventtypes.EventKeeper
 type StakeworkKeeper interface {
 	ShouldRunEpoch(ctx sdk.Context, netuid uint16, tempo uint64) bool
 	RunEpoch(ctx sdk.Context, netuid uint16, raoEmission math.Int) (*stakeworktypes.EpochResult, error)
+	RunRootEpoch(ctx sdk.Context, netuids []uint16, kappa math.LegacyDec) (*stakeworktypes.RootEpochResult, error)
 }
 
 // ERC20Keeper defines the expected interface for the ERC20 module keeper
@@ -57,3 +73,15 @@ type EVMKeeper interface {
 	ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
 	EthereumTx(goCtx context.Context, msg *evmtypes.MsgEthereumTx) (*evmtypes.MsgEthereumTxResponse, error)
 }
+
+type (
+	LegacyParams = paramstypes.ParamSet
+	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
+	// NOTE: This is used solely for migration of the Cosmos SDK x/params managed parameters.
+	Subspace interface {
+		GetParamSetIfExists(ctx sdk.Context, ps LegacyParams)
+		SetParamSet(ctx sdk.Context, ps LegacyParams)
+		HasKeyTable() bool
+		WithKeyTable(table paramstypes.KeyTable) paramstypes.Subspace
+	}
+)
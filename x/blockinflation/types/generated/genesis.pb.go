@@ -25,11 +25,13 @@ const (
 
 // GenesisState defines the blockinflation module's genesis state.
 type GenesisState struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Params               *Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	TotalIssuance        *types.Coin            `protobuf:"bytes,2,opt,name=total_issuance,json=totalIssuance,proto3" json:"total_issuance,omitempty"`
-	TotalBurned          *types.Coin            `protobuf:"bytes,3,opt,name=total_burned,json=totalBurned,proto3" json:"total_burned,omitempty"`
-	PendingSubnetRewards *types.Coin            `protobuf:"bytes,4,opt,name=pending_subnet_rewards,json=pendingSubnetRewards,proto3" json:"pending_subnet_rewards,omitempty"`
+	state                protoimpl.MessageState      `protogen:"open.v1"`
+	Params               *Params                     `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	TotalIssuance        *types.Coin                 `protobuf:"bytes,2,opt,name=total_issuance,json=totalIssuance,proto3" json:"total_issuance,omitempty"`
+	TotalBurned          *types.Coin                 `protobuf:"bytes,3,opt,name=total_burned,json=totalBurned,proto3" json:"total_burned,omitempty"`
+	PendingSubnetRewards *types.Coin                 `protobuf:"bytes,4,opt,name=pending_subnet_rewards,json=pendingSubnetRewards,proto3" json:"pending_subnet_rewards,omitempty"`
+	SuspendedSubnets     []*SubnetEmissionSuspension `protobuf:"bytes,5,rep,name=suspended_subnets,json=suspendedSubnets,proto3" json:"suspended_subnets,omitempty"`
+	SubnetCosts          []*SubnetCosts              `protobuf:"bytes,6,rep,name=subnet_costs,json=subnetCosts,proto3" json:"subnet_costs,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -92,16 +94,179 @@ func (x *GenesisState) GetPendingSubnetRewards() *types.Coin {
 	return nil
 }
 
+func (x *GenesisState) GetSuspendedSubnets() []*SubnetEmissionSuspension {
+	if x != nil {
+		return x.SuspendedSubnets
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSubnetCosts() []*SubnetCosts {
+	if x != nil {
+		return x.SubnetCosts
+	}
+	return nil
+}
+
+// SubnetEmissionSuspension records why the emission of a subnet was suspended.
+type SubnetEmissionSuspension struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// netuid is the subnet whose emission is suspended.
+	Netuid uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	// reason is the error that rolled back the coinbase of the subnet.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// suspended_height is the block height at which the emission was suspended.
+	SuspendedHeight int64 `protobuf:"varint,3,opt,name=suspended_height,json=suspendedHeight,proto3" json:"suspended_height,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SubnetEmissionSuspension) Reset() {
+	*x = SubnetEmissionSuspension{}
+	mi := &file_hetu_blockinflation_v1_genesis_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubnetEmissionSuspension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubnetEmissionSuspension) ProtoMessage() {}
+
+func (x *SubnetEmissionSuspension) ProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_genesis_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubnetEmissionSuspension.ProtoReflect.Descriptor instead.
+func (*SubnetEmissionSuspension) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubnetEmissionSuspension) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+func (x *SubnetEmissionSuspension) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SubnetEmissionSuspension) GetSuspendedHeight() int64 {
+	if x != nil {
+		return x.SuspendedHeight
+	}
+	return 0
+}
+
+// SubnetCosts are the registration and lock costs paid for a subnet, split by
+// where the cost policy sent them. All amounts are in the mint denom.
+type SubnetCosts struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// netuid is the subnet the costs were paid for.
+	Netuid uint32 `protobuf:"varint,1,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	// burned is the cost burned and removed from the total issuance.
+	Burned string `protobuf:"bytes,2,opt,name=burned,proto3" json:"burned,omitempty"`
+	// recycled is the cost recycled into the pending subnet rewards.
+	Recycled string `protobuf:"bytes,3,opt,name=recycled,proto3" json:"recycled,omitempty"`
+	// community_pool is the cost sent to the community pool.
+	CommunityPool string `protobuf:"bytes,4,opt,name=community_pool,json=communityPool,proto3" json:"community_pool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubnetCosts) Reset() {
+	*x = SubnetCosts{}
+	mi := &file_hetu_blockinflation_v1_genesis_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubnetCosts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubnetCosts) ProtoMessage() {}
+
+func (x *SubnetCosts) ProtoReflect() protoreflect.Message {
+	mi := &file_hetu_blockinflation_v1_genesis_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubnetCosts.ProtoReflect.Descriptor instead.
+func (*SubnetCosts) Descriptor() ([]byte, []int) {
+	return file_hetu_blockinflation_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubnetCosts) GetNetuid() uint32 {
+	if x != nil {
+		return x.Netuid
+	}
+	return 0
+}
+
+func (x *SubnetCosts) GetBurned() string {
+	if x != nil {
+		return x.Burned
+	}
+	return ""
+}
+
+func (x *SubnetCosts) GetRecycled() string {
+	if x != nil {
+		return x.Recycled
+	}
+	return ""
+}
+
+func (x *SubnetCosts) GetCommunityPool() string {
+	if x != nil {
+		return x.CommunityPool
+	}
+	return ""
+}
+
 var File_hetu_blockinflation_v1_genesis_proto protoreflect.FileDescriptor
 
 const file_hetu_blockinflation_v1_genesis_proto_rawDesc = "" +
 	"\n" +
-	"$hetu/blockinflation/v1/genesis.proto\x12\x16hetu.blockinflation.v1\x1a\x14gogoproto/gogo.proto\x1a\x1ecosmos/base/v1beta1/coin.proto\x1a\"hetu/blockinflation/v1/query.proto\"\xaf\x02\n" +
+	"$hetu/blockinflation/v1/genesis.proto\x12\x16hetu.blockinflation.v1\x1a\x14gogoproto/gogo.proto\x1a\x1ecosmos/base/v1beta1/coin.proto\x1a#hetu/blockinflation/v1/params.proto\"\xe2\x03\n" +
 	"\fGenesisState\x12<\n" +
 	"\x06params\x18\x01 \x01(\v2\x1e.hetu.blockinflation.v1.ParamsB\x04\xc8\xde\x1f\x00R\x06params\x12F\n" +
 	"\x0etotal_issuance\x18\x02 \x01(\v2\x19.cosmos.base.v1beta1.CoinB\x04\xc8\xde\x1f\x00R\rtotalIssuance\x12B\n" +
 	"\ftotal_burned\x18\x03 \x01(\v2\x19.cosmos.base.v1beta1.CoinB\x04\xc8\xde\x1f\x00R\vtotalBurned\x12U\n" +
-	"\x16pending_subnet_rewards\x18\x04 \x01(\v2\x19.cosmos.base.v1beta1.CoinB\x04\xc8\xde\x1f\x00R\x14pendingSubnetRewardsB8Z6github.com/hetu-project/hetu/v1/x/blockinflation/typesb\x06proto3"
+	"\x16pending_subnet_rewards\x18\x04 \x01(\v2\x19.cosmos.base.v1beta1.CoinB\x04\xc8\xde\x1f\x00R\x14pendingSubnetRewards\x12c\n" +
+	"\x11suspended_subnets\x18\x05 \x03(\v20.hetu.blockinflation.v1.SubnetEmissionSuspensionB\x04\xc8\xde\x1f\x00R\x10suspendedSubnets\x12L\n" +
+	"\fsubnet_costs\x18\x06 \x03(\v2#.hetu.blockinflation.v1.SubnetCostsB\x04\xc8\xde\x1f\x00R\vsubnetCosts\"u\n" +
+	"\x18SubnetEmissionSuspension\x12\x16\n" +
+	"\x06netuid\x18\x01 \x01(\rR\x06netuid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12)\n" +
+	"\x10suspended_height\x18\x03 \x01(\x03R\x0fsuspendedHeight\"\xdd\x01\n" +
+	"\vSubnetCosts\x12\x16\n" +
+	"\x06netuid\x18\x01 \x01(\rR\x06netuid\x125\n" +
+	"\x06burned\x18\x02 \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\x06burned\x129\n" +
+	"\brecycled\x18\x03 \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\brecycled\x12D\n" +
+	"\x0ecommunity_pool\x18\x04 \x01(\tB\x1d\xc8\xde\x1f\x00\xda\xde\x1f\x15cosmossdk.io/math.IntR\rcommunityPoolB8Z6github.com/hetu-project/hetu/v1/x/blockinflation/typesb\x06proto3"
 
 var (
 	file_hetu_blockinflation_v1_genesis_proto_rawDescOnce sync.Once
@@ -115,22 +280,26 @@ func file_hetu_blockinflation_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_hetu_blockinflation_v1_genesis_proto_rawDescData
 }
 
-var file_hetu_blockinflation_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_hetu_blockinflation_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_hetu_blockinflation_v1_genesis_proto_goTypes = []any{
-	(*GenesisState)(nil), // 0: hetu.blockinflation.v1.GenesisState
-	(*Params)(nil),       // 1: hetu.blockinflation.v1.Params
-	(*types.Coin)(nil),   // 2: cosmos.base.v1beta1.Coin
+	(*GenesisState)(nil),             // 0: hetu.blockinflation.v1.GenesisState
+	(*SubnetEmissionSuspension)(nil), // 1: hetu.blockinflation.v1.SubnetEmissionSuspension
+	(*SubnetCosts)(nil),              // 2: hetu.blockinflation.v1.SubnetCosts
+	(*Params)(nil),                   // 3: hetu.blockinflation.v1.Params
+	(*types.Coin)(nil),               // 4: cosmos.base.v1beta1.Coin
 }
 var file_hetu_blockinflation_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: hetu.blockinflation.v1.GenesisState.params:type_name -> hetu.blockinflation.v1.Params
-	2, // 1: hetu.blockinflation.v1.GenesisState.total_issuance:type_name -> cosmos.base.v1beta1.Coin
-	2, // 2: hetu.blockinflation.v1.GenesisState.total_burned:type_name -> cosmos.base.v1beta1.Coin
-	2, // 3: hetu.blockinflation.v1.GenesisState.pending_subnet_rewards:type_name -> cosmos.base.v1beta1.Coin
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	3, // 0: hetu.blockinflation.v1.GenesisState.params:type_name -> hetu.blockinflation.v1.Params
+	4, // 1: hetu.blockinflation.v1.GenesisState.total_issuance:type_name -> cosmos.base.v1beta1.Coin
+	4, // 2: hetu.blockinflation.v1.GenesisState.total_burned:type_name -> cosmos.base.v1beta1.Coin
+	4, // 3: hetu.blockinflation.v1.GenesisState.pending_subnet_rewards:type_name -> cosmos.base.v1beta1.Coin
+	1, // 4: hetu.blockinflation.v1.GenesisState.suspended_subnets:type_name -> hetu.blockinflation.v1.SubnetEmissionSuspension
+	2, // 5: hetu.blockinflation.v1.GenesisState.subnet_costs:type_name -> hetu.blockinflation.v1.SubnetCosts
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_hetu_blockinflation_v1_genesis_proto_init() }
@@ -138,14 +307,14 @@ func file_hetu_blockinflation_v1_genesis_proto_init() {
 	if File_hetu_blockinflation_v1_genesis_proto != nil {
 		return
 	}
-	file_hetu_blockinflation_v1_query_proto_init()
+	file_hetu_blockinflation_v1_params_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hetu_blockinflation_v1_genesis_proto_rawDesc), len(file_hetu_blockinflation_v1_genesis_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
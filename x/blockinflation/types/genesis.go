@@ -17,6 +17,10 @@ type GenesisState struct {
 	TotalBurned sdk.Coin `json:"total_burned" yaml:"total_burned"`
 	// PendingSubnetRewards defines the pending subnet rewards pool
 	PendingSubnetRewards sdk.Coin `json:"pending_subnet_rewards" yaml:"pending_subnet_rewards"`
+	// SuspendedSubnets defines the subnets whose emission is suspended
+	SuspendedSubnets []SubnetEmissionSuspension `json:"suspended_subnets,omitempty" yaml:"suspended_subnets,omitempty"`
+	// SubnetCosts defines the registration and lock costs paid for the subnets
+	SubnetCosts []SubnetCosts `json:"subnet_costs,omitempty" yaml:"subnet_costs,omitempty"`
 }
 
 // DefaultGenesisState returns default genesis state
@@ -63,5 +67,26 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("pending_subnet_rewards denom %q must match params.mint_denom %q", gs.PendingSubnetRewards.Denom, gs.Params.MintDenom)
 	}
 
+	// Ensure each subnet is suspended at most once
+	suspended := make(map[uint16]bool, len(gs.SuspendedSubnets))
+	for _, suspension := range gs.SuspendedSubnets {
+		if suspended[suspension.Netuid] {
+			return fmt.Errorf("duplicate suspended subnet %d", suspension.Netuid)
+		}
+		suspended[suspension.Netuid] = true
+	}
+
+	// Ensure the costs of each subnet are valid and recorded at most once
+	costs := make(map[uint16]bool, len(gs.SubnetCosts))
+	for _, cost := range gs.SubnetCosts {
+		if costs[cost.Netuid] {
+			return fmt.Errorf("duplicate subnet costs %d", cost.Netuid)
+		}
+		if err := cost.Validate(); err != nil {
+			return err
+		}
+		costs[cost.Netuid] = true
+	}
+
 	return nil
 }
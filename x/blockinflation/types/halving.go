@@ -0,0 +1,99 @@
+package types
+
+import (
+	stdmath "math"
+	"math/big"
+
+	"cosmossdk.io/math"
+)
+
+// Halving is an upcoming halving of the block emission
+type Halving struct {
+	// Index is the number of halvings once the threshold is reached
+	Index uint64 `json:"index" yaml:"index"`
+	// Threshold is the total issuance at which the halving starts
+	Threshold math.Int `json:"threshold" yaml:"threshold"`
+	// BlockEmission is the block emission after the halving
+	BlockEmission math.Int `json:"block_emission" yaml:"block_emission"`
+	// EstimatedHeight is the estimated block height at which the total
+	// issuance reaches the threshold
+	EstimatedHeight int64 `json:"estimated_height" yaml:"estimated_height"`
+}
+
+// BlockEmissionSchedule is the current block emission with the upcoming
+// halvings of it
+type BlockEmissionSchedule struct {
+	Denom                string    `json:"denom" yaml:"denom"`
+	TotalSupply          math.Int  `json:"total_supply" yaml:"total_supply"`
+	TotalIssuance        math.Int  `json:"total_issuance" yaml:"total_issuance"`
+	CurrentHalving       uint64    `json:"current_halving" yaml:"current_halving"`
+	CurrentBlockEmission math.Int  `json:"current_block_emission" yaml:"current_block_emission"`
+	Halvings             []Halving `json:"halvings" yaml:"halvings"`
+}
+
+// HalvingThreshold returns the issuance at which the n-th halving starts,
+// supply * (1 - 2^-n) rounded up to a whole amount. The threshold reaches the
+// supply once 2^n exceeds it.
+func HalvingThreshold(supply math.Int, n uint64) math.Int {
+	if n >= uint64(supply.BigInt().BitLen()) {
+		return supply
+	}
+	return supply.Sub(math.NewIntFromBigInt(new(big.Int).Rsh(supply.BigInt(), uint(n))))
+}
+
+// HalvingIndex returns the number of halvings of the emission at the given
+// issuance, the largest n whose threshold the issuance has reached. This is
+// floor(log2(supply / (supply - issuance))) computed on integers only, so it
+// is exact at the halving boundaries. The issuance must be below the supply.
+func HalvingIndex(issuance, supply math.Int) uint64 {
+	n := uint64(0)
+	for issuance.GTE(HalvingThreshold(supply, n+1)) {
+		n++
+	}
+	return n
+}
+
+// HalveEmission halves the emission n times, rounding down
+func HalveEmission(emission math.Int, n uint64) math.Int {
+	if n >= uint64(emission.BigInt().BitLen()) {
+		return math.ZeroInt()
+	}
+	return math.NewIntFromBigInt(new(big.Int).Rsh(emission.BigInt(), uint(n)))
+}
+
+// HalvingSchedule returns the upcoming halvings of the block emission from the
+// given issuance and height, until the block emission rounds down to zero.
+// The heights are estimated assuming the issuance grows by exactly the block
+// emission every block.
+func HalvingSchedule(issuance, supply, defaultEmission math.Int, height int64) []Halving {
+	halvings := []Halving{}
+	if issuance.GTE(supply) {
+		return halvings
+	}
+
+	for n := HalvingIndex(issuance, supply); ; n++ {
+		emission := HalveEmission(defaultEmission, n)
+		threshold := HalvingThreshold(supply, n+1)
+		if !emission.IsPositive() || threshold.GTE(supply) {
+			return halvings
+		}
+
+		if issuance.LT(threshold) {
+			// round up, the threshold is reached within the last block
+			gap := threshold.Sub(issuance)
+			blocks := gap.Add(emission).SubRaw(1).Quo(emission)
+			if !blocks.IsInt64() || height > stdmath.MaxInt64-blocks.Int64() {
+				return halvings
+			}
+			issuance = issuance.Add(blocks.Mul(emission))
+			height += blocks.Int64()
+		}
+
+		halvings = append(halvings, Halving{
+			Index:           n + 1,
+			Threshold:       threshold,
+			BlockEmission:   HalveEmission(defaultEmission, n+1),
+			EstimatedHeight: height,
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHalvingIndexBoundaries(t *testing.T) {
+	supply, ok := math.NewIntFromString("21000000000000000000000000")
+	require.True(t, ok)
+
+	for _, s := range []math.Int{supply, supply.AddRaw(1), math.NewInt(1000), math.NewInt(999)} {
+		for n := uint64(1); n < uint64(s.BigInt().BitLen()); n++ {
+			threshold := HalvingThreshold(s, n)
+
+			// issuance * 2^n >= supply * (2^n - 1) exactly at the threshold
+			pow := new(big.Int).Lsh(big.NewInt(1), uint(n))
+			lhs := new(big.Int).Mul(threshold.BigInt(), pow)
+			rhs := new(big.Int).Mul(s.BigInt(), new(big.Int).Sub(pow, big.NewInt(1)))
+			require.True(t, lhs.Cmp(rhs) >= 0, "supply %s halving %d", s, n)
+			lhs.Sub(lhs, pow)
+			require.True(t, lhs.Cmp(rhs) < 0, "supply %s halving %d", s, n)
+
+			require.Equal(t, n, HalvingIndex(threshold, s), "supply %s halving %d", s, n)
+			require.Equal(t, n-1, HalvingIndex(threshold.SubRaw(1), s), "supply %s halving %d", s, n)
+		}
+	}
+}
+
+func TestHalveEmission(t *testing.T) {
+	emission := math.NewInt(1e18)
+	require.Equal(t, emission, HalveEmission(emission, 0))
+	require.Equal(t, math.NewInt(125000000000000000), HalveEmission(emission, 3))
+	require.True(t, HalveEmission(emission, 60).IsZero())
+	require.True(t, HalveEmission(emission, 1000).IsZero())
+}
+
+func TestHalvingSchedule(t *testing.T) {
+	halvings := HalvingSchedule(math.ZeroInt(), math.NewInt(1000), math.NewInt(100), 10)
+	require.Len(t, halvings, 7)
+	require.Equal(t, Halving{Index: 1, Threshold: math.NewInt(500), BlockEmission: math.NewInt(50), EstimatedHeight: 15}, halvings[0])
+	require.Equal(t, Halving{Index: 2, Threshold: math.NewInt(750), BlockEmission: math.NewInt(25), EstimatedHeight: 20}, halvings[1])
+	// 63 left to the threshold at 12 per block takes 6 blocks
+	require.Equal(t, Halving{Index: 4, Threshold: math.NewInt(938), BlockEmission: math.NewInt(6), EstimatedHeight: 31}, halvings[3])
+	require.Equal(t, Halving{Index: 7, Threshold: math.NewInt(993), BlockEmission: math.ZeroInt(), EstimatedHeight: 47}, halvings[6])
+
+	// the schedule starts at the current halving
+	halvings = HalvingSchedule(math.NewInt(760), math.NewInt(1000), math.NewInt(100), 10)
+	require.Equal(t, uint64(3), halvings[0].Index)
+	require.Equal(t, int64(15), halvings[0].EstimatedHeight)
+
+	require.Empty(t, HalvingSchedule(math.NewInt(1000), math.NewInt(1000), math.NewInt(100), 10))
+}
@@ -27,7 +27,25 @@ var (
 	// PendingSubnetRewardsKey defines the key for pending subnet rewards
 	PendingSubnetRewardsKey = []byte{0x03}
 
+	// RootEpochResultKey defines the key for the result of the last root epoch
+	RootEpochResultKey = []byte{0x04}
+
+	// ParamsKey defines the key for the module parameters
+	ParamsKey = []byte{0x05}
+
 	// PendingSubnetRewardsByNetUIDPrefix defines a map prefix for per-subnet rewards:
 	// 0x10 | netuid(2 bytes) -> rewards
 	PendingSubnetRewardsByNetUIDPrefix = []byte{0x10}
+
+	// PriceObservationPrefix defines a map prefix for the alpha price observations
+	// averaged into the moving price: 0x11 | netuid(2 bytes) | height(8 bytes) -> price
+	PriceObservationPrefix = []byte{0x11}
+
+	// SubnetEmissionSuspensionPrefix defines a map prefix for the subnets whose
+	// emission is suspended: 0x12 | netuid(2 bytes) -> suspension
+	SubnetEmissionSuspensionPrefix = []byte{0x12}
+
+	// SubnetCostsPrefix defines a map prefix for the registration and lock
+	// costs paid for the subnets: 0x13 | netuid(2 bytes) -> costs
+	SubnetCostsPrefix = []byte{0x13}
 )
@@ -5,61 +5,63 @@ import (
 
 	math "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"sigs.k8s.io/yaml"
 )
 
-// Parameter keys
-var (
-	KeyEnableBlockInflation = []byte("EnableBlockInflation")
-	KeyMintDenom            = []byte("MintDenom")
-	KeyTotalSupply          = []byte("TotalSupply")
-	KeyDefaultBlockEmission = []byte("DefaultBlockEmission")
-	KeySubnetRewardBase     = []byte("SubnetRewardBase")
-	KeySubnetRewardK        = []byte("SubnetRewardK")
-	KeySubnetRewardMaxRatio = []byte("SubnetRewardMaxRatio")
-	KeySubnetMovingAlpha    = []byte("SubnetMovingAlpha")
-	KeySubnetOwnerCut       = []byte("SubnetOwnerCut")
+// Subnet emission modes, deciding how the subnet emission is split across subnets
+const (
+	// EmissionModePrice splits the emission by the subnet moving prices
+	EmissionModePrice = "price"
+	// EmissionModeRoot splits the emission by the root network consensus
+	EmissionModeRoot = "root"
+	// EmissionModeBlend blends the price and root splits by RootWeightRatio
+	EmissionModeBlend = "blend"
 )
 
-// ParamKeyTable returns the parameter key table.
-func ParamKeyTable() paramstypes.KeyTable {
-	return paramstypes.NewKeyTable().RegisterParamSet(&Params{})
-}
-
-// Params defines the parameters for the blockinflation module.
-type Params struct {
-	// EnableBlockInflation enables or disables block inflation
-	EnableBlockInflation bool `json:"enable_block_inflation" yaml:"enable_block_inflation"`
-	// MintDenom defines the denomination of the minted token
-	MintDenom string `json:"mint_denom" yaml:"mint_denom"`
-	// TotalSupply defines the maximum total supply (21,000,000,000,000,000 RAO)
-	TotalSupply math.Int `json:"total_supply" yaml:"total_supply"`
-	// DefaultBlockEmission defines the default block emission (1,000,000,000 RAO)
-	DefaultBlockEmission math.Int `json:"default_block_emission" yaml:"default_block_emission"`
-	// SubnetRewardBase defines the base subnet reward ratio (e.g., 0.10)
-	SubnetRewardBase math.LegacyDec `json:"subnet_reward_base" yaml:"subnet_reward_base"`
-	// SubnetRewardK defines the growth rate coefficient (e.g., 0.1)
-	SubnetRewardK math.LegacyDec `json:"subnet_reward_k" yaml:"subnet_reward_k"`
-	// SubnetRewardMaxRatio defines the maximum subnet reward ratio (e.g., 0.5)
-	SubnetRewardMaxRatio math.LegacyDec `json:"subnet_reward_max_ratio" yaml:"subnet_reward_max_ratio"`
-	// SubnetMovingAlpha defines the moving average coefficient for subnet reward
-	SubnetMovingAlpha math.LegacyDec `json:"subnet_moving_alpha" yaml:"subnet_moving_alpha"`
-	// SubnetOwnerCut defines the percentage of alpha_out that goes to subnet owners (e.g., 0.18 = 18%)
-	SubnetOwnerCut math.LegacyDec `json:"subnet_owner_cut" yaml:"subnet_owner_cut"`
-}
+// Supply minters, the modules that can be the active minter of the supply policy
+const (
+	// MinterBlockInflation mints the per block halving emission of x/blockinflation
+	MinterBlockInflation = ModuleName
+	// MinterInflation mints the epoch based exponential inflation of x/inflation
+	MinterInflation = "inflation"
+)
+
+// Subnet cost policies, deciding where the HETU paid for subnet and neuron
+// registrations ends up in the chain supply
+const (
+	// CostPolicyBurn burns the cost, it is removed from the total issuance and
+	// emitted again by the block emission
+	CostPolicyBurn = "burn"
+	// CostPolicyRecycle recycles the cost into the pending subnet rewards
+	CostPolicyRecycle = "recycle"
+	// CostPolicyCommunityPool sends the cost to the community pool
+	CostPolicyCommunityPool = "community_pool"
+)
+
+// MaxPriceTwapWindow bounds the price observations kept per subnet.
+const MaxPriceTwapWindow = 10000
 
 // NewParams creates a new Params instance
-func NewParams(enableBlockInflation bool, mintDenom string, totalSupply, defaultBlockEmission math.Int, subnetRewardBase, subnetRewardK, subnetRewardMaxRatio, subnetMovingAlpha, subnetOwnerCut math.LegacyDec) Params {
+func NewParams(enableBlockInflation bool, mintDenom string, totalSupply, defaultBlockEmission math.Int, subnetRewardBase, subnetRewardK, subnetRewardMaxRatio, subnetMovingAlpha, subnetOwnerCut math.LegacyDec, priceTwapWindow uint64, maxPriceChangePerBlock math.LegacyDec, subnetEmissionMode string, rootWeightRatio, rootDividendRatio math.LegacyDec, rootTempo uint64, activeMinter, lockCostPolicy, registrationCostPolicy string) Params {
 	return Params{
-		EnableBlockInflation: enableBlockInflation,
-		MintDenom:            mintDenom,
-		TotalSupply:          totalSupply,
-		DefaultBlockEmission: defaultBlockEmission,
-		SubnetRewardBase:     subnetRewardBase,
-		SubnetRewardK:        subnetRewardK,
-		SubnetRewardMaxRatio: subnetRewardMaxRatio,
-		SubnetMovingAlpha:    subnetMovingAlpha,
-		SubnetOwnerCut:       subnetOwnerCut,
+		EnableBlockInflation:   enableBlockInflation,
+		MintDenom:              mintDenom,
+		TotalSupply:            totalSupply,
+		DefaultBlockEmission:   defaultBlockEmission,
+		SubnetRewardBase:       subnetRewardBase,
+		SubnetRewardK:          subnetRewardK,
+		SubnetRewardMaxRatio:   subnetRewardMaxRatio,
+		SubnetMovingAlpha:      subnetMovingAlpha,
+		SubnetOwnerCut:         subnetOwnerCut,
+		PriceTwapWindow:        priceTwapWindow,
+		MaxPriceChangePerBlock: maxPriceChangePerBlock,
+		SubnetEmissionMode:     subnetEmissionMode,
+		RootWeightRatio:        rootWeightRatio,
+		RootDividendRatio:      rootDividendRatio,
+		RootTempo:              rootTempo,
+		ActiveMinter:           activeMinter,
+		LockCostPolicy:         lockCostPolicy,
+		RegistrationCostPolicy: registrationCostPolicy,
 	}
 }
 
@@ -78,22 +80,22 @@ func DefaultParams() Params {
 		math.LegacyNewDecWithPrec(90, 2), // Default SubnetRewardMaxRatio (0.50)
 		math.LegacyNewDecWithPrec(3, 6),  // Default SubnetMovingAlpha (0.000003)
 		math.LegacyNewDecWithPrec(18, 2), // Default SubnetOwnerCut (0.18)
+		30,                               // Default PriceTwapWindow (30 blocks)
+		math.LegacyNewDecWithPrec(5, 2),  // Default MaxPriceChangePerBlock (0.05)
+		EmissionModePrice,                // Default SubnetEmissionMode (price)
+		math.LegacyNewDecWithPrec(5, 1),  // Default RootWeightRatio (0.5)
+		math.LegacyNewDecWithPrec(10, 2), // Default RootDividendRatio (0.10)
+		100,                              // Default RootTempo (100 blocks)
+		MinterBlockInflation,             // Default ActiveMinter (blockinflation)
+		CostPolicyBurn,                   // Default LockCostPolicy (burn)
+		CostPolicyBurn,                   // Default RegistrationCostPolicy (burn)
 	)
 }
 
-// ParamSetPairs returns the parameter set pairs.
-func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
-	return paramstypes.ParamSetPairs{
-		paramstypes.NewParamSetPair(KeyEnableBlockInflation, &p.EnableBlockInflation, validateEnableBlockInflation),
-		paramstypes.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
-		paramstypes.NewParamSetPair(KeyTotalSupply, &p.TotalSupply, validateTotalSupply),
-		paramstypes.NewParamSetPair(KeyDefaultBlockEmission, &p.DefaultBlockEmission, validateDefaultBlockEmission),
-		paramstypes.NewParamSetPair(KeySubnetRewardBase, &p.SubnetRewardBase, validateSubnetRewardBase),
-		paramstypes.NewParamSetPair(KeySubnetRewardK, &p.SubnetRewardK, validateSubnetRewardK),
-		paramstypes.NewParamSetPair(KeySubnetRewardMaxRatio, &p.SubnetRewardMaxRatio, validateSubnetRewardMaxRatio),
-		paramstypes.NewParamSetPair(KeySubnetMovingAlpha, &p.SubnetMovingAlpha, validateSubnetMovingAlpha),
-		paramstypes.NewParamSetPair(KeySubnetOwnerCut, &p.SubnetOwnerCut, validateSubnetOwnerCut),
-	}
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
 }
 
 // Validate performs basic validation on blockinflation parameters.
@@ -125,6 +127,33 @@ func (p Params) Validate() error {
 	if err := validateSubnetOwnerCut(p.SubnetOwnerCut); err != nil {
 		return err
 	}
+	if err := validatePriceTwapWindow(p.PriceTwapWindow); err != nil {
+		return err
+	}
+	if err := validateMaxPriceChangePerBlock(p.MaxPriceChangePerBlock); err != nil {
+		return err
+	}
+	if err := validateSubnetEmissionMode(p.SubnetEmissionMode); err != nil {
+		return err
+	}
+	if err := validateRootWeightRatio(p.RootWeightRatio); err != nil {
+		return err
+	}
+	if err := validateRootDividendRatio(p.RootDividendRatio); err != nil {
+		return err
+	}
+	if err := validateRootTempo(p.RootTempo); err != nil {
+		return err
+	}
+	if err := validateActiveMinter(p.ActiveMinter); err != nil {
+		return err
+	}
+	if err := validateCostPolicy(p.LockCostPolicy); err != nil {
+		return fmt.Errorf("lock cost policy: %w", err)
+	}
+	if err := validateCostPolicy(p.RegistrationCostPolicy); err != nil {
+		return fmt.Errorf("registration cost policy: %w", err)
+	}
 
 	// cross-field invariants
 	if p.SubnetRewardBase.GT(p.SubnetRewardMaxRatio) {
@@ -161,6 +190,9 @@ func validateTotalSupply(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if v.IsNil() {
+		return fmt.Errorf("total supply cannot be nil")
+	}
 	if !v.IsPositive() {
 		return fmt.Errorf("total supply must be positive")
 	}
@@ -172,6 +204,9 @@ func validateDefaultBlockEmission(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if v.IsNil() {
+		return fmt.Errorf("default block emission cannot be nil")
+	}
 	if !v.IsPositive() {
 		return fmt.Errorf("default block emission must be positive")
 	}
@@ -183,6 +218,9 @@ func validateSubnetRewardBase(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if v.IsNil() {
+		return fmt.Errorf("subnet reward base cannot be nil")
+	}
 	if v.IsNegative() {
 		return fmt.Errorf("subnet reward base cannot be negative")
 	}
@@ -197,6 +235,9 @@ func validateSubnetRewardK(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if v.IsNil() {
+		return fmt.Errorf("subnet reward k cannot be nil")
+	}
 	if v.IsNegative() {
 		return fmt.Errorf("subnet reward k cannot be negative")
 	}
@@ -208,6 +249,9 @@ func validateSubnetRewardMaxRatio(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if v.IsNil() {
+		return fmt.Errorf("subnet reward max ratio cannot be nil")
+	}
 	if v.IsNegative() {
 		return fmt.Errorf("subnet reward max ratio cannot be negative")
 	}
@@ -222,6 +266,9 @@ func validateSubnetMovingAlpha(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if v.IsNil() {
+		return fmt.Errorf("subnet moving alpha cannot be nil")
+	}
 	if v.IsNegative() {
 		return fmt.Errorf("subnet moving alpha cannot be negative")
 	}
@@ -236,6 +283,9 @@ func validateSubnetOwnerCut(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if v.IsNil() {
+		return fmt.Errorf("subnet owner cut cannot be nil")
+	}
 	if v.IsNegative() {
 		return fmt.Errorf("subnet owner cut cannot be negative")
 	}
@@ -244,3 +294,115 @@ func validateSubnetOwnerCut(i interface{}) error {
 	}
 	return nil
 }
+
+func validatePriceTwapWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > MaxPriceTwapWindow {
+		return fmt.Errorf("price twap window cannot be greater than %d blocks", MaxPriceTwapWindow)
+	}
+	return nil
+}
+
+func validateMaxPriceChangePerBlock(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return fmt.Errorf("max price change per block cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max price change per block cannot be negative")
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max price change per block cannot be greater than 1")
+	}
+	return nil
+}
+
+func validateSubnetEmissionMode(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	switch v {
+	case EmissionModePrice, EmissionModeRoot, EmissionModeBlend:
+		return nil
+	default:
+		return fmt.Errorf("invalid subnet emission mode %q, expected %s, %s or %s", v, EmissionModePrice, EmissionModeRoot, EmissionModeBlend)
+	}
+}
+
+func validateRootWeightRatio(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return fmt.Errorf("root weight ratio cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("root weight ratio cannot be negative")
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("root weight ratio cannot be greater than 1")
+	}
+	return nil
+}
+
+func validateRootDividendRatio(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return fmt.Errorf("root dividend ratio cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("root dividend ratio cannot be negative")
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("root dividend ratio cannot be greater than 1")
+	}
+	return nil
+}
+
+func validateRootTempo(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("root tempo must be positive")
+	}
+	return nil
+}
+
+func validateActiveMinter(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	switch v {
+	case MinterBlockInflation, MinterInflation:
+		return nil
+	default:
+		return fmt.Errorf("invalid active minter %q, expected %s or %s", v, MinterBlockInflation, MinterInflation)
+	}
+}
+
+func validateCostPolicy(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	switch v {
+	case CostPolicyBurn, CostPolicyRecycle, CostPolicyCommunityPool:
+		return nil
+	default:
+		return fmt.Errorf("invalid cost policy %q, expected %s, %s or %s", v, CostPolicyBurn, CostPolicyRecycle, CostPolicyCommunityPool)
+	}
+}
@@ -0,0 +1,1147 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hetu/blockinflation/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the blockinflation module.
+type Params struct {
+	EnableBlockInflation   bool                        `protobuf:"varint,1,opt,name=enable_block_inflation,json=enableBlockInflation,proto3" json:"enable_block_inflation,omitempty"`
+	MintDenom              string                      `protobuf:"bytes,2,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
+	TotalSupply            cosmossdk_io_math.Int       `protobuf:"bytes,3,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
+	DefaultBlockEmission   cosmossdk_io_math.Int       `protobuf:"bytes,4,opt,name=default_block_emission,json=defaultBlockEmission,proto3,customtype=cosmossdk.io/math.Int" json:"default_block_emission"`
+	SubnetRewardBase       cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=subnet_reward_base,json=subnetRewardBase,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"subnet_reward_base"`
+	SubnetRewardK          cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=subnet_reward_k,json=subnetRewardK,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"subnet_reward_k"`
+	SubnetRewardMaxRatio   cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=subnet_reward_max_ratio,json=subnetRewardMaxRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"subnet_reward_max_ratio"`
+	SubnetMovingAlpha      cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=subnet_moving_alpha,json=subnetMovingAlpha,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"subnet_moving_alpha"`
+	SubnetOwnerCut         cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=subnet_owner_cut,json=subnetOwnerCut,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"subnet_owner_cut"`
+	PriceTwapWindow        uint64                      `protobuf:"varint,10,opt,name=price_twap_window,json=priceTwapWindow,proto3" json:"price_twap_window,omitempty"`
+	MaxPriceChangePerBlock cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=max_price_change_per_block,json=maxPriceChangePerBlock,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_change_per_block"`
+	SubnetEmissionMode     string                      `protobuf:"bytes,12,opt,name=subnet_emission_mode,json=subnetEmissionMode,proto3" json:"subnet_emission_mode,omitempty"`
+	RootWeightRatio        cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=root_weight_ratio,json=rootWeightRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"root_weight_ratio"`
+	RootDividendRatio      cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=root_dividend_ratio,json=rootDividendRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"root_dividend_ratio"`
+	RootTempo              uint64                      `protobuf:"varint,15,opt,name=root_tempo,json=rootTempo,proto3" json:"root_tempo,omitempty"`
+	// active_minter is the module allowed to mint new supply of the mint denom,
+	// either blockinflation or inflation
+	ActiveMinter string `protobuf:"bytes,16,opt,name=active_minter,json=activeMinter,proto3" json:"active_minter,omitempty"`
+	// lock_cost_policy is where the part of the network lock cost burned at subnet
+	// registration ends up: burn, recycle or community_pool
+	LockCostPolicy string `protobuf:"bytes,17,opt,name=lock_cost_policy,json=lockCostPolicy,proto3" json:"lock_cost_policy,omitempty"`
+	// registration_cost_policy is where the neuron registration costs paid to the
+	// GlobalStaking treasury end up: burn, recycle or community_pool
+	RegistrationCostPolicy string `protobuf:"bytes,18,opt,name=registration_cost_policy,json=registrationCostPolicy,proto3" json:"registration_cost_policy,omitempty"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99a60824178a1fac, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnableBlockInflation() bool {
+	if m != nil {
+		return m.EnableBlockInflation
+	}
+	return false
+}
+
+func (m *Params) GetMintDenom() string {
+	if m != nil {
+		return m.MintDenom
+	}
+	return ""
+}
+
+func (m *Params) GetPriceTwapWindow() uint64 {
+	if m != nil {
+		return m.PriceTwapWindow
+	}
+	return 0
+}
+
+func (m *Params) GetSubnetEmissionMode() string {
+	if m != nil {
+		return m.SubnetEmissionMode
+	}
+	return ""
+}
+
+func (m *Params) GetRootTempo() uint64 {
+	if m != nil {
+		return m.RootTempo
+	}
+	return 0
+}
+
+func (m *Params) GetActiveMinter() string {
+	if m != nil {
+		return m.ActiveMinter
+	}
+	return ""
+}
+
+func (m *Params) GetLockCostPolicy() string {
+	if m != nil {
+		return m.LockCostPolicy
+	}
+	return ""
+}
+
+func (m *Params) GetRegistrationCostPolicy() string {
+	if m != nil {
+		return m.RegistrationCostPolicy
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "hetu.blockinflation.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("hetu/blockinflation/v1/params.proto", fileDescriptor_99a60824178a1fac)
+}
+
+var fileDescriptor_99a60824178a1fac = []byte{
+	// 658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x4f, 0x13, 0x4f,
+	0x14, 0xc0, 0xdb, 0xef, 0x17, 0x91, 0x0e, 0x3f, 0x4a, 0xc7, 0x5a, 0x27, 0x18, 0x0a, 0x91, 0x4b,
+	0x63, 0x62, 0x2b, 0xd1, 0x18, 0xe3, 0x49, 0x0b, 0x1e, 0x08, 0x36, 0xd4, 0x42, 0x42, 0xc2, 0x65,
+	0x32, 0xdd, 0x7d, 0x6c, 0x47, 0x76, 0x77, 0x36, 0x3b, 0xb3, 0xdd, 0xf6, 0xbf, 0xf0, 0xe8, 0xd1,
+	0x9b, 0xff, 0x0a, 0x47, 0x8e, 0xc6, 0x03, 0x31, 0xf0, 0x8f, 0x98, 0x79, 0xbb, 0x20, 0xd5, 0xcb,
+	0x7a, 0x6b, 0xdf, 0x7b, 0x9f, 0x4f, 0xde, 0xbe, 0x79, 0x33, 0x64, 0x6b, 0x04, 0x26, 0xe9, 0x0c,
+	0x7d, 0xe5, 0x9c, 0xc9, 0xf0, 0xd4, 0x17, 0x46, 0xaa, 0xb0, 0x33, 0xde, 0xee, 0x44, 0x22, 0x16,
+	0x81, 0x6e, 0x47, 0xb1, 0x32, 0x8a, 0x36, 0x6c, 0x51, 0x7b, 0xb6, 0xa8, 0x3d, 0xde, 0x5e, 0xab,
+	0x7b, 0xca, 0x53, 0x58, 0xd2, 0xb1, 0xbf, 0xb2, 0xea, 0x27, 0xdf, 0x2a, 0x64, 0xbe, 0x8f, 0x38,
+	0x7d, 0x49, 0x1a, 0x10, 0x8a, 0xa1, 0x0f, 0x1c, 0x61, 0x7e, 0x4b, 0xb3, 0xf2, 0x66, 0xb9, 0xb5,
+	0x30, 0xa8, 0x67, 0xd9, 0xae, 0x4d, 0xee, 0xdd, 0xe4, 0xe8, 0x3a, 0x21, 0x81, 0x0c, 0x0d, 0x77,
+	0x21, 0x54, 0x01, 0xfb, 0x6f, 0xb3, 0xdc, 0xaa, 0x0c, 0x2a, 0x36, 0xb2, 0x6b, 0x03, 0xf4, 0x2d,
+	0x59, 0x32, 0xca, 0x08, 0x9f, 0xeb, 0x24, 0x8a, 0xfc, 0x29, 0xfb, 0xdf, 0x16, 0x74, 0xd7, 0xcf,
+	0x2f, 0x37, 0x4a, 0x3f, 0x2e, 0x37, 0x1e, 0x3a, 0x4a, 0x07, 0x4a, 0x6b, 0xf7, 0xac, 0x2d, 0x55,
+	0x27, 0x10, 0x66, 0xd4, 0xde, 0x0b, 0xcd, 0x60, 0x11, 0x91, 0x43, 0x24, 0xe8, 0x21, 0x69, 0xb8,
+	0x70, 0x2a, 0x12, 0xdf, 0xe4, 0x7d, 0x41, 0x20, 0xb5, 0xb6, 0x6d, 0xcd, 0x15, 0x71, 0xd5, 0x73,
+	0x18, 0xdb, 0x7e, 0x9f, 0xa3, 0xf4, 0x23, 0xa1, 0x3a, 0x19, 0x86, 0x60, 0x78, 0x0c, 0xa9, 0x88,
+	0x5d, 0x3e, 0x14, 0x1a, 0xd8, 0x3d, 0x14, 0x6e, 0xe5, 0xc2, 0xc7, 0x7f, 0x0b, 0x3f, 0x80, 0x27,
+	0x9c, 0xe9, 0x2e, 0x38, 0x83, 0xd5, 0x0c, 0x1f, 0x20, 0xdd, 0x15, 0x1a, 0xe8, 0x3e, 0xa9, 0xce,
+	0x2a, 0xcf, 0xd8, 0x7c, 0x71, 0xdf, 0xf2, 0x5d, 0xdf, 0x3e, 0x3d, 0x21, 0x8f, 0x66, 0x65, 0x81,
+	0x98, 0xf0, 0xd8, 0x4e, 0x9c, 0xdd, 0x2f, 0x2e, 0xad, 0xdf, 0x95, 0xf6, 0xc4, 0x64, 0x60, 0x05,
+	0xf4, 0x90, 0x3c, 0xc8, 0xdd, 0x81, 0x1a, 0xcb, 0xd0, 0xe3, 0xc2, 0x8f, 0x46, 0x82, 0x2d, 0x14,
+	0xf7, 0xd6, 0x32, 0xbe, 0x87, 0xf8, 0x3b, 0x4b, 0xd3, 0x1e, 0xc9, 0x27, 0xc2, 0x55, 0x1a, 0x42,
+	0xcc, 0x9d, 0xc4, 0xb0, 0x4a, 0x71, 0xe3, 0x4a, 0x06, 0x1f, 0x58, 0x76, 0x27, 0x31, 0xf4, 0x29,
+	0xa9, 0x45, 0xb1, 0x74, 0x80, 0x9b, 0x54, 0x44, 0x3c, 0x95, 0xa1, 0xab, 0x52, 0x46, 0x36, 0xcb,
+	0xad, 0xb9, 0x41, 0x15, 0x13, 0x47, 0xa9, 0x88, 0x8e, 0x31, 0x4c, 0x39, 0x59, 0xb3, 0xd3, 0xc9,
+	0xea, 0x9d, 0x91, 0x08, 0x3d, 0xe0, 0x11, 0xc4, 0xd9, 0xb6, 0xb0, 0xc5, 0xe2, 0x4d, 0x34, 0x02,
+	0x31, 0xe9, 0x5b, 0xcb, 0x0e, 0x4a, 0xfa, 0x10, 0xe3, 0xd2, 0xd0, 0xe7, 0x24, 0x1f, 0xe4, 0xed,
+	0xea, 0xf1, 0x40, 0xb9, 0xc0, 0x96, 0x70, 0xd9, 0xf3, 0x45, 0xba, 0x59, 0xad, 0x9e, 0x72, 0x81,
+	0x1e, 0x90, 0x5a, 0xac, 0x94, 0xe1, 0x29, 0x48, 0x6f, 0x64, 0xf2, 0x83, 0x5b, 0x2e, 0xde, 0x49,
+	0xd5, 0xd2, 0xc7, 0x08, 0xdf, 0x9e, 0x19, 0x0a, 0x5d, 0x39, 0x96, 0x2e, 0x84, 0x6e, 0xae, 0x5c,
+	0xf9, 0x87, 0x33, 0xb3, 0xfc, 0x6e, 0x8e, 0x67, 0xd2, 0x75, 0x42, 0x50, 0x6a, 0x20, 0x88, 0x14,
+	0xab, 0xe2, 0x74, 0x2b, 0x36, 0x72, 0x64, 0x03, 0x74, 0x8b, 0x2c, 0x0b, 0xc7, 0xc8, 0x31, 0x70,
+	0x7b, 0x9d, 0x21, 0x66, 0xab, 0xf8, 0xbd, 0x4b, 0x59, 0xb0, 0x87, 0x31, 0xda, 0x22, 0xab, 0x78,
+	0x29, 0x1d, 0xa5, 0x0d, 0x8f, 0x94, 0x2f, 0x9d, 0x29, 0xab, 0x61, 0xdd, 0x8a, 0x8d, 0xef, 0x28,
+	0x6d, 0xfa, 0x18, 0xa5, 0xaf, 0x09, 0x8b, 0xc1, 0x93, 0xda, 0x60, 0xeb, 0xe1, 0x0c, 0x41, 0x91,
+	0x68, 0xdc, 0xcd, 0xff, 0x26, 0xdf, 0xcc, 0x7d, 0xf9, 0xba, 0x51, 0xea, 0xf6, 0xcf, 0xaf, 0x9a,
+	0xe5, 0x8b, 0xab, 0x66, 0xf9, 0xe7, 0x55, 0xb3, 0xfc, 0xf9, 0xba, 0x59, 0xba, 0xb8, 0x6e, 0x96,
+	0xbe, 0x5f, 0x37, 0x4b, 0x27, 0xaf, 0x3c, 0x69, 0x46, 0xc9, 0xb0, 0xed, 0xa8, 0xa0, 0x63, 0x1f,
+	0xbf, 0x67, 0x51, 0xac, 0x3e, 0x81, 0x63, 0xf0, 0x8f, 0x7d, 0x1f, 0x27, 0x7f, 0xbe, 0x99, 0x66,
+	0x1a, 0x81, 0x1e, 0xce, 0xe3, 0x13, 0xf8, 0xe2, 0xd7, 0x00, 0xee, 0x0a, 0xdf, 0xbf, 0x57, 0x05,
+	0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RegistrationCostPolicy) > 0 {
+		i -= len(m.RegistrationCostPolicy)
+		copy(dAtA[i:], m.RegistrationCostPolicy)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RegistrationCostPolicy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.LockCostPolicy) > 0 {
+		i -= len(m.LockCostPolicy)
+		copy(dAtA[i:], m.LockCostPolicy)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.LockCostPolicy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ActiveMinter) > 0 {
+		i -= len(m.ActiveMinter)
+		copy(dAtA[i:], m.ActiveMinter)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ActiveMinter)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.RootTempo != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RootTempo))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.RootDividendRatio.Size()
+		i -= size
+		if _, err := m.RootDividendRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.RootWeightRatio.Size()
+		i -= size
+		if _, err := m.RootWeightRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.SubnetEmissionMode) > 0 {
+		i -= len(m.SubnetEmissionMode)
+		copy(dAtA[i:], m.SubnetEmissionMode)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SubnetEmissionMode)))
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size := m.MaxPriceChangePerBlock.Size()
+		i -= size
+		if _, err := m.MaxPriceChangePerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.PriceTwapWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PriceTwapWindow))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.SubnetOwnerCut.Size()
+		i -= size
+		if _, err := m.SubnetOwnerCut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.SubnetMovingAlpha.Size()
+		i -= size
+		if _, err := m.SubnetMovingAlpha.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.SubnetRewardMaxRatio.Size()
+		i -= size
+		if _, err := m.SubnetRewardMaxRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SubnetRewardK.Size()
+		i -= size
+		if _, err := m.SubnetRewardK.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SubnetRewardBase.Size()
+		i -= size
+		if _, err := m.SubnetRewardBase.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DefaultBlockEmission.Size()
+		i -= size
+		if _, err := m.DefaultBlockEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EnableBlockInflation {
+		i--
+		if m.EnableBlockInflation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EnableBlockInflation {
+		n += 2
+	}
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.DefaultBlockEmission.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SubnetRewardBase.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SubnetRewardK.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SubnetRewardMaxRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SubnetMovingAlpha.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SubnetOwnerCut.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.PriceTwapWindow != 0 {
+		n += 1 + sovParams(uint64(m.PriceTwapWindow))
+	}
+	l = m.MaxPriceChangePerBlock.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.SubnetEmissionMode)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.RootWeightRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.RootDividendRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.RootTempo != 0 {
+		n += 1 + sovParams(uint64(m.RootTempo))
+	}
+	l = len(m.ActiveMinter)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.LockCostPolicy)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.RegistrationCostPolicy)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableBlockInflation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableBlockInflation = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultBlockEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultBlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubnetRewardBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubnetRewardBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubnetRewardK", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubnetRewardK.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubnetRewardMaxRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubnetRewardMaxRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubnetMovingAlpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubnetMovingAlpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubnetOwnerCut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubnetOwnerCut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTwapWindow", wireType)
+			}
+			m.PriceTwapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceTwapWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChangePerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceChangePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubnetEmissionMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubnetEmissionMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootWeightRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RootWeightRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootDividendRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RootDividendRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootTempo", wireType)
+			}
+			m.RootTempo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RootTempo |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveMinter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveMinter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockCostPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockCostPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationCostPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationCostPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
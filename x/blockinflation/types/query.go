@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc"
 
 	pb "github.com/hetu-project/hetu/v1/x/blockinflation/types/generated"
@@ -18,6 +19,9 @@ func ProtoParamsToParams(protoParams *pb.Params) Params {
 	subnetRewardMaxRatio, _ := math.LegacyNewDecFromStr(protoParams.SubnetRewardMaxRatio)
 	subnetMovingAlpha, _ := math.LegacyNewDecFromStr(protoParams.SubnetMovingAlpha)
 	subnetOwnerCut, _ := math.LegacyNewDecFromStr(protoParams.SubnetOwnerCut)
+	maxPriceChangePerBlock, _ := math.LegacyNewDecFromStr(protoParams.MaxPriceChangePerBlock)
+	rootWeightRatio, _ := math.LegacyNewDecFromStr(protoParams.RootWeightRatio)
+	rootDividendRatio, _ := math.LegacyNewDecFromStr(protoParams.RootDividendRatio)
 
 	return NewParams(
 		protoParams.EnableBlockInflation,
@@ -29,6 +33,15 @@ func ProtoParamsToParams(protoParams *pb.Params) Params {
 		subnetRewardMaxRatio,
 		subnetMovingAlpha,
 		subnetOwnerCut,
+		protoParams.PriceTwapWindow,
+		maxPriceChangePerBlock,
+		protoParams.SubnetEmissionMode,
+		rootWeightRatio,
+		rootDividendRatio,
+		protoParams.RootTempo,
+		protoParams.ActiveMinter,
+		protoParams.LockCostPolicy,
+		protoParams.RegistrationCostPolicy,
 	)
 }
 
@@ -50,12 +63,91 @@ type QueryPendingSubnetRewardsResponse struct {
 	PendingSubnetRewards sdk.Coin `json:"pending_subnet_rewards"`
 }
 
+// QuerySuspendedSubnetsRequest is the request type for the Query/SuspendedSubnets RPC method.
+type QuerySuspendedSubnetsRequest struct{}
+
+// QuerySuspendedSubnetsResponse is the response type for the Query/SuspendedSubnets RPC method.
+type QuerySuspendedSubnetsResponse struct {
+	// Suspensions defines the suspended subnets ordered by netuid.
+	Suspensions []SubnetEmissionSuspension `json:"suspensions"`
+}
+
+// QuerySuspendedSubnetRequest is the request type for the Query/SuspendedSubnet RPC method.
+type QuerySuspendedSubnetRequest struct {
+	// Netuid is the subnet to query.
+	Netuid uint16 `json:"netuid"`
+}
+
+// QuerySuspendedSubnetResponse is the response type for the Query/SuspendedSubnet RPC method.
+type QuerySuspendedSubnetResponse struct {
+	// Suspension defines the emission suspension of the subnet.
+	Suspension SubnetEmissionSuspension `json:"suspension"`
+}
+
+// QuerySupplyBreakdownRequest is the request type for the Query/SupplyBreakdown RPC method.
+type QuerySupplyBreakdownRequest struct {
+	// Pagination defines an optional pagination for the accounts whose vesting supply is summed up.
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QuerySupplyBreakdownResponse is the response type for the Query/SupplyBreakdown RPC method.
+// The vesting and circulating amounts only cover the vesting accounts of the page.
+type QuerySupplyBreakdownResponse struct {
+	SupplyBreakdown
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryBlockEmissionScheduleRequest is the request type for the Query/BlockEmissionSchedule RPC method.
+type QueryBlockEmissionScheduleRequest struct{}
+
+// QueryBlockEmissionScheduleResponse is the response type for the Query/BlockEmissionSchedule RPC method.
+type QueryBlockEmissionScheduleResponse struct {
+	BlockEmissionSchedule
+}
+
+// QueryAllSubnetCostsRequest is the request type for the Query/AllSubnetCosts RPC method.
+type QueryAllSubnetCostsRequest struct{}
+
+// QueryAllSubnetCostsResponse is the response type for the Query/AllSubnetCosts RPC method.
+type QueryAllSubnetCostsResponse struct {
+	// Denom is the mint denom.
+	Denom string `json:"denom"`
+	// SubnetCosts defines the costs of the subnets ordered by netuid.
+	SubnetCosts []SubnetCosts `json:"subnet_costs"`
+}
+
+// QuerySubnetCostsRequest is the request type for the Query/SubnetCosts RPC method.
+type QuerySubnetCostsRequest struct {
+	// Netuid is the subnet to query.
+	Netuid uint16 `json:"netuid"`
+}
+
+// QuerySubnetCostsResponse is the response type for the Query/SubnetCosts RPC method.
+type QuerySubnetCostsResponse struct {
+	// Denom is the mint denom.
+	Denom string `json:"denom"`
+	// SubnetCosts defines the costs of the subnet.
+	SubnetCosts SubnetCosts `json:"subnet_costs"`
+}
+
 // QueryClient is the client API for Query service.
 type QueryClient interface {
 	// Params queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// PendingSubnetRewards queries the pending subnet rewards.
 	PendingSubnetRewards(ctx context.Context, in *QueryPendingSubnetRewardsRequest, opts ...grpc.CallOption) (*QueryPendingSubnetRewardsResponse, error)
+	// SuspendedSubnets queries all subnets whose emission is suspended.
+	SuspendedSubnets(ctx context.Context, in *QuerySuspendedSubnetsRequest, opts ...grpc.CallOption) (*QuerySuspendedSubnetsResponse, error)
+	// SuspendedSubnet queries the emission suspension of a subnet.
+	SuspendedSubnet(ctx context.Context, in *QuerySuspendedSubnetRequest, opts ...grpc.CallOption) (*QuerySuspendedSubnetResponse, error)
+	// SupplyBreakdown queries the supply of the mint denom against the supply cap.
+	SupplyBreakdown(ctx context.Context, in *QuerySupplyBreakdownRequest, opts ...grpc.CallOption) (*QuerySupplyBreakdownResponse, error)
+	// BlockEmissionSchedule queries the current block emission and the upcoming halvings.
+	BlockEmissionSchedule(ctx context.Context, in *QueryBlockEmissionScheduleRequest, opts ...grpc.CallOption) (*QueryBlockEmissionScheduleResponse, error)
+	// AllSubnetCosts queries the registration and lock costs paid for all subnets.
+	AllSubnetCosts(ctx context.Context, in *QueryAllSubnetCostsRequest, opts ...grpc.CallOption) (*QueryAllSubnetCostsResponse, error)
+	// SubnetCosts queries the registration and lock costs paid for a subnet.
+	SubnetCosts(ctx context.Context, in *QuerySubnetCostsRequest, opts ...grpc.CallOption) (*QuerySubnetCostsResponse, error)
 }
 
 type queryClient struct {
@@ -90,3 +182,136 @@ func (c *queryClient) PendingSubnetRewards(ctx context.Context, in *QueryPending
 		},
 	}, nil
 }
+
+func (c *queryClient) SuspendedSubnets(ctx context.Context, in *QuerySuspendedSubnetsRequest, opts ...grpc.CallOption) (*QuerySuspendedSubnetsResponse, error) {
+	res, err := c.grpcClient.SuspendedSubnets(ctx, &pb.QuerySuspendedSubnetsRequest{}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	suspensions := make([]SubnetEmissionSuspension, 0, len(res.Suspensions))
+	for _, suspension := range res.Suspensions {
+		suspensions = append(suspensions, protoSuspensionToSuspension(suspension))
+	}
+
+	return &QuerySuspendedSubnetsResponse{
+		Suspensions: suspensions,
+	}, nil
+}
+
+func (c *queryClient) SuspendedSubnet(ctx context.Context, in *QuerySuspendedSubnetRequest, opts ...grpc.CallOption) (*QuerySuspendedSubnetResponse, error) {
+	res, err := c.grpcClient.SuspendedSubnet(ctx, &pb.QuerySuspendedSubnetRequest{Netuid: uint32(in.Netuid)}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QuerySuspendedSubnetResponse{
+		Suspension: protoSuspensionToSuspension(res.Suspension),
+	}, nil
+}
+
+func (c *queryClient) SupplyBreakdown(ctx context.Context, in *QuerySupplyBreakdownRequest, opts ...grpc.CallOption) (*QuerySupplyBreakdownResponse, error) {
+	res, err := c.grpcClient.SupplyBreakdown(ctx, &pb.QuerySupplyBreakdownRequest{Pagination: in.Pagination}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QuerySupplyBreakdownResponse{
+		SupplyBreakdown: SupplyBreakdown{
+			ActiveMinter:  res.ActiveMinter,
+			Denom:         res.Denom,
+			SupplyCap:     protoIntToInt(res.SupplyCap),
+			TotalSupply:   protoIntToInt(res.TotalSupply),
+			Issued:        protoIntToInt(res.Issued),
+			Remaining:     protoIntToInt(res.Remaining),
+			Burned:        protoIntToInt(res.Burned),
+			LockedInAMM:   protoIntToInt(res.LockedInAmm),
+			Vesting:       protoIntToInt(res.Vesting),
+			CommunityPool: protoIntToInt(res.CommunityPool),
+			Circulating:   protoIntToInt(res.Circulating),
+		},
+		Pagination: res.Pagination,
+	}, nil
+}
+
+func (c *queryClient) BlockEmissionSchedule(ctx context.Context, in *QueryBlockEmissionScheduleRequest, opts ...grpc.CallOption) (*QueryBlockEmissionScheduleResponse, error) {
+	res, err := c.grpcClient.BlockEmissionSchedule(ctx, &pb.QueryBlockEmissionScheduleRequest{}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	halvings := make([]Halving, 0, len(res.Halvings))
+	for _, halving := range res.Halvings {
+		halvings = append(halvings, Halving{
+			Index:           halving.GetIndex(),
+			Threshold:       protoIntToInt(halving.GetThreshold()),
+			BlockEmission:   protoIntToInt(halving.GetBlockEmission()),
+			EstimatedHeight: halving.GetEstimatedHeight(),
+		})
+	}
+
+	return &QueryBlockEmissionScheduleResponse{
+		BlockEmissionSchedule: BlockEmissionSchedule{
+			Denom:                res.Denom,
+			TotalSupply:          protoIntToInt(res.TotalSupply),
+			TotalIssuance:        protoIntToInt(res.TotalIssuance),
+			CurrentHalving:       res.CurrentHalving,
+			CurrentBlockEmission: protoIntToInt(res.CurrentBlockEmission),
+			Halvings:             halvings,
+		},
+	}, nil
+}
+
+func (c *queryClient) AllSubnetCosts(ctx context.Context, in *QueryAllSubnetCostsRequest, opts ...grpc.CallOption) (*QueryAllSubnetCostsResponse, error) {
+	res, err := c.grpcClient.AllSubnetCosts(ctx, &pb.QueryAllSubnetCostsRequest{}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	allCosts := make([]SubnetCosts, 0, len(res.SubnetCosts))
+	for _, costs := range res.SubnetCosts {
+		allCosts = append(allCosts, protoSubnetCostsToSubnetCosts(costs))
+	}
+
+	return &QueryAllSubnetCostsResponse{
+		Denom:       res.Denom,
+		SubnetCosts: allCosts,
+	}, nil
+}
+
+func (c *queryClient) SubnetCosts(ctx context.Context, in *QuerySubnetCostsRequest, opts ...grpc.CallOption) (*QuerySubnetCostsResponse, error) {
+	res, err := c.grpcClient.SubnetCosts(ctx, &pb.QuerySubnetCostsRequest{Netuid: uint32(in.Netuid)}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QuerySubnetCostsResponse{
+		Denom:       res.Denom,
+		SubnetCosts: protoSubnetCostsToSubnetCosts(res.SubnetCosts),
+	}, nil
+}
+
+func protoIntToInt(s string) math.Int {
+	i, ok := math.NewIntFromString(s)
+	if !ok {
+		return math.ZeroInt()
+	}
+	return i
+}
+
+func protoSuspensionToSuspension(suspension *pb.SubnetEmissionSuspension) SubnetEmissionSuspension {
+	return SubnetEmissionSuspension{
+		Netuid:          uint16(suspension.GetNetuid()),
+		Reason:          suspension.GetReason(),
+		SuspendedHeight: suspension.GetSuspendedHeight(),
+	}
+}
+
+func protoSubnetCostsToSubnetCosts(costs *pb.SubnetCosts) SubnetCosts {
+	return SubnetCosts{
+		Netuid:        uint16(costs.GetNetuid()),
+		Burned:        protoIntToInt(costs.GetBurned()),
+		Recycled:      protoIntToInt(costs.GetRecycled()),
+		CommunityPool: protoIntToInt(costs.GetCommunityPool()),
+	}
+}
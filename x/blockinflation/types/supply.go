@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// SupplyBreakdown splits the supply of the mint denom by where it is held.
+// Circulating is the total supply minus the amounts locked in the AMM pools,
+// in vesting accounts and in the community pool.
+type SupplyBreakdown struct {
+	ActiveMinter  string   `json:"active_minter" yaml:"active_minter"`
+	Denom         string   `json:"denom" yaml:"denom"`
+	SupplyCap     math.Int `json:"supply_cap" yaml:"supply_cap"`
+	TotalSupply   math.Int `json:"total_supply" yaml:"total_supply"`
+	Issued        math.Int `json:"issued" yaml:"issued"`
+	Remaining     math.Int `json:"remaining" yaml:"remaining"`
+	Burned        math.Int `json:"burned" yaml:"burned"`
+	LockedInAMM   math.Int `json:"locked_in_amm" yaml:"locked_in_amm"`
+	Vesting       math.Int `json:"vesting" yaml:"vesting"`
+	CommunityPool math.Int `json:"community_pool" yaml:"community_pool"`
+	Circulating   math.Int `json:"circulating" yaml:"circulating"`
+}
+
+// SubnetCosts are the registration and lock costs paid for a subnet, split by
+// where the cost policy sent them
+type SubnetCosts struct {
+	Netuid        uint16   `json:"netuid" yaml:"netuid"`
+	Burned        math.Int `json:"burned" yaml:"burned"`
+	Recycled      math.Int `json:"recycled" yaml:"recycled"`
+	CommunityPool math.Int `json:"community_pool" yaml:"community_pool"`
+}
+
+// NewSubnetCosts returns the empty costs of a subnet
+func NewSubnetCosts(netuid uint16) SubnetCosts {
+	return SubnetCosts{
+		Netuid:        netuid,
+		Burned:        math.ZeroInt(),
+		Recycled:      math.ZeroInt(),
+		CommunityPool: math.ZeroInt(),
+	}
+}
+
+// Validate checks that the costs are not negative
+func (c SubnetCosts) Validate() error {
+	for _, amount := range []math.Int{c.Burned, c.Recycled, c.CommunityPool} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("invalid costs of subnet %d", c.Netuid)
+		}
+	}
+	return nil
+}
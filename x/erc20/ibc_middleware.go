@@ -51,8 +51,8 @@ func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule) IBCMiddleware {
 // It receives the tokens through the default ICS20 OnRecvPacket callback logic
 // and then automatically converts the Cosmos Coin to their ERC20 token
 // representation.
-// If the acknowledgement fails, this callback will default to the ibc-core
-// packet callback.
+// If the acknowledgement fails or is asynchronous, this callback will default
+// to the ibc-core packet callback.
 func (im IBCMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -60,6 +60,12 @@ func (im IBCMiddleware) OnRecvPacket(
 ) exported.Acknowledgement {
 	ack := im.Module.OnRecvPacket(ctx, packet, relayer)
 
+	// return if the acknowledgement is asynchronous, as for a packet forwarded
+	// by the packet-forward middleware, since the coins are not held by the receiver
+	if ack == nil {
+		return nil
+	}
+
 	// return if the acknowledgement is an error ACK
 	if !ack.Success() {
 		return ack
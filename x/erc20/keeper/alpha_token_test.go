@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/app"
+	"github.com/hetu-project/hetu/v1/contracts"
+	"github.com/hetu-project/hetu/v1/testutil"
+	"github.com/hetu-project/hetu/v1/utils"
+	"github.com/hetu-project/hetu/v1/x/erc20/keeper"
+	"github.com/hetu-project/hetu/v1/x/erc20/types"
+	evm "github.com/hetu-project/hetu/v1/x/evm/types"
+	feemarkettypes "github.com/hetu-project/hetu/v1/x/feemarket/types"
+)
+
+// erc20MetadataEVM answers the ERC20 metadata calls of every contract without
+// running the EVM
+type erc20MetadataEVM struct {
+	types.EVMKeeper
+}
+
+func (erc20MetadataEVM) ApplyMessage(_ sdk.Context, msg core.Message, _ vm.EVMLogger, _ bool) (*evm.MsgEthereumTxResponse, error) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	method, err := erc20.MethodById(msg.Data())
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	switch method.Name {
+	case "name":
+		value = erc20Name
+	case "symbol":
+		value = erc20Symbol
+	case "decimals":
+		value = cosmosDecimals
+	default:
+		return nil, fmt.Errorf("unexpected call to %s", method.Name)
+	}
+	ret, err := method.Outputs.Pack(value)
+	if err != nil {
+		return nil, err
+	}
+	return &evm.MsgEthereumTxResponse{Ret: ret}, nil
+}
+
+func TestRegisterAlphaToken(t *testing.T) {
+	contractAddr := common.HexToAddress("0x00000000000000000000000000000000000000b1")
+	netuid := uint16(1)
+	coinName := types.CreateAlphaDenom(netuid)
+
+	testCases := []struct {
+		name     string
+		malleate func(ctx sdk.Context, k keeper.Keeper)
+		expPass  bool
+	}{
+		{
+			"token ERC20 already registered",
+			func(ctx sdk.Context, k keeper.Keeper) {
+				_, err := k.RegisterERC20(ctx, contractAddr)
+				require.NoError(t, err)
+			},
+			false,
+		},
+		{
+			"denom already registered",
+			func(ctx sdk.Context, k keeper.Keeper) {
+				pair := types.NewTokenPair(contractAddr, coinName, types.OWNER_EXTERNAL)
+				k.SetDenomMap(ctx, coinName, pair.GetID())
+			},
+			false,
+		},
+		{
+			"meta data already stored",
+			func(ctx sdk.Context, k keeper.Keeper) {
+				_, err := k.CreateAlphaCoinMetadata(ctx, netuid, contractAddr)
+				require.NoError(t, err)
+			},
+			false,
+		},
+		{
+			"ok",
+			func(sdk.Context, keeper.Keeper) {},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hetu := app.Setup(false, feemarkettypes.DefaultGenesisState())
+			header := testutil.NewHeader(1, time.Now().UTC(), utils.MainnetChainID+"-1", sdk.ConsAddress{}, nil, nil)
+			ctx := hetu.BaseApp.NewContextLegacy(false, header)
+			k := keeper.NewKeeper(
+				hetu.GetKey(types.StoreKey), hetu.AppCodec(),
+				authtypes.NewModuleAddress(govtypes.ModuleName), hetu.AccountKeeper,
+				hetu.BankKeeper, erc20MetadataEVM{}, hetu.StakingKeeper)
+
+			tc.malleate(ctx, k)
+
+			pair, err := k.RegisterAlphaToken(ctx, netuid, contractAddr)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, coinName, pair.Denom)
+			require.Equal(t, types.OWNER_EXTERNAL, pair.ContractOwner)
+			require.True(t, k.IsDenomRegistered(ctx, coinName))
+			require.True(t, k.IsERC20Registered(ctx, contractAddr))
+
+			metadata, found := hetu.BankKeeper.GetDenomMetaData(ctx, coinName)
+			require.True(t, found)
+			require.Equal(t, coinName, metadata.Base)
+			require.Equal(t, coinName, metadata.Name)
+			require.Equal(t, "alpha1", metadata.Display)
+			require.Equal(t, erc20Symbol, metadata.Symbol)
+			require.Len(t, metadata.DenomUnits, 2)
+			require.Equal(t, uint32(cosmosDecimals), metadata.DenomUnits[1].Exponent)
+		})
+	}
+}
@@ -209,6 +209,13 @@ func (k Keeper) ConvertCoinToERC20FromPacket(ctx sdk.Context, data transfertypes
 		return nil
 	}
 
+	// no-op, the refund was not credited to the sender. This is the case of a
+	// packet forwarded by the packet-forward middleware, which refunds the
+	// original sender on the previous hop instead.
+	if k.bankKeeper.GetBalance(ctx, sender, coin.Denom).Amount.LT(coin.Amount) {
+		return nil
+	}
+
 	msg := types.NewMsgConvertCoin(coin, common.BytesToAddress(sender), sender)
 
 	// NOTE: we don't use ValidateBasic the msg since we've already validated the
@@ -101,6 +101,11 @@ func (b *MockBankKeeper) HasSupply(_ sdk.Context, _ string) bool {
 	return args.Bool(0)
 }
 
+func (b *MockBankKeeper) GetSupply(_ context.Context, _ string) sdk.Coin {
+	args := b.Called(mock.Anything, mock.Anything)
+	return args.Get(0).(sdk.Coin)
+}
+
 func (b *MockBankKeeper) GetBalance(_ sdk.Context, _ sdk.AccAddress, _ string) sdk.Coin {
 	args := b.Called(mock.Anything, mock.Anything)
 	return args.Get(0).(sdk.Coin)
@@ -17,6 +17,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
@@ -94,6 +96,105 @@ func (k Keeper) RegisterERC20(
 	return &pair, nil
 }
 
+// RegisterAlphaToken creates the Cosmos coin of the alpha token of a subnet and
+// registers the token pair between the coin and the alpha token
+func (k Keeper) RegisterAlphaToken(
+	ctx sdk.Context,
+	netuid uint16,
+	contract common.Address,
+) (*types.TokenPair, error) {
+	// Check if ERC20 is already registered
+	if k.IsERC20Registered(ctx, contract) {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "token ERC20 contract already registered: %s", contract.String(),
+		)
+	}
+
+	metadata, err := k.CreateAlphaCoinMetadata(ctx, netuid, contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(
+			err, "failed to create alpha coin denom metadata for ERC20",
+		)
+	}
+
+	pair := types.NewTokenPair(contract, metadata.Base, types.OWNER_EXTERNAL)
+	k.SetTokenPair(ctx, pair)
+	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
+	k.SetERC20Map(ctx, common.HexToAddress(pair.Erc20Address), pair.GetID())
+	return &pair, nil
+}
+
+// CreateAlphaCoinMetadata generates the metadata to represent the alpha token
+// of a subnet on hetu. The base denomination is alpha/<netuid>.
+func (k Keeper) CreateAlphaCoinMetadata(
+	ctx sdk.Context,
+	netuid uint16,
+	contract common.Address,
+) (*banktypes.Metadata, error) {
+	strContract := contract.String()
+
+	erc20Data, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	// base denomination
+	base := types.CreateAlphaDenom(netuid)
+
+	// Check if metadata already exists
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, base); found {
+		return nil, errorsmod.Wrap(
+			types.ErrInternalTokenPair, "denom metadata already registered",
+		)
+	}
+
+	if k.IsDenomRegistered(ctx, base) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInternalTokenPair, "coin denomination already registered: %s", base,
+		)
+	}
+
+	metadata := banktypes.Metadata{
+		Description: types.CreateAlphaDenomDescription(netuid, strContract),
+		Base:        base,
+		// NOTE: Denom units MUST be increasing
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    base,
+				Exponent: 0,
+			},
+		},
+		Name:    base,
+		Symbol:  erc20Data.Symbol,
+		Display: base,
+	}
+
+	// only append metadata if decimals > 0, otherwise validation fails. The
+	// display denomination is derived from the netuid, as the names of the
+	// alpha tokens are chosen by the subnet owners and need not be unique.
+	if erc20Data.Decimals > 0 {
+		display := fmt.Sprintf("%s%d", types.AlphaDenomPrefix, netuid)
+		metadata.DenomUnits = append(
+			metadata.DenomUnits,
+			&banktypes.DenomUnit{
+				Denom:    display,
+				Exponent: uint32(erc20Data.Decimals),
+			},
+		)
+		metadata.Display = display
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return nil, errorsmod.Wrapf(
+			err, "alpha token data is invalid for contract %s", strContract,
+		)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	return &metadata, nil
+}
+
 // CreateCoinMetadata generates the metadata to represent the ERC20 token on
 // evmos.
 func (k Keeper) CreateCoinMetadata(
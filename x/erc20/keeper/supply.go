@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hetu-project/hetu/v1/contracts"
+)
+
+// GetSupply returns the supply of a denomination, as the bank keeper does.
+// The coins of a token pair owned by an ERC20 contract, such as the subnet
+// alpha tokens, only exist once converted from the contract, so their supply
+// is the total supply of the contract instead.
+//
+// It is used as the channel value of the IBC rate limits, which are a
+// percentage of the supply of the denomination.
+func (k Keeper) GetSupply(goCtx context.Context, denom string) sdk.Coin {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id := k.GetTokenPairID(ctx, denom)
+	if len(id) == 0 {
+		return k.bankKeeper.GetSupply(ctx, denom)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found || !pair.IsNativeERC20() || pair.Denom != denom {
+		return k.bankKeeper.GetSupply(ctx, denom)
+	}
+
+	supply := k.TotalSupply(ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, pair.GetERC20Contract())
+	if supply == nil {
+		return k.bankKeeper.GetSupply(ctx, denom)
+	}
+
+	return sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(supply))
+}
@@ -64,8 +64,10 @@ func (msg MsgConvertCoin) Type() string { return TypeMsgConvertCoin }
 // ValidateBasic runs stateless checks on the message
 func (msg MsgConvertCoin) ValidateBasic() error {
 	if err := ValidateErc20Denom(msg.Coin.Denom); err != nil {
-		if err := ibctransfertypes.ValidateIBCDenom(msg.Coin.Denom); err != nil {
-			return err
+		if err := ValidateAlphaDenom(msg.Coin.Denom); err != nil {
+			if err := ibctransfertypes.ValidateIBCDenom(msg.Coin.Denom); err != nil {
+				return err
+			}
 		}
 	}
 
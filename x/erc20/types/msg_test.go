@@ -122,6 +122,13 @@ func (suite *MsgsTestSuite) TestMsgConvertCoin() {
 			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
 			true,
 		},
+		{
+			"msg convert coin - pass with `alpha/{netuid}` denom",
+			sdk.NewCoin("alpha/1", math.NewInt(100)),
+			utiltx.GenerateAddress().String(),
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			true,
+		},
 		{
 			"msg convert coin - pass with `ibc/{hash}` denom",
 			sdk.NewCoin("ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", math.NewInt(100)),
@@ -18,6 +18,7 @@ package types
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -33,6 +34,9 @@ const (
 	ProposalTypeRegisterCoin          string = "RegisterCoin"
 	ProposalTypeRegisterERC20         string = "RegisterERC20"
 	ProposalTypeToggleTokenConversion string = "ToggleTokenConversion" // #nosec
+
+	// AlphaDenomPrefix prefixes the denominations of the subnet alpha tokens
+	AlphaDenomPrefix = "alpha"
 )
 
 // Implements Proposal Interface
@@ -61,6 +65,16 @@ func CreateDenom(address string) string {
 	return fmt.Sprintf("%s/%s", ModuleName, address)
 }
 
+// CreateAlphaDenom generates the denomination of the alpha token of a subnet
+func CreateAlphaDenom(netuid uint16) string {
+	return fmt.Sprintf("%s/%d", AlphaDenomPrefix, netuid)
+}
+
+// CreateAlphaDenomDescription generates a string with the alpha coin description
+func CreateAlphaDenomDescription(netuid uint16, address string) string {
+	return fmt.Sprintf("Cosmos coin token representation of the subnet %d alpha token %s", netuid, address)
+}
+
 // NewRegisterCoinProposal returns new instance of RegisterCoinProposal
 func NewRegisterCoinProposal(title, description string, coinMetadata ...banktypes.Metadata) v1beta1.Content {
 	return &RegisterCoinProposal{
@@ -135,6 +149,20 @@ func ValidateErc20Denom(denom string) error {
 	return evmostypes.ValidateAddress(denomSplit[1])
 }
 
+// ValidateAlphaDenom checks if a denom is the denom of a subnet alpha token
+func ValidateAlphaDenom(denom string) error {
+	denomSplit := strings.SplitN(denom, "/", 2)
+
+	if len(denomSplit) != 2 || denomSplit[0] != AlphaDenomPrefix {
+		return fmt.Errorf("invalid denom. %s denomination should be prefixed with the format 'alpha/", denom)
+	}
+
+	if _, err := strconv.ParseUint(denomSplit[1], 10, 16); err != nil {
+		return fmt.Errorf("invalid denom. %s denomination should end with a subnet netuid: %w", denom, err)
+	}
+	return nil
+}
+
 // NewRegisterERC20Proposal returns new instance of RegisterERC20Proposal
 func NewRegisterERC20Proposal(title, description string, erc20Addreses ...string) v1beta1.Content {
 	return &RegisterERC20Proposal{
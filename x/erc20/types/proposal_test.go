@@ -76,6 +76,59 @@ func (suite *ProposalTestSuite) TestCreateDenom() {
 	}
 }
 
+func (suite *ProposalTestSuite) TestCreateAlphaDenom() {
+	suite.Require().Equal("alpha/0", types.CreateAlphaDenom(0))
+	suite.Require().Equal("alpha/65535", types.CreateAlphaDenom(65535))
+}
+
+func (suite *ProposalTestSuite) TestValidateAlphaDenom() {
+	testCases := []struct {
+		name    string
+		denom   string
+		expPass bool
+	}{
+		{
+			"- instead of /",
+			"alpha-1",
+			false,
+		},
+		{
+			"erc20 prefix",
+			"erc20/1",
+			false,
+		},
+		{
+			"netuid not a number",
+			"alpha/one",
+			false,
+		},
+		{
+			"netuid overflows uint16",
+			"alpha/65536",
+			false,
+		},
+		{
+			"multiple /",
+			"alpha/1/test",
+			false,
+		},
+		{
+			"pass",
+			"alpha/1",
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		err := types.ValidateAlphaDenom(tc.denom)
+
+		if tc.expPass {
+			suite.Require().Nil(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
 func (suite *ProposalTestSuite) TestValidateErc20Denom() {
 	testCases := []struct {
 		name    string
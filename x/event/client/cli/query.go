@@ -1,19 +1,27 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	eventtypes "github.com/hetu-project/hetu/v1/hetu/event/v1"
+	"github.com/hetu-project/hetu/v1/x/event/types"
 )
 
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "subnet",
+		Aliases:                    []string{types.ModuleName},
 		Short:                      "Querying commands for the subnet",
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
@@ -25,6 +33,15 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySubnet(),
 		GetCmdQuerySubnetNeurons(),
 		GetCmdQuerySubnetPool(),
+		GetCmdQueryStakePosition(),
+		GetCmdQueryRegistrationCosts(),
+		GetCmdQueryParams(),
+		GetCmdQueryPriceHistory(),
+		GetCmdQueryPriceCandles(),
+		GetCmdQueryChildKeys(),
+		GetCmdQueryValidatorTake(),
+		GetCmdQueryDividends(),
+		GetCmdQueryNativeStake(),
 	)
 
 	return cmd
@@ -156,3 +173,350 @@ func GetCmdQuerySubnetPool() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryStakePosition implements the query stake position command.
+func GetCmdQueryStakePosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stake-position [account]",
+		Short: "Query the global stake of an account and its allocations to subnets",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid account: %s", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.StakePosition(cmd.Context(), &eventtypes.QueryStakePositionRequest{Account: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRegistrationCosts implements the query registration costs command.
+func GetCmdQueryRegistrationCosts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "registration-costs",
+		Short: "Query the registration costs paid to the treasury",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RegistrationCosts(cmd.Context(), &eventtypes.QueryRegistrationCostsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the event module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &eventtypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const (
+	flagStartHeight = "start-height"
+	flagEndHeight   = "end-height"
+	flagLimit       = "limit"
+)
+
+// GetCmdQueryPriceHistory implements the query price history command.
+func GetCmdQueryPriceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [netuid]",
+		Short: "Query the price and emission snapshots of a subnet",
+		Long: "Query the price and emission snapshots taken at each tempo of a subnet. " +
+			"When more snapshots are available, next_height is the --start-height of the next page.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			netuid, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return fmt.Errorf("invalid netuid: %w", err)
+			}
+			startHeight, err := cmd.Flags().GetInt64(flagStartHeight)
+			if err != nil {
+				return err
+			}
+			endHeight, err := cmd.Flags().GetInt64(flagEndHeight)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint64(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PriceHistory(cmd.Context(), &eventtypes.QueryPriceHistoryRequest{
+				Netuid:      uint32(netuid),
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				Limit:       limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, res)
+		},
+	}
+
+	cmd.Flags().Int64(flagStartHeight, 0, "First height of the snapshots, inclusive")
+	cmd.Flags().Int64(flagEndHeight, 0, "Last height of the snapshots, inclusive (0 for the latest)")
+	cmd.Flags().Uint64(flagLimit, 0, "Maximum number of snapshots returned (0 for the default)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPriceCandles implements the query price candles command.
+func GetCmdQueryPriceCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-candles [netuid]",
+		Short: "Query the per-block OHLC price candles of a subnet AMM pool",
+		Long: "Query the OHLC price candles of the blocks with swaps or liquidity changes in a subnet AMM pool. " +
+			"When more candles are available, next_height is the --start-height of the next page.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			netuid, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return fmt.Errorf("invalid netuid: %w", err)
+			}
+			startHeight, err := cmd.Flags().GetInt64(flagStartHeight)
+			if err != nil {
+				return err
+			}
+			endHeight, err := cmd.Flags().GetInt64(flagEndHeight)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint64(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PriceCandles(cmd.Context(), &eventtypes.QueryPriceCandlesRequest{
+				Netuid:      uint32(netuid),
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				Limit:       limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, res)
+		},
+	}
+
+	cmd.Flags().Int64(flagStartHeight, 0, "First height of the candles, inclusive")
+	cmd.Flags().Int64(flagEndHeight, 0, "Last height of the candles, inclusive (0 for the latest)")
+	cmd.Flags().Uint64(flagLimit, 0, "Maximum number of candles returned (0 for the default)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryChildKeys implements the query child keys command.
+func GetCmdQueryChildKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "child-keys [netuid] [hotkey]",
+		Short: "Query the child and parent keys of a hotkey in a subnet and its effective stake",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			netuid, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return fmt.Errorf("invalid netuid: %w", err)
+			}
+			if !common.IsHexAddress(args[1]) {
+				return fmt.Errorf("invalid hotkey: %s", args[1])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ChildKeys(cmd.Context(), &eventtypes.QueryChildKeysRequest{
+				Netuid: uint32(netuid),
+				Hotkey: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorTake implements the query validator take command.
+func GetCmdQueryValidatorTake() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-take [netuid] [validator]",
+		Short: "Query the take a validator keeps from its dividends in a subnet",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			netuid, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return fmt.Errorf("invalid netuid: %w", err)
+			}
+			if !common.IsHexAddress(args[1]) {
+				return fmt.Errorf("invalid validator: %s", args[1])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ValidatorTake(cmd.Context(), &eventtypes.QueryValidatorTakeRequest{
+				Netuid:    uint32(netuid),
+				Validator: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDividends implements the query dividends command.
+func GetCmdQueryDividends() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dividends [delegator]",
+		Short: "Query the alpha dividends a delegator can claim",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid delegator: %s", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Dividends(cmd.Context(), &eventtypes.QueryDividendsRequest{Delegator: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryNativeStake implements the query native stake command.
+func GetCmdQueryNativeStake() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "native-stake [validator]",
+		Short: "Query the bonded tokens of a validator operator counted as subnet stake",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid validator: %s", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.NativeStake(cmd.Context(), &eventtypes.QueryNativeStakeRequest{Validator: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// printJSON prints a query response, the event query types are not gogoproto
+// messages so they are not printed with the client codec.
+func printJSON(clientCtx client.Context, msg proto.Message) error {
+	bz, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintRaw(json.RawMessage(bz))
+}
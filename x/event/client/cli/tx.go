@@ -0,0 +1,353 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+// NewTxCmd returns a root CLI command handler for event transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "subnet",
+		Aliases:                    []string{types.ModuleName},
+		Short:                      "Subnet transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSetChildKeysCmd(),
+		NewSetChildKeyTakeCmd(),
+		NewSetValidatorTakeCmd(),
+		NewClaimDividendsCmd(),
+		NewSetNativeStakeAllocationCmd(),
+		NewSetWeightsCmd(),
+		NewUpdateParamsProposalCmd(),
+	)
+	return txCmd
+}
+
+// NewSetChildKeysCmd returns a CLI command handler for setting the child keys of the sender
+func NewSetChildKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-child-keys NETUID [CHILD_HEX:PROPORTION,...]",
+		Short: "Lend shares of the sender's stake weight in a subnet to child hotkeys. When the children are omitted, the current children are revoked.",
+		Long: "Lend shares of the sender's stake weight in a subnet to child hotkeys, e.g. 0xabc...:0.5,0xdef...:0.25. " +
+			"The new children replace the current ones after the child key cooldown.",
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			netuid, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return fmt.Errorf("invalid netuid: %w", err)
+			}
+
+			var children []types.ChildKey
+			if len(args) == 2 && args[1] != "" {
+				for _, entry := range strings.Split(args[1], ",") {
+					child, proportion, ok := strings.Cut(entry, ":")
+					if !ok {
+						return fmt.Errorf("invalid child key %q, expected CHILD_HEX:PROPORTION", entry)
+					}
+					dec, err := math.LegacyNewDecFromStr(proportion)
+					if err != nil {
+						return fmt.Errorf("invalid proportion for child %s: %w", child, err)
+					}
+					children = append(children, types.ChildKey{Child: child, Proportion: dec})
+				}
+			}
+
+			msg := types.NewMsgSetChildKeys(cliCtx.GetFromAddress(), uint16(netuid), children)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetChildKeyTakeCmd returns a CLI command handler for setting the take of the sender as a child key
+func NewSetChildKeyTakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-child-key-take NETUID TAKE",
+		Short: "Set the share of the parents' dividends the sender keeps as a child key in a subnet",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			netuid, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return fmt.Errorf("invalid netuid: %w", err)
+			}
+			take, err := math.LegacyNewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid take: %w", err)
+			}
+
+			msg := types.NewMsgSetChildKeyTake(cliCtx.GetFromAddress(), uint16(netuid), take)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetValidatorTakeCmd returns a CLI command handler for setting the take of the sender as a validator
+func NewSetValidatorTakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-validator-take NETUID TAKE",
+		Short: "Set the share of the dividends the sender keeps as a validator in a subnet before sharing them with its delegators",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			netuid, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return fmt.Errorf("invalid netuid: %w", err)
+			}
+			take, err := math.LegacyNewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid take: %w", err)
+			}
+
+			msg := types.NewMsgSetValidatorTake(cliCtx.GetFromAddress(), uint16(netuid), take)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewClaimDividendsCmd returns a CLI command handler for claiming the dividends of the sender as a delegator
+func NewClaimDividendsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-dividends [NETUID]",
+		Short: "Claim the alpha dividends the sender earned as a delegator. When the netuid is omitted, the dividends of all subnets are claimed.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimAllDividends(cliCtx.GetFromAddress())
+			if len(args) == 1 {
+				netuid, err := strconv.ParseUint(args[0], 10, 16)
+				if err != nil {
+					return fmt.Errorf("invalid netuid: %w", err)
+				}
+				msg = types.NewMsgClaimDividends(cliCtx.GetFromAddress(), uint16(netuid))
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetNativeStakeAllocationCmd returns a CLI command handler for allocating the bonded tokens of the sender as a validator operator to subnets
+func NewSetNativeStakeAllocationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-native-stake-allocation [NETUID:PROPORTION,...]",
+		Short: "Allocate shares of the tokens bonded to the sender's validator to subnets. When the allocations are omitted, the current allocations are revoked.",
+		Long: "Allocate shares of the tokens bonded to the sender's validator on x/staking to subnets, e.g. 1:0.5,2:0.25. " +
+			"The allocated tokens count as the validator's subnet stake while native stake is enabled.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var allocations []types.NativeSubnetAllocation
+			if len(args) == 1 && args[0] != "" {
+				for _, entry := range strings.Split(args[0], ",") {
+					netuidStr, proportion, ok := strings.Cut(entry, ":")
+					if !ok {
+						return fmt.Errorf("invalid allocation %q, expected NETUID:PROPORTION", entry)
+					}
+					netuid, err := strconv.ParseUint(netuidStr, 10, 16)
+					if err != nil {
+						return fmt.Errorf("invalid netuid %q: %w", netuidStr, err)
+					}
+					dec, err := math.LegacyNewDecFromStr(proportion)
+					if err != nil {
+						return fmt.Errorf("invalid proportion for netuid %d: %w", netuid, err)
+					}
+					allocations = append(allocations, types.NativeSubnetAllocation{Netuid: uint32(netuid), Proportion: dec})
+				}
+			}
+
+			msg := types.NewMsgSetNativeStakeAllocation(cliCtx.GetFromAddress(), allocations)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetWeightsCmd returns a CLI command handler for setting the weights of the sender as a validator
+func NewSetWeightsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-weights NETUID [DEST_HEX:WEIGHT,...]",
+		Short: "Set the weights of the sender as a validator in a subnet, or in the root network for netuid 0. When the weights are omitted, the current weights are cleared.",
+		Long: "Set the weights of the sender as a validator in a subnet, e.g. 0xabc...:100,0xdef...:50. " +
+			"The weights replace the ones previously set by the sender. The sender needs a validator permit on the subnet, " +
+			"or stake on the root network for netuid 0.",
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			netuid, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return fmt.Errorf("invalid netuid: %w", err)
+			}
+
+			var weights []types.DestWeight
+			if len(args) == 2 && args[1] != "" {
+				for _, entry := range strings.Split(args[1], ",") {
+					dest, weightStr, ok := strings.Cut(entry, ":")
+					if !ok {
+						return fmt.Errorf("invalid weight %q, expected DEST_HEX:WEIGHT", entry)
+					}
+					weight, err := strconv.ParseUint(weightStr, 10, 64)
+					if err != nil {
+						return fmt.Errorf("invalid weight for destination %s: %w", dest, err)
+					}
+					weights = append(weights, types.DestWeight{Dest: dest, Weight: weight})
+				}
+			}
+
+			msg := types.NewMsgSetWeights(cliCtx.GetFromAddress(), uint16(netuid), weights)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUpdateParamsProposalCmd implements the command to submit a governance
+// proposal updating the event parameters.
+func NewUpdateParamsProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params-proposal PARAMS_FILE",
+		Short: "Submit a proposal to update the event parameters",
+		Long: `Submit a governance proposal to update the event parameters along with an initial deposit.
+The parameters must be supplied in full via a JSON file, e.g. the output of the params query with the values to change edited.`,
+		Example: fmt.Sprintf(`$ %s tx subnet update-params-proposal params.json --title="Shorten price history" --summary="..." --deposit=10000000ahetu --from=<key_or_address>
+
+Where params.json contains (example):
+
+{
+  "price_history_retention": "2000",
+  "child_key_cooldown": "7200",
+  "max_child_key_take": "0.180000000000000000",
+  "min_validator_take": "0.000000000000000000",
+  "max_validator_take": "0.180000000000000000",
+  "default_validator_take": "0.180000000000000000",
+  "validator_take_cooldown": "7200",
+  "native_stake_enabled": false,
+  "alpha_token_pairs_enabled": true,
+  "price_candle_retention": "7200",
+  "global_staking_address": "0x...",
+  "subnet_manager_address": "0x..."
+}`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read params file: %w", err)
+			}
+
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+				return fmt.Errorf("failed to parse params file: %w", err)
+			}
+
+			authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+			msg := types.NewMsgUpdateParams(authority.String(), params)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	erc20types "github.com/hetu-project/hetu/v1/x/erc20/types"
+	eventabi "github.com/hetu-project/hetu/v1/x/event/abi"
+	"github.com/hetu-project/hetu/v1/x/event/keeper"
+)
+
+// recordingRegistrar records the alpha tokens registered as token pairs
+type recordingRegistrar struct {
+	tokens map[uint16]common.Address
+}
+
+func (r *recordingRegistrar) RegisterAlphaToken(_ sdk.Context, netuid uint16, contract common.Address) (*erc20types.TokenPair, error) {
+	if _, found := r.tokens[netuid]; found {
+		return nil, erc20types.ErrTokenPairAlreadyExists
+	}
+	r.tokens[netuid] = contract
+	pair := erc20types.NewTokenPair(contract, erc20types.CreateAlphaDenom(netuid), erc20types.OWNER_EXTERNAL)
+	return &pair, nil
+}
+
+// subnetManagerContract is the SubnetManager contract emitting the NetworkRegistered logs
+var subnetManagerContract = common.HexToAddress("0x00000000000000000000000000000000000000d5")
+
+// setSubnetManagerContract sets subnetManagerContract as the SubnetManager
+// contract in the params.
+func setSubnetManagerContract(t *testing.T, ctx sdk.Context, k *keeper.Keeper) {
+	params := k.GetParams(ctx)
+	params.SubnetManagerAddress = subnetManagerContract.Hex()
+	require.NoError(t, k.SetParams(ctx, params))
+}
+
+// hyperparams mirrors the hyperparams tuple of the NetworkRegistered event
+type hyperparams struct {
+	Rho                   uint16
+	Kappa                 uint16
+	ImmunityPeriod        uint16
+	Tempo                 uint16
+	MaxValidators         uint16
+	ActivityCutoff        uint16
+	MaxAllowedUids        uint16
+	MaxAllowedValidators  uint16
+	MinAllowedWeights     uint16
+	MaxWeightsLimit       uint16
+	BaseNeuronCost        *big.Int
+	CurrentDifficulty     uint64
+	TargetRegsPerInterval uint16
+	MaxRegsPerBlock       uint16
+	WeightsRateLimit      uint64
+	RegistrationAllowed   bool
+	CommitRevealEnabled   bool
+	CommitRevealPeriod    uint64
+	ServingRateLimit      uint64
+	ValidatorThreshold    *big.Int
+	NeuronThreshold       *big.Int
+}
+
+func networkRegisteredLog(t *testing.T, netuid uint16, alphaToken common.Address) ethTypes.Log {
+	event := parseABI(t, eventabi.SubnetManagerABI).Events["NetworkRegistered"]
+	data, err := event.Inputs.NonIndexed().Pack(
+		alphaToken,
+		common.HexToAddress("0x00000000000000000000000000000000000000a1"),
+		big.NewInt(1000),
+		big.NewInt(1000),
+		big.NewInt(100),
+		"subnet",
+		hyperparams{
+			BaseNeuronCost:     big.NewInt(0),
+			ValidatorThreshold: big.NewInt(0),
+			NeuronThreshold:    big.NewInt(0),
+		},
+	)
+	require.NoError(t, err)
+
+	owner := common.HexToAddress("0x00000000000000000000000000000000000000c3")
+	topics := []common.Hash{event.ID, common.BigToHash(big.NewInt(int64(netuid))), common.BytesToHash(owner.Bytes())}
+	return ethTypes.Log{Address: subnetManagerContract, Topics: topics, Data: data}
+}
+
+func TestNetworkRegisteredRegistersAlphaToken(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	registrar := &recordingRegistrar{tokens: map[uint16]common.Address{}}
+	k.SetAlphaTokenRegistrar(registrar)
+	setSubnetManagerContract(t, ctx, k)
+	alphaToken := common.HexToAddress("0x00000000000000000000000000000000000000b1")
+
+	k.HandleEvmLogs(ctx, []ethTypes.Log{networkRegisteredLog(t, 1, alphaToken)})
+	require.Equal(t, map[uint16]common.Address{1: alphaToken}, registrar.tokens)
+	info, found := k.GetSubnetInfo(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, alphaToken.Hex(), info.AlphaToken)
+
+	var attrs map[string]string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "alpha_token_pair_registered" {
+			attrs = map[string]string{}
+			for _, attr := range event.Attributes {
+				attrs[attr.Key] = attr.Value
+			}
+		}
+	}
+	require.Equal(t, map[string]string{"netuid": "1", "alpha_token": alphaToken.Hex(), "denom": "alpha/1"}, attrs)
+
+	// a failed registration leaves the subnet registered
+	k.HandleEvmLogs(ctx, []ethTypes.Log{networkRegisteredLog(t, 1, alphaToken)})
+	_, found = k.GetSubnetInfo(ctx, 1)
+	require.True(t, found)
+
+	// no token pair is registered when disabled
+	params := k.GetParams(ctx)
+	params.AlphaTokenPairsEnabled = false
+	require.NoError(t, k.SetParams(ctx, params))
+	k.HandleEvmLogs(ctx, []ethTypes.Log{networkRegisteredLog(t, 2, common.HexToAddress("0x00000000000000000000000000000000000000b2"))})
+	_, found = k.GetSubnetInfo(ctx, 2)
+	require.True(t, found)
+	require.Len(t, registrar.tokens, 1)
+
+	require.Panics(t, func() { k.SetAlphaTokenRegistrar(registrar) })
+}
+
+func TestNetworkRegisteredFromOtherContractDoesNotClaimAlphaToken(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	registrar := &recordingRegistrar{tokens: map[uint16]common.Address{}}
+	k.SetAlphaTokenRegistrar(registrar)
+	emitter := common.HexToAddress("0x00000000000000000000000000000000000000e5")
+
+	// any contract is accepted while the SubnetManager contract is not set
+	earlyToken := common.HexToAddress("0x00000000000000000000000000000000000000b2")
+	early := networkRegisteredLog(t, 2, earlyToken)
+	early.Address = emitter
+	k.HandleEvmLogs(ctx, []ethTypes.Log{early})
+	require.Equal(t, map[uint16]common.Address{2: earlyToken}, registrar.tokens)
+
+	// once it is set, a log from another contract cannot claim the alpha
+	// denom of a netuid
+	setSubnetManagerContract(t, ctx, k)
+	forged := networkRegisteredLog(t, 1, common.HexToAddress("0x00000000000000000000000000000000000000e6"))
+	forged.Address = emitter
+	k.HandleEvmLogs(ctx, []ethTypes.Log{forged})
+	require.Len(t, registrar.tokens, 1)
+	_, found := k.GetSubnetInfo(ctx, 1)
+	require.False(t, found)
+
+	// the registration by the SubnetManager registers its alpha token
+	alphaToken := common.HexToAddress("0x00000000000000000000000000000000000000b1")
+	k.HandleEvmLogs(ctx, []ethTypes.Log{networkRegisteredLog(t, 1, alphaToken)})
+	require.Equal(t, map[uint16]common.Address{1: alphaToken, 2: earlyToken}, registrar.tokens)
+}
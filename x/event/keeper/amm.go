@@ -0,0 +1,299 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+// ---------------- AMM pool index ----------------
+
+// setAmmPoolNetuid indexes the subnet of an AMM pool address, so that the pool
+// events can be attributed to their subnet.
+func (k Keeper) setAmmPoolNetuid(ctx sdk.Context, pool common.Address, netuid uint16) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("amm_pool:"))
+	store.Set(pool.Bytes(), uint16ToBytes(netuid))
+}
+
+// GetNetuidByAmmPool returns the subnet of a registered AMM pool address.
+func (k Keeper) GetNetuidByAmmPool(ctx sdk.Context, pool common.Address) (uint16, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("amm_pool:"))
+	bz := store.Get(pool.Bytes())
+	if len(bz) != 2 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint16(bz), true
+}
+
+// ---------------- AMM events ----------------
+
+// isAMMTopic returns true for the SubnetAMM events that change the pool state.
+func (k Keeper) isAMMTopic(topic string) bool {
+	switch topic {
+	case k.topicSwapHETUForAlpha, k.topicSwapAlphaForHETU, k.topicLiquidityInjected,
+		k.topicLiquidityWithdrawn, k.topicReservesUpdated:
+		return true
+	}
+	return false
+}
+
+// handleAMMLogs applies the SubnetAMM events of a transaction to the subnet
+// pools. Only the events emitted by registered pools are handled. When a pool
+// emits ReservesUpdated, its reserves are taken as is; otherwise the swap and
+// liquidity amounts are applied to the stored reserves. Every reserve change is
+// recorded in the OHLC candle of the block.
+func (k Keeper) handleAMMLogs(ctx sdk.Context, logs []ethTypes.Log) {
+	var pools []common.Address
+	byPool := make(map[common.Address][]ethTypes.Log)
+	for _, log := range logs {
+		if _, ok := byPool[log.Address]; !ok {
+			pools = append(pools, log.Address)
+		}
+		byPool[log.Address] = append(byPool[log.Address], log)
+	}
+
+	for _, pool := range pools {
+		netuid, found := k.GetNetuidByAmmPool(ctx, pool)
+		if !found {
+			k.Logger(ctx).Debug("Ignoring SubnetAMM event from unregistered pool", "contract_address", pool.Hex())
+			continue
+		}
+		k.applyPoolLogs(ctx, netuid, byPool[pool])
+	}
+}
+
+func (k Keeper) applyPoolLogs(ctx sdk.Context, netuid uint16, logs []ethTypes.Log) {
+	hasReserves := false
+	for _, log := range logs {
+		if log.Topics[0].Hex() == k.topicReservesUpdated {
+			hasReserves = true
+			break
+		}
+	}
+
+	taoIn := k.GetSubnetTaoIn(ctx, netuid)
+	alphaIn := k.GetSubnetAlphaIn(ctx, netuid)
+	volume := math.ZeroInt()
+	trades := uint64(0)
+	var prices []math.LegacyDec
+
+	for _, log := range logs {
+		switch log.Topics[0].Hex() {
+		case k.topicSwapHETUForAlpha:
+			var event struct {
+				HetuAmountIn   *big.Int
+				AlphaAmountOut *big.Int
+				NewPrice       *big.Int
+			}
+			if err := k.subnetAMMABI.UnpackIntoInterface(&event, "SwapHETUForAlpha", log.Data); err != nil {
+				k.Logger(ctx).Error("parse SwapHETUForAlpha failed", "err", err)
+				continue
+			}
+			volume = volume.Add(math.NewIntFromBigInt(event.HetuAmountIn))
+			trades++
+			if hasReserves {
+				continue
+			}
+			taoIn = taoIn.Add(math.NewIntFromBigInt(event.HetuAmountIn))
+			alphaIn = subFloorZero(alphaIn, math.NewIntFromBigInt(event.AlphaAmountOut))
+		case k.topicSwapAlphaForHETU:
+			var event struct {
+				AlphaAmountIn *big.Int
+				HetuAmountOut *big.Int
+				NewPrice      *big.Int
+			}
+			if err := k.subnetAMMABI.UnpackIntoInterface(&event, "SwapAlphaForHETU", log.Data); err != nil {
+				k.Logger(ctx).Error("parse SwapAlphaForHETU failed", "err", err)
+				continue
+			}
+			volume = volume.Add(math.NewIntFromBigInt(event.HetuAmountOut))
+			trades++
+			if hasReserves {
+				continue
+			}
+			alphaIn = alphaIn.Add(math.NewIntFromBigInt(event.AlphaAmountIn))
+			taoIn = subFloorZero(taoIn, math.NewIntFromBigInt(event.HetuAmountOut))
+		case k.topicLiquidityInjected, k.topicLiquidityWithdrawn:
+			if hasReserves {
+				continue
+			}
+			name := "LiquidityInjected"
+			if log.Topics[0].Hex() == k.topicLiquidityWithdrawn {
+				name = "LiquidityWithdrawn"
+			}
+			var event struct {
+				HetuAmount  *big.Int
+				AlphaAmount *big.Int
+			}
+			if err := k.subnetAMMABI.UnpackIntoInterface(&event, name, log.Data); err != nil {
+				k.Logger(ctx).Error("parse "+name+" failed", "err", err)
+				continue
+			}
+			if name == "LiquidityInjected" {
+				taoIn = taoIn.Add(math.NewIntFromBigInt(event.HetuAmount))
+				alphaIn = alphaIn.Add(math.NewIntFromBigInt(event.AlphaAmount))
+			} else {
+				taoIn = subFloorZero(taoIn, math.NewIntFromBigInt(event.HetuAmount))
+				alphaIn = subFloorZero(alphaIn, math.NewIntFromBigInt(event.AlphaAmount))
+			}
+		case k.topicReservesUpdated:
+			var event struct {
+				SubnetHetu     *big.Int
+				SubnetAlphaIn  *big.Int
+				SubnetAlphaOut *big.Int
+			}
+			if err := k.subnetAMMABI.UnpackIntoInterface(&event, "ReservesUpdated", log.Data); err != nil {
+				k.Logger(ctx).Error("parse ReservesUpdated failed", "err", err)
+				continue
+			}
+			taoIn = math.NewIntFromBigInt(event.SubnetHetu)
+			alphaIn = math.NewIntFromBigInt(event.SubnetAlphaIn)
+		default:
+			continue
+		}
+
+		if alphaIn.IsPositive() {
+			prices = append(prices, math.LegacyNewDecFromInt(taoIn).QuoInt(alphaIn))
+		}
+	}
+
+	k.SetSubnetTaoIn(ctx, netuid, taoIn)
+	k.SetSubnetAlphaIn(ctx, netuid, alphaIn)
+	if volume.IsPositive() {
+		k.SetSubnetVolume(ctx, netuid, k.GetSubnetVolume(ctx, netuid).Add(volume))
+	}
+	k.updatePriceCandle(ctx, netuid, prices, volume, trades)
+
+	k.Logger(ctx).Debug("Applied SubnetAMM events",
+		"netuid", netuid,
+		"tao_in", taoIn.String(),
+		"alpha_in", alphaIn.String(),
+		"volume", volume.String())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"amm_pool_updated",
+			sdk.NewAttribute("netuid", fmt.Sprintf("%d", netuid)),
+			sdk.NewAttribute("tao_in", taoIn.String()),
+			sdk.NewAttribute("alpha_in", alphaIn.String()),
+			sdk.NewAttribute("volume", volume.String()),
+		),
+	)
+}
+
+// subFloorZero subtracts b from a, flooring the result at zero. Stored reserves
+// that lag behind the pool are corrected by the reconciliation with the pool.
+func subFloorZero(a, b math.Int) math.Int {
+	if a.LT(b) {
+		return math.ZeroInt()
+	}
+	return a.Sub(b)
+}
+
+// ---------------- OHLC ----------------
+
+func priceCandleKey(netuid uint16, height int64) []byte {
+	key := make([]byte, 10)
+	binary.BigEndian.PutUint16(key, netuid)
+	binary.BigEndian.PutUint64(key[2:], uint64(height))
+	return key
+}
+
+// updatePriceCandle records the prices, volume and trades in the candle of the
+// current block, and prunes the candles older than PriceCandleRetention blocks.
+func (k Keeper) updatePriceCandle(ctx sdk.Context, netuid uint16, prices []math.LegacyDec, volume math.Int, trades uint64) {
+	retention := k.GetParams(ctx).PriceCandleRetention
+	if retention == 0 || (len(prices) == 0 && trades == 0) {
+		return
+	}
+
+	candle, found := k.GetPriceCandle(ctx, netuid, ctx.BlockHeight())
+	if !found {
+		if len(prices) == 0 {
+			return
+		}
+		candle = types.NewPriceCandle(netuid, ctx.BlockHeight(), prices[0])
+	}
+	for _, price := range prices {
+		candle.Update(price)
+	}
+	candle.Volume = candle.Volume.Add(volume)
+	candle.Trades += trades
+	k.SetPriceCandle(ctx, candle)
+
+	// Prune the candles out of the retention window, the retention may have
+	// been lowered since the last candle so more than one can be removed.
+	if ctx.BlockHeight() < int64(retention) {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("amm_candle:"))
+	iterator := store.Iterator(priceCandleKey(netuid, 0), priceCandleKey(netuid, ctx.BlockHeight()-int64(retention)+1))
+	var pruned [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		pruned = append(pruned, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+	for _, key := range pruned {
+		store.Delete(key)
+	}
+}
+
+// SetPriceCandle stores the OHLC candle of a subnet for a block.
+func (k Keeper) SetPriceCandle(ctx sdk.Context, candle types.PriceCandle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("amm_candle:"))
+	bz, _ := json.Marshal(candle)
+	store.Set(priceCandleKey(candle.Netuid, candle.Height), bz)
+}
+
+// GetPriceCandle returns the OHLC candle of a subnet for a block.
+func (k Keeper) GetPriceCandle(ctx sdk.Context, netuid uint16, height int64) (types.PriceCandle, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("amm_candle:"))
+	bz := store.Get(priceCandleKey(netuid, height))
+	if bz == nil {
+		return types.PriceCandle{}, false
+	}
+	var candle types.PriceCandle
+	if err := json.Unmarshal(bz, &candle); err != nil {
+		return types.PriceCandle{}, false
+	}
+	return candle, true
+}
+
+// GetPriceCandles returns at most limit candles of a subnet with a height in
+// [startHeight, endHeight], ordered by height. A zero endHeight or limit means
+// no bound. The returned height is the height to start the next page from, or
+// zero when there are no more candles.
+func (k Keeper) GetPriceCandles(ctx sdk.Context, netuid uint16, startHeight, endHeight int64, limit uint64) ([]types.PriceCandle, int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("amm_candle:"))
+	end := priceCandleKey(netuid+1, 0)
+	if netuid == ^uint16(0) {
+		end = nil
+	}
+	if endHeight > 0 {
+		end = priceCandleKey(netuid, endHeight+1)
+	}
+	iterator := store.Iterator(priceCandleKey(netuid, startHeight), end)
+	defer iterator.Close()
+
+	var candles []types.PriceCandle
+	for ; iterator.Valid(); iterator.Next() {
+		var candle types.PriceCandle
+		if err := json.Unmarshal(iterator.Value(), &candle); err != nil {
+			continue
+		}
+		if limit > 0 && uint64(len(candles)) == limit {
+			return candles, candle.Height
+		}
+		candles = append(candles, candle)
+	}
+	return candles, 0
+}
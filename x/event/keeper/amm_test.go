@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	eventabi "github.com/hetu-project/hetu/v1/x/event/abi"
+	"github.com/hetu-project/hetu/v1/x/event/keeper"
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+func parseABI(t *testing.T, bz []byte) abi.ABI {
+	parsed, err := abi.JSON(bytes.NewReader(bz))
+	require.NoError(t, err)
+	return parsed
+}
+
+func setupKeeper(t *testing.T) (*keeper.Keeper, sdk.Context, abi.ABI) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	ammABI := parseABI(t, eventabi.SubnetAMMABI)
+	k := keeper.NewKeeper(
+		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		key,
+		parseABI(t, eventabi.SubnetRegistryABI),
+		parseABI(t, eventabi.StakingSelfABI),
+		parseABI(t, eventabi.StakingDelegatedABI),
+		parseABI(t, eventabi.WeightsABI),
+		parseABI(t, eventabi.SubnetManagerABI),
+		parseABI(t, eventabi.NeuronManagerABI),
+		parseABI(t, eventabi.GlobalStakingABI),
+		ammABI,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+	)
+	return k, ctx, ammABI
+}
+
+func ammLog(t *testing.T, ammABI abi.ABI, pool common.Address, name string, args ...interface{}) ethTypes.Log {
+	event := ammABI.Events[name]
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	require.NoError(t, err)
+
+	topics := []common.Hash{event.ID}
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			topics = append(topics, common.BytesToHash(common.Address{}.Bytes()))
+		}
+	}
+	return ethTypes.Log{Address: pool, Topics: topics, Data: data}
+}
+
+func TestHandleAMMLogs(t *testing.T) {
+	k, ctx, ammABI := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	pool := common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	k.SetSubnet(ctx, types.Subnet{Netuid: 1, AmmPool: pool.Hex(), Mechanism: 1})
+	k.SetSubnetTaoIn(ctx, 1, math.NewInt(1000))
+	k.SetSubnetAlphaIn(ctx, 1, math.NewInt(1000))
+
+	netuid, found := k.GetNetuidByAmmPool(ctx, pool)
+	require.True(t, found)
+	require.Equal(t, uint16(1), netuid)
+
+	// events of an unregistered contract are ignored
+	fake := common.HexToAddress("0x00000000000000000000000000000000000000b2")
+	k.HandleEvmLogs(ctx, []ethTypes.Log{
+		ammLog(t, ammABI, fake, "SwapHETUForAlpha", big.NewInt(500), big.NewInt(300), big.NewInt(0)),
+	})
+	require.Equal(t, math.NewInt(1000), k.GetSubnetTaoIn(ctx, 1))
+	_, found = k.GetPriceCandle(ctx, 1, 10)
+	require.False(t, found)
+
+	// without ReservesUpdated the swap amounts are applied to the reserves
+	k.HandleEvmLogs(ctx, []ethTypes.Log{
+		ammLog(t, ammABI, pool, "SwapHETUForAlpha", big.NewInt(1000), big.NewInt(500), big.NewInt(0)),
+	})
+	require.Equal(t, math.NewInt(2000), k.GetSubnetTaoIn(ctx, 1))
+	require.Equal(t, math.NewInt(500), k.GetSubnetAlphaIn(ctx, 1))
+	require.Equal(t, math.NewInt(1000), k.GetSubnetVolume(ctx, 1))
+
+	// ReservesUpdated takes precedence over the swap amounts
+	k.HandleEvmLogs(ctx, []ethTypes.Log{
+		ammLog(t, ammABI, pool, "SwapAlphaForHETU", big.NewInt(500), big.NewInt(1000), big.NewInt(0)),
+		ammLog(t, ammABI, pool, "ReservesUpdated", big.NewInt(1200), big.NewInt(1000), big.NewInt(0)),
+	})
+	require.Equal(t, math.NewInt(1200), k.GetSubnetTaoIn(ctx, 1))
+	require.Equal(t, math.NewInt(1000), k.GetSubnetAlphaIn(ctx, 1))
+	require.Equal(t, math.NewInt(2000), k.GetSubnetVolume(ctx, 1))
+
+	candle, found := k.GetPriceCandle(ctx, 1, 10)
+	require.True(t, found)
+	require.Equal(t, math.LegacyNewDec(4), candle.Open)
+	require.Equal(t, math.LegacyNewDec(4), candle.High)
+	require.Equal(t, math.LegacyMustNewDecFromStr("1.2"), candle.Low)
+	require.Equal(t, math.LegacyMustNewDecFromStr("1.2"), candle.Close)
+	require.Equal(t, math.NewInt(2000), candle.Volume)
+	require.Equal(t, uint64(2), candle.Trades)
+
+	// liquidity changes the reserves but is not counted as volume
+	ctx = ctx.WithBlockHeight(11)
+	k.HandleEvmLogs(ctx, []ethTypes.Log{
+		ammLog(t, ammABI, pool, "LiquidityInjected", big.NewInt(1200), big.NewInt(1000)),
+	})
+	require.Equal(t, math.NewInt(2400), k.GetSubnetTaoIn(ctx, 1))
+	require.Equal(t, math.NewInt(2000), k.GetSubnetAlphaIn(ctx, 1))
+	require.Equal(t, math.NewInt(2000), k.GetSubnetVolume(ctx, 1))
+	candles, nextHeight := k.GetPriceCandles(ctx, 1, 0, 0, 0)
+	require.Len(t, candles, 2)
+	require.Zero(t, nextHeight)
+}
+
+func TestPriceCandleRetention(t *testing.T) {
+	k, ctx, ammABI := setupKeeper(t)
+
+	pool := common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	k.SetSubnet(ctx, types.Subnet{Netuid: 1, AmmPool: pool.Hex(), Mechanism: 1})
+	params := types.DefaultParams()
+	params.PriceCandleRetention = 3
+	require.NoError(t, k.SetParams(ctx, params))
+
+	for height := int64(1); height <= 5; height++ {
+		k.HandleEvmLogs(ctx.WithBlockHeight(height), []ethTypes.Log{
+			ammLog(t, ammABI, pool, "ReservesUpdated", big.NewInt(height*100), big.NewInt(100), big.NewInt(0)),
+		})
+	}
+
+	// only the candles of the last 3 blocks are kept
+	candles, _ := k.GetPriceCandles(ctx, 1, 0, 0, 0)
+	require.Len(t, candles, 3)
+	require.Equal(t, int64(3), candles[0].Height)
+
+	candles, nextHeight := k.GetPriceCandles(ctx, 1, 0, 0, 2)
+	require.Len(t, candles, 2)
+	require.Equal(t, int64(5), nextHeight)
+
+	// zero disables the candles
+	params.PriceCandleRetention = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	k.HandleEvmLogs(ctx.WithBlockHeight(6), []ethTypes.Log{
+		ammLog(t, ammABI, pool, "ReservesUpdated", big.NewInt(600), big.NewInt(100), big.NewInt(0)),
+	})
+	_, found := k.GetPriceCandle(ctx, 1, 6)
+	require.False(t, found)
+}
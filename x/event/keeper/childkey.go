@@ -0,0 +1,369 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+// Hotkey entries are keyed by netuid and the address bytes of the hotkey, the
+// child key reverse index additionally by the address bytes of the parent.
+func hotkeyKey(netuid uint16, hotkey string) []byte {
+	return append(uint16ToBytes(netuid), common.HexToAddress(hotkey).Bytes()...)
+}
+
+// ---------------- Child keys ----------------
+
+// SetChildKeys stores the children of a parent on a subnet and maintains the
+// child to parent index. An empty list of children removes the entry.
+func (k Keeper) SetChildKeys(ctx sdk.Context, childKeys types.ChildKeys) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("childkeys:"))
+	parentStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("parentkeys:"))
+
+	if previous, found := k.GetChildKeys(ctx, childKeys.Netuid, childKeys.Parent); found {
+		for _, child := range previous.Children {
+			parentStore.Delete(append(hotkeyKey(previous.Netuid, child.Child), common.HexToAddress(previous.Parent).Bytes()...))
+		}
+	}
+
+	key := hotkeyKey(childKeys.Netuid, childKeys.Parent)
+	if len(childKeys.Children) == 0 {
+		store.Delete(key)
+		return
+	}
+
+	bz, _ := json.Marshal(childKeys)
+	store.Set(key, bz)
+	for _, child := range childKeys.Children {
+		parentStore.Set(append(hotkeyKey(childKeys.Netuid, child.Child), common.HexToAddress(childKeys.Parent).Bytes()...), []byte(child.Proportion.String()))
+	}
+}
+
+// GetChildKeys returns the children of a parent on a subnet.
+func (k Keeper) GetChildKeys(ctx sdk.Context, netuid uint16, parent string) (types.ChildKeys, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("childkeys:"))
+	bz := store.Get(hotkeyKey(netuid, parent))
+	if bz == nil {
+		return types.ChildKeys{}, false
+	}
+	var childKeys types.ChildKeys
+	if err := json.Unmarshal(bz, &childKeys); err != nil {
+		return types.ChildKeys{}, false
+	}
+	return childKeys, true
+}
+
+// GetChildKeysByNetuid returns the children of all parents on a subnet.
+func (k Keeper) GetChildKeysByNetuid(ctx sdk.Context, netuid uint16) []types.ChildKeys {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("childkeys:"))
+	iterator := storetypes.KVStorePrefixIterator(store, uint16ToBytes(netuid))
+	defer iterator.Close()
+
+	var all []types.ChildKeys
+	for ; iterator.Valid(); iterator.Next() {
+		var childKeys types.ChildKeys
+		if err := json.Unmarshal(iterator.Value(), &childKeys); err != nil {
+			continue
+		}
+		all = append(all, childKeys)
+	}
+	return all
+}
+
+// GetAllChildKeys returns the children of all parents on all subnets.
+func (k Keeper) GetAllChildKeys(ctx sdk.Context) []types.ChildKeys {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("childkeys:"))
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var all []types.ChildKeys
+	for ; iterator.Valid(); iterator.Next() {
+		var childKeys types.ChildKeys
+		if err := json.Unmarshal(iterator.Value(), &childKeys); err != nil {
+			continue
+		}
+		all = append(all, childKeys)
+	}
+	return all
+}
+
+// GetParentKeys returns the parents lending stake weight to a child hotkey on
+// a subnet, ordered by parent address.
+func (k Keeper) GetParentKeys(ctx sdk.Context, netuid uint16, child string) []types.ParentKey {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("parentkeys:"))
+	childKey := hotkeyKey(netuid, child)
+	iterator := storetypes.KVStorePrefixIterator(store, childKey)
+	defer iterator.Close()
+
+	var parents []types.ParentKey
+	for ; iterator.Valid(); iterator.Next() {
+		proportion, err := math.LegacyNewDecFromStr(string(iterator.Value()))
+		if err != nil {
+			continue
+		}
+		parents = append(parents, types.ParentKey{
+			Parent:     common.BytesToAddress(iterator.Key()[len(childKey):]).Hex(),
+			Proportion: proportion,
+		})
+	}
+	return parents
+}
+
+// GetChildHotkeysByNetuid returns the hotkeys that are the child of at least
+// one parent on a subnet, ordered by address.
+func (k Keeper) GetChildHotkeysByNetuid(ctx sdk.Context, netuid uint16) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("parentkeys:"))
+	iterator := storetypes.KVStorePrefixIterator(store, uint16ToBytes(netuid))
+	defer iterator.Close()
+
+	var children []string
+	for ; iterator.Valid(); iterator.Next() {
+		child := common.BytesToAddress(iterator.Key()[2 : 2+common.AddressLength]).Hex()
+		if len(children) == 0 || children[len(children)-1] != child {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// ---------------- Pending child keys ----------------
+
+// SetPendingChildKeys stores children waiting for the cooldown, replacing any
+// earlier pending children of the parent.
+func (k Keeper) SetPendingChildKeys(ctx sdk.Context, pending types.PendingChildKeys) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("pending_childkeys:"))
+	bz, _ := json.Marshal(pending)
+	store.Set(hotkeyKey(pending.Netuid, pending.Parent), bz)
+}
+
+// GetPendingChildKeys returns the children of a parent waiting for the cooldown.
+func (k Keeper) GetPendingChildKeys(ctx sdk.Context, netuid uint16, parent string) (types.PendingChildKeys, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("pending_childkeys:"))
+	bz := store.Get(hotkeyKey(netuid, parent))
+	if bz == nil {
+		return types.PendingChildKeys{}, false
+	}
+	var pending types.PendingChildKeys
+	if err := json.Unmarshal(bz, &pending); err != nil {
+		return types.PendingChildKeys{}, false
+	}
+	return pending, true
+}
+
+// GetAllPendingChildKeys returns all children waiting for the cooldown.
+func (k Keeper) GetAllPendingChildKeys(ctx sdk.Context) []types.PendingChildKeys {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("pending_childkeys:"))
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var all []types.PendingChildKeys
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingChildKeys
+		if err := json.Unmarshal(iterator.Value(), &pending); err != nil {
+			continue
+		}
+		all = append(all, pending)
+	}
+	return all
+}
+
+// ApplyPendingChildKeys replaces the children of the parents whose cooldown
+// is over with their pending children.
+func (k Keeper) ApplyPendingChildKeys(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("pending_childkeys:"))
+	height := uint64(ctx.BlockHeight())
+
+	for _, pending := range k.GetAllPendingChildKeys(ctx) {
+		if pending.CooldownBlock > height {
+			continue
+		}
+		store.Delete(hotkeyKey(pending.Netuid, pending.Parent))
+
+		k.SetChildKeys(ctx, types.ChildKeys{
+			Parent:          pending.Parent,
+			Netuid:          pending.Netuid,
+			Children:        pending.Children,
+			LastUpdateBlock: height,
+		})
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"child_keys_applied",
+				sdk.NewAttribute("parent", pending.Parent),
+				sdk.NewAttribute("netuid", fmt.Sprintf("%d", pending.Netuid)),
+				sdk.NewAttribute("children", fmt.Sprintf("%d", len(pending.Children))),
+			),
+		)
+	}
+}
+
+// ---------------- Child key take ----------------
+
+// SetChildKeyTake stores the take of a child hotkey on a subnet.
+func (k Keeper) SetChildKeyTake(ctx sdk.Context, take types.ChildKeyTake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("childkey_take:"))
+	bz, _ := json.Marshal(take)
+	store.Set(hotkeyKey(take.Netuid, take.Child), bz)
+}
+
+// GetChildKeyTake returns the take of a child hotkey on a subnet, zero when
+// it was never set.
+func (k Keeper) GetChildKeyTake(ctx sdk.Context, netuid uint16, child string) math.LegacyDec {
+	take, found := k.getChildKeyTake(ctx, netuid, child)
+	if !found {
+		return math.LegacyZeroDec()
+	}
+	return take.Take
+}
+
+func (k Keeper) getChildKeyTake(ctx sdk.Context, netuid uint16, child string) (types.ChildKeyTake, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("childkey_take:"))
+	bz := store.Get(hotkeyKey(netuid, child))
+	if bz == nil {
+		return types.ChildKeyTake{}, false
+	}
+	var take types.ChildKeyTake
+	if err := json.Unmarshal(bz, &take); err != nil {
+		return types.ChildKeyTake{}, false
+	}
+	return take, true
+}
+
+// GetAllChildKeyTakes returns the takes of all child hotkeys.
+func (k Keeper) GetAllChildKeyTakes(ctx sdk.Context) []types.ChildKeyTake {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("childkey_take:"))
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var takes []types.ChildKeyTake
+	for ; iterator.Valid(); iterator.Next() {
+		var take types.ChildKeyTake
+		if err := json.Unmarshal(iterator.Value(), &take); err != nil {
+			continue
+		}
+		takes = append(takes, take)
+	}
+	return takes
+}
+
+// ---------------- Effective stake ----------------
+
+// ownStakes returns the stake of each hotkey on a subnet, keyed by its
+// checksummed hex address. The vesting stake of the accounts is added, and
+// the native stake of the validators when native stake is enabled.
+func (k Keeper) ownStakes(ctx sdk.Context, netuid uint16) map[string]math.Int {
+	stakes := make(map[string]math.Int)
+	for _, stake := range k.GetAllValidatorStakesByNetuid(ctx, netuid) {
+		amount, ok := math.NewIntFromString(stake.Amount)
+		if !ok || !common.IsHexAddress(stake.Validator) {
+			continue
+		}
+		hotkey := common.HexToAddress(stake.Validator).Hex()
+		if existing, found := stakes[hotkey]; found {
+			amount = amount.Add(existing)
+		}
+		stakes[hotkey] = amount
+	}
+	for _, stake := range k.GetVestingStakesByNetuid(ctx, netuid) {
+		if existing, found := stakes[stake.Account]; found {
+			stakes[stake.Account] = existing.Add(stake.Amount)
+		} else {
+			stakes[stake.Account] = stake.Amount
+		}
+	}
+	if !k.GetParams(ctx).NativeStakeEnabled {
+		return stakes
+	}
+	for _, stake := range k.GetNativeStakesByNetuid(ctx, netuid) {
+		hotkey := common.HexToAddress(stake.Validator).Hex()
+		if existing, found := stakes[hotkey]; found {
+			stakes[hotkey] = existing.Add(stake.Amount)
+		} else {
+			stakes[hotkey] = stake.Amount
+		}
+	}
+	return stakes
+}
+
+// lentStake returns the stake weight a parent with the given stake lends to a
+// child at the given proportion.
+func lentStake(stake math.Int, proportion math.LegacyDec) math.Int {
+	return proportion.MulInt(stake).TruncateInt()
+}
+
+// GetStakeContributions returns the stake weight of a hotkey on a subnet,
+// split into its own stake left after lending to its children and the stake
+// lent by each of its parents. Stake weight is lent one level deep: a parent
+// lends out of its own stake only.
+func (k Keeper) GetStakeContributions(ctx sdk.Context, netuid uint16, hotkey string) (math.Int, []types.StakeContribution) {
+	stakes := k.ownStakes(ctx, netuid)
+	hotkey = common.HexToAddress(hotkey).Hex()
+
+	own, found := stakes[hotkey]
+	if !found {
+		own = math.ZeroInt()
+	}
+	if childKeys, found := k.GetChildKeys(ctx, netuid, hotkey); found {
+		lent := math.ZeroInt()
+		for _, child := range childKeys.Children {
+			lent = lent.Add(lentStake(own, child.Proportion))
+		}
+		own = own.Sub(lent)
+	}
+
+	var contributions []types.StakeContribution
+	for _, parent := range k.GetParentKeys(ctx, netuid, hotkey) {
+		parentStake, found := stakes[parent.Parent]
+		if !found {
+			continue
+		}
+		contributions = append(contributions, types.StakeContribution{
+			Parent: parent.Parent,
+			Amount: lentStake(parentStake, parent.Proportion),
+		})
+	}
+	return own, contributions
+}
+
+// GetEffectiveStake returns the stake weight of a hotkey on a subnet: its own
+// stake minus the stake lent to its children plus the stake lent by its parents.
+func (k Keeper) GetEffectiveStake(ctx sdk.Context, netuid uint16, hotkey string) math.Int {
+	effective, contributions := k.GetStakeContributions(ctx, netuid, hotkey)
+	for _, contribution := range contributions {
+		effective = effective.Add(contribution.Amount)
+	}
+	return effective
+}
+
+// GetEffectiveStakes returns the stake weight of every hotkey on a subnet,
+// keyed by its checksummed hex address.
+func (k Keeper) GetEffectiveStakes(ctx sdk.Context, netuid uint16) map[string]math.Int {
+	stakes := k.ownStakes(ctx, netuid)
+	effective := make(map[string]math.Int, len(stakes))
+	for hotkey, stake := range stakes {
+		effective[hotkey] = stake
+	}
+
+	for _, childKeys := range k.GetChildKeysByNetuid(ctx, netuid) {
+		parentStake, found := stakes[childKeys.Parent]
+		if !found {
+			continue
+		}
+		for _, child := range childKeys.Children {
+			lent := lentStake(parentStake, child.Proportion)
+			effective[childKeys.Parent] = effective[childKeys.Parent].Sub(lent)
+			if existing, found := effective[child.Child]; found {
+				lent = lent.Add(existing)
+			}
+			effective[child.Child] = lent
+		}
+	}
+	return effective
+}
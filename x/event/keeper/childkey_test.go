@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/x/event/keeper"
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+var (
+	parentKey = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	childKey  = common.HexToAddress("0x00000000000000000000000000000000000000c1")
+	otherKey  = common.HexToAddress("0x00000000000000000000000000000000000000c2")
+)
+
+// setupChildKeys returns a keeper with subnet 1, a parent hotkey staking 1000
+// and a child hotkey staking 200.
+func setupChildKeys(t *testing.T) (*keeper.Keeper, types.MsgServer, sdk.Context) {
+	k, ctx, _ := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	k.SetSubnet(ctx, types.Subnet{Netuid: 1, Owner: parentKey.Hex()})
+	k.SetValidatorStake(ctx, types.ValidatorStake{Netuid: 1, Validator: parentKey.Hex(), Amount: "1000"})
+	k.SetValidatorStake(ctx, types.ValidatorStake{Netuid: 1, Validator: childKey.Hex(), Amount: "200"})
+	return k, keeper.NewMsgServerImpl(*k), ctx
+}
+
+func TestSetChildKeysCooldown(t *testing.T) {
+	k, msgServer, ctx := setupChildKeys(t)
+	cooldown := k.GetParams(ctx).ChildKeyCooldown
+
+	msg := types.NewMsgSetChildKeys(parentKey.Bytes(), 1, []types.ChildKey{
+		{Child: childKey.Hex(), Proportion: math.LegacyNewDecWithPrec(3, 1)},
+		{Child: otherKey.Hex(), Proportion: math.LegacyNewDecWithPrec(1, 1)},
+	})
+	require.NoError(t, msg.ValidateBasic())
+	res, err := msgServer.SetChildKeys(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10)+cooldown, res.CooldownBlock)
+
+	// the children wait for the cooldown
+	k.ApplyPendingChildKeys(ctx)
+	_, found := k.GetChildKeys(ctx, 1, parentKey.Hex())
+	require.False(t, found)
+	require.Equal(t, math.NewInt(200), k.GetEffectiveStake(ctx, 1, childKey.Hex()))
+
+	ctx = ctx.WithBlockHeight(int64(res.CooldownBlock))
+	k.ApplyPendingChildKeys(ctx)
+	childKeys, found := k.GetChildKeys(ctx, 1, parentKey.Hex())
+	require.True(t, found)
+	require.Len(t, childKeys.Children, 2)
+	_, found = k.GetPendingChildKeys(ctx, 1, parentKey.Hex())
+	require.False(t, found)
+
+	require.Equal(t, []types.ParentKey{{Parent: parentKey.Hex(), Proportion: math.LegacyNewDecWithPrec(3, 1)}}, k.GetParentKeys(ctx, 1, childKey.Hex()))
+	require.Equal(t, []string{childKey.Hex(), otherKey.Hex()}, k.GetChildHotkeysByNetuid(ctx, 1))
+
+	// the parent keeps 60% of its stake weight, the children get the rest
+	require.Equal(t, math.NewInt(600), k.GetEffectiveStake(ctx, 1, parentKey.Hex()))
+	require.Equal(t, math.NewInt(500), k.GetEffectiveStake(ctx, 1, childKey.Hex()))
+	require.Equal(t, math.NewInt(100), k.GetEffectiveStake(ctx, 1, otherKey.Hex()))
+	require.Equal(t, map[string]math.Int{
+		parentKey.Hex(): math.NewInt(600),
+		childKey.Hex():  math.NewInt(500),
+		otherKey.Hex():  math.NewInt(100),
+	}, k.GetEffectiveStakes(ctx, 1))
+
+	own, contributions := k.GetStakeContributions(ctx, 1, childKey.Hex())
+	require.Equal(t, math.NewInt(200), own)
+	require.Equal(t, []types.StakeContribution{{Parent: parentKey.Hex(), Amount: math.NewInt(300)}}, contributions)
+
+	// revoking the children clears the reverse index after the cooldown
+	_, err = msgServer.SetChildKeys(ctx, types.NewMsgSetChildKeys(parentKey.Bytes(), 1, nil))
+	require.NoError(t, err)
+	k.ApplyPendingChildKeys(ctx.WithBlockHeight(ctx.BlockHeight() + int64(cooldown)))
+	_, found = k.GetChildKeys(ctx, 1, parentKey.Hex())
+	require.False(t, found)
+	require.Empty(t, k.GetParentKeys(ctx, 1, childKey.Hex()))
+	require.Equal(t, math.NewInt(1000), k.GetEffectiveStake(ctx, 1, parentKey.Hex()))
+}
+
+func TestSetChildKeysValidation(t *testing.T) {
+	k, msgServer, ctx := setupChildKeys(t)
+
+	testCases := []struct {
+		name     string
+		children []types.ChildKey
+	}{
+		{"own child", []types.ChildKey{{Child: parentKey.Hex(), Proportion: math.LegacyNewDecWithPrec(5, 1)}}},
+		{"duplicate child", []types.ChildKey{
+			{Child: childKey.Hex(), Proportion: math.LegacyNewDecWithPrec(2, 1)},
+			{Child: childKey.Hex(), Proportion: math.LegacyNewDecWithPrec(2, 1)},
+		}},
+		{"zero proportion", []types.ChildKey{{Child: childKey.Hex(), Proportion: math.LegacyZeroDec()}}},
+		{"proportions above one", []types.ChildKey{
+			{Child: childKey.Hex(), Proportion: math.LegacyNewDecWithPrec(6, 1)},
+			{Child: otherKey.Hex(), Proportion: math.LegacyNewDecWithPrec(5, 1)},
+		}},
+		{"invalid child", []types.ChildKey{{Child: "child", Proportion: math.LegacyNewDecWithPrec(5, 1)}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ErrorIs(t, types.NewMsgSetChildKeys(parentKey.Bytes(), 1, tc.children).ValidateBasic(), types.ErrInvalidChildKeys)
+		})
+	}
+
+	_, err := msgServer.SetChildKeys(ctx, types.NewMsgSetChildKeys(parentKey.Bytes(), 2, nil))
+	require.ErrorIs(t, err, types.ErrSubnetNotFound)
+	_, found := k.GetPendingChildKeys(ctx, 2, parentKey.Hex())
+	require.False(t, found)
+}
+
+func TestSetChildKeyTakeRateLimit(t *testing.T) {
+	k, msgServer, ctx := setupChildKeys(t)
+	params := k.GetParams(ctx)
+
+	_, err := msgServer.SetChildKeyTake(ctx, types.NewMsgSetChildKeyTake(childKey.Bytes(), 1, params.MaxChildKeyTake.Add(math.LegacyNewDecWithPrec(1, 2))))
+	require.ErrorIs(t, err, types.ErrInvalidChildKeyTake)
+	require.True(t, k.GetChildKeyTake(ctx, 1, childKey.Hex()).IsZero())
+
+	_, err = msgServer.SetChildKeyTake(ctx, types.NewMsgSetChildKeyTake(childKey.Bytes(), 1, math.LegacyNewDecWithPrec(1, 1)))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), k.GetChildKeyTake(ctx, 1, childKey.Hex()))
+
+	// raising the take again has to wait for the cooldown, lowering it does not
+	_, err = msgServer.SetChildKeyTake(ctx, types.NewMsgSetChildKeyTake(childKey.Bytes(), 1, math.LegacyNewDecWithPrec(15, 2)))
+	require.ErrorIs(t, err, types.ErrChildKeyRateLimited)
+	_, err = msgServer.SetChildKeyTake(ctx, types.NewMsgSetChildKeyTake(childKey.Bytes(), 1, math.LegacyNewDecWithPrec(5, 2)))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.ChildKeyCooldown))
+	_, err = msgServer.SetChildKeyTake(ctx, types.NewMsgSetChildKeyTake(childKey.Bytes(), 1, math.LegacyNewDecWithPrec(15, 2)))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(15, 2), k.GetChildKeyTake(ctx, 1, childKey.Hex()))
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+// ---------------- Validator take ----------------
+
+// SetValidatorTake stores the take of a validator on a subnet.
+func (k Keeper) SetValidatorTake(ctx sdk.Context, take types.ValidatorTake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("validator_take:"))
+	bz, _ := json.Marshal(take)
+	store.Set(hotkeyKey(take.Netuid, take.Validator), bz)
+}
+
+// GetValidatorTake returns the take of a validator on a subnet, the default
+// validator take when it was never set.
+func (k Keeper) GetValidatorTake(ctx sdk.Context, netuid uint16, validator string) math.LegacyDec {
+	take, found := k.getValidatorTake(ctx, netuid, validator)
+	if !found {
+		return k.GetParams(ctx).DefaultValidatorTake
+	}
+	return take.Take
+}
+
+func (k Keeper) getValidatorTake(ctx sdk.Context, netuid uint16, validator string) (types.ValidatorTake, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("validator_take:"))
+	bz := store.Get(hotkeyKey(netuid, validator))
+	if bz == nil {
+		return types.ValidatorTake{}, false
+	}
+	var take types.ValidatorTake
+	if err := json.Unmarshal(bz, &take); err != nil {
+		return types.ValidatorTake{}, false
+	}
+	return take, true
+}
+
+// GetAllValidatorTakes returns the takes set by all validators.
+func (k Keeper) GetAllValidatorTakes(ctx sdk.Context) []types.ValidatorTake {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("validator_take:"))
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var takes []types.ValidatorTake
+	for ; iterator.Valid(); iterator.Next() {
+		var take types.ValidatorTake
+		if err := json.Unmarshal(iterator.Value(), &take); err != nil {
+			continue
+		}
+		takes = append(takes, take)
+	}
+	return takes
+}
+
+// ---------------- Claimable dividends ----------------
+
+// Claimable dividends are keyed by delegator first, so that all the
+// dividends of a delegator are claimed with a single prefix scan.
+func claimableDividendKey(delegator string, netuid uint16) []byte {
+	return append(common.HexToAddress(delegator).Bytes(), uint16ToBytes(netuid)...)
+}
+
+// SetClaimableDividend stores the alpha dividends a delegator can claim on a
+// subnet. A zero amount removes the entry.
+func (k Keeper) SetClaimableDividend(ctx sdk.Context, dividend types.ClaimableDividend) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("claimable_dividend:"))
+	key := claimableDividendKey(dividend.Delegator, dividend.Netuid)
+	if !dividend.Amount.IsPositive() {
+		store.Delete(key)
+		return
+	}
+	bz, _ := json.Marshal(dividend)
+	store.Set(key, bz)
+}
+
+// GetClaimableDividend returns the alpha dividends a delegator can claim on a subnet.
+func (k Keeper) GetClaimableDividend(ctx sdk.Context, netuid uint16, delegator string) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("claimable_dividend:"))
+	bz := store.Get(claimableDividendKey(delegator, netuid))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+	var dividend types.ClaimableDividend
+	if err := json.Unmarshal(bz, &dividend); err != nil {
+		return math.ZeroInt()
+	}
+	return dividend.Amount
+}
+
+// AddClaimableDividend credits alpha dividends to a delegator on a subnet.
+func (k Keeper) AddClaimableDividend(ctx sdk.Context, netuid uint16, delegator string, amount math.Int) {
+	if !amount.IsPositive() {
+		return
+	}
+	k.SetClaimableDividend(ctx, types.ClaimableDividend{
+		Delegator: common.HexToAddress(delegator).Hex(),
+		Netuid:    netuid,
+		Amount:    k.GetClaimableDividend(ctx, netuid, delegator).Add(amount),
+	})
+}
+
+// GetClaimableDividendsByDelegator returns the alpha dividends a delegator can
+// claim, ordered by netuid.
+func (k Keeper) GetClaimableDividendsByDelegator(ctx sdk.Context, delegator string) []types.ClaimableDividend {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("claimable_dividend:"))
+	iterator := storetypes.KVStorePrefixIterator(store, common.HexToAddress(delegator).Bytes())
+	defer iterator.Close()
+
+	var dividends []types.ClaimableDividend
+	for ; iterator.Valid(); iterator.Next() {
+		var dividend types.ClaimableDividend
+		if err := json.Unmarshal(iterator.Value(), &dividend); err != nil {
+			continue
+		}
+		dividends = append(dividends, dividend)
+	}
+	return dividends
+}
+
+// GetAllClaimableDividends returns the alpha dividends all delegators can claim.
+func (k Keeper) GetAllClaimableDividends(ctx sdk.Context) []types.ClaimableDividend {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("claimable_dividend:"))
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var dividends []types.ClaimableDividend
+	for ; iterator.Valid(); iterator.Next() {
+		var dividend types.ClaimableDividend
+		if err := json.Unmarshal(iterator.Value(), &dividend); err != nil {
+			continue
+		}
+		dividends = append(dividends, dividend)
+	}
+	return dividends
+}
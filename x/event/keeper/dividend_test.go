@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/x/event/keeper"
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+var delegatorKey = common.HexToAddress("0x00000000000000000000000000000000000000d1")
+
+// mockAlphaMinter records the alpha tokens minted for claimed dividends.
+type mockAlphaMinter struct {
+	minted map[uint16]*big.Int
+	err    error
+}
+
+func (m *mockAlphaMinter) MintAlphaTokens(_ sdk.Context, netuid uint16, _ string, amount *big.Int) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.minted[netuid] = amount
+	return nil
+}
+
+func TestSetValidatorTake(t *testing.T) {
+	k, msgServer, ctx := setupChildKeys(t)
+	params := k.GetParams(ctx)
+
+	// validators start with the default take
+	require.Equal(t, params.DefaultValidatorTake, k.GetValidatorTake(ctx, 1, parentKey.Hex()))
+
+	_, err := msgServer.SetValidatorTake(ctx, types.NewMsgSetValidatorTake(parentKey.Bytes(), 1, params.MaxValidatorTake.Add(math.LegacyNewDecWithPrec(1, 2))))
+	require.ErrorIs(t, err, types.ErrInvalidValidatorTake)
+	_, err = msgServer.SetValidatorTake(ctx, types.NewMsgSetValidatorTake(parentKey.Bytes(), 2, math.LegacyNewDecWithPrec(1, 1)))
+	require.ErrorIs(t, err, types.ErrSubnetNotFound)
+
+	_, err = msgServer.SetValidatorTake(ctx, types.NewMsgSetValidatorTake(parentKey.Bytes(), 1, math.LegacyNewDecWithPrec(1, 1)))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), k.GetValidatorTake(ctx, 1, parentKey.Hex()))
+
+	// raising the take again has to wait for the cooldown, lowering it does not
+	_, err = msgServer.SetValidatorTake(ctx, types.NewMsgSetValidatorTake(parentKey.Bytes(), 1, math.LegacyNewDecWithPrec(15, 2)))
+	require.ErrorIs(t, err, types.ErrValidatorTakeRateLimited)
+	_, err = msgServer.SetValidatorTake(ctx, types.NewMsgSetValidatorTake(parentKey.Bytes(), 1, math.LegacyNewDecWithPrec(5, 2)))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.ValidatorTakeCooldown))
+	_, err = msgServer.SetValidatorTake(ctx, types.NewMsgSetValidatorTake(parentKey.Bytes(), 1, math.LegacyNewDecWithPrec(15, 2)))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(15, 2), k.GetValidatorTake(ctx, 1, parentKey.Hex()))
+}
+
+func TestClaimDividends(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	minter := &mockAlphaMinter{minted: make(map[uint16]*big.Int)}
+	k.SetAlphaMinter(minter)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	_, err := msgServer.ClaimDividends(ctx, types.NewMsgClaimAllDividends(delegatorKey.Bytes()))
+	require.ErrorIs(t, err, types.ErrNoDividends)
+
+	k.AddClaimableDividend(ctx, 1, delegatorKey.Hex(), math.NewInt(100))
+	k.AddClaimableDividend(ctx, 1, delegatorKey.Hex(), math.NewInt(50))
+	k.AddClaimableDividend(ctx, 2, delegatorKey.Hex(), math.NewInt(30))
+	require.Len(t, k.GetClaimableDividendsByDelegator(ctx, delegatorKey.Hex()), 2)
+
+	// a failed mint keeps the dividends claimable
+	minter.err = errors.New("mint failed")
+	_, err = msgServer.ClaimDividends(ctx, types.NewMsgClaimDividends(delegatorKey.Bytes(), 1))
+	require.ErrorIs(t, err, types.ErrDividendClaim)
+	require.Equal(t, math.NewInt(150), k.GetClaimableDividend(ctx, 1, delegatorKey.Hex()))
+	minter.err = nil
+
+	res, err := msgServer.ClaimDividends(ctx, types.NewMsgClaimDividends(delegatorKey.Bytes(), 1))
+	require.NoError(t, err)
+	require.Equal(t, []types.DividendClaim{{Netuid: 1, Amount: math.NewInt(150)}}, res.Claimed)
+	require.Equal(t, big.NewInt(150), minter.minted[1])
+	require.True(t, k.GetClaimableDividend(ctx, 1, delegatorKey.Hex()).IsZero())
+
+	// a zero netuid claims the root network only
+	_, err = msgServer.ClaimDividends(ctx, types.NewMsgClaimDividends(delegatorKey.Bytes(), 0))
+	require.ErrorIs(t, err, types.ErrNoDividends)
+	require.Equal(t, math.NewInt(30), k.GetClaimableDividend(ctx, 2, delegatorKey.Hex()))
+
+	res, err = msgServer.ClaimDividends(ctx, types.NewMsgClaimAllDividends(delegatorKey.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, []types.DividendClaim{{Netuid: 2, Amount: math.NewInt(30)}}, res.Claimed)
+	require.Empty(t, k.GetAllClaimableDividends(ctx))
+}
+
+func TestMsgClaimDividendsValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewMsgClaimDividends(delegatorKey.Bytes(), 0).ValidateBasic())
+	require.NoError(t, types.NewMsgClaimAllDividends(delegatorKey.Bytes()).ValidateBasic())
+
+	msg := types.NewMsgClaimAllDividends(delegatorKey.Bytes())
+	msg.Netuid = 1
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrDividendClaim)
+}
@@ -0,0 +1,311 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+// ---------------- GlobalStaking events ----------------
+
+// isGlobalStakingTopic returns true for the GlobalStaking events that change
+// the stake ledger.
+func (k Keeper) isGlobalStakingTopic(topic string) bool {
+	switch topic {
+	case k.topicGlobalStakeAdded, k.topicGlobalStakeRemoved, k.topicRegistrationCost,
+		k.topicSubnetAllocationChanged, k.topicDeallocatedFromSubnet:
+		return true
+	}
+	return false
+}
+
+// isGlobalStakingContract returns true if the address is the GlobalStaking
+// contract set in the params. While it is not set, any contract is accepted
+// as before the params existed.
+func (k Keeper) isGlobalStakingContract(ctx sdk.Context, address common.Address) bool {
+	contract := k.GetParams(ctx).GlobalStakingAddress
+	return contract == "" || common.HexToAddress(contract) == address
+}
+
+// GlobalStakeAdded (GlobalStaking) - HETU deposited as global stake
+func (k Keeper) handleGlobalStakeAdded(ctx sdk.Context, log ethTypes.Log) {
+	if len(log.Topics) < 2 {
+		k.Logger(ctx).Error("GlobalStakeAdded event has insufficient topics", "topics_length", len(log.Topics))
+		return
+	}
+	user := common.BytesToAddress(log.Topics[1].Bytes())
+
+	var event struct {
+		Amount *big.Int
+	}
+	if err := k.globalStakingABI.UnpackIntoInterface(&event, "GlobalStakeAdded", log.Data); err != nil {
+		k.Logger(ctx).Error("parse GlobalStakeAdded failed", "err", err)
+		return
+	}
+
+	stake := k.getOrNewGlobalStake(ctx, user)
+	stake.TotalStaked = types.AddBigIntString(stake.TotalStaked, event.Amount.String())
+	k.setGlobalStakeUpdated(ctx, stake)
+}
+
+// GlobalStakeRemoved (GlobalStaking) - HETU withdrawn from the global stake
+func (k Keeper) handleGlobalStakeRemoved(ctx sdk.Context, log ethTypes.Log) {
+	if len(log.Topics) < 2 {
+		k.Logger(ctx).Error("GlobalStakeRemoved event has insufficient topics", "topics_length", len(log.Topics))
+		return
+	}
+	user := common.BytesToAddress(log.Topics[1].Bytes())
+
+	var event struct {
+		Amount *big.Int
+	}
+	if err := k.globalStakingABI.UnpackIntoInterface(&event, "GlobalStakeRemoved", log.Data); err != nil {
+		k.Logger(ctx).Error("parse GlobalStakeRemoved failed", "err", err)
+		return
+	}
+
+	stake := k.getOrNewGlobalStake(ctx, user)
+	stake.TotalStaked = types.SubBigIntString(stake.TotalStaked, event.Amount.String())
+	k.setGlobalStakeUpdated(ctx, stake)
+}
+
+// RegistrationCost (GlobalStaking) - Neuron registration cost paid to the treasury
+func (k Keeper) handleRegistrationCost(ctx sdk.Context, log ethTypes.Log) {
+	if len(log.Topics) < 3 {
+		k.Logger(ctx).Error("RegistrationCost event has insufficient topics", "topics_length", len(log.Topics))
+		return
+	}
+	user := common.BytesToAddress(log.Topics[1].Bytes())
+	netuid := uint16(new(big.Int).SetBytes(log.Topics[2].Bytes()).Uint64())
+
+	var event struct {
+		Cost *big.Int
+	}
+	if err := k.globalStakingABI.UnpackIntoInterface(&event, "RegistrationCost", log.Data); err != nil {
+		k.Logger(ctx).Error("parse RegistrationCost failed", "err", err)
+		return
+	}
+	cost := event.Cost.String()
+
+	alloc := k.getOrNewSubnetAllocation(ctx, user, netuid)
+	alloc.RegistrationCost = types.AddBigIntString(alloc.RegistrationCost, cost)
+	alloc.LastUpdateBlock = uint64(ctx.BlockHeight())
+	k.SetSubnetAllocation(ctx, alloc)
+
+	stake := k.getOrNewGlobalStake(ctx, user)
+	stake.RegistrationCost = types.AddBigIntString(stake.RegistrationCost, cost)
+	k.setGlobalStakeUpdated(ctx, stake)
+
+	k.SetSubnetRegistrationCost(ctx, netuid, types.AddBigIntString(k.GetSubnetRegistrationCost(ctx, netuid), cost))
+	k.applySubnetCost(ctx, netuid, types.SubnetCostRegistration, log.Address, event.Cost)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"registration_cost_paid",
+			sdk.NewAttribute("account", user.Hex()),
+			sdk.NewAttribute("netuid", fmt.Sprintf("%d", netuid)),
+			sdk.NewAttribute("cost", cost),
+		),
+	)
+}
+
+// setAllocationAmount sets the stake an account allocated to a subnet and
+// moves the difference with the previous allocation into the account's
+// allocated stake, so that the allocated stake remains the sum of the
+// allocations.
+func (k Keeper) setAllocationAmount(ctx sdk.Context, user common.Address, netuid uint16, amount string) {
+	alloc := k.getOrNewSubnetAllocation(ctx, user, netuid)
+	previous := alloc.Amount
+	alloc.Amount = amount
+	alloc.LastUpdateBlock = uint64(ctx.BlockHeight())
+	k.SetSubnetAllocation(ctx, alloc)
+
+	stake := k.getOrNewGlobalStake(ctx, user)
+	stake.Allocated = types.AddBigIntString(types.SubBigIntString(stake.Allocated, previous), amount)
+	k.setGlobalStakeUpdated(ctx, stake)
+}
+
+func (k Keeper) getOrNewGlobalStake(ctx sdk.Context, user common.Address) types.GlobalStake {
+	stake, found := k.GetGlobalStake(ctx, user.Hex())
+	if !found {
+		stake = types.NewGlobalStake(user.Hex())
+	}
+	return stake
+}
+
+func (k Keeper) getOrNewSubnetAllocation(ctx sdk.Context, user common.Address, netuid uint16) types.SubnetAllocation {
+	alloc, found := k.GetSubnetAllocation(ctx, user.Hex(), netuid)
+	if !found {
+		alloc = types.NewSubnetAllocation(user.Hex(), netuid)
+	}
+	return alloc
+}
+
+// setGlobalStakeUpdated stores the global stake of an account at the current
+// block and emits its new balances.
+func (k Keeper) setGlobalStakeUpdated(ctx sdk.Context, stake types.GlobalStake) {
+	stake.LastUpdateBlock = uint64(ctx.BlockHeight())
+	k.SetGlobalStake(ctx, stake)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"global_stake_updated",
+			sdk.NewAttribute("account", stake.Account),
+			sdk.NewAttribute("total_staked", stake.TotalStaked),
+			sdk.NewAttribute("allocated", stake.Allocated),
+			sdk.NewAttribute("registration_cost", stake.RegistrationCost),
+			sdk.NewAttribute("available", stake.Available()),
+		),
+	)
+}
+
+// ---------------- Global stake ----------------
+
+// Accounts are keyed by their address bytes, so that lookups do not depend on
+// the case of the hex string.
+func globalStakeKey(account string) []byte {
+	return common.HexToAddress(account).Bytes()
+}
+
+func subnetAllocationKey(account string, netuid uint16) []byte {
+	return append(globalStakeKey(account), uint16ToBytes(netuid)...)
+}
+
+// SetGlobalStake stores the global stake position of an account.
+func (k Keeper) SetGlobalStake(ctx sdk.Context, stake types.GlobalStake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("global_stake:"))
+	bz, _ := json.Marshal(stake)
+	store.Set(globalStakeKey(stake.Account), bz)
+}
+
+// GetGlobalStake returns the global stake position of an account.
+func (k Keeper) GetGlobalStake(ctx sdk.Context, account string) (types.GlobalStake, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("global_stake:"))
+	bz := store.Get(globalStakeKey(account))
+	if bz == nil {
+		return types.GlobalStake{}, false
+	}
+	var stake types.GlobalStake
+	if err := json.Unmarshal(bz, &stake); err != nil {
+		return types.GlobalStake{}, false
+	}
+	return stake, true
+}
+
+// GetAllGlobalStakes returns the global stake positions of all accounts.
+func (k Keeper) GetAllGlobalStakes(ctx sdk.Context) []types.GlobalStake {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("global_stake:"))
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var stakes []types.GlobalStake
+	for ; iterator.Valid(); iterator.Next() {
+		var stake types.GlobalStake
+		if err := json.Unmarshal(iterator.Value(), &stake); err != nil {
+			continue
+		}
+		stakes = append(stakes, stake)
+	}
+	return stakes
+}
+
+// ---------------- Subnet allocation ----------------
+
+// SetSubnetAllocation stores the global stake an account allocated to a subnet.
+func (k Keeper) SetSubnetAllocation(ctx sdk.Context, alloc types.SubnetAllocation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("stake_alloc:"))
+	bz, _ := json.Marshal(alloc)
+	store.Set(subnetAllocationKey(alloc.Account, alloc.Netuid), bz)
+}
+
+// GetSubnetAllocation returns the global stake an account allocated to a subnet.
+func (k Keeper) GetSubnetAllocation(ctx sdk.Context, account string, netuid uint16) (types.SubnetAllocation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("stake_alloc:"))
+	bz := store.Get(subnetAllocationKey(account, netuid))
+	if bz == nil {
+		return types.SubnetAllocation{}, false
+	}
+	var alloc types.SubnetAllocation
+	if err := json.Unmarshal(bz, &alloc); err != nil {
+		return types.SubnetAllocation{}, false
+	}
+	return alloc, true
+}
+
+// GetSubnetAllocationsByAccount returns the allocations of an account ordered by netuid.
+func (k Keeper) GetSubnetAllocationsByAccount(ctx sdk.Context, account string) []types.SubnetAllocation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("stake_alloc:"))
+	iterator := storetypes.KVStorePrefixIterator(store, globalStakeKey(account))
+	defer iterator.Close()
+
+	var allocs []types.SubnetAllocation
+	for ; iterator.Valid(); iterator.Next() {
+		var alloc types.SubnetAllocation
+		if err := json.Unmarshal(iterator.Value(), &alloc); err != nil {
+			continue
+		}
+		allocs = append(allocs, alloc)
+	}
+	return allocs
+}
+
+// GetAllSubnetAllocations returns the allocations of all accounts.
+func (k Keeper) GetAllSubnetAllocations(ctx sdk.Context) []types.SubnetAllocation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("stake_alloc:"))
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var allocs []types.SubnetAllocation
+	for ; iterator.Valid(); iterator.Next() {
+		var alloc types.SubnetAllocation
+		if err := json.Unmarshal(iterator.Value(), &alloc); err != nil {
+			continue
+		}
+		allocs = append(allocs, alloc)
+	}
+	return allocs
+}
+
+// ---------------- Registration cost ----------------
+
+// SetSubnetRegistrationCost stores the registration costs paid to the treasury for a subnet.
+func (k Keeper) SetSubnetRegistrationCost(ctx sdk.Context, netuid uint16, amount string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("registration_cost:"))
+	store.Set(uint16ToBytes(netuid), []byte(amount))
+}
+
+// GetSubnetRegistrationCost returns the registration costs paid to the treasury for a subnet.
+func (k Keeper) GetSubnetRegistrationCost(ctx sdk.Context, netuid uint16) string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("registration_cost:"))
+	bz := store.Get(uint16ToBytes(netuid))
+	if bz == nil {
+		return "0"
+	}
+	return string(bz)
+}
+
+// GetAllSubnetRegistrationCosts returns the registration costs paid to the
+// treasury per subnet, ordered by netuid.
+func (k Keeper) GetAllSubnetRegistrationCosts(ctx sdk.Context) []types.SubnetRegistrationCost {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("registration_cost:"))
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var costs []types.SubnetRegistrationCost
+	for ; iterator.Valid(); iterator.Next() {
+		costs = append(costs, types.SubnetRegistrationCost{
+			Netuid: binary.BigEndian.Uint16(iterator.Key()),
+			Amount: string(iterator.Value()),
+		})
+	}
+	return costs
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	eventtypes "github.com/hetu-project/hetu/v1/hetu/event/v1"
+	eventabi "github.com/hetu-project/hetu/v1/x/event/abi"
+	"github.com/hetu-project/hetu/v1/x/event/keeper"
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+// globalStakingContract is the GlobalStaking contract emitting the staking logs
+var globalStakingContract = common.HexToAddress("0x00000000000000000000000000000000000000d4")
+
+// setGlobalStakingContract sets globalStakingContract as the GlobalStaking
+// contract in the params.
+func setGlobalStakingContract(t *testing.T, ctx sdk.Context, k *keeper.Keeper) {
+	params := k.GetParams(ctx)
+	params.GlobalStakingAddress = globalStakingContract.Hex()
+	require.NoError(t, k.SetParams(ctx, params))
+}
+
+// stakingLog builds a GlobalStaking log, indexed are the user and, for the
+// subnet events, the netuid.
+func stakingLog(t *testing.T, stakingABI abi.ABI, name string, user common.Address, netuid uint16, args ...interface{}) ethTypes.Log {
+	event := stakingABI.Events[name]
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	require.NoError(t, err)
+
+	topics := []common.Hash{event.ID, common.BytesToHash(user.Bytes())}
+	if len(event.Inputs)-len(event.Inputs.NonIndexed()) > 1 {
+		topics = append(topics, common.BigToHash(big.NewInt(int64(netuid))))
+	}
+	return ethTypes.Log{Address: globalStakingContract, Topics: topics, Data: data}
+}
+
+func TestHandleGlobalStakingLogs(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(5)
+	setGlobalStakingContract(t, ctx, k)
+	stakingABI := parseABI(t, eventabi.GlobalStakingABI)
+	user := common.HexToAddress("0x00000000000000000000000000000000000000c3")
+
+	// allocations are tracked before the account registers as a neuron
+	k.HandleEvmLogs(ctx, []ethTypes.Log{
+		stakingLog(t, stakingABI, "GlobalStakeAdded", user, 0, big.NewInt(1000)),
+		stakingLog(t, stakingABI, "SubnetAllocationChanged", user, 1, big.NewInt(0), big.NewInt(400)),
+		stakingLog(t, stakingABI, "SubnetAllocationChanged", user, 2, big.NewInt(0), big.NewInt(100)),
+		stakingLog(t, stakingABI, "RegistrationCost", user, 1, big.NewInt(50)),
+	})
+	_, found := k.GetNeuronInfo(ctx, 1, user.Hex())
+	require.False(t, found)
+
+	stake, found := k.GetGlobalStake(ctx, user.Hex())
+	require.True(t, found)
+	require.Equal(t, "1000", stake.TotalStaked)
+	require.Equal(t, "500", stake.Allocated)
+	require.Equal(t, "50", stake.RegistrationCost)
+	require.Equal(t, "450", stake.Available())
+	require.Equal(t, uint64(5), stake.LastUpdateBlock)
+
+	// the neuron stake follows the allocation once registered
+	k.SetNeuronInfo(ctx, types.NeuronInfo{Account: user.Hex(), Netuid: 1, Stake: "400"})
+	k.HandleEvmLogs(ctx, []ethTypes.Log{
+		stakingLog(t, stakingABI, "SubnetAllocationChanged", user, 1, big.NewInt(400), big.NewInt(600)),
+		stakingLog(t, stakingABI, "DeallocatedFromSubnet", user, 1, big.NewInt(200)),
+		stakingLog(t, stakingABI, "DeallocatedFromSubnet", user, 2, big.NewInt(100)),
+		stakingLog(t, stakingABI, "GlobalStakeRemoved", user, 0, big.NewInt(300)),
+	})
+	neuron, found := k.GetNeuronInfo(ctx, 1, user.Hex())
+	require.True(t, found)
+	require.Equal(t, "400", neuron.Stake)
+
+	alloc, found := k.GetSubnetAllocation(ctx, user.Hex(), 1)
+	require.True(t, found)
+	require.Equal(t, "400", alloc.Amount)
+	require.Equal(t, "50", alloc.RegistrationCost)
+
+	// queries are case insensitive on the account
+	res, err := keeper.NewQueryServer(*k).StakePosition(ctx, &eventtypes.QueryStakePositionRequest{Account: "0x00000000000000000000000000000000000000C3"})
+	require.NoError(t, err)
+	require.Equal(t, "700", res.TotalStaked)
+	require.Equal(t, "400", res.Allocated)
+	require.Equal(t, "250", res.Available)
+	require.Len(t, res.Allocations, 2)
+	require.Equal(t, uint32(2), res.Allocations[1].Netuid)
+	require.Equal(t, "0", res.Allocations[1].Amount)
+
+	costs, err := keeper.NewQueryServer(*k).RegistrationCosts(ctx, &eventtypes.QueryRegistrationCostsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "50", costs.Total)
+	require.Len(t, costs.Subnets, 1)
+}
+
+func TestGlobalStakingLogsFromOtherContracts(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	stakingABI := parseABI(t, eventabi.GlobalStakingABI)
+	user := common.HexToAddress("0x00000000000000000000000000000000000000c3")
+	logs := []ethTypes.Log{
+		stakingLog(t, stakingABI, "GlobalStakeAdded", user, 0, big.NewInt(1000)),
+		stakingLog(t, stakingABI, "SubnetAllocationChanged", user, 1, big.NewInt(0), big.NewInt(400)),
+		stakingLog(t, stakingABI, "RegistrationCost", user, 1, big.NewInt(50)),
+	}
+
+	forged := make([]ethTypes.Log, len(logs))
+	for i, log := range logs {
+		log.Address = common.HexToAddress("0x00000000000000000000000000000000000000e5")
+		forged[i] = log
+	}
+
+	// any contract is accepted while the GlobalStaking contract is not set
+	k.HandleEvmLogs(ctx, forged[:1])
+	stake, found := k.GetGlobalStake(ctx, user.Hex())
+	require.True(t, found)
+	require.Equal(t, "1000", stake.TotalStaked)
+
+	// logs forged by another contract are ignored once it is set
+	setGlobalStakingContract(t, ctx, k)
+	k.HandleEvmLogs(ctx, forged)
+	stake, found = k.GetGlobalStake(ctx, user.Hex())
+	require.True(t, found)
+	require.Equal(t, "1000", stake.TotalStaked)
+	require.Equal(t, "0", stake.Allocated)
+	require.Equal(t, "0", k.GetSubnetRegistrationCost(ctx, 1))
+
+	k.HandleEvmLogs(ctx, logs)
+	stake, found = k.GetGlobalStake(ctx, user.Hex())
+	require.True(t, found)
+	require.Equal(t, "2000", stake.TotalStaked)
+	require.Equal(t, "50", k.GetSubnetRegistrationCost(ctx, 1))
+}
+
+// recordingCostPolicy records the subnet costs applied to the supply
+type recordingCostPolicy struct {
+	costs []string
+}
+
+func (p *recordingCostPolicy) ApplySubnetCost(_ sdk.Context, netuid uint16, source string, payer sdk.AccAddress, amount math.Int) error {
+	p.costs = append(p.costs, fmt.Sprintf("%d/%s/%s/%s", netuid, source, common.BytesToAddress(payer).Hex(), amount))
+	return nil
+}
+
+func TestRegistrationCostAppliesSubnetCostPolicy(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	policy := &recordingCostPolicy{}
+	k.SetSubnetCostPolicy(policy)
+	setGlobalStakingContract(t, ctx, k)
+	stakingABI := parseABI(t, eventabi.GlobalStakingABI)
+	user := common.HexToAddress("0x00000000000000000000000000000000000000c3")
+
+	k.HandleEvmLogs(ctx, []ethTypes.Log{
+		stakingLog(t, stakingABI, "RegistrationCost", user, 1, big.NewInt(50)),
+		stakingLog(t, stakingABI, "RegistrationCost", user, 2, big.NewInt(0)),
+	})
+	require.Equal(t, []string{"1/registration/" + globalStakingContract.Hex() + "/50"}, policy.costs)
+	require.Panics(t, func() { k.SetSubnetCostPolicy(policy) })
+}
+
+func TestNetworkRegisteredAppliesLockCost(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	policy := &recordingCostPolicy{}
+	k.SetSubnetCostPolicy(policy)
+	alphaToken := common.HexToAddress("0x00000000000000000000000000000000000000b1")
+
+	// logs from another contract are ignored
+	setSubnetManagerContract(t, ctx, k)
+	forged := networkRegisteredLog(t, 1, alphaToken)
+	forged.Address = common.HexToAddress("0x00000000000000000000000000000000000000e5")
+	k.HandleEvmLogs(ctx, []ethTypes.Log{forged})
+	_, found := k.GetSubnetInfo(ctx, 1)
+	require.False(t, found)
+	require.Empty(t, policy.costs)
+
+	// the burned lock cost is collected from the SubnetManager
+	k.HandleEvmLogs(ctx, []ethTypes.Log{networkRegisteredLog(t, 1, alphaToken)})
+	_, found = k.GetSubnetInfo(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, []string{"1/lock/" + subnetManagerContract.Hex() + "/100"}, policy.costs)
+}
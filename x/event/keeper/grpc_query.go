@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	eventtypes "github.com/hetu-project/hetu/v1/hetu/event/v1"
+	"github.com/hetu-project/hetu/v1/x/event/types"
 )
 
 // QueryServer implements the gRPC QueryServer interface
@@ -177,3 +180,337 @@ func (q QueryServer) ValidatorWeights(ctx context.Context, req *eventtypes.Query
 		Weights:   weights,
 	}, nil
 }
+
+// StakePosition implements the Query/StakePosition gRPC method
+func (q QueryServer) StakePosition(ctx context.Context, req *eventtypes.QueryStakePositionRequest) (*eventtypes.QueryStakePositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if !common.IsHexAddress(req.Account) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account %s", req.Account)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	stake, found := q.Keeper.GetGlobalStake(sdkCtx, req.Account)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "global stake for account %s not found", req.Account)
+	}
+
+	allocs := q.Keeper.GetSubnetAllocationsByAccount(sdkCtx, req.Account)
+
+	// Convert to protobuf types
+	pbAllocs := make([]*eventtypes.SubnetAllocationInfo, len(allocs))
+	for i, alloc := range allocs {
+		pbAllocs[i] = &eventtypes.SubnetAllocationInfo{
+			Netuid:           uint32(alloc.Netuid),
+			Amount:           alloc.Amount,
+			RegistrationCost: alloc.RegistrationCost,
+			LastUpdateBlock:  alloc.LastUpdateBlock,
+		}
+	}
+
+	return &eventtypes.QueryStakePositionResponse{
+		Account:          stake.Account,
+		TotalStaked:      stake.TotalStaked,
+		Allocated:        stake.Allocated,
+		RegistrationCost: stake.RegistrationCost,
+		Available:        stake.Available(),
+		Allocations:      pbAllocs,
+	}, nil
+}
+
+// RegistrationCosts implements the Query/RegistrationCosts gRPC method
+func (q QueryServer) RegistrationCosts(ctx context.Context, req *eventtypes.QueryRegistrationCostsRequest) (*eventtypes.QueryRegistrationCostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	costs := q.Keeper.GetAllSubnetRegistrationCosts(sdkCtx)
+
+	// Convert to protobuf types
+	total := "0"
+	pbCosts := make([]*eventtypes.SubnetRegistrationCost, len(costs))
+	for i, cost := range costs {
+		total = types.AddBigIntString(total, cost.Amount)
+		pbCosts[i] = &eventtypes.SubnetRegistrationCost{
+			Netuid: uint32(cost.Netuid),
+			Amount: cost.Amount,
+		}
+	}
+
+	return &eventtypes.QueryRegistrationCostsResponse{
+		Total:   total,
+		Subnets: pbCosts,
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (q QueryServer) Params(ctx context.Context, req *eventtypes.QueryParamsRequest) (*eventtypes.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := q.Keeper.GetParams(sdkCtx)
+
+	return &eventtypes.QueryParamsResponse{
+		PriceHistoryRetention:  params.PriceHistoryRetention,
+		ChildKeyCooldown:       params.ChildKeyCooldown,
+		MaxChildKeyTake:        params.MaxChildKeyTake.String(),
+		MinValidatorTake:       params.MinValidatorTake.String(),
+		MaxValidatorTake:       params.MaxValidatorTake.String(),
+		DefaultValidatorTake:   params.DefaultValidatorTake.String(),
+		ValidatorTakeCooldown:  params.ValidatorTakeCooldown,
+		NativeStakeEnabled:     params.NativeStakeEnabled,
+		AlphaTokenPairsEnabled: params.AlphaTokenPairsEnabled,
+		PriceCandleRetention:   params.PriceCandleRetention,
+		GlobalStakingAddress:   params.GlobalStakingAddress,
+		SubnetManagerAddress:   params.SubnetManagerAddress,
+	}, nil
+}
+
+const (
+	// defaultPriceHistoryLimit is the number of snapshots returned when no limit is given
+	defaultPriceHistoryLimit = 100
+	// maxPriceHistoryLimit is the maximum number of snapshots returned by a query
+	maxPriceHistoryLimit = 1000
+)
+
+// PriceHistory implements the Query/PriceHistory gRPC method
+func (q QueryServer) PriceHistory(ctx context.Context, req *eventtypes.QueryPriceHistoryRequest) (*eventtypes.QueryPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.StartHeight < 0 || req.EndHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "heights must not be negative")
+	}
+	if req.EndHeight > 0 && req.EndHeight < req.StartHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "end height %d is lower than start height %d", req.EndHeight, req.StartHeight)
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultPriceHistoryLimit
+	}
+	if limit > maxPriceHistoryLimit {
+		limit = maxPriceHistoryLimit
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	snapshots, nextHeight := q.Keeper.GetPriceHistory(sdkCtx, uint16(req.Netuid), req.StartHeight, req.EndHeight, limit)
+
+	// Convert to protobuf types
+	pbSnapshots := make([]*eventtypes.PriceSnapshot, len(snapshots))
+	for i, snapshot := range snapshots {
+		pbSnapshots[i] = &eventtypes.PriceSnapshot{
+			Netuid:          uint32(snapshot.Netuid),
+			Height:          snapshot.Height,
+			Price:           snapshot.Price.String(),
+			MovingPrice:     snapshot.MovingPrice.String(),
+			TaoIn:           snapshot.TaoIn.String(),
+			AlphaIn:         snapshot.AlphaIn.String(),
+			AlphaOut:        snapshot.AlphaOut.String(),
+			PendingEmission: snapshot.PendingEmission.String(),
+			OwnerCut:        snapshot.OwnerCut.String(),
+		}
+	}
+
+	return &eventtypes.QueryPriceHistoryResponse{
+		Snapshots:  pbSnapshots,
+		NextHeight: nextHeight,
+	}, nil
+}
+
+const (
+	// defaultPriceCandlesLimit is the number of candles returned when no limit is given
+	defaultPriceCandlesLimit = 100
+	// maxPriceCandlesLimit is the maximum number of candles returned by a query
+	maxPriceCandlesLimit = 1000
+)
+
+// PriceCandles implements the Query/PriceCandles gRPC method
+func (q QueryServer) PriceCandles(ctx context.Context, req *eventtypes.QueryPriceCandlesRequest) (*eventtypes.QueryPriceCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.StartHeight < 0 || req.EndHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "heights must not be negative")
+	}
+	if req.EndHeight > 0 && req.EndHeight < req.StartHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "end height %d is lower than start height %d", req.EndHeight, req.StartHeight)
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultPriceCandlesLimit
+	}
+	if limit > maxPriceCandlesLimit {
+		limit = maxPriceCandlesLimit
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	candles, nextHeight := q.Keeper.GetPriceCandles(sdkCtx, uint16(req.Netuid), req.StartHeight, req.EndHeight, limit)
+
+	// Convert to protobuf types
+	pbCandles := make([]*eventtypes.PriceCandle, len(candles))
+	for i, candle := range candles {
+		pbCandles[i] = &eventtypes.PriceCandle{
+			Netuid: uint32(candle.Netuid),
+			Height: candle.Height,
+			Open:   candle.Open.String(),
+			High:   candle.High.String(),
+			Low:    candle.Low.String(),
+			Close:  candle.Close.String(),
+			Volume: candle.Volume.String(),
+			Trades: candle.Trades,
+		}
+	}
+
+	return &eventtypes.QueryPriceCandlesResponse{
+		Candles:    pbCandles,
+		NextHeight: nextHeight,
+	}, nil
+}
+
+// ChildKeys implements the Query/ChildKeys gRPC method
+func (q QueryServer) ChildKeys(ctx context.Context, req *eventtypes.QueryChildKeysRequest) (*eventtypes.QueryChildKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if !common.IsHexAddress(req.Hotkey) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hotkey %s", req.Hotkey)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	netuid := uint16(req.Netuid)
+	hotkey := common.HexToAddress(req.Hotkey).Hex()
+	if _, found := q.Keeper.GetSubnet(sdkCtx, netuid); !found {
+		return nil, status.Errorf(codes.NotFound, "subnet %d not found", req.Netuid)
+	}
+
+	// Convert to protobuf types
+	resp := &eventtypes.QueryChildKeysResponse{
+		Netuid: req.Netuid,
+		Hotkey: hotkey,
+		Take:   q.Keeper.GetChildKeyTake(sdkCtx, netuid, hotkey).String(),
+	}
+	if childKeys, found := q.Keeper.GetChildKeys(sdkCtx, netuid, hotkey); found {
+		for _, child := range childKeys.Children {
+			resp.Children = append(resp.Children, &eventtypes.ChildKeyInfo{
+				Hotkey:     child.Child,
+				Proportion: child.Proportion.String(),
+			})
+		}
+	}
+	if pending, found := q.Keeper.GetPendingChildKeys(sdkCtx, netuid, hotkey); found {
+		for _, child := range pending.Children {
+			resp.PendingChildren = append(resp.PendingChildren, &eventtypes.ChildKeyInfo{
+				Hotkey:     child.Child,
+				Proportion: child.Proportion.String(),
+			})
+		}
+		resp.CooldownBlock = pending.CooldownBlock
+	}
+	for _, parent := range q.Keeper.GetParentKeys(sdkCtx, netuid, hotkey) {
+		resp.Parents = append(resp.Parents, &eventtypes.ChildKeyInfo{
+			Hotkey:     parent.Parent,
+			Proportion: parent.Proportion.String(),
+		})
+	}
+
+	stake := q.Keeper.ownStakes(sdkCtx, netuid)[hotkey]
+	if stake.IsNil() {
+		stake = math.ZeroInt()
+	}
+	resp.Stake = stake.String()
+	resp.EffectiveStake = q.Keeper.GetEffectiveStake(sdkCtx, netuid, hotkey).String()
+
+	return resp, nil
+}
+
+// ValidatorTake implements the Query/ValidatorTake gRPC method
+func (q QueryServer) ValidatorTake(ctx context.Context, req *eventtypes.QueryValidatorTakeRequest) (*eventtypes.QueryValidatorTakeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if !common.IsHexAddress(req.Validator) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator %s", req.Validator)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	netuid := uint16(req.Netuid)
+	validator := common.HexToAddress(req.Validator).Hex()
+	if _, found := q.Keeper.GetSubnet(sdkCtx, netuid); !found {
+		return nil, status.Errorf(codes.NotFound, "subnet %d not found", req.Netuid)
+	}
+
+	resp := &eventtypes.QueryValidatorTakeResponse{
+		Netuid:    req.Netuid,
+		Validator: validator,
+		Take:      q.Keeper.GetValidatorTake(sdkCtx, netuid, validator).String(),
+	}
+	if take, found := q.Keeper.getValidatorTake(sdkCtx, netuid, validator); found {
+		resp.LastUpdateBlock = take.LastUpdateBlock
+	}
+	return resp, nil
+}
+
+// Dividends implements the Query/Dividends gRPC method
+func (q QueryServer) Dividends(ctx context.Context, req *eventtypes.QueryDividendsRequest) (*eventtypes.QueryDividendsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if !common.IsHexAddress(req.Delegator) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator %s", req.Delegator)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delegator := common.HexToAddress(req.Delegator).Hex()
+	dividends := q.Keeper.GetClaimableDividendsByDelegator(sdkCtx, delegator)
+
+	// Convert to protobuf types
+	pbDividends := make([]*eventtypes.ClaimableDividend, len(dividends))
+	for i, dividend := range dividends {
+		pbDividends[i] = &eventtypes.ClaimableDividend{
+			Netuid: uint32(dividend.Netuid),
+			Amount: dividend.Amount.String(),
+		}
+	}
+
+	return &eventtypes.QueryDividendsResponse{
+		Delegator: delegator,
+		Dividends: pbDividends,
+	}, nil
+}
+
+// NativeStake returns the bonded x/staking tokens of a validator counted as subnet stake
+func (q QueryServer) NativeStake(ctx context.Context, req *eventtypes.QueryNativeStakeRequest) (*eventtypes.QueryNativeStakeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if !common.IsHexAddress(req.Validator) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator %s", req.Validator)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	validator := common.HexToAddress(req.Validator)
+	allocations := q.Keeper.GetNativeStakeAllocations(sdkCtx, validator.Hex())
+
+	// Convert to protobuf types
+	stakes := make([]*eventtypes.NativeSubnetStake, len(allocations))
+	for i, allocation := range allocations {
+		stakes[i] = &eventtypes.NativeSubnetStake{
+			Netuid:     uint32(allocation.Netuid),
+			Proportion: allocation.Proportion.String(),
+			Amount:     q.Keeper.GetNativeStake(sdkCtx, allocation.Netuid, validator.Hex()).String(),
+		}
+	}
+
+	return &eventtypes.QueryNativeStakeResponse{
+		Validator:    validator.Hex(),
+		BondedTokens: q.Keeper.BondedTokens(sdkCtx, sdk.ValAddress(validator.Bytes())).String(),
+		Enabled:      q.Keeper.GetParams(sdkCtx).NativeStakeEnabled,
+		Stakes:       stakes,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks wrapper struct for the event keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the staking hooks keeping the native subnet stake of the
+// validators in line with their bonded tokens
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterValidatorBonded marks the native stake of the validator for a sync
+func (h Hooks) AfterValidatorBonded(ctx context.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	h.k.markNativeStakeDirty(sdk.UnwrapSDKContext(ctx), valAddr)
+	return nil
+}
+
+// AfterValidatorBeginUnbonding marks the native stake of the validator for a sync
+func (h Hooks) AfterValidatorBeginUnbonding(ctx context.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	h.k.markNativeStakeDirty(sdk.UnwrapSDKContext(ctx), valAddr)
+	return nil
+}
+
+// AfterValidatorRemoved marks the native stake of the validator for a sync
+func (h Hooks) AfterValidatorRemoved(ctx context.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	h.k.markNativeStakeDirty(sdk.UnwrapSDKContext(ctx), valAddr)
+	return nil
+}
+
+// AfterDelegationModified marks the native stake of the validator for a sync.
+// The bonded tokens of the validator are not final yet when unbonding.
+func (h Hooks) AfterDelegationModified(ctx context.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.markNativeStakeDirty(sdk.UnwrapSDKContext(ctx), valAddr)
+	return nil
+}
+
+// BeforeDelegationRemoved marks the native stake of the validator for a sync
+func (h Hooks) BeforeDelegationRemoved(ctx context.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.markNativeStakeDirty(sdk.UnwrapSDKContext(ctx), valAddr)
+	return nil
+}
+
+// BeforeValidatorSlashed reduces the native stake of the validator by the
+// slashed fraction right away
+func (h Hooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	h.k.slashNativeStake(sdkCtx, valAddr, fraction)
+	h.k.markNativeStakeDirty(sdkCtx, valAddr)
+	return nil
+}
+
+func (h Hooks) AfterValidatorCreated(_ context.Context, _ sdk.ValAddress) error { return nil }
+
+func (h Hooks) BeforeValidatorModified(_ context.Context, _ sdk.ValAddress) error { return nil }
+
+func (h Hooks) BeforeDelegationCreated(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationSharesModified(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error { return nil }
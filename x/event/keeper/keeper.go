@@ -52,6 +52,8 @@ var (
 type Keeper struct {
 	cdc      codec.Codec
 	storeKey storetypes.StoreKey
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
 
 	// Legacy contract ABIs (maintain compatibility)
 	subnetRegistryABI   abi.ABI
@@ -63,6 +65,16 @@ type Keeper struct {
 	subnetManagerABI abi.ABI
 	neuronManagerABI abi.ABI
 	globalStakingABI abi.ABI
+	subnetAMMABI     abi.ABI
+
+	// alphaMinter mints the alpha dividends claimed by delegators
+	alphaMinter types.AlphaMinter
+	// stakingKeeper provides the bonded tokens counted as native subnet stake
+	stakingKeeper types.StakingKeeper
+	// subnetCostPolicy applies the registration and lock costs to the supply
+	subnetCostPolicy types.SubnetCostPolicy
+	// alphaTokenRegistrar registers the alpha tokens of new subnets as token pairs
+	alphaTokenRegistrar types.AlphaTokenRegistrar
 
 	// Event topic IDs
 	topicSubnetRegistered        string
@@ -80,6 +92,14 @@ type Keeper struct {
 	topicServiceUpdated          string
 	topicSubnetAllocationChanged string
 	topicDeallocatedFromSubnet   string
+	topicGlobalStakeAdded        string
+	topicGlobalStakeRemoved      string
+	topicRegistrationCost        string
+	topicSwapHETUForAlpha        string
+	topicSwapAlphaForHETU        string
+	topicLiquidityInjected       string
+	topicLiquidityWithdrawn      string
+	topicReservesUpdated         string
 }
 
 // ----------- Keeper initialization -----------
@@ -93,10 +113,18 @@ func NewKeeper(
 	subnetManagerABI abi.ABI,
 	neuronManagerABI abi.ABI,
 	globalStakingABI abi.ABI,
+	subnetAMMABI abi.ABI,
+	authority sdk.AccAddress,
 ) *Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
 	k := &Keeper{
 		cdc:                 cdc,
 		storeKey:            storeKey,
+		authority:           authority,
 		subnetRegistryABI:   subnetRegistryABI,
 		stakingSelfABI:      stakingSelfABI,
 		stakingDelegatedABI: stakingDelegatedABI,
@@ -104,6 +132,7 @@ func NewKeeper(
 		subnetManagerABI:    subnetManagerABI,
 		neuronManagerABI:    neuronManagerABI,
 		globalStakingABI:    globalStakingABI,
+		subnetAMMABI:        subnetAMMABI,
 	}
 	// Legacy events
 	k.topicSubnetRegistered = k.subnetRegistryABI.Events["SubnetRegistered"].ID.Hex()
@@ -122,9 +151,125 @@ func NewKeeper(
 	k.topicServiceUpdated = k.neuronManagerABI.Events["ServiceUpdated"].ID.Hex()
 	k.topicSubnetAllocationChanged = k.globalStakingABI.Events["SubnetAllocationChanged"].ID.Hex()
 	k.topicDeallocatedFromSubnet = k.globalStakingABI.Events["DeallocatedFromSubnet"].ID.Hex()
+	k.topicGlobalStakeAdded = k.globalStakingABI.Events["GlobalStakeAdded"].ID.Hex()
+	k.topicGlobalStakeRemoved = k.globalStakingABI.Events["GlobalStakeRemoved"].ID.Hex()
+	k.topicRegistrationCost = k.globalStakingABI.Events["RegistrationCost"].ID.Hex()
+	// SubnetAMM pool events
+	k.topicSwapHETUForAlpha = k.subnetAMMABI.Events["SwapHETUForAlpha"].ID.Hex()
+	k.topicSwapAlphaForHETU = k.subnetAMMABI.Events["SwapAlphaForHETU"].ID.Hex()
+	k.topicLiquidityInjected = k.subnetAMMABI.Events["LiquidityInjected"].ID.Hex()
+	k.topicLiquidityWithdrawn = k.subnetAMMABI.Events["LiquidityWithdrawn"].ID.Hex()
+	k.topicReservesUpdated = k.subnetAMMABI.Events["ReservesUpdated"].ID.Hex()
+	return k
+}
+
+// SetAlphaMinter sets the minter of the alpha dividends claimed by delegators.
+// It has to be set before the keeper is copied into the module.
+func (k *Keeper) SetAlphaMinter(minter types.AlphaMinter) *Keeper {
+	if k.alphaMinter != nil {
+		panic("cannot set event alpha minter twice")
+	}
+
+	k.alphaMinter = minter
+	return k
+}
+
+// SetStakingKeeper sets the x/staking keeper whose bonded tokens validators
+// allocate to subnets. It has to be set before the keeper is copied into the
+// module and the staking hooks.
+func (k *Keeper) SetStakingKeeper(stakingKeeper types.StakingKeeper) *Keeper {
+	if k.stakingKeeper != nil {
+		panic("cannot set event staking keeper twice")
+	}
+
+	k.stakingKeeper = stakingKeeper
+	return k
+}
+
+// SetSubnetCostPolicy sets the policy applying the subnet registration and
+// lock costs to the supply. It has to be set before the keeper is copied into
+// the module.
+func (k *Keeper) SetSubnetCostPolicy(policy types.SubnetCostPolicy) *Keeper {
+	if k.subnetCostPolicy != nil {
+		panic("cannot set event subnet cost policy twice")
+	}
+
+	k.subnetCostPolicy = policy
 	return k
 }
 
+// isSubnetManagerContract returns true if the address is the SubnetManager
+// contract set in the params. While it is not set, any contract is accepted
+// as before the params existed.
+func (k Keeper) isSubnetManagerContract(ctx sdk.Context, address common.Address) bool {
+	contract := k.GetParams(ctx).SubnetManagerAddress
+	return contract == "" || common.HexToAddress(contract) == address
+}
+
+// applySubnetCost applies a registration or lock cost paid to a contract to
+// the supply. The cost is applied atomically, a failure is logged and leaves
+// the supply untouched.
+func (k Keeper) applySubnetCost(ctx sdk.Context, netuid uint16, source string, contract common.Address, amount *big.Int) {
+	if k.subnetCostPolicy == nil || amount == nil || amount.Sign() <= 0 {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.subnetCostPolicy.ApplySubnetCost(cacheCtx, netuid, source, sdk.AccAddress(contract.Bytes()), math.NewIntFromBigInt(amount)); err != nil {
+		k.Logger(ctx).Error("Failed to apply subnet cost",
+			"netuid", netuid,
+			"source", source,
+			"contract", contract.Hex(),
+			"amount", amount.String(),
+			"error", err)
+		return
+	}
+	write()
+}
+
+// SetAlphaTokenRegistrar sets the x/erc20 keeper registering the alpha tokens
+// of new subnets as token pairs. It has to be set before the keeper is copied
+// into the module.
+func (k *Keeper) SetAlphaTokenRegistrar(registrar types.AlphaTokenRegistrar) *Keeper {
+	if k.alphaTokenRegistrar != nil {
+		panic("cannot set event alpha token registrar twice")
+	}
+
+	k.alphaTokenRegistrar = registrar
+	return k
+}
+
+// registerAlphaToken registers the alpha token of a new subnet as a token
+// pair when enabled. It only runs for NetworkRegistered events of the
+// configured SubnetManager, so no other contract can claim the alpha denom of
+// a netuid. The registration is atomic, a failure is logged and leaves the
+// subnet registration untouched.
+func (k Keeper) registerAlphaToken(ctx sdk.Context, netuid uint16, alphaToken common.Address) {
+	if k.alphaTokenRegistrar == nil || !k.GetParams(ctx).AlphaTokenPairsEnabled {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	pair, err := k.alphaTokenRegistrar.RegisterAlphaToken(cacheCtx, netuid, alphaToken)
+	if err != nil {
+		k.Logger(ctx).Error("Failed to register alpha token pair",
+			"netuid", netuid,
+			"alpha_token", alphaToken.Hex(),
+			"error", err)
+		return
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"alpha_token_pair_registered",
+			sdk.NewAttribute("netuid", fmt.Sprintf("%d", netuid)),
+			sdk.NewAttribute("alpha_token", pair.Erc20Address),
+			sdk.NewAttribute("denom", pair.Denom),
+		),
+	)
+}
+
 // ----------- Logger -----------
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/event")
@@ -134,6 +279,8 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 func (k *Keeper) HandleEvmLogs(ctx sdk.Context, logs []ethTypes.Log) {
 	k.Logger(ctx).Debug("Starting to process EVM events", "event_count", len(logs))
 
+	// SubnetAMM events are applied together once all the logs are read
+	var ammLogs []ethTypes.Log
 	for _, log := range logs {
 		if len(log.Topics) == 0 {
 			continue
@@ -141,6 +288,19 @@ func (k *Keeper) HandleEvmLogs(ctx sdk.Context, logs []ethTypes.Log) {
 		topic := log.Topics[0].Hex()
 		k.Logger(ctx).Debug("Processing EVM event", "topic", topic, "contract_address", log.Address.Hex(), "block_height", log.BlockNumber)
 
+		// GlobalStaking events write the stake ledger, only the configured
+		// contract can emit them once it is set
+		if k.isGlobalStakingTopic(topic) && !k.isGlobalStakingContract(ctx, log.Address) {
+			k.Logger(ctx).Debug("Ignoring GlobalStaking event from unconfigured contract", "topic", topic, "contract_address", log.Address.Hex())
+			continue
+		}
+		// NetworkRegistered creates a subnet and burns its lock cost, only the
+		// configured SubnetManager contract can emit it once it is set
+		if topic == k.topicNetworkRegistered && !k.isSubnetManagerContract(ctx, log.Address) {
+			k.Logger(ctx).Debug("Ignoring NetworkRegistered event from unconfigured contract", "contract_address", log.Address.Hex())
+			continue
+		}
+
 		switch topic {
 		// Legacy events (maintain compatibility)
 		case k.topicSubnetRegistered:
@@ -190,11 +350,29 @@ func (k *Keeper) HandleEvmLogs(ctx sdk.Context, logs []ethTypes.Log) {
 		case k.topicDeallocatedFromSubnet:
 			k.Logger(ctx).Debug("Identified DeallocatedFromSubnet event")
 			k.handleDeallocatedFromSubnet(ctx, log)
+		case k.topicGlobalStakeAdded:
+			k.Logger(ctx).Debug("Identified GlobalStakeAdded event")
+			k.handleGlobalStakeAdded(ctx, log)
+		case k.topicGlobalStakeRemoved:
+			k.Logger(ctx).Debug("Identified GlobalStakeRemoved event")
+			k.handleGlobalStakeRemoved(ctx, log)
+		case k.topicRegistrationCost:
+			k.Logger(ctx).Debug("Identified RegistrationCost event")
+			k.handleRegistrationCost(ctx, log)
 		default:
+			if k.isAMMTopic(topic) {
+				k.Logger(ctx).Debug("Identified SubnetAMM event")
+				ammLogs = append(ammLogs, log)
+				continue
+			}
 			k.Logger(ctx).Debug("Unrecognized EVM event topic", "topic", topic, "contract_address", log.Address.Hex())
 		}
 	}
 
+	if len(ammLogs) > 0 {
+		k.handleAMMLogs(ctx, ammLogs)
+	}
+
 	k.Logger(ctx).Debug("Finished processing EVM events", "event_count", len(logs))
 }
 
@@ -607,6 +785,12 @@ func (k Keeper) handleNetworkRegistered(ctx sdk.Context, log ethTypes.Log) {
 
 	k.SetSubnetInfo(ctx, subnetInfo)
 
+	// The burned part of the lock cost leaves circulation with the contracts
+	k.applySubnetCost(ctx, netuid, types.SubnetCostLock, log.Address, event.BurnedAmount)
+
+	// The alpha token is converted to a Cosmos coin through its token pair
+	k.registerAlphaToken(ctx, netuid, event.AlphaToken)
+
 	// Initialize the AlphaIn and TaoIn of the subnet using LockdAmount as the initial value
 	lockedAmount, ok := math.NewIntFromString(event.LockedAmount.String())
 	if !ok {
@@ -1084,6 +1268,9 @@ func (k Keeper) handleSubnetAllocationChanged(ctx sdk.Context, log ethTypes.Log)
 		"old_amount", event.OldAmount.String(),
 		"new_amount", event.NewAmount.String())
 
+	// Update the allocation ledger, registered as a neuron or not
+	k.setAllocationAmount(ctx, event.User, event.Netuid, event.NewAmount.String())
+
 	// Update neuron stake information
 	neuronInfo, found := k.GetNeuronInfo(ctx, event.Netuid, event.User.Hex())
 	if found {
@@ -1095,17 +1282,38 @@ func (k Keeper) handleSubnetAllocationChanged(ctx sdk.Context, log ethTypes.Log)
 
 // DeallocatedFromSubnet (GlobalStaking) - Withdraw stake from subnet event
 func (k Keeper) handleDeallocatedFromSubnet(ctx sdk.Context, log ethTypes.Log) {
-	var event struct {
-		User   common.Address
-		Netuid uint16
+	// user and netuid are indexed, only the amount is in the data
+	if len(log.Topics) < 3 {
+		k.Logger(ctx).Error("DeallocatedFromSubnet event has insufficient topics", "topics_length", len(log.Topics))
+		return
+	}
+
+	var nonIndexed struct {
 		Amount *big.Int
 	}
 
-	if err := k.globalStakingABI.UnpackIntoInterface(&event, "DeallocatedFromSubnet", log.Data); err != nil {
+	if err := k.globalStakingABI.UnpackIntoInterface(&nonIndexed, "DeallocatedFromSubnet", log.Data); err != nil {
 		k.Logger(ctx).Error("parse DeallocatedFromSubnet failed", "err", err)
 		return
 	}
 
+	event := struct {
+		User   common.Address
+		Netuid uint16
+		Amount *big.Int
+	}{
+		User:   common.BytesToAddress(log.Topics[1].Bytes()),
+		Netuid: uint16(new(big.Int).SetBytes(log.Topics[2].Bytes()).Uint64()),
+		Amount: nonIndexed.Amount,
+	}
+
+	// Update the allocation ledger, registered as a neuron or not
+	allocated := "0"
+	if alloc, found := k.GetSubnetAllocation(ctx, event.User.Hex(), event.Netuid); found {
+		allocated = alloc.Amount
+	}
+	k.setAllocationAmount(ctx, event.User, event.Netuid, types.SubBigIntString(allocated, event.Amount.String()))
+
 	// Update neuron stake information (reduce stake)
 	neuronInfo, found := k.GetNeuronInfo(ctx, event.Netuid, event.User.Hex())
 	if found {
@@ -1129,6 +1337,10 @@ func (k Keeper) SetSubnet(ctx sdk.Context, subnet types.Subnet) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("subnet:"))
 	bz, _ := json.Marshal(subnet)
 	store.Set(uint16ToBytes(subnet.Netuid), bz)
+
+	if common.IsHexAddress(subnet.AmmPool) {
+		k.setAmmPoolNetuid(ctx, common.HexToAddress(subnet.AmmPool), subnet.Netuid)
+	}
 }
 
 func (k Keeper) GetSubnet(ctx sdk.Context, netuid uint16) (types.Subnet, bool) {
@@ -1347,10 +1559,9 @@ func (k Keeper) GetAllSubnetNetuids(ctx sdk.Context) []uint16 {
 	subnets := k.GetAllSubnets(ctx)
 	var netuids []uint16
 	for _, subnet := range subnets {
-		// if subnet.Netuid != 0 { // Filter out root subnet
-		// 	netuids = append(netuids, subnet.Netuid)
-		// }
-		netuids = append(netuids, subnet.Netuid)
+		if subnet.Netuid != 0 { // Filter out root subnet
+			netuids = append(netuids, subnet.Netuid)
+		}
 	}
 	return netuids
 }
@@ -1371,10 +1582,7 @@ func (k Keeper) GetSubnetsToEmitTo(ctx sdk.Context) []uint16 {
 			"current_block", currentBlock,
 			"is_eligible", subnet.FirstEmissionBlock > 0 && uint64(currentBlock) >= subnet.FirstEmissionBlock)
 
-		// if subnet.Netuid != 0 && subnet.FirstEmissionBlock > 0 { // Filter out root subnet and subnets without first emission block set
-		// 	netuids = append(netuids, subnet.Netuid)
-		// }
-		if subnet.FirstEmissionBlock > 0 { // Filter out subnets without first emission block set
+		if subnet.Netuid != 0 && subnet.FirstEmissionBlock > 0 { // Filter out root subnet and subnets without first emission block set
 			// Additional check: only include subnets where current block height >= first emission block
 			if uint64(currentBlock) >= subnet.FirstEmissionBlock {
 				netuids = append(netuids, subnet.Netuid)
@@ -1705,8 +1913,9 @@ func (k Keeper) GetMovingAlphaPrice(ctx sdk.Context, netuid uint16) math.LegacyD
 	return price
 }
 
-// UpdateMovingPrice updates the moving price for a subnet
-func (k Keeper) UpdateMovingPrice(ctx sdk.Context, netuid uint16, movingAlpha math.LegacyDec, halvingBlocks uint64) {
+// UpdateMovingPrice updates the moving price for a subnet with the given price,
+// the caller decides which price (spot or averaged) is fed into the EMA
+func (k Keeper) UpdateMovingPrice(ctx sdk.Context, netuid uint16, price, movingAlpha math.LegacyDec, halvingBlocks uint64) {
 	// Get first emission block
 	firstEmissionBlock, exists := k.GetSubnetFirstEmissionBlock(ctx, netuid)
 	if !exists {
@@ -1742,8 +1951,8 @@ func (k Keeper) UpdateMovingPrice(ctx sdk.Context, netuid uint16, movingAlpha ma
 	// Calculate 1 - alpha
 	oneMinusAlpha := math.LegacyNewDec(1).Sub(alpha)
 
-	// Get current price (capped at 1.0)
-	currentPrice := k.GetAlphaPrice(ctx, netuid)
+	// Cap the current price at 1.0
+	currentPrice := price
 	if currentPrice.GT(math.LegacyNewDec(1)) {
 		currentPrice = math.LegacyNewDec(1)
 	}
@@ -0,0 +1,338 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+// msgServer implements the Msg service of the event module
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the Msg service for the given keeper
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return msgServer{Keeper: k}
+}
+
+// SetChildKeys queues the children of a parent hotkey on a subnet. They
+// replace the current children once the child key cooldown is over.
+func (k msgServer) SetChildKeys(
+	goCtx context.Context,
+	msg *types.MsgSetChildKeys,
+) (*types.MsgSetChildKeysResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	parent := common.BytesToAddress(sdk.MustAccAddressFromBech32(msg.Parent)).Hex()
+	netuid := uint16(msg.Netuid)
+
+	if _, found := k.GetSubnet(ctx, netuid); !found {
+		return nil, errorsmod.Wrapf(types.ErrSubnetNotFound, "netuid %d", netuid)
+	}
+
+	children := make([]types.ChildKey, len(msg.Children))
+	for i, child := range msg.Children {
+		children[i] = types.ChildKey{
+			Child:      common.HexToAddress(child.Child).Hex(),
+			Proportion: child.Proportion,
+		}
+	}
+
+	cooldownBlock := uint64(ctx.BlockHeight()) + k.GetParams(ctx).ChildKeyCooldown
+	k.SetPendingChildKeys(ctx, types.PendingChildKeys{
+		Parent:        parent,
+		Netuid:        netuid,
+		Children:      children,
+		CooldownBlock: cooldownBlock,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"child_keys_set",
+			sdk.NewAttribute("parent", parent),
+			sdk.NewAttribute("netuid", fmt.Sprintf("%d", netuid)),
+			sdk.NewAttribute("children", fmt.Sprintf("%d", len(children))),
+			sdk.NewAttribute("cooldown_block", fmt.Sprintf("%d", cooldownBlock)),
+		),
+	)
+
+	return &types.MsgSetChildKeysResponse{CooldownBlock: cooldownBlock}, nil
+}
+
+// SetChildKeyTake sets the take of a child hotkey on a subnet. The take can
+// be lowered at any time but raised only once per child key cooldown.
+func (k msgServer) SetChildKeyTake(
+	goCtx context.Context,
+	msg *types.MsgSetChildKeyTake,
+) (*types.MsgSetChildKeyTakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	child := common.BytesToAddress(sdk.MustAccAddressFromBech32(msg.Child)).Hex()
+	netuid := uint16(msg.Netuid)
+
+	if _, found := k.GetSubnet(ctx, netuid); !found {
+		return nil, errorsmod.Wrapf(types.ErrSubnetNotFound, "netuid %d", netuid)
+	}
+
+	params := k.GetParams(ctx)
+	if msg.Take.GT(params.MaxChildKeyTake) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidChildKeyTake, "take %s exceeds the maximum %s", msg.Take, params.MaxChildKeyTake)
+	}
+
+	height := uint64(ctx.BlockHeight())
+	if current, found := k.getChildKeyTake(ctx, netuid, child); found && msg.Take.GT(current.Take) {
+		if next := current.LastUpdateBlock + params.ChildKeyCooldown; height < next {
+			return nil, errorsmod.Wrapf(types.ErrChildKeyRateLimited, "take can be raised again at block %d", next)
+		}
+	}
+
+	k.Keeper.SetChildKeyTake(ctx, types.ChildKeyTake{
+		Child:           child,
+		Netuid:          netuid,
+		Take:            msg.Take,
+		LastUpdateBlock: height,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"child_key_take_set",
+			sdk.NewAttribute("child", child),
+			sdk.NewAttribute("netuid", fmt.Sprintf("%d", netuid)),
+			sdk.NewAttribute("take", msg.Take.String()),
+		),
+	)
+
+	return &types.MsgSetChildKeyTakeResponse{}, nil
+}
+
+// SetValidatorTake sets the take of a validator on a subnet. The take can be
+// lowered at any time but raised only once per validator take cooldown.
+func (k msgServer) SetValidatorTake(
+	goCtx context.Context,
+	msg *types.MsgSetValidatorTake,
+) (*types.MsgSetValidatorTakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	validator := common.BytesToAddress(sdk.MustAccAddressFromBech32(msg.Validator)).Hex()
+	netuid := uint16(msg.Netuid)
+
+	if _, found := k.GetSubnet(ctx, netuid); !found {
+		return nil, errorsmod.Wrapf(types.ErrSubnetNotFound, "netuid %d", netuid)
+	}
+
+	params := k.GetParams(ctx)
+	if msg.Take.LT(params.MinValidatorTake) || msg.Take.GT(params.MaxValidatorTake) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidValidatorTake, "take %s is not between %s and %s", msg.Take, params.MinValidatorTake, params.MaxValidatorTake)
+	}
+
+	height := uint64(ctx.BlockHeight())
+	if current, found := k.getValidatorTake(ctx, netuid, validator); found && msg.Take.GT(current.Take) {
+		if next := current.LastUpdateBlock + params.ValidatorTakeCooldown; height < next {
+			return nil, errorsmod.Wrapf(types.ErrValidatorTakeRateLimited, "take can be raised again at block %d", next)
+		}
+	}
+
+	k.Keeper.SetValidatorTake(ctx, types.ValidatorTake{
+		Validator:       validator,
+		Netuid:          netuid,
+		Take:            msg.Take,
+		LastUpdateBlock: height,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"validator_take_set",
+			sdk.NewAttribute("validator", validator),
+			sdk.NewAttribute("netuid", fmt.Sprintf("%d", netuid)),
+			sdk.NewAttribute("take", msg.Take.String()),
+		),
+	)
+
+	return &types.MsgSetValidatorTakeResponse{}, nil
+}
+
+// ClaimDividends mints the alpha dividends a delegator earned on a subnet, or
+// on all subnets when no netuid is given.
+func (k msgServer) ClaimDividends(
+	goCtx context.Context,
+	msg *types.MsgClaimDividends,
+) (*types.MsgClaimDividendsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	delegator := common.BytesToAddress(sdk.MustAccAddressFromBech32(msg.Delegator)).Hex()
+
+	var dividends []types.ClaimableDividend
+	if msg.All {
+		dividends = k.GetClaimableDividendsByDelegator(ctx, delegator)
+	} else if amount := k.GetClaimableDividend(ctx, uint16(msg.Netuid), delegator); amount.IsPositive() {
+		dividends = []types.ClaimableDividend{{Delegator: delegator, Netuid: uint16(msg.Netuid), Amount: amount}}
+	}
+	if len(dividends) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrNoDividends, "delegator %s", delegator)
+	}
+	if k.alphaMinter == nil {
+		return nil, errorsmod.Wrap(types.ErrDividendClaim, "alpha minter not set")
+	}
+
+	claimed := make([]types.DividendClaim, 0, len(dividends))
+	for _, dividend := range dividends {
+		if err := k.alphaMinter.MintAlphaTokens(ctx, dividend.Netuid, delegator, dividend.Amount.BigInt()); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrDividendClaim, "netuid %d: %s", dividend.Netuid, err)
+		}
+		k.SetClaimableDividend(ctx, types.ClaimableDividend{Delegator: delegator, Netuid: dividend.Netuid, Amount: math.ZeroInt()})
+		claimed = append(claimed, types.DividendClaim{Netuid: uint32(dividend.Netuid), Amount: dividend.Amount})
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"dividends_claimed",
+				sdk.NewAttribute("delegator", delegator),
+				sdk.NewAttribute("netuid", fmt.Sprintf("%d", dividend.Netuid)),
+				sdk.NewAttribute("amount", dividend.Amount.String()),
+			),
+		)
+	}
+
+	return &types.MsgClaimDividendsResponse{Claimed: claimed}, nil
+}
+
+// SetNativeStakeAllocation allocates the bonded x/staking tokens of a
+// validator to subnets, replacing its current allocations.
+func (k msgServer) SetNativeStakeAllocation(
+	goCtx context.Context,
+	msg *types.MsgSetNativeStakeAllocation,
+) (*types.MsgSetNativeStakeAllocationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).NativeStakeEnabled {
+		return nil, types.ErrNativeStakeDisabled
+	}
+
+	// Error checked during msg validation
+	operator := sdk.MustAccAddressFromBech32(msg.Validator)
+	validator := common.BytesToAddress(operator).Hex()
+
+	if k.stakingKeeper == nil {
+		return nil, errorsmod.Wrap(types.ErrValidatorNotFound, "staking keeper not set")
+	}
+	if _, err := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(operator)); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrValidatorNotFound, "%s is not a validator operator", msg.Validator)
+	}
+
+	allocations := make([]types.NativeStakeAllocation, len(msg.Allocations))
+	for i, allocation := range msg.Allocations {
+		netuid := uint16(allocation.Netuid)
+		if _, found := k.GetSubnet(ctx, netuid); !found {
+			return nil, errorsmod.Wrapf(types.ErrSubnetNotFound, "netuid %d", netuid)
+		}
+		allocations[i] = types.NativeStakeAllocation{
+			Validator:  validator,
+			Netuid:     netuid,
+			Proportion: allocation.Proportion,
+		}
+	}
+
+	k.ReplaceNativeStakeAllocations(ctx, validator, allocations)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"native_stake_allocation_set",
+			sdk.NewAttribute("validator", validator),
+			sdk.NewAttribute("subnets", fmt.Sprintf("%d", len(allocations))),
+			sdk.NewAttribute("bonded_tokens", k.BondedTokens(ctx, sdk.ValAddress(operator)).String()),
+		),
+	)
+
+	return &types.MsgSetNativeStakeAllocationResponse{}, nil
+}
+
+// SetWeights replaces the weights of a validator on a subnet, or on the root
+// network for a zero netuid. Only active validator neurons of the subnet, or
+// validators with root stake on the root network, can set weights. The number
+// of weights is capped by the max weights limit of the subnet, and by the
+// number of subnets on the root network.
+func (k msgServer) SetWeights(
+	goCtx context.Context,
+	msg *types.MsgSetWeights,
+) (*types.MsgSetWeightsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	validator := common.BytesToAddress(sdk.MustAccAddressFromBech32(msg.Validator)).Hex()
+	netuid := uint16(msg.Netuid)
+
+	if netuid == 0 {
+		// the root network is not registered as a subnet, its validators are
+		// the accounts with root stake and they weight each subnet once
+		stake, found := k.GetValidatorStake(ctx, netuid, validator)
+		amount, ok := math.NewIntFromString(stake.Amount)
+		if !found || !ok || !amount.IsPositive() {
+			return nil, errorsmod.Wrapf(types.ErrValidatorNotFound, "%s has no stake on the root network", validator)
+		}
+		if limit := len(k.GetAllSubnetNetuids(ctx)); len(msg.Weights) > limit {
+			return nil, errorsmod.Wrapf(types.ErrInvalidWeights, "%d weights exceed the %d subnets", len(msg.Weights), limit)
+		}
+	} else {
+		subnet, found := k.GetSubnet(ctx, netuid)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrSubnetNotFound, "netuid %d", netuid)
+		}
+		neuron, found := k.GetNeuronInfo(ctx, netuid, validator)
+		if !found || !neuron.IsActive || !neuron.IsValidator {
+			return nil, errorsmod.Wrapf(types.ErrValidatorNotFound, "%s has no validator permit on netuid %d", validator, netuid)
+		}
+		limit, err := strconv.ParseUint(subnet.Params[types.KeyMaxWeightsLimit], 10, 64)
+		if err == nil && limit > 0 && uint64(len(msg.Weights)) > limit {
+			return nil, errorsmod.Wrapf(types.ErrInvalidWeights, "%d weights exceed the limit of %d", len(msg.Weights), limit)
+		}
+	}
+
+	weights := make(map[string]uint64, len(msg.Weights))
+	for _, w := range msg.Weights {
+		weights[common.HexToAddress(w.Dest).Hex()] = w.Weight
+	}
+	k.SetValidatorWeight(ctx, netuid, validator, weights)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"weights_set",
+			sdk.NewAttribute("validator", validator),
+			sdk.NewAttribute("netuid", fmt.Sprintf("%d", netuid)),
+			sdk.NewAttribute("weights", fmt.Sprintf("%d", len(weights))),
+		),
+	)
+
+	return &types.MsgSetWeightsResponse{}, nil
+}
+
+// UpdateParams updates the event module parameters, the signer must be the
+// governance account.
+func (k msgServer) UpdateParams(
+	goCtx context.Context,
+	msg *types.MsgUpdateParams,
+) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, errorsmod.Wrapf(err, "error setting params")
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
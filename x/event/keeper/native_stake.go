@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+// Native stake is the bonded x/staking tokens of a validator, its own and
+// delegated, that count as its stake on the subnets it allocates them to.
+// Staking hooks mark the validators whose tokens changed, their native stake
+// is synced at the beginning of the next block. Slashing applies right away.
+
+// ---------------- Native stake allocations ----------------
+
+// Native stake allocations are keyed by validator first, so that all the
+// allocations of a validator are synced with a single prefix scan.
+func nativeStakeAllocationKey(validator string, netuid uint16) []byte {
+	return append(common.HexToAddress(validator).Bytes(), uint16ToBytes(netuid)...)
+}
+
+// SetNativeStakeAllocation stores the share of the bonded tokens of a
+// validator allocated to a subnet.
+func (k Keeper) SetNativeStakeAllocation(ctx sdk.Context, allocation types.NativeStakeAllocation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("native_stake_allocation:"))
+	bz, _ := json.Marshal(allocation)
+	store.Set(nativeStakeAllocationKey(allocation.Validator, allocation.Netuid), bz)
+}
+
+// GetNativeStakeAllocations returns the subnet allocations of a validator,
+// ordered by netuid.
+func (k Keeper) GetNativeStakeAllocations(ctx sdk.Context, validator string) []types.NativeStakeAllocation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("native_stake_allocation:"))
+	iterator := storetypes.KVStorePrefixIterator(store, common.HexToAddress(validator).Bytes())
+	defer iterator.Close()
+
+	var allocations []types.NativeStakeAllocation
+	for ; iterator.Valid(); iterator.Next() {
+		var allocation types.NativeStakeAllocation
+		if err := json.Unmarshal(iterator.Value(), &allocation); err != nil {
+			continue
+		}
+		allocations = append(allocations, allocation)
+	}
+	return allocations
+}
+
+// GetAllNativeStakeAllocations returns the subnet allocations of all validators.
+func (k Keeper) GetAllNativeStakeAllocations(ctx sdk.Context) []types.NativeStakeAllocation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("native_stake_allocation:"))
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var allocations []types.NativeStakeAllocation
+	for ; iterator.Valid(); iterator.Next() {
+		var allocation types.NativeStakeAllocation
+		if err := json.Unmarshal(iterator.Value(), &allocation); err != nil {
+			continue
+		}
+		allocations = append(allocations, allocation)
+	}
+	return allocations
+}
+
+// ReplaceNativeStakeAllocations replaces the subnet allocations of a validator
+// and resyncs its native stake. The native stake on subnets no longer
+// allocated to is removed.
+func (k Keeper) ReplaceNativeStakeAllocations(ctx sdk.Context, validator string, allocations []types.NativeStakeAllocation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("native_stake_allocation:"))
+	for _, allocation := range k.GetNativeStakeAllocations(ctx, validator) {
+		store.Delete(nativeStakeAllocationKey(validator, allocation.Netuid))
+		k.SetNativeStake(ctx, types.NativeStake{Validator: allocation.Validator, Netuid: allocation.Netuid, Amount: math.ZeroInt()})
+	}
+	for _, allocation := range allocations {
+		k.SetNativeStakeAllocation(ctx, allocation)
+	}
+	k.SyncNativeStake(ctx, sdk.ValAddress(common.HexToAddress(validator).Bytes()))
+}
+
+// ---------------- Native stakes ----------------
+
+// SetNativeStake stores the native stake of a validator on a subnet. A zero
+// amount removes the entry.
+func (k Keeper) SetNativeStake(ctx sdk.Context, stake types.NativeStake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("native_stake:"))
+	key := hotkeyKey(stake.Netuid, stake.Validator)
+	if !stake.Amount.IsPositive() {
+		store.Delete(key)
+		return
+	}
+	bz, _ := json.Marshal(stake)
+	store.Set(key, bz)
+}
+
+// GetNativeStake returns the native stake of a validator on a subnet.
+func (k Keeper) GetNativeStake(ctx sdk.Context, netuid uint16, validator string) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("native_stake:"))
+	bz := store.Get(hotkeyKey(netuid, validator))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+	var stake types.NativeStake
+	if err := json.Unmarshal(bz, &stake); err != nil {
+		return math.ZeroInt()
+	}
+	return stake.Amount
+}
+
+// GetNativeStakesByNetuid returns the native stakes on a subnet.
+func (k Keeper) GetNativeStakesByNetuid(ctx sdk.Context, netuid uint16) []types.NativeStake {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("native_stake:"))
+	iterator := storetypes.KVStorePrefixIterator(store, uint16ToBytes(netuid))
+	defer iterator.Close()
+
+	var stakes []types.NativeStake
+	for ; iterator.Valid(); iterator.Next() {
+		var stake types.NativeStake
+		if err := json.Unmarshal(iterator.Value(), &stake); err != nil {
+			continue
+		}
+		stakes = append(stakes, stake)
+	}
+	return stakes
+}
+
+// GetAllNativeStakes returns the native stakes on all subnets.
+func (k Keeper) GetAllNativeStakes(ctx sdk.Context) []types.NativeStake {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("native_stake:"))
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var stakes []types.NativeStake
+	for ; iterator.Valid(); iterator.Next() {
+		var stake types.NativeStake
+		if err := json.Unmarshal(iterator.Value(), &stake); err != nil {
+			continue
+		}
+		stakes = append(stakes, stake)
+	}
+	return stakes
+}
+
+// ---------------- Sync ----------------
+
+// BondedTokens returns the tokens bonded to a validator on x/staking, zero
+// when it is not bonded.
+func (k Keeper) BondedTokens(ctx sdk.Context, valAddr sdk.ValAddress) math.Int {
+	if k.stakingKeeper == nil {
+		return math.ZeroInt()
+	}
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil || !validator.IsBonded() {
+		return math.ZeroInt()
+	}
+	return validator.GetTokens()
+}
+
+// SyncNativeStake sets the native stake of a validator on each subnet it
+// allocates its bonded tokens to.
+func (k Keeper) SyncNativeStake(ctx sdk.Context, valAddr sdk.ValAddress) {
+	validator := common.BytesToAddress(valAddr).Hex()
+	allocations := k.GetNativeStakeAllocations(ctx, validator)
+	if len(allocations) == 0 {
+		return
+	}
+
+	tokens := k.BondedTokens(ctx, valAddr)
+	for _, allocation := range allocations {
+		k.SetNativeStake(ctx, types.NativeStake{
+			Validator: validator,
+			Netuid:    allocation.Netuid,
+			Amount:    allocation.Proportion.MulInt(tokens).TruncateInt(),
+		})
+	}
+}
+
+// markNativeStakeDirty queues the native stake of a validator for a sync at
+// the beginning of the next block. Validators without allocations are skipped.
+func (k Keeper) markNativeStakeDirty(ctx sdk.Context, valAddr sdk.ValAddress) {
+	if len(k.GetNativeStakeAllocations(ctx, common.BytesToAddress(valAddr).Hex())) == 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("native_stake_dirty:"))
+	store.Set(valAddr, []byte{1})
+}
+
+// SyncDirtyNativeStakes syncs the native stake of the validators whose bonded
+// tokens changed since the last block.
+func (k Keeper) SyncDirtyNativeStakes(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("native_stake_dirty:"))
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	var dirty []sdk.ValAddress
+	for ; iterator.Valid(); iterator.Next() {
+		dirty = append(dirty, sdk.ValAddress(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, valAddr := range dirty {
+		k.SyncNativeStake(ctx, valAddr)
+		store.Delete(valAddr)
+	}
+}
+
+// slashNativeStake reduces the native stake of a validator on each subnet by
+// the fraction of its bonded tokens slashed on x/staking.
+func (k Keeper) slashNativeStake(ctx sdk.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) {
+	validator := common.BytesToAddress(valAddr).Hex()
+	for _, allocation := range k.GetNativeStakeAllocations(ctx, validator) {
+		stake := k.GetNativeStake(ctx, allocation.Netuid, validator)
+		slashed := fraction.MulInt(stake).TruncateInt()
+		if !slashed.IsPositive() {
+			continue
+		}
+		k.SetNativeStake(ctx, types.NativeStake{Validator: validator, Netuid: allocation.Netuid, Amount: stake.Sub(slashed)})
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"native_stake_slashed",
+				sdk.NewAttribute("validator", validator),
+				sdk.NewAttribute("netuid", fmt.Sprintf("%d", allocation.Netuid)),
+				sdk.NewAttribute("fraction", fraction.String()),
+				sdk.NewAttribute("amount", slashed.String()),
+			),
+		)
+	}
+}
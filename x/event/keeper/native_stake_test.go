@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/x/event/keeper"
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+var operatorKey = common.HexToAddress("0x00000000000000000000000000000000000000b1")
+
+// mockStakingKeeper serves the x/staking validators by operator address.
+type mockStakingKeeper struct {
+	validators map[string]stakingtypes.Validator
+}
+
+func (m *mockStakingKeeper) GetValidator(_ context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	validator, found := m.validators[addr.String()]
+	if !found {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return validator, nil
+}
+
+func (m *mockStakingKeeper) setTokens(valAddr sdk.ValAddress, tokens int64) {
+	m.validators[valAddr.String()] = stakingtypes.Validator{
+		OperatorAddress: valAddr.String(),
+		Status:          stakingtypes.Bonded,
+		Tokens:          math.NewInt(tokens),
+	}
+}
+
+func hasEvent(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// setupNativeStake returns a keeper with subnets 1 and 2 and a bonded
+// validator with 1000 tokens, whose operator stakes 100 on subnet 1 in EVM.
+func setupNativeStake(t *testing.T) (*keeper.Keeper, *mockStakingKeeper, types.MsgServer, sdk.Context) {
+	k, ctx, _ := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	stakingKeeper := &mockStakingKeeper{validators: make(map[string]stakingtypes.Validator)}
+	stakingKeeper.setTokens(sdk.ValAddress(operatorKey.Bytes()), 1000)
+	k.SetStakingKeeper(stakingKeeper)
+
+	k.SetSubnet(ctx, types.Subnet{Netuid: 1, Owner: operatorKey.Hex()})
+	k.SetSubnet(ctx, types.Subnet{Netuid: 2, Owner: operatorKey.Hex()})
+	k.SetValidatorStake(ctx, types.ValidatorStake{Netuid: 1, Validator: operatorKey.Hex(), Amount: "100"})
+	return k, stakingKeeper, keeper.NewMsgServerImpl(*k), ctx
+}
+
+func TestSetNativeStakeAllocation(t *testing.T) {
+	k, _, msgServer, ctx := setupNativeStake(t)
+	allocations := []types.NativeSubnetAllocation{
+		{Netuid: 1, Proportion: math.LegacyNewDecWithPrec(5, 1)},
+		{Netuid: 2, Proportion: math.LegacyNewDecWithPrec(25, 2)},
+	}
+
+	// native stake is disabled by default
+	_, err := msgServer.SetNativeStakeAllocation(ctx, types.NewMsgSetNativeStakeAllocation(operatorKey.Bytes(), allocations))
+	require.ErrorIs(t, err, types.ErrNativeStakeDisabled)
+
+	params := k.GetParams(ctx)
+	params.NativeStakeEnabled = true
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err = msgServer.SetNativeStakeAllocation(ctx, types.NewMsgSetNativeStakeAllocation(parentKey.Bytes(), allocations))
+	require.ErrorIs(t, err, types.ErrValidatorNotFound)
+	_, err = msgServer.SetNativeStakeAllocation(ctx, types.NewMsgSetNativeStakeAllocation(operatorKey.Bytes(), []types.NativeSubnetAllocation{
+		{Netuid: 3, Proportion: math.LegacyNewDecWithPrec(5, 1)},
+	}))
+	require.ErrorIs(t, err, types.ErrSubnetNotFound)
+
+	_, err = msgServer.SetNativeStakeAllocation(ctx, types.NewMsgSetNativeStakeAllocation(operatorKey.Bytes(), allocations))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(500), k.GetNativeStake(ctx, 1, operatorKey.Hex()))
+	require.Equal(t, math.NewInt(250), k.GetNativeStake(ctx, 2, operatorKey.Hex()))
+
+	// the native stake adds to the EVM stake
+	require.Equal(t, math.NewInt(600), k.GetEffectiveStake(ctx, 1, operatorKey.Hex()))
+	require.Equal(t, math.NewInt(250), k.GetEffectiveStake(ctx, 2, operatorKey.Hex()))
+
+	// revoking an allocation removes the native stake on that subnet
+	_, err = msgServer.SetNativeStakeAllocation(ctx, types.NewMsgSetNativeStakeAllocation(operatorKey.Bytes(), allocations[1:]))
+	require.NoError(t, err)
+	require.True(t, k.GetNativeStake(ctx, 1, operatorKey.Hex()).IsZero())
+	require.Equal(t, math.NewInt(100), k.GetEffectiveStake(ctx, 1, operatorKey.Hex()))
+	require.Len(t, k.GetNativeStakeAllocations(ctx, operatorKey.Hex()), 1)
+
+	// disabling native stake leaves only the EVM stake
+	params.NativeStakeEnabled = false
+	require.NoError(t, k.SetParams(ctx, params))
+	require.True(t, k.GetEffectiveStake(ctx, 2, operatorKey.Hex()).IsZero())
+}
+
+func TestNativeStakeHooks(t *testing.T) {
+	k, stakingKeeper, msgServer, ctx := setupNativeStake(t)
+	params := k.GetParams(ctx)
+	params.NativeStakeEnabled = true
+	require.NoError(t, k.SetParams(ctx, params))
+	valAddr := sdk.ValAddress(operatorKey.Bytes())
+	hooks := k.Hooks()
+
+	_, err := msgServer.SetNativeStakeAllocation(ctx, types.NewMsgSetNativeStakeAllocation(operatorKey.Bytes(), []types.NativeSubnetAllocation{
+		{Netuid: 1, Proportion: math.LegacyNewDecWithPrec(5, 1)},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(500), k.GetNativeStake(ctx, 1, operatorKey.Hex()))
+
+	// new delegations are synced at the beginning of the next block
+	stakingKeeper.setTokens(valAddr, 2000)
+	require.NoError(t, hooks.AfterDelegationModified(ctx, parentKey.Bytes(), valAddr))
+	require.Equal(t, math.NewInt(500), k.GetNativeStake(ctx, 1, operatorKey.Hex()))
+	k.SyncDirtyNativeStakes(ctx)
+	require.Equal(t, math.NewInt(1000), k.GetNativeStake(ctx, 1, operatorKey.Hex()))
+
+	// slashing reduces the native stake right away
+	require.NoError(t, hooks.BeforeValidatorSlashed(ctx, valAddr, math.LegacyNewDecWithPrec(1, 1)))
+	require.Equal(t, math.NewInt(900), k.GetNativeStake(ctx, 1, operatorKey.Hex()))
+	require.True(t, hasEvent(ctx.EventManager().Events(), "native_stake_slashed"))
+
+	// unbonded validators have no native stake
+	validator := stakingKeeper.validators[valAddr.String()]
+	validator.Status = stakingtypes.Unbonding
+	stakingKeeper.validators[valAddr.String()] = validator
+	require.NoError(t, hooks.AfterValidatorBeginUnbonding(ctx, nil, valAddr))
+	k.SyncDirtyNativeStakes(ctx)
+	require.True(t, k.GetNativeStake(ctx, 1, operatorKey.Hex()).IsZero())
+	require.Empty(t, k.GetAllNativeStakes(ctx))
+}
+
+func TestNativeStakeAllocationValidation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		allocations []types.NativeSubnetAllocation
+	}{
+		{"zero netuid", []types.NativeSubnetAllocation{{Netuid: 0, Proportion: math.LegacyNewDecWithPrec(5, 1)}}},
+		{"duplicate netuid", []types.NativeSubnetAllocation{
+			{Netuid: 1, Proportion: math.LegacyNewDecWithPrec(2, 1)},
+			{Netuid: 1, Proportion: math.LegacyNewDecWithPrec(2, 1)},
+		}},
+		{"zero proportion", []types.NativeSubnetAllocation{{Netuid: 1, Proportion: math.LegacyZeroDec()}}},
+		{"proportions above one", []types.NativeSubnetAllocation{
+			{Netuid: 1, Proportion: math.LegacyNewDecWithPrec(6, 1)},
+			{Netuid: 2, Proportion: math.LegacyNewDecWithPrec(5, 1)},
+		}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ErrorIs(t, types.NewMsgSetNativeStakeAllocation(operatorKey.Bytes(), tc.allocations).ValidateBasic(), types.ErrInvalidNativeStakeAllocation)
+		})
+	}
+	require.NoError(t, types.NewMsgSetNativeStakeAllocation(operatorKey.Bytes(), nil).ValidateBasic())
+}
@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/x/event/keeper"
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	params := types.DefaultParams()
+	params.PriceHistoryRetention = 500
+	params.MaxChildKeyTake = math.LegacyNewDecWithPrec(1, 1)
+
+	// only the gov authority can update the params
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(authtypes.NewModuleAddress("other").String(), params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+
+	// invalid params are rejected and the current params kept
+	invalid := params
+	invalid.PriceHistoryRetention = types.MaxPriceHistoryRetention + 1
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(authority, invalid))
+	require.Error(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+}
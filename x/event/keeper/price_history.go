@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+// ---------------- Price history ----------------
+
+func priceSnapshotKey(netuid uint16, height int64) []byte {
+	key := make([]byte, 10)
+	binary.BigEndian.PutUint16(key, netuid)
+	binary.BigEndian.PutUint64(key[2:], uint64(height))
+	return key
+}
+
+// RecordPriceSnapshot stores the current price and emission state of a subnet
+// in its price history, given the pending emission and owner cut drained at
+// the tempo. The history is a ring buffer of PriceHistoryRetention snapshots.
+func (k Keeper) RecordPriceSnapshot(ctx sdk.Context, netuid uint16, pendingEmission, ownerCut math.Int) {
+	retention := k.GetParams(ctx).PriceHistoryRetention
+	if retention == 0 {
+		return
+	}
+
+	snapshot := types.PriceSnapshot{
+		Netuid:          netuid,
+		Height:          ctx.BlockHeight(),
+		Price:           k.GetAlphaPrice(ctx, netuid),
+		MovingPrice:     k.GetMovingAlphaPrice(ctx, netuid),
+		TaoIn:           k.GetSubnetTaoIn(ctx, netuid),
+		AlphaIn:         k.GetSubnetAlphaIn(ctx, netuid),
+		AlphaOut:        k.GetSubnetAlphaOut(ctx, netuid),
+		PendingEmission: pendingEmission,
+		OwnerCut:        ownerCut,
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("price_history:"))
+	key := priceSnapshotKey(netuid, snapshot.Height)
+	count := k.getPriceHistoryCount(ctx, netuid)
+	if !store.Has(key) {
+		count++
+	}
+	bz, _ := json.Marshal(snapshot)
+	store.Set(key, bz)
+
+	// Prune the oldest snapshots, the retention may have been lowered since
+	// the last snapshot so more than one can be removed.
+	if count > retention {
+		iterator := storetypes.KVStorePrefixIterator(store, uint16ToBytes(netuid))
+		var pruned [][]byte
+		for ; iterator.Valid() && count > retention; iterator.Next() {
+			pruned = append(pruned, append([]byte{}, iterator.Key()...))
+			count--
+		}
+		iterator.Close()
+		for _, key := range pruned {
+			store.Delete(key)
+		}
+	}
+	k.setPriceHistoryCount(ctx, netuid, count)
+}
+
+// GetPriceHistory returns at most limit snapshots of a subnet with a height in
+// [startHeight, endHeight], ordered by height. A zero endHeight or limit means
+// no bound. The returned height is the height to start the next page from, or
+// zero when there are no more snapshots.
+func (k Keeper) GetPriceHistory(ctx sdk.Context, netuid uint16, startHeight, endHeight int64, limit uint64) ([]types.PriceSnapshot, int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("price_history:"))
+	end := priceSnapshotKey(netuid+1, 0)
+	if netuid == ^uint16(0) {
+		end = nil
+	}
+	if endHeight > 0 {
+		end = priceSnapshotKey(netuid, endHeight+1)
+	}
+	iterator := store.Iterator(priceSnapshotKey(netuid, startHeight), end)
+	defer iterator.Close()
+
+	var snapshots []types.PriceSnapshot
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.PriceSnapshot
+		if err := json.Unmarshal(iterator.Value(), &snapshot); err != nil {
+			continue
+		}
+		if limit > 0 && uint64(len(snapshots)) == limit {
+			return snapshots, snapshot.Height
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, 0
+}
+
+func (k Keeper) getPriceHistoryCount(ctx sdk.Context, netuid uint16) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("price_history_count:"))
+	bz := store.Get(uint16ToBytes(netuid))
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setPriceHistoryCount(ctx sdk.Context, netuid uint16, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("price_history_count:"))
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(uint16ToBytes(netuid), bz)
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	eventtypes "github.com/hetu-project/hetu/v1/hetu/event/v1"
+	"github.com/hetu-project/hetu/v1/x/event/keeper"
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+func TestPriceHistory(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+	params := types.DefaultParams()
+	params.PriceHistoryRetention = 3
+	require.NoError(t, k.SetParams(ctx, params))
+
+	k.SetSubnet(ctx, types.Subnet{Netuid: 1, Mechanism: 1})
+	k.SetSubnet(ctx, types.Subnet{Netuid: 2, Mechanism: 1})
+	k.SetSubnetTaoIn(ctx, 1, math.NewInt(2000))
+	k.SetSubnetAlphaIn(ctx, 1, math.NewInt(1000))
+
+	// one snapshot per tempo, the oldest are pruned past the retention
+	for height := int64(10); height <= 50; height += 10 {
+		ctx = ctx.WithBlockHeight(height)
+		k.RecordPriceSnapshot(ctx, 1, math.NewInt(height), math.NewInt(1))
+		k.RecordPriceSnapshot(ctx, 2, math.NewInt(height), math.ZeroInt())
+	}
+
+	snapshots, next := k.GetPriceHistory(ctx, 1, 0, 0, 0)
+	require.Len(t, snapshots, 3)
+	require.Zero(t, next)
+	require.Equal(t, int64(30), snapshots[0].Height)
+	require.Equal(t, int64(50), snapshots[2].Height)
+	require.Equal(t, math.LegacyNewDec(2), snapshots[2].Price)
+	require.Equal(t, math.NewInt(2000), snapshots[2].TaoIn)
+	require.Equal(t, math.NewInt(50), snapshots[2].PendingEmission)
+	require.Equal(t, math.NewInt(1), snapshots[2].OwnerCut)
+
+	// lowering the retention prunes on the next snapshot
+	params.PriceHistoryRetention = 1
+	require.NoError(t, k.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(60)
+	k.RecordPriceSnapshot(ctx, 2, math.ZeroInt(), math.ZeroInt())
+	snapshots, _ = k.GetPriceHistory(ctx, 2, 0, 0, 0)
+	require.Len(t, snapshots, 1)
+	require.Equal(t, int64(60), snapshots[0].Height)
+
+	// height ranges and pages
+	queryServer := keeper.NewQueryServer(*k)
+	res, err := queryServer.PriceHistory(ctx, &eventtypes.QueryPriceHistoryRequest{Netuid: 1, StartHeight: 35, EndHeight: 50, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, res.Snapshots, 1)
+	require.Equal(t, int64(40), res.Snapshots[0].Height)
+	require.Equal(t, int64(50), res.NextHeight)
+
+	res, err = queryServer.PriceHistory(ctx, &eventtypes.QueryPriceHistoryRequest{Netuid: 1, StartHeight: res.NextHeight, EndHeight: 50, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, res.Snapshots, 1)
+	require.Equal(t, "2.000000000000000000", res.Snapshots[0].Price)
+	require.Zero(t, res.NextHeight)
+
+	_, err = queryServer.PriceHistory(ctx, &eventtypes.QueryPriceHistoryRequest{Netuid: 1, StartHeight: 50, EndHeight: 40})
+	require.Error(t, err)
+
+	// a zero retention disables the history
+	params.PriceHistoryRetention = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(70)
+	k.RecordPriceSnapshot(ctx, 1, math.ZeroInt(), math.ZeroInt())
+	snapshots, _ = k.GetPriceHistory(ctx, 1, 0, 0, 0)
+	require.Len(t, snapshots, 3)
+}
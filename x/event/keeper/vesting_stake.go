@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+// Vesting stake is the locked tokens of vesting accounts that the vesting
+// module escrows on their behalf, since they cannot be spent on the
+// GlobalStaking contract. The vesting module keeps the escrow and the stake in
+// sync, the stake counts as the own stake of the account on the subnet.
+
+// SetVestingStake stores the vesting stake of an account on a subnet and
+// maintains the account to subnet index. A zero amount removes the entry.
+func (k Keeper) SetVestingStake(ctx sdk.Context, stake types.VestingStake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("vesting_stake:"))
+	accountStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("account_vesting_stake:"))
+	key := hotkeyKey(stake.Netuid, stake.Account)
+	accountKey := append(common.HexToAddress(stake.Account).Bytes(), uint16ToBytes(stake.Netuid)...)
+	if !stake.Amount.IsPositive() {
+		store.Delete(key)
+		accountStore.Delete(accountKey)
+		return
+	}
+	stake.Account = common.HexToAddress(stake.Account).Hex()
+	bz, _ := json.Marshal(stake)
+	store.Set(key, bz)
+	accountStore.Set(accountKey, []byte{})
+}
+
+// GetVestingStake returns the vesting stake of an account on a subnet.
+func (k Keeper) GetVestingStake(ctx sdk.Context, netuid uint16, account string) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("vesting_stake:"))
+	bz := store.Get(hotkeyKey(netuid, account))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+	var stake types.VestingStake
+	if err := json.Unmarshal(bz, &stake); err != nil {
+		return math.ZeroInt()
+	}
+	return stake.Amount
+}
+
+// GetVestingStakesByNetuid returns the vesting stakes on a subnet.
+func (k Keeper) GetVestingStakesByNetuid(ctx sdk.Context, netuid uint16) []types.VestingStake {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("vesting_stake:"))
+	iterator := storetypes.KVStorePrefixIterator(store, uint16ToBytes(netuid))
+	defer iterator.Close()
+
+	var stakes []types.VestingStake
+	for ; iterator.Valid(); iterator.Next() {
+		var stake types.VestingStake
+		if err := json.Unmarshal(iterator.Value(), &stake); err != nil {
+			continue
+		}
+		stakes = append(stakes, stake)
+	}
+	return stakes
+}
+
+// GetVestingStakesByAccount returns the vesting stakes of an account, ordered
+// by netuid, through the account to subnet index.
+func (k Keeper) GetVestingStakesByAccount(ctx sdk.Context, account string) []types.VestingStake {
+	accountStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("account_vesting_stake:"))
+	iterator := storetypes.KVStorePrefixIterator(accountStore, common.HexToAddress(account).Bytes())
+	defer iterator.Close()
+
+	account = common.HexToAddress(account).Hex()
+	var stakes []types.VestingStake
+	for ; iterator.Valid(); iterator.Next() {
+		netuid := binary.BigEndian.Uint16(iterator.Key()[common.AddressLength:])
+		stakes = append(stakes, types.VestingStake{
+			Account: account,
+			Netuid:  netuid,
+			Amount:  k.GetVestingStake(ctx, netuid, account),
+		})
+	}
+	return stakes
+}
+
+// GetAllVestingStakes returns the vesting stakes on all subnets.
+func (k Keeper) GetAllVestingStakes(ctx sdk.Context) []types.VestingStake {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte("vesting_stake:"))
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var stakes []types.VestingStake
+	for ; iterator.Valid(); iterator.Next() {
+		var stake types.VestingStake
+		if err := json.Unmarshal(iterator.Value(), &stake); err != nil {
+			continue
+		}
+		stakes = append(stakes, stake)
+	}
+	return stakes
+}
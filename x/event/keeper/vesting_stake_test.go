@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+func TestVestingStake(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	k.SetValidatorStake(ctx, types.ValidatorStake{Netuid: 1, Validator: operatorKey.Hex(), Amount: "100"})
+
+	// accounts are stored checksummed whatever the case they are set with
+	k.SetVestingStake(ctx, types.VestingStake{Account: strings.ToLower(operatorKey.Hex()), Netuid: 1, Amount: math.NewInt(300)})
+	k.SetVestingStake(ctx, types.VestingStake{Account: operatorKey.Hex(), Netuid: 2, Amount: math.NewInt(50)})
+	k.SetVestingStake(ctx, types.VestingStake{Account: parentKey.Hex(), Netuid: 1, Amount: math.NewInt(20)})
+
+	require.Equal(t, math.NewInt(300), k.GetVestingStake(ctx, 1, operatorKey.Hex()))
+	require.Len(t, k.GetVestingStakesByNetuid(ctx, 1), 2)
+	stakes := k.GetVestingStakesByAccount(ctx, operatorKey.Hex())
+	require.Len(t, stakes, 2)
+	require.Equal(t, operatorKey.Hex(), stakes[0].Account)
+	require.Equal(t, uint16(1), stakes[0].Netuid)
+	require.Equal(t, uint16(2), stakes[1].Netuid)
+
+	// the vesting stake adds to the EVM stake, whether native stake is enabled or not
+	require.Equal(t, math.NewInt(400), k.GetEffectiveStake(ctx, 1, operatorKey.Hex()))
+	require.Equal(t, math.NewInt(20), k.GetEffectiveStake(ctx, 1, parentKey.Hex()))
+
+	// a zero amount removes the stake
+	k.SetVestingStake(ctx, types.VestingStake{Account: operatorKey.Hex(), Netuid: 1, Amount: math.ZeroInt()})
+	require.True(t, k.GetVestingStake(ctx, 1, operatorKey.Hex()).IsZero())
+	require.Equal(t, math.NewInt(100), k.GetEffectiveStake(ctx, 1, operatorKey.Hex()))
+	require.Len(t, k.GetAllVestingStakes(ctx), 2)
+	stakes = k.GetVestingStakesByAccount(ctx, operatorKey.Hex())
+	require.Len(t, stakes, 1)
+	require.Equal(t, uint16(2), stakes[0].Netuid)
+	require.Equal(t, math.NewInt(50), stakes[0].Amount)
+}
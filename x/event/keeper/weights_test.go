@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+func TestSetWeights(t *testing.T) {
+	k, msgServer, ctx := setupChildKeys(t)
+	k.SetSubnet(ctx, types.Subnet{Netuid: 2, Params: map[string]string{types.KeyMaxWeightsLimit: "1"}})
+	for _, netuid := range []uint16{1, 2} {
+		k.SetNeuronInfo(ctx, types.NeuronInfo{Account: parentKey.Hex(), Netuid: netuid, IsActive: true, IsValidator: true})
+	}
+	k.SetNeuronInfo(ctx, types.NeuronInfo{Account: childKey.Hex(), Netuid: 1, IsActive: true})
+
+	weights := []types.DestWeight{
+		{Dest: childKey.Hex(), Weight: 30},
+		{Dest: otherKey.Hex(), Weight: 10},
+	}
+	msg := types.NewMsgSetWeights(parentKey.Bytes(), 1, weights)
+	require.NoError(t, msg.ValidateBasic())
+	require.Error(t, types.NewMsgSetWeights(parentKey.Bytes(), 1, append(weights, weights[0])).ValidateBasic())
+
+	_, err := msgServer.SetWeights(ctx, types.NewMsgSetWeights(parentKey.Bytes(), 3, weights))
+	require.ErrorIs(t, err, types.ErrSubnetNotFound)
+	_, err = msgServer.SetWeights(ctx, types.NewMsgSetWeights(parentKey.Bytes(), 2, weights))
+	require.ErrorIs(t, err, types.ErrInvalidWeights)
+
+	// only active validator neurons of the subnet can set weights
+	_, err = msgServer.SetWeights(ctx, types.NewMsgSetWeights(childKey.Bytes(), 1, weights))
+	require.ErrorIs(t, err, types.ErrValidatorNotFound)
+	_, err = msgServer.SetWeights(ctx, types.NewMsgSetWeights(otherKey.Bytes(), 1, weights))
+	require.ErrorIs(t, err, types.ErrValidatorNotFound)
+
+	_, err = msgServer.SetWeights(ctx, msg)
+	require.NoError(t, err)
+	weight, found := k.GetValidatorWeight(ctx, 1, parentKey.Hex())
+	require.True(t, found)
+	require.Equal(t, map[string]uint64{childKey.Hex(): 30, otherKey.Hex(): 10}, weight.Weights)
+
+	// the weights replace the previous ones
+	_, err = msgServer.SetWeights(ctx, types.NewMsgSetWeights(parentKey.Bytes(), 1, weights[1:]))
+	require.NoError(t, err)
+	weight, _ = k.GetValidatorWeight(ctx, 1, parentKey.Hex())
+	require.Equal(t, map[string]uint64{otherKey.Hex(): 10}, weight.Weights)
+}
+
+func TestSetRootWeights(t *testing.T) {
+	k, msgServer, ctx := setupChildKeys(t)
+	k.SetSubnet(ctx, types.Subnet{Netuid: 2})
+	weights := []types.DestWeight{
+		{Dest: childKey.Hex(), Weight: 30},
+		{Dest: otherKey.Hex(), Weight: 10},
+	}
+
+	// only validators with root stake can set root weights
+	_, err := msgServer.SetWeights(ctx, types.NewMsgSetWeights(parentKey.Bytes(), 0, weights))
+	require.ErrorIs(t, err, types.ErrValidatorNotFound)
+	k.SetValidatorStake(ctx, types.ValidatorStake{Netuid: 0, Validator: parentKey.Hex(), Amount: "1000"})
+
+	// root validators weight each subnet at most once
+	_, err = msgServer.SetWeights(ctx, types.NewMsgSetWeights(parentKey.Bytes(), 0, append(weights, types.DestWeight{Dest: parentKey.Hex(), Weight: 1})))
+	require.ErrorIs(t, err, types.ErrInvalidWeights)
+
+	_, err = msgServer.SetWeights(ctx, types.NewMsgSetWeights(parentKey.Bytes(), 0, weights))
+	require.NoError(t, err)
+	weight, found := k.GetValidatorWeight(ctx, 0, parentKey.Hex())
+	require.True(t, found)
+	require.Len(t, weight.Weights, 2)
+}
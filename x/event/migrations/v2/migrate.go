@@ -0,0 +1,58 @@
+package v2
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+var (
+	// SubnetKeyPrefix is the prefix of the subnets in the store
+	SubnetKeyPrefix = []byte("subnet:")
+	// AmmPoolKeyPrefix is the prefix of the AMM pool address to subnet index
+	AmmPoolKeyPrefix = []byte("amm_pool:")
+)
+
+// MigrateStore migrates the x/event module state from the consensus version 1
+// to version 2. Specifically, it stores the default module params, which can
+// be updated by governance and did not exist in version 1, and indexes the AMM
+// pool of every subnet, so that the AMM events are attributed with the index
+// only.
+func MigrateStore(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	params := types.DefaultParams()
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	subnets := prefix.NewStore(store, SubnetKeyPrefix)
+	pools := prefix.NewStore(store, AmmPoolKeyPrefix)
+
+	iterator := subnets.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var subnet types.Subnet
+		if err := json.Unmarshal(iterator.Value(), &subnet); err != nil {
+			return err
+		}
+		if !common.IsHexAddress(subnet.AmmPool) {
+			continue
+		}
+
+		netuid := make([]byte, 2)
+		binary.BigEndian.PutUint16(netuid, subnet.Netuid)
+		pools.Set(common.HexToAddress(subnet.AmmPool).Bytes(), netuid)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/hetu-project/hetu/v1/x/event/migrations/v2"
+	"github.com/hetu-project/hetu/v1/x/event/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	pool := common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	for _, subnet := range []types.Subnet{
+		{Netuid: 1, AmmPool: pool.Hex()},
+		{Netuid: 2}, // no pool deployed yet
+	} {
+		bz, err := json.Marshal(subnet)
+		require.NoError(t, err)
+		store.Set(append(append([]byte{}, v2.SubnetKeyPrefix...), byte(subnet.Netuid>>8), byte(subnet.Netuid)), bz)
+	}
+
+	require.NoError(t, v2.MigrateStore(store, cdc))
+
+	var params types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &params))
+	require.Equal(t, types.DefaultParams(), params)
+
+	require.Equal(t, []byte{0, 1}, store.Get(append(append([]byte{}, v2.AmmPoolKeyPrefix...), pool.Bytes()...)))
+
+	var indexed int
+	iterator := storetypes.KVStorePrefixIterator(store, v2.AmmPoolKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		indexed++
+	}
+	iterator.Close()
+	require.Equal(t, 1, indexed)
+}
@@ -52,7 +52,9 @@ func (AppModuleBasic) Name() string {
 func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	types.RegisterLegacyAminoCodec(cdc)
 }
-func (AppModuleBasic) RegisterInterfaces(_ cdctypes.InterfaceRegistry) {}
+func (AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+}
 
 func (AppModuleBasic) DefaultGenesis(_ codec.JSONCodec) json.RawMessage {
 	bz, err := json.Marshal(types.DefaultGenesisState())
@@ -80,7 +82,9 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 	}
 }
 
-func (AppModuleBasic) GetTxCmd() *cobra.Command { return nil }
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.NewTxCmd()
+}
 
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
@@ -112,8 +116,14 @@ func (am AppModule) QuerierRoute() string {
 }
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	// Register gRPC Query Service
+	// Register gRPC Msg and Query Services
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Errorf("failed to migrate %s to v2: %w", types.ModuleName, err))
+	}
 }
 
 func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
@@ -124,6 +134,10 @@ func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, gs json.RawM
 		panic(err)
 	}
 
+	if err := am.keeper.SetParams(ctx, genState.Params); err != nil {
+		panic(fmt.Errorf("event: invalid params: %w", err))
+	}
+
 	// Initialize subnet data
 	for _, subnet := range genState.Subnets {
 		am.keeper.SetSubnet(ctx, subnet)
@@ -144,6 +158,46 @@ func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, gs json.RawM
 		am.keeper.SetValidatorWeight(ctx, weight.Netuid, weight.Validator, weight.Weights)
 	}
 
+	// Initialize global stake data, the treasury totals are rebuilt from the allocations
+	for _, stake := range genState.GlobalStakes {
+		am.keeper.SetGlobalStake(ctx, stake)
+	}
+	for _, alloc := range genState.SubnetAllocations {
+		am.keeper.SetSubnetAllocation(ctx, alloc)
+		cost := types.AddBigIntString(am.keeper.GetSubnetRegistrationCost(ctx, alloc.Netuid), alloc.RegistrationCost)
+		am.keeper.SetSubnetRegistrationCost(ctx, alloc.Netuid, cost)
+	}
+
+	// Initialize child key data
+	for _, childKeys := range genState.ChildKeys {
+		am.keeper.SetChildKeys(ctx, childKeys)
+	}
+	for _, pending := range genState.PendingChildKeys {
+		am.keeper.SetPendingChildKeys(ctx, pending)
+	}
+	for _, take := range genState.ChildKeyTakes {
+		am.keeper.SetChildKeyTake(ctx, take)
+	}
+
+	// Initialize delegator dividend data
+	for _, take := range genState.ValidatorTakes {
+		am.keeper.SetValidatorTake(ctx, take)
+	}
+	for _, dividend := range genState.ClaimableDividends {
+		am.keeper.SetClaimableDividend(ctx, dividend)
+	}
+
+	// Initialize native stake data
+	for _, allocation := range genState.NativeStakeAllocations {
+		am.keeper.SetNativeStakeAllocation(ctx, allocation)
+	}
+	for _, stake := range genState.NativeStakes {
+		am.keeper.SetNativeStake(ctx, stake)
+	}
+	for _, stake := range genState.VestingStakes {
+		am.keeper.SetVestingStake(ctx, stake)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -174,7 +228,23 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMe
 		}
 	}
 
-	genState := types.NewGenesisState(subnets, validatorStakes, delegations, validatorWeights)
+	genState := types.NewGenesisState(
+		am.keeper.GetParams(ctx),
+		subnets,
+		validatorStakes,
+		delegations,
+		validatorWeights,
+		am.keeper.GetAllGlobalStakes(ctx),
+		am.keeper.GetAllSubnetAllocations(ctx),
+		am.keeper.GetAllChildKeys(ctx),
+		am.keeper.GetAllPendingChildKeys(ctx),
+		am.keeper.GetAllChildKeyTakes(ctx),
+		am.keeper.GetAllValidatorTakes(ctx),
+		am.keeper.GetAllClaimableDividends(ctx),
+		am.keeper.GetAllNativeStakeAllocations(ctx),
+		am.keeper.GetAllNativeStakes(ctx),
+		am.keeper.GetAllVestingStakes(ctx),
+	)
 	bz, err := json.Marshal(genState)
 	if err != nil {
 		panic(err)
@@ -182,12 +252,20 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMe
 	return bz
 }
 
-func (am AppModule) BeginBlock(ctx context.Context) error          { return nil }
+// BeginBlock applies the child keys whose cooldown is over and syncs the
+// native stake of the validators whose bonded tokens changed
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	am.keeper.ApplyPendingChildKeys(sdkCtx)
+	am.keeper.SyncDirtyNativeStakes(sdkCtx)
+	return nil
+}
+
 func (am AppModule) EndBlock(_ sdk.Context) []abci.ValidatorUpdate { return []abci.ValidatorUpdate{} }
 
 func (AppModule) GenerateGenesisState(_ *module.SimulationState)               {}
 func (am AppModule) RegisterStoreDecoder(_ interface{})                        {}
 func (am AppModule) WeightedOperations(_ module.SimulationState) []interface{} { return nil }
-func (AppModule) ConsensusVersion() uint64                                     { return 1 }
+func (AppModule) ConsensusVersion() uint64                                     { return 2 }
 func (am AppModule) IsAppModule()                                              {}
 func (am AppModule) IsOnePerModuleType()                                       {}
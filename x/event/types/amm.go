@@ -0,0 +1,43 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// PriceCandle holds the OHLC price data of a subnet AMM pool for one block.
+// Prices are the HETU per alpha ratio of the pool reserves after each reserve
+// change, so Open is the price after the first change of the block.
+type PriceCandle struct {
+	Netuid uint16         `json:"netuid" yaml:"netuid"`
+	Height int64          `json:"height" yaml:"height"`
+	Open   math.LegacyDec `json:"open" yaml:"open"`
+	High   math.LegacyDec `json:"high" yaml:"high"`
+	Low    math.LegacyDec `json:"low" yaml:"low"`
+	Close  math.LegacyDec `json:"close" yaml:"close"`
+	Volume math.Int       `json:"volume" yaml:"volume"` // HETU volume of the swaps
+	Trades uint64         `json:"trades" yaml:"trades"` // number of swaps
+}
+
+// NewPriceCandle creates the candle of a block opened at the given price.
+func NewPriceCandle(netuid uint16, height int64, price math.LegacyDec) PriceCandle {
+	return PriceCandle{
+		Netuid: netuid,
+		Height: height,
+		Open:   price,
+		High:   price,
+		Low:    price,
+		Close:  price,
+		Volume: math.ZeroInt(),
+	}
+}
+
+// Update records a new price of the block.
+func (c *PriceCandle) Update(price math.LegacyDec) {
+	if price.GT(c.High) {
+		c.High = price
+	}
+	if price.LT(c.Low) {
+		c.Low = price
+	}
+	c.Close = price
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxChildKeys bounds the number of child hotkeys a parent can have on a subnet
+const MaxChildKeys = 5
+
+// ChildKeys represents the child hotkeys a parent lends its stake weight to on
+// a subnet. Addresses are hex encoded.
+type ChildKeys struct {
+	Parent          string     `json:"parent"`
+	Netuid          uint16     `json:"netuid"`
+	Children        []ChildKey `json:"children"`
+	LastUpdateBlock uint64     `json:"last_update_block"`
+}
+
+// PendingChildKeys represents child hotkeys waiting for the cooldown before
+// they replace the current children of a parent.
+type PendingChildKeys struct {
+	Parent        string     `json:"parent"`
+	Netuid        uint16     `json:"netuid"`
+	Children      []ChildKey `json:"children"`
+	CooldownBlock uint64     `json:"cooldown_block"`
+}
+
+// ChildKeyTake represents the take a child hotkey keeps from the dividends
+// earned with the stake weight of its parents on a subnet.
+type ChildKeyTake struct {
+	Child           string         `json:"child"`
+	Netuid          uint16         `json:"netuid"`
+	Take            math.LegacyDec `json:"take"`
+	LastUpdateBlock uint64         `json:"last_update_block"`
+}
+
+// ParentKey represents a parent lending a share of its stake weight to a
+// child hotkey on a subnet.
+type ParentKey struct {
+	Parent     string         `json:"parent"`
+	Proportion math.LegacyDec `json:"proportion"`
+}
+
+// StakeContribution represents the stake weight a parent lends to a child
+// hotkey on a subnet.
+type StakeContribution struct {
+	Parent string   `json:"parent"`
+	Amount math.Int `json:"amount"`
+}
+
+// ValidateChildKeys checks the children of a parent: valid and distinct hex
+// addresses other than the parent, proportions in (0, 1] summing up to at
+// most 1, and at most MaxChildKeys children.
+func ValidateChildKeys(parent string, children []ChildKey) error {
+	if len(children) > MaxChildKeys {
+		return fmt.Errorf("too many child keys: %d > %d", len(children), MaxChildKeys)
+	}
+
+	seen := make(map[string]bool, len(children))
+	total := math.LegacyZeroDec()
+	for _, child := range children {
+		if !common.IsHexAddress(child.Child) {
+			return fmt.Errorf("invalid child hex address %q", child.Child)
+		}
+		addr := common.HexToAddress(child.Child).Hex()
+		if addr == common.HexToAddress(parent).Hex() {
+			return fmt.Errorf("a hotkey cannot be its own child")
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate child %s", addr)
+		}
+		seen[addr] = true
+
+		if child.Proportion.IsNil() || !child.Proportion.IsPositive() || child.Proportion.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("child %s proportion must be in (0, 1], got %s", addr, child.Proportion)
+		}
+		total = total.Add(child.Proportion)
+	}
+	if total.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("child proportions sum up to %s, more than 1", total)
+	}
+	return nil
+}
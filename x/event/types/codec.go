@@ -2,8 +2,51 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
+var (
+	amino = codec.NewLegacyAmino()
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewAminoCodec(amino)
+)
+
+const (
+	// Amino names
+	setChildKeysName             = "hetu/event/MsgSetChildKeys"
+	setChildKeyTakeName          = "hetu/event/MsgSetChildKeyTake"
+	setValidatorTakeName         = "hetu/event/MsgSetValidatorTake"
+	claimDividendsName           = "hetu/event/MsgClaimDividends"
+	setNativeStakeAllocationName = "hetu/event/MsgSetNativeStakeAllocation"
+	setWeightsName               = "hetu/event/MsgSetWeights"
+	updateParamsName             = "hetu/event/MsgUpdateParams"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterInterfaces register implementations
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgSetChildKeys{},
+		&MsgSetChildKeyTake{},
+		&MsgSetValidatorTake{},
+		&MsgClaimDividends{},
+		&MsgSetNativeStakeAllocation{},
+		&MsgSetWeights{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
 // RegisterLegacyAminoCodec registers the necessary x/event interfaces and concrete types
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
@@ -13,4 +56,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(ValidatorStake{}, "hetu/event/ValidatorStake", nil)
 	cdc.RegisterConcrete(Delegation{}, "hetu/event/Delegation", nil)
 	cdc.RegisterConcrete(ValidatorWeight{}, "hetu/event/ValidatorWeight", nil)
+	cdc.RegisterConcrete(&MsgSetChildKeys{}, setChildKeysName, nil)
+	cdc.RegisterConcrete(&MsgSetChildKeyTake{}, setChildKeyTakeName, nil)
+	cdc.RegisterConcrete(&MsgSetValidatorTake{}, setValidatorTakeName, nil)
+	cdc.RegisterConcrete(&MsgClaimDividends{}, claimDividendsName, nil)
+	cdc.RegisterConcrete(&MsgSetNativeStakeAllocation{}, setNativeStakeAllocationName, nil)
+	cdc.RegisterConcrete(&MsgSetWeights{}, setWeightsName, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
 }
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// ValidatorTake represents the take a validator keeps from its dividends on a
+// subnet before sharing them with its delegators.
+type ValidatorTake struct {
+	Validator       string         `json:"validator"`
+	Netuid          uint16         `json:"netuid"`
+	Take            math.LegacyDec `json:"take"`
+	LastUpdateBlock uint64         `json:"last_update_block"`
+}
+
+// ClaimableDividend represents the alpha dividends a delegator earned on a
+// subnet and did not claim yet. Addresses are hex encoded.
+type ClaimableDividend struct {
+	Delegator string   `json:"delegator"`
+	Netuid    uint16   `json:"netuid"`
+	Amount    math.Int `json:"amount"`
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrSubnetNotFound               = errorsmod.Register(ModuleName, 2, "subnet not found")
+	ErrInvalidChildKeys             = errorsmod.Register(ModuleName, 3, "invalid child keys")
+	ErrInvalidChildKeyTake          = errorsmod.Register(ModuleName, 4, "invalid child key take")
+	ErrChildKeyRateLimited          = errorsmod.Register(ModuleName, 5, "child key take changed too recently")
+	ErrInvalidValidatorTake         = errorsmod.Register(ModuleName, 6, "invalid validator take")
+	ErrValidatorTakeRateLimited     = errorsmod.Register(ModuleName, 7, "validator take changed too recently")
+	ErrNoDividends                  = errorsmod.Register(ModuleName, 8, "no dividends to claim")
+	ErrDividendClaim                = errorsmod.Register(ModuleName, 9, "failed to claim dividends")
+	ErrNativeStakeDisabled          = errorsmod.Register(ModuleName, 10, "native stake is disabled")
+	ErrInvalidNativeStakeAllocation = errorsmod.Register(ModuleName, 11, "invalid native stake allocation")
+	ErrValidatorNotFound            = errorsmod.Register(ModuleName, 12, "validator not found")
+	ErrInvalidWeights               = errorsmod.Register(ModuleName, 13, "invalid weights")
+)
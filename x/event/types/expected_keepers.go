@@ -2,8 +2,15 @@
 package types
 
 import (
+	"context"
+	"math/big"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20types "github.com/hetu-project/hetu/v1/x/erc20/types"
 )
 
 // EventKeeper defines the standard interface for the event module keeper
@@ -23,6 +30,17 @@ type EventKeeper interface {
 	GetValidatorStake(ctx sdk.Context, netuid uint16, validator string) (ValidatorStake, bool)
 	GetAllValidatorStakesByNetuid(ctx sdk.Context, netuid uint16) []ValidatorStake
 
+	// Child key related
+	GetEffectiveStakes(ctx sdk.Context, netuid uint16) map[string]math.Int
+	GetStakeContributions(ctx sdk.Context, netuid uint16, hotkey string) (math.Int, []StakeContribution)
+	GetChildHotkeysByNetuid(ctx sdk.Context, netuid uint16) []string
+	GetChildKeyTake(ctx sdk.Context, netuid uint16, child string) math.LegacyDec
+
+	// Delegator dividend related
+	GetDelegationsByValidator(ctx sdk.Context, netuid uint16, validator string) []Delegation
+	GetValidatorTake(ctx sdk.Context, netuid uint16, validator string) math.LegacyDec
+	AddClaimableDividend(ctx sdk.Context, netuid uint16, delegator string, amount math.Int)
+
 	// Weight related
 	GetValidatorWeight(ctx sdk.Context, netuid uint16, validator string) (ValidatorWeight, bool)
 
@@ -35,7 +53,7 @@ type EventKeeper interface {
 	GetAlphaPrice(ctx sdk.Context, netuid uint16) math.LegacyDec
 	GetMovingAlphaPrice(ctx sdk.Context, netuid uint16) math.LegacyDec
 	SetSubnetMovingPrice(ctx sdk.Context, netuid uint16, price math.LegacyDec)
-	UpdateMovingPrice(ctx sdk.Context, netuid uint16, movingAlpha math.LegacyDec, halvingBlocks uint64)
+	UpdateMovingPrice(ctx sdk.Context, netuid uint16, price, movingAlpha math.LegacyDec, halvingBlocks uint64)
 
 	// Alpha/TAO tracking
 	GetSubnetAlphaIn(ctx sdk.Context, netuid uint16) math.Int
@@ -62,9 +80,44 @@ type EventKeeper interface {
 	SetPendingOwnerCut(ctx sdk.Context, netuid uint16, amount math.Int)
 	AddPendingOwnerCut(ctx sdk.Context, netuid uint16, amount math.Int)
 
+	// Price history
+	RecordPriceSnapshot(ctx sdk.Context, netuid uint16, pendingEmission, ownerCut math.Int)
+
 	// Epoch tracking
 	GetBlocksSinceLastStep(ctx sdk.Context, netuid uint16) uint64
 	SetBlocksSinceLastStep(ctx sdk.Context, netuid uint16, blocks uint64)
 	GetLastMechanismStepBlock(ctx sdk.Context, netuid uint16) int64
 	SetLastMechanismStepBlock(ctx sdk.Context, netuid uint16, block int64)
 }
+
+// AlphaMinter defines the expected interface minting the alpha tokens of a subnet
+type AlphaMinter interface {
+	MintAlphaTokens(ctx sdk.Context, netuid uint16, recipient string, amount *big.Int) error
+}
+
+// Sources of the subnet costs passed to the SubnetCostPolicy
+const (
+	// SubnetCostLock is the part of the network lock cost burned at subnet registration
+	SubnetCostLock = "lock"
+	// SubnetCostRegistration is a neuron registration cost paid to the GlobalStaking treasury
+	SubnetCostRegistration = "registration"
+)
+
+// SubnetCostPolicy defines the expected interface deciding where the HETU paid
+// to the contracts for subnet and neuron registrations ends up in the supply.
+// The payer is the contract holding the HETU paid.
+type SubnetCostPolicy interface {
+	ApplySubnetCost(ctx sdk.Context, netuid uint16, source string, payer sdk.AccAddress, amount math.Int) error
+}
+
+// AlphaTokenRegistrar defines the expected interface of the x/erc20 keeper
+// registering the alpha token of a subnet as a token pair
+type AlphaTokenRegistrar interface {
+	RegisterAlphaToken(ctx sdk.Context, netuid uint16, contract common.Address) (*erc20types.TokenPair, error)
+}
+
+// StakingKeeper defines the expected interface of the x/staking keeper whose
+// bonded tokens count as subnet stake
+type StakingKeeper interface {
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+}
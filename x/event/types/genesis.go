@@ -5,43 +5,92 @@ package types
 
 import (
 	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // GenesisState defines the event module's genesis state.
 type GenesisState struct {
-	Subnets          []Subnet          `json:"subnets"`
-	ValidatorStakes  []ValidatorStake  `json:"validator_stakes"`
-	Delegations      []Delegation      `json:"delegations"`
-	ValidatorWeights []ValidatorWeight `json:"validator_weights"`
+	Params                 Params                  `json:"params"`
+	Subnets                []Subnet                `json:"subnets"`
+	ValidatorStakes        []ValidatorStake        `json:"validator_stakes"`
+	Delegations            []Delegation            `json:"delegations"`
+	ValidatorWeights       []ValidatorWeight       `json:"validator_weights"`
+	GlobalStakes           []GlobalStake           `json:"global_stakes"`
+	SubnetAllocations      []SubnetAllocation      `json:"subnet_allocations"`
+	ChildKeys              []ChildKeys             `json:"child_keys"`
+	PendingChildKeys       []PendingChildKeys      `json:"pending_child_keys"`
+	ChildKeyTakes          []ChildKeyTake          `json:"child_key_takes"`
+	ValidatorTakes         []ValidatorTake         `json:"validator_takes"`
+	ClaimableDividends     []ClaimableDividend     `json:"claimable_dividends"`
+	NativeStakeAllocations []NativeStakeAllocation `json:"native_stake_allocations"`
+	NativeStakes           []NativeStake           `json:"native_stakes"`
+	VestingStakes          []VestingStake          `json:"vesting_stakes"`
 }
 
 // NewGenesisState creates a new genesis state instance
 func NewGenesisState(
+	params Params,
 	subnets []Subnet,
 	validatorStakes []ValidatorStake,
 	delegations []Delegation,
 	validatorWeights []ValidatorWeight,
+	globalStakes []GlobalStake,
+	subnetAllocations []SubnetAllocation,
+	childKeys []ChildKeys,
+	pendingChildKeys []PendingChildKeys,
+	childKeyTakes []ChildKeyTake,
+	validatorTakes []ValidatorTake,
+	claimableDividends []ClaimableDividend,
+	nativeStakeAllocations []NativeStakeAllocation,
+	nativeStakes []NativeStake,
+	vestingStakes []VestingStake,
 ) *GenesisState {
 	return &GenesisState{
-		Subnets:          subnets,
-		ValidatorStakes:  validatorStakes,
-		Delegations:      delegations,
-		ValidatorWeights: validatorWeights,
+		Params:                 params,
+		Subnets:                subnets,
+		ValidatorStakes:        validatorStakes,
+		Delegations:            delegations,
+		ValidatorWeights:       validatorWeights,
+		GlobalStakes:           globalStakes,
+		SubnetAllocations:      subnetAllocations,
+		ChildKeys:              childKeys,
+		PendingChildKeys:       pendingChildKeys,
+		ChildKeyTakes:          childKeyTakes,
+		ValidatorTakes:         validatorTakes,
+		ClaimableDividends:     claimableDividends,
+		NativeStakeAllocations: nativeStakeAllocations,
+		NativeStakes:           nativeStakes,
+		VestingStakes:          vestingStakes,
 	}
 }
 
 // DefaultGenesisState returns the default event genesis state
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Subnets:          make([]Subnet, 0),
-		ValidatorStakes:  make([]ValidatorStake, 0),
-		Delegations:      make([]Delegation, 0),
-		ValidatorWeights: make([]ValidatorWeight, 0),
+		Params:                 DefaultParams(),
+		Subnets:                make([]Subnet, 0),
+		ValidatorStakes:        make([]ValidatorStake, 0),
+		Delegations:            make([]Delegation, 0),
+		ValidatorWeights:       make([]ValidatorWeight, 0),
+		GlobalStakes:           make([]GlobalStake, 0),
+		SubnetAllocations:      make([]SubnetAllocation, 0),
+		ChildKeys:              make([]ChildKeys, 0),
+		PendingChildKeys:       make([]PendingChildKeys, 0),
+		ChildKeyTakes:          make([]ChildKeyTake, 0),
+		ValidatorTakes:         make([]ValidatorTake, 0),
+		ClaimableDividends:     make([]ClaimableDividend, 0),
+		NativeStakeAllocations: make([]NativeStakeAllocation, 0),
+		NativeStakes:           make([]NativeStake, 0),
+		VestingStakes:          make([]VestingStake, 0),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
 	seenNetuid := make(map[uint16]bool)
 	for _, s := range gs.Subnets {
 		if seenNetuid[s.Netuid] {
@@ -70,5 +119,90 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("validator weights: empty validator for netuid %d", vw.Netuid)
 		}
 	}
+	seenAccount := make(map[string]bool)
+	for _, stake := range gs.GlobalStakes {
+		if !common.IsHexAddress(stake.Account) {
+			return fmt.Errorf("global stake: invalid account %q", stake.Account)
+		}
+		account := common.HexToAddress(stake.Account).Hex()
+		if seenAccount[account] {
+			return fmt.Errorf("duplicate global stake account: %s", account)
+		}
+		seenAccount[account] = true
+	}
+	for _, sa := range gs.SubnetAllocations {
+		if !common.IsHexAddress(sa.Account) {
+			return fmt.Errorf("subnet allocation: invalid account %q for netuid %d", sa.Account, sa.Netuid)
+		}
+	}
+	for _, ck := range gs.ChildKeys {
+		if !common.IsHexAddress(ck.Parent) {
+			return fmt.Errorf("child keys: invalid parent %q for netuid %d", ck.Parent, ck.Netuid)
+		}
+		if err := ValidateChildKeys(ck.Parent, ck.Children); err != nil {
+			return fmt.Errorf("child keys of %s for netuid %d: %w", ck.Parent, ck.Netuid, err)
+		}
+	}
+	for _, pck := range gs.PendingChildKeys {
+		if !common.IsHexAddress(pck.Parent) {
+			return fmt.Errorf("pending child keys: invalid parent %q for netuid %d", pck.Parent, pck.Netuid)
+		}
+		if err := ValidateChildKeys(pck.Parent, pck.Children); err != nil {
+			return fmt.Errorf("pending child keys of %s for netuid %d: %w", pck.Parent, pck.Netuid, err)
+		}
+	}
+	for _, ckt := range gs.ChildKeyTakes {
+		if !common.IsHexAddress(ckt.Child) {
+			return fmt.Errorf("child key take: invalid child %q for netuid %d", ckt.Child, ckt.Netuid)
+		}
+		if ckt.Take.IsNil() || ckt.Take.IsNegative() || ckt.Take.GT(gs.Params.MaxChildKeyTake) {
+			return fmt.Errorf("child key take of %s for netuid %d must be between 0 and %s", ckt.Child, ckt.Netuid, gs.Params.MaxChildKeyTake)
+		}
+	}
+	for _, vt := range gs.ValidatorTakes {
+		if !common.IsHexAddress(vt.Validator) {
+			return fmt.Errorf("validator take: invalid validator %q for netuid %d", vt.Validator, vt.Netuid)
+		}
+		if vt.Take.IsNil() || vt.Take.LT(gs.Params.MinValidatorTake) || vt.Take.GT(gs.Params.MaxValidatorTake) {
+			return fmt.Errorf("validator take of %s for netuid %d must be between %s and %s", vt.Validator, vt.Netuid, gs.Params.MinValidatorTake, gs.Params.MaxValidatorTake)
+		}
+	}
+	for _, cd := range gs.ClaimableDividends {
+		if !common.IsHexAddress(cd.Delegator) {
+			return fmt.Errorf("claimable dividend: invalid delegator %q for netuid %d", cd.Delegator, cd.Netuid)
+		}
+		if cd.Amount.IsNil() || cd.Amount.IsNegative() {
+			return fmt.Errorf("claimable dividend of %s for netuid %d must not be negative", cd.Delegator, cd.Netuid)
+		}
+	}
+	allocations := make(map[string][]NativeSubnetAllocation)
+	for _, nsa := range gs.NativeStakeAllocations {
+		if !common.IsHexAddress(nsa.Validator) {
+			return fmt.Errorf("native stake allocation: invalid validator %q for netuid %d", nsa.Validator, nsa.Netuid)
+		}
+		validator := common.HexToAddress(nsa.Validator).Hex()
+		allocations[validator] = append(allocations[validator], NativeSubnetAllocation{Netuid: uint32(nsa.Netuid), Proportion: nsa.Proportion})
+	}
+	for validator, subnetAllocations := range allocations {
+		if err := ValidateNativeSubnetAllocations(subnetAllocations); err != nil {
+			return fmt.Errorf("native stake allocations of %s: %w", validator, err)
+		}
+	}
+	for _, ns := range gs.NativeStakes {
+		if !common.IsHexAddress(ns.Validator) {
+			return fmt.Errorf("native stake: invalid validator %q for netuid %d", ns.Validator, ns.Netuid)
+		}
+		if ns.Amount.IsNil() || ns.Amount.IsNegative() {
+			return fmt.Errorf("native stake of %s for netuid %d must not be negative", ns.Validator, ns.Netuid)
+		}
+	}
+	for _, vs := range gs.VestingStakes {
+		if !common.IsHexAddress(vs.Account) {
+			return fmt.Errorf("vesting stake: invalid account %q for netuid %d", vs.Account, vs.Netuid)
+		}
+		if vs.Amount.IsNil() || vs.Amount.IsNegative() {
+			return fmt.Errorf("vesting stake of %s for netuid %d must not be negative", vs.Account, vs.Netuid)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,53 @@
+package types
+
+// GlobalStake represents an account's position in the GlobalStaking contract.
+// Amounts are string-encoded integers in HETU's smallest unit (no decimals).
+type GlobalStake struct {
+	Account          string `json:"account"`
+	TotalStaked      string `json:"total_staked"`
+	Allocated        string `json:"allocated"`         // sum of the subnet allocations
+	RegistrationCost string `json:"registration_cost"` // paid to the treasury
+	LastUpdateBlock  uint64 `json:"last_update_block"`
+}
+
+// NewGlobalStake creates an empty global stake position for an account.
+func NewGlobalStake(account string) GlobalStake {
+	return GlobalStake{
+		Account:          account,
+		TotalStaked:      "0",
+		Allocated:        "0",
+		RegistrationCost: "0",
+	}
+}
+
+// Available returns the stake that is neither allocated to a subnet nor spent
+// on registration costs, matching GlobalStaking.getAvailableStake.
+func (s GlobalStake) Available() string {
+	return SubBigIntString(SubBigIntString(s.TotalStaked, s.Allocated), s.RegistrationCost)
+}
+
+// SubnetAllocation represents the global stake an account allocated to a subnet.
+// Allocations are tracked whether or not the account is registered as a neuron.
+type SubnetAllocation struct {
+	Account          string `json:"account"`
+	Netuid           uint16 `json:"netuid"`
+	Amount           string `json:"amount"`
+	RegistrationCost string `json:"registration_cost"`
+	LastUpdateBlock  uint64 `json:"last_update_block"`
+}
+
+// NewSubnetAllocation creates an empty allocation of an account to a subnet.
+func NewSubnetAllocation(account string, netuid uint16) SubnetAllocation {
+	return SubnetAllocation{
+		Account:          account,
+		Netuid:           netuid,
+		Amount:           "0",
+		RegistrationCost: "0",
+	}
+}
+
+// SubnetRegistrationCost represents the registration costs paid to the treasury for a subnet.
+type SubnetRegistrationCost struct {
+	Netuid uint16 `json:"netuid"`
+	Amount string `json:"amount"`
+}
@@ -23,6 +23,24 @@ type QueryClient interface {
 	SubnetPool(ctx context.Context, in *eventtypes.QuerySubnetPoolRequest, opts ...grpc.CallOption) (*eventtypes.QuerySubnetPoolResponse, error)
 	// ValidatorWeights returns weights for a validator in a subnet
 	ValidatorWeights(ctx context.Context, in *eventtypes.QueryValidatorWeightsRequest, opts ...grpc.CallOption) (*eventtypes.QueryValidatorWeightsResponse, error)
+	// StakePosition returns the global staking position of an account across subnets
+	StakePosition(ctx context.Context, in *eventtypes.QueryStakePositionRequest, opts ...grpc.CallOption) (*eventtypes.QueryStakePositionResponse, error)
+	// RegistrationCosts returns the registration costs paid to the treasury
+	RegistrationCosts(ctx context.Context, in *eventtypes.QueryRegistrationCostsRequest, opts ...grpc.CallOption) (*eventtypes.QueryRegistrationCostsResponse, error)
+	// Params returns the event module parameters
+	Params(ctx context.Context, in *eventtypes.QueryParamsRequest, opts ...grpc.CallOption) (*eventtypes.QueryParamsResponse, error)
+	// PriceHistory returns the price and emission snapshots of a subnet
+	PriceHistory(ctx context.Context, in *eventtypes.QueryPriceHistoryRequest, opts ...grpc.CallOption) (*eventtypes.QueryPriceHistoryResponse, error)
+	// PriceCandles returns the per-block OHLC price candles of a subnet AMM pool
+	PriceCandles(ctx context.Context, in *eventtypes.QueryPriceCandlesRequest, opts ...grpc.CallOption) (*eventtypes.QueryPriceCandlesResponse, error)
+	// ChildKeys returns the child and parent hotkeys of a hotkey in a subnet
+	ChildKeys(ctx context.Context, in *eventtypes.QueryChildKeysRequest, opts ...grpc.CallOption) (*eventtypes.QueryChildKeysResponse, error)
+	// ValidatorTake returns the take of a validator in a subnet
+	ValidatorTake(ctx context.Context, in *eventtypes.QueryValidatorTakeRequest, opts ...grpc.CallOption) (*eventtypes.QueryValidatorTakeResponse, error)
+	// Dividends returns the alpha dividends a delegator can claim
+	Dividends(ctx context.Context, in *eventtypes.QueryDividendsRequest, opts ...grpc.CallOption) (*eventtypes.QueryDividendsResponse, error)
+	// NativeStake returns the bonded x/staking tokens of a validator counted as subnet stake
+	NativeStake(ctx context.Context, in *eventtypes.QueryNativeStakeRequest, opts ...grpc.CallOption) (*eventtypes.QueryNativeStakeResponse, error)
 }
 
 // QueryServer defines the server API for Query service.
@@ -37,6 +55,24 @@ type QueryServer interface {
 	SubnetPool(ctx context.Context, in *eventtypes.QuerySubnetPoolRequest) (*eventtypes.QuerySubnetPoolResponse, error)
 	// ValidatorWeights returns weights for a validator in a subnet
 	ValidatorWeights(ctx context.Context, in *eventtypes.QueryValidatorWeightsRequest) (*eventtypes.QueryValidatorWeightsResponse, error)
+	// StakePosition returns the global staking position of an account across subnets
+	StakePosition(ctx context.Context, in *eventtypes.QueryStakePositionRequest) (*eventtypes.QueryStakePositionResponse, error)
+	// RegistrationCosts returns the registration costs paid to the treasury
+	RegistrationCosts(ctx context.Context, in *eventtypes.QueryRegistrationCostsRequest) (*eventtypes.QueryRegistrationCostsResponse, error)
+	// Params returns the event module parameters
+	Params(ctx context.Context, in *eventtypes.QueryParamsRequest) (*eventtypes.QueryParamsResponse, error)
+	// PriceHistory returns the price and emission snapshots of a subnet
+	PriceHistory(ctx context.Context, in *eventtypes.QueryPriceHistoryRequest) (*eventtypes.QueryPriceHistoryResponse, error)
+	// PriceCandles returns the per-block OHLC price candles of a subnet AMM pool
+	PriceCandles(ctx context.Context, in *eventtypes.QueryPriceCandlesRequest) (*eventtypes.QueryPriceCandlesResponse, error)
+	// ChildKeys returns the child and parent hotkeys of a hotkey in a subnet
+	ChildKeys(ctx context.Context, in *eventtypes.QueryChildKeysRequest) (*eventtypes.QueryChildKeysResponse, error)
+	// ValidatorTake returns the take of a validator in a subnet
+	ValidatorTake(ctx context.Context, in *eventtypes.QueryValidatorTakeRequest) (*eventtypes.QueryValidatorTakeResponse, error)
+	// Dividends returns the alpha dividends a delegator can claim
+	Dividends(ctx context.Context, in *eventtypes.QueryDividendsRequest) (*eventtypes.QueryDividendsResponse, error)
+	// NativeStake returns the bonded x/staking tokens of a validator counted as subnet stake
+	NativeStake(ctx context.Context, in *eventtypes.QueryNativeStakeRequest) (*eventtypes.QueryNativeStakeResponse, error)
 }
 
 // RegisterQueryServer registers the QueryServer implementation with the gRPC server.
@@ -77,6 +113,42 @@ func (q *queryServerWrapper) ValidatorWeights(ctx context.Context, req *eventtyp
 	return q.srv.ValidatorWeights(ctx, req)
 }
 
+func (q *queryServerWrapper) StakePosition(ctx context.Context, req *eventtypes.QueryStakePositionRequest) (*eventtypes.QueryStakePositionResponse, error) {
+	return q.srv.StakePosition(ctx, req)
+}
+
+func (q *queryServerWrapper) RegistrationCosts(ctx context.Context, req *eventtypes.QueryRegistrationCostsRequest) (*eventtypes.QueryRegistrationCostsResponse, error) {
+	return q.srv.RegistrationCosts(ctx, req)
+}
+
+func (q *queryServerWrapper) Params(ctx context.Context, req *eventtypes.QueryParamsRequest) (*eventtypes.QueryParamsResponse, error) {
+	return q.srv.Params(ctx, req)
+}
+
+func (q *queryServerWrapper) PriceHistory(ctx context.Context, req *eventtypes.QueryPriceHistoryRequest) (*eventtypes.QueryPriceHistoryResponse, error) {
+	return q.srv.PriceHistory(ctx, req)
+}
+
+func (q *queryServerWrapper) PriceCandles(ctx context.Context, req *eventtypes.QueryPriceCandlesRequest) (*eventtypes.QueryPriceCandlesResponse, error) {
+	return q.srv.PriceCandles(ctx, req)
+}
+
+func (q *queryServerWrapper) ChildKeys(ctx context.Context, req *eventtypes.QueryChildKeysRequest) (*eventtypes.QueryChildKeysResponse, error) {
+	return q.srv.ChildKeys(ctx, req)
+}
+
+func (q *queryServerWrapper) ValidatorTake(ctx context.Context, req *eventtypes.QueryValidatorTakeRequest) (*eventtypes.QueryValidatorTakeResponse, error) {
+	return q.srv.ValidatorTake(ctx, req)
+}
+
+func (q *queryServerWrapper) Dividends(ctx context.Context, req *eventtypes.QueryDividendsRequest) (*eventtypes.QueryDividendsResponse, error) {
+	return q.srv.Dividends(ctx, req)
+}
+
+func (q *queryServerWrapper) NativeStake(ctx context.Context, req *eventtypes.QueryNativeStakeRequest) (*eventtypes.QueryNativeStakeResponse, error) {
+	return q.srv.NativeStake(ctx, req)
+}
+
 // queryClientWrapper wraps the eventtypes.QueryClient to make it compatible with QueryClient.
 type queryClientWrapper struct {
 	client eventtypes.QueryClient
@@ -101,3 +173,39 @@ func (q *queryClientWrapper) SubnetPool(ctx context.Context, in *eventtypes.Quer
 func (q *queryClientWrapper) ValidatorWeights(ctx context.Context, in *eventtypes.QueryValidatorWeightsRequest, opts ...grpc.CallOption) (*eventtypes.QueryValidatorWeightsResponse, error) {
 	return q.client.ValidatorWeights(ctx, in, opts...)
 }
+
+func (q *queryClientWrapper) StakePosition(ctx context.Context, in *eventtypes.QueryStakePositionRequest, opts ...grpc.CallOption) (*eventtypes.QueryStakePositionResponse, error) {
+	return q.client.StakePosition(ctx, in, opts...)
+}
+
+func (q *queryClientWrapper) RegistrationCosts(ctx context.Context, in *eventtypes.QueryRegistrationCostsRequest, opts ...grpc.CallOption) (*eventtypes.QueryRegistrationCostsResponse, error) {
+	return q.client.RegistrationCosts(ctx, in, opts...)
+}
+
+func (q *queryClientWrapper) Params(ctx context.Context, in *eventtypes.QueryParamsRequest, opts ...grpc.CallOption) (*eventtypes.QueryParamsResponse, error) {
+	return q.client.Params(ctx, in, opts...)
+}
+
+func (q *queryClientWrapper) PriceHistory(ctx context.Context, in *eventtypes.QueryPriceHistoryRequest, opts ...grpc.CallOption) (*eventtypes.QueryPriceHistoryResponse, error) {
+	return q.client.PriceHistory(ctx, in, opts...)
+}
+
+func (q *queryClientWrapper) PriceCandles(ctx context.Context, in *eventtypes.QueryPriceCandlesRequest, opts ...grpc.CallOption) (*eventtypes.QueryPriceCandlesResponse, error) {
+	return q.client.PriceCandles(ctx, in, opts...)
+}
+
+func (q *queryClientWrapper) ChildKeys(ctx context.Context, in *eventtypes.QueryChildKeysRequest, opts ...grpc.CallOption) (*eventtypes.QueryChildKeysResponse, error) {
+	return q.client.ChildKeys(ctx, in, opts...)
+}
+
+func (q *queryClientWrapper) ValidatorTake(ctx context.Context, in *eventtypes.QueryValidatorTakeRequest, opts ...grpc.CallOption) (*eventtypes.QueryValidatorTakeResponse, error) {
+	return q.client.ValidatorTake(ctx, in, opts...)
+}
+
+func (q *queryClientWrapper) Dividends(ctx context.Context, in *eventtypes.QueryDividendsRequest, opts ...grpc.CallOption) (*eventtypes.QueryDividendsResponse, error) {
+	return q.client.Dividends(ctx, in, opts...)
+}
+
+func (q *queryClientWrapper) NativeStake(ctx context.Context, in *eventtypes.QueryNativeStakeRequest, opts ...grpc.CallOption) (*eventtypes.QueryNativeStakeResponse, error) {
+	return q.client.NativeStake(ctx, in, opts...)
+}
@@ -13,3 +13,6 @@ const (
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_event"
 )
+
+// ParamsKey defines the key for the module parameters
+var ParamsKey = []byte("params")
@@ -0,0 +1,179 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	_ sdk.Msg = &MsgSetChildKeys{}
+	_ sdk.Msg = &MsgSetChildKeyTake{}
+	_ sdk.Msg = &MsgSetValidatorTake{}
+	_ sdk.Msg = &MsgClaimDividends{}
+	_ sdk.Msg = &MsgSetNativeStakeAllocation{}
+	_ sdk.Msg = &MsgSetWeights{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgSetChildKeys creates a new instance of MsgSetChildKeys
+func NewMsgSetChildKeys(parent sdk.AccAddress, netuid uint16, children []ChildKey) *MsgSetChildKeys {
+	return &MsgSetChildKeys{
+		Parent:   parent.String(),
+		Netuid:   uint32(netuid),
+		Children: children,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetChildKeys) ValidateBasic() error {
+	parent, err := sdk.AccAddressFromBech32(msg.Parent)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid parent address")
+	}
+	if msg.Netuid == 0 || msg.Netuid > uint32(^uint16(0)) {
+		return errorsmod.Wrapf(ErrInvalidChildKeys, "invalid netuid %d", msg.Netuid)
+	}
+	if err := ValidateChildKeys(common.BytesToAddress(parent).Hex(), msg.Children); err != nil {
+		return errorsmod.Wrap(ErrInvalidChildKeys, err.Error())
+	}
+	return nil
+}
+
+// NewMsgSetChildKeyTake creates a new instance of MsgSetChildKeyTake
+func NewMsgSetChildKeyTake(child sdk.AccAddress, netuid uint16, take math.LegacyDec) *MsgSetChildKeyTake {
+	return &MsgSetChildKeyTake{
+		Child:  child.String(),
+		Netuid: uint32(netuid),
+		Take:   take,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetChildKeyTake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Child); err != nil {
+		return errorsmod.Wrap(err, "invalid child address")
+	}
+	if msg.Netuid == 0 || msg.Netuid > uint32(^uint16(0)) {
+		return errorsmod.Wrapf(ErrInvalidChildKeyTake, "invalid netuid %d", msg.Netuid)
+	}
+	if msg.Take.IsNil() || msg.Take.IsNegative() || msg.Take.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidChildKeyTake, "take must be between 0 and 1, got %s", msg.Take)
+	}
+	return nil
+}
+
+// NewMsgSetValidatorTake creates a new instance of MsgSetValidatorTake
+func NewMsgSetValidatorTake(validator sdk.AccAddress, netuid uint16, take math.LegacyDec) *MsgSetValidatorTake {
+	return &MsgSetValidatorTake{
+		Validator: validator.String(),
+		Netuid:    uint32(netuid),
+		Take:      take,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetValidatorTake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Validator); err != nil {
+		return errorsmod.Wrap(err, "invalid validator address")
+	}
+	if msg.Netuid == 0 || msg.Netuid > uint32(^uint16(0)) {
+		return errorsmod.Wrapf(ErrInvalidValidatorTake, "invalid netuid %d", msg.Netuid)
+	}
+	if msg.Take.IsNil() || msg.Take.IsNegative() || msg.Take.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidValidatorTake, "take must be between 0 and 1, got %s", msg.Take)
+	}
+	return nil
+}
+
+// NewMsgClaimDividends creates a new instance of MsgClaimDividends claiming
+// the dividends of a subnet, a zero netuid claims those of the root network
+func NewMsgClaimDividends(delegator sdk.AccAddress, netuid uint16) *MsgClaimDividends {
+	return &MsgClaimDividends{
+		Delegator: delegator.String(),
+		Netuid:    uint32(netuid),
+	}
+}
+
+// NewMsgClaimAllDividends creates a new instance of MsgClaimDividends claiming
+// the dividends of all subnets
+func NewMsgClaimAllDividends(delegator sdk.AccAddress) *MsgClaimDividends {
+	return &MsgClaimDividends{
+		Delegator: delegator.String(),
+		All:       true,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgClaimDividends) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Delegator); err != nil {
+		return errorsmod.Wrap(err, "invalid delegator address")
+	}
+	if msg.Netuid > uint32(^uint16(0)) {
+		return errorsmod.Wrapf(ErrDividendClaim, "invalid netuid %d", msg.Netuid)
+	}
+	if msg.All && msg.Netuid != 0 {
+		return errorsmod.Wrapf(ErrDividendClaim, "netuid %d must be zero when claiming all subnets", msg.Netuid)
+	}
+	return nil
+}
+
+// NewMsgSetNativeStakeAllocation creates a new instance of MsgSetNativeStakeAllocation
+func NewMsgSetNativeStakeAllocation(validator sdk.AccAddress, allocations []NativeSubnetAllocation) *MsgSetNativeStakeAllocation {
+	return &MsgSetNativeStakeAllocation{
+		Validator:   validator.String(),
+		Allocations: allocations,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetNativeStakeAllocation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Validator); err != nil {
+		return errorsmod.Wrap(err, "invalid validator address")
+	}
+	if err := ValidateNativeSubnetAllocations(msg.Allocations); err != nil {
+		return errorsmod.Wrap(ErrInvalidNativeStakeAllocation, err.Error())
+	}
+	return nil
+}
+
+// NewMsgSetWeights creates a new instance of MsgSetWeights, a zero netuid sets
+// the weights on the root network
+func NewMsgSetWeights(validator sdk.AccAddress, netuid uint16, weights []DestWeight) *MsgSetWeights {
+	return &MsgSetWeights{
+		Validator: validator.String(),
+		Netuid:    uint32(netuid),
+		Weights:   weights,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetWeights) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Validator); err != nil {
+		return errorsmod.Wrap(err, "invalid validator address")
+	}
+	if msg.Netuid > uint32(^uint16(0)) {
+		return errorsmod.Wrapf(ErrInvalidWeights, "invalid netuid %d", msg.Netuid)
+	}
+	if err := ValidateDestWeights(msg.Weights); err != nil {
+		return errorsmod.Wrap(ErrInvalidWeights, err.Error())
+	}
+	return nil
+}
+
+// NewMsgUpdateParams creates a new instance of MsgUpdateParams
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return msg.Params.Validate()
+}
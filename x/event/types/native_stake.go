@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// MaxNativeStakeAllocations bounds the number of subnets the bonded tokens of
+// a validator are allocated to.
+const MaxNativeStakeAllocations = 32
+
+// NativeStakeAllocation represents the share of the bonded x/staking tokens
+// of a validator allocated to a subnet. The validator is the hex address of
+// its operator account.
+type NativeStakeAllocation struct {
+	Validator  string         `json:"validator"`
+	Netuid     uint16         `json:"netuid"`
+	Proportion math.LegacyDec `json:"proportion"`
+}
+
+// NativeStake represents the bonded x/staking tokens of a validator counted as
+// its stake on a subnet.
+type NativeStake struct {
+	Validator string   `json:"validator"`
+	Netuid    uint16   `json:"netuid"`
+	Amount    math.Int `json:"amount"`
+}
+
+// ValidateNativeSubnetAllocations checks that the allocations target distinct
+// subnets with proportions in (0, 1] summing up to at most 1.
+func ValidateNativeSubnetAllocations(allocations []NativeSubnetAllocation) error {
+	if len(allocations) > MaxNativeStakeAllocations {
+		return fmt.Errorf("too many subnet allocations: %d > %d", len(allocations), MaxNativeStakeAllocations)
+	}
+
+	seen := make(map[uint32]bool, len(allocations))
+	total := math.LegacyZeroDec()
+	for _, allocation := range allocations {
+		if allocation.Netuid == 0 || allocation.Netuid > uint32(^uint16(0)) {
+			return fmt.Errorf("invalid netuid %d", allocation.Netuid)
+		}
+		if seen[allocation.Netuid] {
+			return fmt.Errorf("duplicate netuid %d", allocation.Netuid)
+		}
+		seen[allocation.Netuid] = true
+
+		if allocation.Proportion.IsNil() || !allocation.Proportion.IsPositive() || allocation.Proportion.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("netuid %d proportion must be in (0, 1], got %s", allocation.Netuid, allocation.Proportion)
+		}
+		total = total.Add(allocation.Proportion)
+	}
+	if total.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("subnet proportions sum up to %s, more than 1", total)
+	}
+	return nil
+}
+
+// VestingStake represents the locked tokens of a vesting account, escrowed by
+// the vesting module, counted as its stake on a subnet. The account is its
+// hex address.
+type VestingStake struct {
+	Account string   `json:"account"`
+	Netuid  uint16   `json:"netuid"`
+	Amount  math.Int `json:"amount"`
+}
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+)
+
 // DefaultParamsMap returns default parameter mapping
 // When the param field in contract events doesn't specify certain parameters, use these default values
 func DefaultParamsMap() map[string]string {
@@ -35,3 +42,78 @@ func DefaultParamsMap() map[string]string {
 		KeyAlphaSigmoidSteepness: "10.0", // Alpha sigmoid steepness
 	}
 }
+
+const (
+	// DefaultPriceHistoryRetention is the default number of price snapshots kept per subnet
+	DefaultPriceHistoryRetention uint64 = 2000
+	// MaxPriceHistoryRetention bounds the price history state of a subnet
+	MaxPriceHistoryRetention uint64 = 100000
+	// DefaultPriceCandleRetention is the default number of blocks the AMM price candles are kept for
+	DefaultPriceCandleRetention uint64 = 7200
+	// MaxPriceCandleRetention bounds the price candle state of a subnet
+	MaxPriceCandleRetention uint64 = 100000
+	// DefaultChildKeyCooldown is the default number of blocks before new child keys take effect
+	DefaultChildKeyCooldown uint64 = 7200
+	// DefaultValidatorTakeCooldown is the default minimum number of blocks between two validator take increases
+	DefaultValidatorTakeCooldown uint64 = 7200
+)
+
+// DefaultMaxChildKeyTake is the default upper bound of the child key take
+func DefaultMaxChildKeyTake() math.LegacyDec {
+	return math.LegacyNewDecWithPrec(18, 2)
+}
+
+// DefaultMinValidatorTake is the default lower bound of the validator take
+func DefaultMinValidatorTake() math.LegacyDec {
+	return math.LegacyZeroDec()
+}
+
+// DefaultMaxValidatorTake is the default upper bound of the validator take,
+// also the take of validators that never set theirs
+func DefaultMaxValidatorTake() math.LegacyDec {
+	return math.LegacyNewDecWithPrec(18, 2)
+}
+
+// DefaultParams returns the default event module parameters
+func DefaultParams() Params {
+	return Params{
+		PriceHistoryRetention:  DefaultPriceHistoryRetention,
+		ChildKeyCooldown:       DefaultChildKeyCooldown,
+		MaxChildKeyTake:        DefaultMaxChildKeyTake(),
+		MinValidatorTake:       DefaultMinValidatorTake(),
+		MaxValidatorTake:       DefaultMaxValidatorTake(),
+		DefaultValidatorTake:   DefaultMaxValidatorTake(),
+		ValidatorTakeCooldown:  DefaultValidatorTakeCooldown,
+		AlphaTokenPairsEnabled: true,
+		PriceCandleRetention:   DefaultPriceCandleRetention,
+	}
+}
+
+// Validate performs basic validation of the event module parameters
+func (p Params) Validate() error {
+	if p.PriceHistoryRetention > MaxPriceHistoryRetention {
+		return fmt.Errorf("price history retention %d exceeds the maximum %d", p.PriceHistoryRetention, MaxPriceHistoryRetention)
+	}
+	if p.PriceCandleRetention > MaxPriceCandleRetention {
+		return fmt.Errorf("price candle retention %d exceeds the maximum %d", p.PriceCandleRetention, MaxPriceCandleRetention)
+	}
+	if p.GlobalStakingAddress != "" && !common.IsHexAddress(p.GlobalStakingAddress) {
+		return fmt.Errorf("invalid global staking address %s", p.GlobalStakingAddress)
+	}
+	if p.SubnetManagerAddress != "" && !common.IsHexAddress(p.SubnetManagerAddress) {
+		return fmt.Errorf("invalid subnet manager address %s", p.SubnetManagerAddress)
+	}
+	if p.MaxChildKeyTake.IsNil() || p.MaxChildKeyTake.IsNegative() || p.MaxChildKeyTake.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max child key take must be between 0 and 1, got %s", p.MaxChildKeyTake)
+	}
+	if p.MinValidatorTake.IsNil() || p.MinValidatorTake.IsNegative() {
+		return fmt.Errorf("min validator take must not be negative, got %s", p.MinValidatorTake)
+	}
+	if p.MaxValidatorTake.IsNil() || p.MaxValidatorTake.LT(p.MinValidatorTake) || p.MaxValidatorTake.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max validator take must be between the min validator take %s and 1, got %s", p.MinValidatorTake, p.MaxValidatorTake)
+	}
+	if p.DefaultValidatorTake.IsNil() || p.DefaultValidatorTake.LT(p.MinValidatorTake) || p.DefaultValidatorTake.GT(p.MaxValidatorTake) {
+		return fmt.Errorf("default validator take must be between %s and %s, got %s", p.MinValidatorTake, p.MaxValidatorTake, p.DefaultValidatorTake)
+	}
+	return nil
+}
@@ -0,0 +1,820 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hetu/event/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the event module parameters
+type Params struct {
+	// price_history_retention is the number of price snapshots kept per subnet,
+	// the oldest snapshots are pruned first. Zero disables the price history.
+	PriceHistoryRetention uint64 `protobuf:"varint,1,opt,name=price_history_retention,json=priceHistoryRetention,proto3" json:"price_history_retention,omitempty"`
+	// child_key_cooldown is the number of blocks before new child keys take
+	// effect, and the minimum number of blocks between two take increases.
+	ChildKeyCooldown uint64 `protobuf:"varint,2,opt,name=child_key_cooldown,json=childKeyCooldown,proto3" json:"child_key_cooldown,omitempty"`
+	// max_child_key_take bounds the take child hotkeys keep from the dividends
+	// earned with the stake weight of their parents.
+	MaxChildKeyTake cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_child_key_take,json=maxChildKeyTake,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_child_key_take"`
+	// min_validator_take and max_validator_take bound the take validators keep
+	// from their dividends before sharing them with their delegators.
+	MinValidatorTake cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=min_validator_take,json=minValidatorTake,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_validator_take"`
+	MaxValidatorTake cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_validator_take,json=maxValidatorTake,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_validator_take"`
+	// default_validator_take is the take of validators that never set theirs.
+	DefaultValidatorTake cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=default_validator_take,json=defaultValidatorTake,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"default_validator_take"`
+	// validator_take_cooldown is the minimum number of blocks between two
+	// validator take increases.
+	ValidatorTakeCooldown uint64 `protobuf:"varint,7,opt,name=validator_take_cooldown,json=validatorTakeCooldown,proto3" json:"validator_take_cooldown,omitempty"`
+	// native_stake_enabled counts the bonded x/staking tokens validators
+	// allocate to subnets as their subnet stake.
+	NativeStakeEnabled bool `protobuf:"varint,8,opt,name=native_stake_enabled,json=nativeStakeEnabled,proto3" json:"native_stake_enabled,omitempty"`
+	// alpha_token_pairs_enabled registers the alpha token of each new subnet as
+	// an x/erc20 token pair with the alpha/<netuid> denom.
+	AlphaTokenPairsEnabled bool `protobuf:"varint,9,opt,name=alpha_token_pairs_enabled,json=alphaTokenPairsEnabled,proto3" json:"alpha_token_pairs_enabled,omitempty"`
+	// price_candle_retention is the number of blocks the per-block AMM price
+	// candles are kept for. Zero disables the price candles.
+	PriceCandleRetention uint64 `protobuf:"varint,10,opt,name=price_candle_retention,json=priceCandleRetention,proto3" json:"price_candle_retention,omitempty"`
+	// global_staking_address is the GlobalStaking contract, the only emitter
+	// whose events change the global stake ledger. Empty ignores the events.
+	GlobalStakingAddress string `protobuf:"bytes,11,opt,name=global_staking_address,json=globalStakingAddress,proto3" json:"global_staking_address,omitempty"`
+	// subnet_manager_address is the SubnetManager contract, the only emitter
+	// whose NetworkRegistered events burn the lock cost of a subnet.
+	SubnetManagerAddress string `protobuf:"bytes,12,opt,name=subnet_manager_address,json=subnetManagerAddress,proto3" json:"subnet_manager_address,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad8a4bd7a85171a2, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetPriceHistoryRetention() uint64 {
+	if m != nil {
+		return m.PriceHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetChildKeyCooldown() uint64 {
+	if m != nil {
+		return m.ChildKeyCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetValidatorTakeCooldown() uint64 {
+	if m != nil {
+		return m.ValidatorTakeCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetNativeStakeEnabled() bool {
+	if m != nil {
+		return m.NativeStakeEnabled
+	}
+	return false
+}
+
+func (m *Params) GetAlphaTokenPairsEnabled() bool {
+	if m != nil {
+		return m.AlphaTokenPairsEnabled
+	}
+	return false
+}
+
+func (m *Params) GetPriceCandleRetention() uint64 {
+	if m != nil {
+		return m.PriceCandleRetention
+	}
+	return 0
+}
+
+func (m *Params) GetGlobalStakingAddress() string {
+	if m != nil {
+		return m.GlobalStakingAddress
+	}
+	return ""
+}
+
+func (m *Params) GetSubnetManagerAddress() string {
+	if m != nil {
+		return m.SubnetManagerAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "hetu.event.v1.Params")
+}
+
+func init() { proto.RegisterFile("hetu/event/v1/params.proto", fileDescriptor_ad8a4bd7a85171a2) }
+
+var fileDescriptor_ad8a4bd7a85171a2 = []byte{
+	// 496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0x80, 0x1b, 0x18, 0x65, 0x33, 0x20, 0x26, 0xab, 0x74, 0x65, 0x48, 0x59, 0x05, 0x97, 0x1e,
+	0x58, 0xc2, 0xc4, 0x34, 0x89, 0x23, 0x2b, 0x08, 0x24, 0x40, 0x2a, 0xdd, 0x84, 0x04, 0x17, 0xeb,
+	0x6d, 0xf2, 0x92, 0x98, 0x26, 0x76, 0x64, 0xbb, 0xa1, 0xfd, 0x07, 0x1c, 0xf9, 0x59, 0x3b, 0xee,
+	0x88, 0x38, 0x4c, 0xa8, 0xfd, 0x23, 0x28, 0x76, 0x3f, 0xd6, 0x9d, 0xb6, 0x5b, 0x92, 0xc7, 0xcf,
+	0x23, 0x2b, 0xf6, 0x4b, 0x76, 0x53, 0x34, 0xa3, 0x10, 0x4b, 0x14, 0x26, 0x2c, 0x0f, 0xc2, 0x02,
+	0x14, 0xe4, 0x3a, 0x28, 0x94, 0x34, 0x92, 0x3e, 0xa8, 0x58, 0x60, 0x59, 0x50, 0x1e, 0xec, 0x36,
+	0x12, 0x99, 0x48, 0x4b, 0xc2, 0xea, 0xc9, 0x2d, 0x7a, 0xfa, 0xab, 0x4e, 0xea, 0x3d, 0x6b, 0xd1,
+	0x23, 0xb2, 0x53, 0x28, 0x1e, 0x21, 0x4b, 0xb9, 0x36, 0x52, 0x4d, 0x98, 0x42, 0x83, 0xc2, 0x70,
+	0x29, 0x5a, 0x5e, 0xdb, 0xeb, 0x6c, 0xf4, 0x1f, 0x59, 0xfc, 0xde, 0xd1, 0xfe, 0x02, 0xd2, 0xe7,
+	0x84, 0x46, 0x29, 0xcf, 0x62, 0x36, 0xc4, 0x09, 0x8b, 0xa4, 0xcc, 0x62, 0xf9, 0x53, 0xb4, 0x6e,
+	0x59, 0x65, 0xdb, 0x92, 0x0f, 0x38, 0xe9, 0xce, 0xbf, 0xd3, 0x1e, 0xa1, 0x39, 0x8c, 0xd9, 0xca,
+	0x30, 0x30, 0xc4, 0xd6, 0xed, 0xb6, 0xd7, 0xd9, 0x3a, 0x7e, 0x76, 0x76, 0xb1, 0x57, 0xfb, 0x7b,
+	0xb1, 0xf7, 0x24, 0x92, 0x3a, 0x97, 0x5a, 0xc7, 0xc3, 0x80, 0xcb, 0x30, 0x07, 0x93, 0x06, 0x1f,
+	0x31, 0x81, 0x68, 0xf2, 0x06, 0xa3, 0xfe, 0xc3, 0x1c, 0xc6, 0xdd, 0x79, 0xf5, 0x14, 0x86, 0x48,
+	0x3f, 0x13, 0x9a, 0x73, 0xc1, 0x4a, 0xc8, 0x78, 0x0c, 0x46, 0x2a, 0x57, 0xdc, 0xb8, 0x7e, 0x71,
+	0x3b, 0xe7, 0xe2, 0xcb, 0xc2, 0x5e, 0x26, 0x61, 0x7c, 0x35, 0x79, 0xe7, 0x26, 0x49, 0x18, 0xaf,
+	0x27, 0xbf, 0x92, 0x66, 0x8c, 0xdf, 0x61, 0x94, 0x99, 0xab, 0xd9, 0xfa, 0xf5, 0xb3, 0x8d, 0x79,
+	0x62, 0x3d, 0x7d, 0x44, 0x76, 0xd6, 0x93, 0xab, 0x53, 0xb8, 0xeb, 0x0e, 0xae, 0xbc, 0xbc, 0x7e,
+	0x79, 0x14, 0x2f, 0x48, 0x43, 0x80, 0xe1, 0x25, 0x32, 0x6d, 0x2d, 0x14, 0x30, 0xc8, 0x30, 0x6e,
+	0x6d, 0xb6, 0xbd, 0xce, 0x66, 0x9f, 0x3a, 0x76, 0x52, 0xa1, 0xb7, 0x8e, 0xd0, 0x57, 0xe4, 0x31,
+	0x64, 0x45, 0x0a, 0xcc, 0xc8, 0x21, 0x0a, 0x56, 0x00, 0x57, 0x7a, 0xa9, 0x6d, 0x59, 0xad, 0x69,
+	0x17, 0x9c, 0x56, 0xbc, 0x57, 0xe1, 0x85, 0x7a, 0x48, 0x9a, 0xee, 0x76, 0x45, 0x20, 0xe2, 0x0c,
+	0x2f, 0x5d, 0x2e, 0x62, 0xf7, 0xd8, 0xb0, 0xb4, 0x6b, 0xe1, 0xea, 0x6e, 0x1d, 0x92, 0x66, 0x92,
+	0xc9, 0x01, 0x64, 0x76, 0x8b, 0x5c, 0x24, 0x0c, 0xe2, 0x58, 0xa1, 0xd6, 0xad, 0x7b, 0xd5, 0x5f,
+	0xeb, 0x37, 0x1c, 0x3d, 0x71, 0xf0, 0xb5, 0x63, 0x95, 0xa5, 0x47, 0x03, 0x81, 0x86, 0xe5, 0x20,
+	0x20, 0x41, 0xb5, 0xb4, 0xee, 0x3b, 0xcb, 0xd1, 0x4f, 0x0e, 0xce, 0xad, 0xe3, 0x77, 0x67, 0x53,
+	0xdf, 0x3b, 0x9f, 0xfa, 0xde, 0xbf, 0xa9, 0xef, 0xfd, 0x9e, 0xf9, 0xb5, 0xf3, 0x99, 0x5f, 0xfb,
+	0x33, 0xf3, 0x6b, 0xdf, 0xf6, 0x13, 0x6e, 0xd2, 0xd1, 0x20, 0x88, 0x64, 0x1e, 0x56, 0x43, 0xb5,
+	0x5f, 0x28, 0xf9, 0x03, 0x23, 0x63, 0x5f, 0xaa, 0xb9, 0x1b, 0xcf, 0x47, 0xd0, 0x4c, 0x0a, 0xd4,
+	0x83, 0xba, 0x1d, 0xad, 0x97, 0xff, 0x07, 0x00, 0x8d, 0xbe, 0x00, 0xbd, 0x9d, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubnetManagerAddress) > 0 {
+		i -= len(m.SubnetManagerAddress)
+		copy(dAtA[i:], m.SubnetManagerAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SubnetManagerAddress)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.GlobalStakingAddress) > 0 {
+		i -= len(m.GlobalStakingAddress)
+		copy(dAtA[i:], m.GlobalStakingAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.GlobalStakingAddress)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.PriceCandleRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PriceCandleRetention))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AlphaTokenPairsEnabled {
+		i--
+		if m.AlphaTokenPairsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.NativeStakeEnabled {
+		i--
+		if m.NativeStakeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ValidatorTakeCooldown != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ValidatorTakeCooldown))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.DefaultValidatorTake.Size()
+		i -= size
+		if _, err := m.DefaultValidatorTake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxValidatorTake.Size()
+		i -= size
+		if _, err := m.MaxValidatorTake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinValidatorTake.Size()
+		i -= size
+		if _, err := m.MinValidatorTake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxChildKeyTake.Size()
+		i -= size
+		if _, err := m.MaxChildKeyTake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ChildKeyCooldown != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChildKeyCooldown))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PriceHistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PriceHistoryRetention))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PriceHistoryRetention != 0 {
+		n += 1 + sovParams(uint64(m.PriceHistoryRetention))
+	}
+	if m.ChildKeyCooldown != 0 {
+		n += 1 + sovParams(uint64(m.ChildKeyCooldown))
+	}
+	l = m.MaxChildKeyTake.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinValidatorTake.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxValidatorTake.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.DefaultValidatorTake.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ValidatorTakeCooldown != 0 {
+		n += 1 + sovParams(uint64(m.ValidatorTakeCooldown))
+	}
+	if m.NativeStakeEnabled {
+		n += 2
+	}
+	if m.AlphaTokenPairsEnabled {
+		n += 2
+	}
+	if m.PriceCandleRetention != 0 {
+		n += 1 + sovParams(uint64(m.PriceCandleRetention))
+	}
+	l = len(m.GlobalStakingAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.SubnetManagerAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryRetention", wireType)
+			}
+			m.PriceHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildKeyCooldown", wireType)
+			}
+			m.ChildKeyCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChildKeyCooldown |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChildKeyTake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChildKeyTake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidatorTake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidatorTake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorTake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorTake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValidatorTake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultValidatorTake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorTakeCooldown", wireType)
+			}
+			m.ValidatorTakeCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorTakeCooldown |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeStakeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NativeStakeEnabled = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlphaTokenPairsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AlphaTokenPairsEnabled = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCandleRetention", wireType)
+			}
+			m.PriceCandleRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceCandleRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalStakingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalStakingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubnetManagerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubnetManagerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
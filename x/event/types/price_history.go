@@ -0,0 +1,19 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// PriceSnapshot holds the price and emission state of a subnet, taken when its
+// pending emission is drained at the end of a tempo.
+type PriceSnapshot struct {
+	Netuid          uint16         `json:"netuid" yaml:"netuid"`
+	Height          int64          `json:"height" yaml:"height"`
+	Price           math.LegacyDec `json:"price" yaml:"price"`
+	MovingPrice     math.LegacyDec `json:"moving_price" yaml:"moving_price"`
+	TaoIn           math.Int       `json:"tao_in" yaml:"tao_in"`     // HETU reserve of the pool
+	AlphaIn         math.Int       `json:"alpha_in" yaml:"alpha_in"` // alpha reserve of the pool
+	AlphaOut        math.Int       `json:"alpha_out" yaml:"alpha_out"`
+	PendingEmission math.Int       `json:"pending_emission" yaml:"pending_emission"` // alpha drained at the tempo
+	OwnerCut        math.Int       `json:"owner_cut" yaml:"owner_cut"`
+}
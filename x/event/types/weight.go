@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // ValidatorWeight represents a validator's weight assignments to other validators in a subnet
@@ -39,3 +40,20 @@ func (vw *ValidatorWeight) SetWeight(addr sdk.AccAddress, weight uint64) {
 	}
 	vw.Weights[addr.String()] = weight
 }
+
+// ValidateDestWeights checks that the destinations of the weights are distinct
+// hex addresses
+func ValidateDestWeights(weights []DestWeight) error {
+	seen := make(map[string]bool, len(weights))
+	for _, w := range weights {
+		if !common.IsHexAddress(w.Dest) {
+			return fmt.Errorf("invalid destination address %s", w.Dest)
+		}
+		dest := common.HexToAddress(w.Dest).Hex()
+		if seen[dest] {
+			return fmt.Errorf("duplicate destination %s", dest)
+		}
+		seen[dest] = true
+	}
+	return nil
+}
@@ -57,17 +57,22 @@ func (k *Keeper) TxConfig(ctx sdk.Context, txHash common.Hash) statedb.TxConfig
 	)
 }
 
-// VMConfig creates an EVM configuration from the debug setting and the extra EIPs enabled on the
-// module parameters. The config generated uses the default JumpTable from the EVM.
+// VMConfig creates an EVM configuration from the debug setting and the extra EIPs enabled by the
+// fork schedule and the module parameters. The config generated uses the default JumpTable from the EVM.
 func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig, tracer vm.EVMLogger) vm.Config {
 	noBaseFee := true
 	if types.IsLondon(cfg.ChainConfig, ctx.BlockHeight()) {
 		noBaseFee = k.feeMarketKeeper.GetParams(ctx).NoBaseFee
 	}
 
+	// the ERC-7562 tracer checks the rules on every opcode, the other tracers keep
+	// the call level tracing they always had
+	_, erc7562 := tracer.(*types.ERC7562Tracer)
+
 	return vm.Config{
+		Debug:     erc7562,
 		Tracer:    tracer,
 		NoBaseFee: noBaseFee,
-		ExtraEips: cfg.Params.EIPs(),
+		ExtraEips: cfg.Params.ActiveEIPs(big.NewInt(ctx.BlockHeight())),
 	}
 }
@@ -340,6 +340,14 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 	}
 
 	stateDB := statedb.New(ctx, k, txConfig)
+	if len(cfg.Overrides) > 0 {
+		if commit {
+			return nil, errorsmod.Wrap(types.ErrInvalidState, "state overrides cannot be committed")
+		}
+		if err := stateDB.ApplyOverrides(cfg.Overrides); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidState, err.Error())
+		}
+	}
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	leftoverGas := msg.Gas()
@@ -372,8 +380,14 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
-	if rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil); rules.IsBerlin {
-		stateDB.PrepareAccessList(msg.From(), msg.To(), []common.Address{}, msg.AccessList())
+	height := big.NewInt(ctx.BlockHeight())
+	rules := cfg.ChainConfig.Rules(height, cfg.ChainConfig.MergeNetsplitBlock != nil)
+	// the Shanghai and Cancun EIPs implemented by the module only apply from its
+	// EIP activation block
+	rules.IsShanghai = cfg.Params.ChainConfig.IsShanghai(height)
+	stateDB.Prepare(rules, msg.From(), cfg.CoinBase, msg.To(), []common.Address{}, msg.AccessList())
+	if cfg.Params.ChainConfig.IsCancun(height) {
+		stateDB.EnableEIP6780()
 	}
 
 	if contractCreation {
@@ -85,5 +85,8 @@ func TestMigrate(t *testing.T) {
 	require.Equal(t, legacySubspace.ps.EnableCreate, params.EnableCreate)
 	require.Equal(t, legacySubspace.ps.AllowUnprotectedTxs, params.AllowUnprotectedTxs)
 	require.Equal(t, legacySubspace.ps.ExtraEIPs, params.ExtraEIPs.EIPs)
-	require.EqualValues(t, legacySubspace.ps.ChainConfig, params.V4ChainConfig)
+	// the v4 layout has no fields for the forks added to ChainConfig after v4
+	expChainCfg := legacySubspace.ps.ChainConfig
+	expChainCfg.EIPActivationBlock = nil
+	require.Equal(t, cdc.MustMarshal(&expChainCfg), cdc.MustMarshal(&params.V4ChainConfig))
 }
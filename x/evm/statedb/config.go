@@ -59,4 +59,7 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// Overrides are applied to the state before executing a message, they are only
+	// set on queries such as eth_call and debug_traceCall.
+	Overrides types.StateOverride
 }
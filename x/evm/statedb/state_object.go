@@ -78,6 +78,11 @@ type stateObject struct {
 	// flags
 	dirtyCode bool
 	suicided  bool
+	// newContract is true if the account has been created in the current transaction
+	newContract bool
+	// storageCleared is true once the storage has been replaced by a state
+	// override, the committed slots are then read as empty
+	storageCleared bool
 }
 
 // newObject creates a state object.
@@ -215,6 +220,9 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
+	if s.storageCleared {
+		return common.Hash{}
+	}
 	// If no live objects are available, load it from keeper
 	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
 	s.originStorage[key] = value
@@ -248,3 +256,11 @@ func (s *stateObject) SetState(key common.Hash, value common.Hash) {
 func (s *stateObject) setState(key, value common.Hash) {
 	s.dirtyStorage[key] = value
 }
+
+// clearStorage drops the storage of the account without loading it, so that
+// every committed slot reads as empty. It is not journaled.
+func (s *stateObject) clearStorage() {
+	s.originStorage = make(Storage)
+	s.dirtyStorage = make(Storage)
+	s.storageCleared = true
+}
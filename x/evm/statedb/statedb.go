@@ -27,6 +27,8 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/hetu-project/hetu/v1/x/evm/types"
 )
 
 // revision is the identifier of a version of state.
@@ -69,6 +71,20 @@ type StateDB struct {
 
 	// Transient storage
 	transientStorage transientStorage
+
+	// eip6780 restricts SELFDESTRUCT to the contracts created in the same
+	// transaction, it is enabled from the Cancun fork.
+	eip6780 bool
+
+	// lastCredit is the last balance credit. The interpreter credits the
+	// beneficiary of a SELFDESTRUCT right before calling Suicide.
+	lastCredit balanceCredit
+}
+
+// balanceCredit is an amount added to the balance of an account.
+type balanceCredit struct {
+	beneficiary common.Address
+	amount      *big.Int
 }
 
 // New creates a new state from a given trie.
@@ -80,10 +96,18 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 		journal:      newJournal(),
 		accessList:   newAccessList(),
 
+		transientStorage: newTransientStorage(),
+
 		txConfig: txConfig,
 	}
 }
 
+// EnableEIP6780 applies the EIP-6780 SELFDESTRUCT semantics for the rest of the
+// transaction.
+func (s *StateDB) EnableEIP6780() {
+	s.eip6780 = true
+}
+
 // Keeper returns the underlying `Keeper`
 func (s *StateDB) Keeper() Keeper {
 	return s.keeper
@@ -280,6 +304,8 @@ func (s *StateDB) createObject(addr common.Address) (newobj, prev *stateObject)
 // Carrying over the balance ensures that Ether doesn't disappear.
 func (s *StateDB) CreateAccount(addr common.Address) {
 	newObj, prev := s.createObject(addr)
+	// the previous object is restored on revert, so the flag needs no journal entry
+	newObj.newContract = true
 	if prev != nil {
 		newObj.setBalance(prev.account.Balance)
 	}
@@ -291,6 +317,14 @@ func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.
 	if so == nil {
 		return nil
 	}
+	if so.storageCleared {
+		for _, key := range so.dirtyStorage.SortedKeys() {
+			if value := so.dirtyStorage[key]; value != (common.Hash{}) && !cb(key, value) {
+				break
+			}
+		}
+		return nil
+	}
 	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
 		if value, dirty := so.dirtyStorage[key]; dirty {
 			return cb(key, value)
@@ -316,6 +350,7 @@ func (s *StateDB) AddBalance(addr common.Address, amount *big.Int) {
 	stateObject := s.getOrNewStateObject(addr)
 	if stateObject != nil {
 		stateObject.AddBalance(amount)
+		s.lastCredit = balanceCredit{beneficiary: addr, amount: new(big.Int).Set(amount)}
 	}
 }
 
@@ -361,6 +396,11 @@ func (s *StateDB) Suicide(addr common.Address) bool {
 	if stateObject == nil {
 		return false
 	}
+	if s.eip6780 && !stateObject.newContract {
+		// EIP-6780: the account is kept and only its balance is sent to the beneficiary
+		s.transferSuicidedBalance(stateObject)
+		return true
+	}
 	s.journal.append(suicideChange{
 		account:     &addr,
 		prev:        stateObject.suicided,
@@ -372,6 +412,55 @@ func (s *StateDB) Suicide(addr common.Address) bool {
 	return true
 }
 
+// transferSuicidedBalance completes the balance transfer of a SELFDESTRUCT that
+// does not delete the account. The interpreter has already credited the
+// beneficiary with the balance, so the account is debited unless it is its own
+// beneficiary, in which case the credit is taken back.
+func (s *StateDB) transferSuicidedBalance(stateObject *stateObject) {
+	credit := s.lastCredit
+	s.lastCredit = balanceCredit{}
+	if credit.amount != nil && credit.beneficiary == stateObject.address {
+		stateObject.SubBalance(credit.amount)
+		return
+	}
+	stateObject.SubBalance(stateObject.Balance())
+}
+
+// ApplyOverrides replaces the accounts state with the given overrides. It is
+// meant to be used on a StateDB that is never committed, such as eth_call, as a
+// replaced storage is only cleared in memory.
+func (s *StateDB) ApplyOverrides(overrides types.StateOverride) error {
+	if err := overrides.Validate(); err != nil {
+		return err
+	}
+	for addr, account := range overrides {
+		if account.Nonce != nil {
+			s.SetNonce(addr, uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			s.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil && *account.Balance != nil {
+			stateObject := s.getOrNewStateObject(addr)
+			stateObject.SetBalance(new(big.Int).Set((*big.Int)(*account.Balance)))
+		}
+		if account.State != nil {
+			// the whole storage is replaced, the existing slots are dropped lazily
+			// instead of iterating over the account storage
+			s.getOrNewStateObject(addr).clearStorage()
+			for key, value := range *account.State {
+				s.SetState(addr, key, value)
+			}
+		}
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				s.SetState(addr, key, value)
+			}
+		}
+	}
+	return nil
+}
+
 // SetTransientState sets transient storage for a given account. It
 // adds the change to the journal so that it can be rolled back
 // to its previous value if there is a revert.
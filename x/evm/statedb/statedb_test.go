@@ -6,10 +6,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/hetu-project/hetu/v1/x/evm/statedb"
+	"github.com/hetu-project/hetu/v1/x/evm/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -107,6 +109,152 @@ func (suite *StateDBTestSuite) TestAccount() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestSuicideEIP6780() {
+	key1 := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+	testCases := []struct {
+		name     string
+		malleate func(*statedb.StateDB)
+	}{
+		{"existing contract keeps its code and state", func(db *statedb.StateDB) {
+			// emulate the interpreter: credit the beneficiary, then call Suicide
+			db.AddBalance(address2, db.GetBalance(address))
+			suite.Require().True(db.Suicide(address))
+			suite.Require().False(db.HasSuicided(address))
+			suite.Require().NoError(db.Commit())
+
+			db = statedb.New(sdk.Context{}, db.Keeper(), emptyTxConfig)
+			suite.Require().True(db.Exist(address))
+			suite.Require().Zero(db.GetBalance(address).Sign())
+			suite.Require().Equal(big.NewInt(100), db.GetBalance(address2))
+			suite.Require().Equal([]byte("hello world"), db.GetCode(address))
+			suite.Require().Equal(value1, db.GetState(address, key1))
+		}},
+		{"existing contract as its own beneficiary", func(db *statedb.StateDB) {
+			db.AddBalance(address, db.GetBalance(address))
+			suite.Require().True(db.Suicide(address))
+			suite.Require().False(db.HasSuicided(address))
+			suite.Require().Equal(big.NewInt(100), db.GetBalance(address))
+		}},
+		{"existing contract without balance as its own beneficiary", func(db *statedb.StateDB) {
+			db.SubBalance(address, db.GetBalance(address))
+			db.AddBalance(address2, big.NewInt(10))
+			db.AddBalance(address, db.GetBalance(address))
+			suite.Require().True(db.Suicide(address))
+			suite.Require().Zero(db.GetBalance(address).Sign())
+			suite.Require().Equal(big.NewInt(10), db.GetBalance(address2))
+		}},
+		{"contract created in the same transaction is deleted", func(db *statedb.StateDB) {
+			db.CreateAccount(address3)
+			db.SetCode(address3, []byte("hello world"))
+			db.AddBalance(address3, big.NewInt(10))
+
+			db.AddBalance(address2, db.GetBalance(address3))
+			suite.Require().True(db.Suicide(address3))
+			suite.Require().True(db.HasSuicided(address3))
+			suite.Require().NoError(db.Commit())
+
+			db = statedb.New(sdk.Context{}, db.Keeper(), emptyTxConfig)
+			suite.Require().False(db.Exist(address3))
+			suite.Require().Equal(big.NewInt(10), db.GetBalance(address2))
+		}},
+		{"revert", func(db *statedb.StateDB) {
+			rev := db.Snapshot()
+			db.AddBalance(address2, db.GetBalance(address))
+			suite.Require().True(db.Suicide(address))
+			db.RevertToSnapshot(rev)
+			suite.Require().Equal(big.NewInt(100), db.GetBalance(address))
+			suite.Require().Zero(db.GetBalance(address2).Sign())
+		}},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			keeper := NewMockKeeper()
+			db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+			db.CreateAccount(address)
+			db.SetCode(address, []byte("hello world"))
+			db.AddBalance(address, big.NewInt(100))
+			db.SetState(address, key1, value1)
+			suite.Require().NoError(db.Commit())
+
+			db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+			db.EnableEIP6780()
+			tc.malleate(db)
+		})
+	}
+}
+
+func (suite *StateDBTestSuite) TestTransientStorage() {
+	key := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+	value2 := common.BigToHash(big.NewInt(3))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	db.SetTransientState(address, key, value1)
+	rev := db.Snapshot()
+	db.SetTransientState(address, key, value2)
+	suite.Require().Equal(value2, db.GetTransientState(address, key))
+	db.RevertToSnapshot(rev)
+	suite.Require().Equal(value1, db.GetTransientState(address, key))
+
+	// transient storage is never persisted
+	suite.Require().NoError(db.Commit())
+	suite.Require().Empty(keeper.accounts)
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+}
+
+func (suite *StateDBTestSuite) TestApplyOverrides() {
+	key1 := common.BigToHash(big.NewInt(1))
+	key2 := common.BigToHash(big.NewInt(2))
+	value1 := common.BigToHash(big.NewInt(10))
+	value2 := common.BigToHash(big.NewInt(20))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetState(address, key1, value1)
+	db.SetState(address2, key1, value1)
+	suite.Require().NoError(db.Commit())
+
+	nonce := hexutil.Uint64(7)
+	code := hexutil.Bytes{0x60, 0x00}
+	balance := (*hexutil.Big)(big.NewInt(100))
+	state := map[common.Hash]common.Hash{key2: value2}
+	stateDiff := map[common.Hash]common.Hash{key2: value2}
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().Error(db.ApplyOverrides(types.StateOverride{
+		address: {State: &state, StateDiff: &stateDiff},
+	}))
+
+	suite.Require().NoError(db.ApplyOverrides(types.StateOverride{
+		address:  {Nonce: &nonce, Code: &code, Balance: &balance, State: &state},
+		address2: {StateDiff: &stateDiff},
+	}))
+	suite.Require().Equal(uint64(7), db.GetNonce(address))
+	suite.Require().Equal([]byte(code), db.GetCode(address))
+	suite.Require().Equal(big.NewInt(100), db.GetBalance(address))
+
+	// state replaces the whole storage, stateDiff only the given slots
+	suite.Require().Equal(common.Hash{}, db.GetState(address, key1))
+	suite.Require().Equal(common.Hash{}, db.GetCommittedState(address, key1))
+	suite.Require().Equal(value2, db.GetState(address, key2))
+	suite.Require().Equal(value1, db.GetState(address2, key1))
+	suite.Require().Equal(value2, db.GetState(address2, key2))
+
+	// the replaced storage only holds the overridden slots
+	storage := make(map[common.Hash]common.Hash)
+	suite.Require().NoError(db.ForEachStorage(address, func(key, value common.Hash) bool {
+		storage[key] = value
+		return true
+	}))
+	suite.Require().Equal(state, storage)
+}
+
 func (suite *StateDBTestSuite) TestAccountOverride() {
 	keeper := NewMockKeeper()
 	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
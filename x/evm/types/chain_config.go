@@ -78,6 +78,7 @@ func DefaultChainConfig() ChainConfig {
 	mergeNetsplitBlock := math.ZeroInt()
 	shanghaiBlock := math.ZeroInt()
 	cancunBlock := math.ZeroInt()
+	eipActivationBlock := math.ZeroInt()
 
 	return ChainConfig{
 		HomesteadBlock:      &homesteadBlock,
@@ -99,6 +100,7 @@ func DefaultChainConfig() ChainConfig {
 		MergeNetsplitBlock:  &mergeNetsplitBlock,
 		ShanghaiBlock:       &shanghaiBlock,
 		CancunBlock:         &cancunBlock,
+		EIPActivationBlock:  &eipActivationBlock,
 	}
 }
 
@@ -175,6 +177,9 @@ func (cc ChainConfig) Validate() error {
 	if err := validateBlock(cc.CancunBlock); err != nil {
 		return errorsmod.Wrap(err, "CancunBlock")
 	}
+	if err := validateBlock(cc.EIPActivationBlock); err != nil {
+		return errorsmod.Wrap(err, "EIPActivationBlock")
+	}
 	// NOTE: chain ID is not needed to check config order
 	if err := cc.EthereumConfig(nil).CheckConfigForkOrder(); err != nil {
 		return errorsmod.Wrap(err, "invalid config fork order")
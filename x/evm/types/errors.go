@@ -42,6 +42,8 @@ const (
 	codeErrGasOverflow
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
+	codeErrBlobTxNotSupported
+	codeErrSetCodeTxNotSupported
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -91,6 +93,12 @@ var (
 
 	// ErrInvalidGasLimit returns an error if gas limit value is invalid
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
+
+	// ErrBlobTxNotSupported returns an error if an EIP-4844 blob transaction is submitted
+	ErrBlobTxNotSupported = errorsmod.Register(ModuleName, codeErrBlobTxNotSupported, "blob transactions are not supported")
+
+	// ErrSetCodeTxNotSupported returns an error if an EIP-7702 set code transaction is submitted
+	ErrSetCodeTxNotSupported = errorsmod.Register(ModuleName, codeErrSetCodeTxNotSupported, "set code transactions are not supported")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
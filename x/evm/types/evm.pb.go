@@ -159,6 +159,12 @@ type ChainConfig struct {
 	ShanghaiBlock *cosmossdk_io_math.Int `protobuf:"bytes,22,opt,name=shanghai_block,json=shanghaiBlock,proto3,customtype=cosmossdk.io/math.Int" json:"shanghai_block,omitempty" yaml:"shanghai_block"`
 	// cancun_block switch block (nil = no fork, 0 = already on cancun)
 	CancunBlock *cosmossdk_io_math.Int `protobuf:"bytes,23,opt,name=cancun_block,json=cancunBlock,proto3,customtype=cosmossdk.io/math.Int" json:"cancun_block,omitempty" yaml:"cancun_block"`
+	// eip_activation_block switch block (nil = not activated, 0 = activated at
+	// genesis) from which the Shanghai and Cancun EIPs implemented by the EVM
+	// module apply, on top of shanghai_block and cancun_block. Chains that ran
+	// before these EIPs were implemented activate them in an upgrade, so that
+	// past blocks replay with the rules they were produced with.
+	EIPActivationBlock *cosmossdk_io_math.Int `protobuf:"bytes,24,opt,name=eip_activation_block,json=eipActivationBlock,proto3,customtype=cosmossdk.io/math.Int" json:"eip_activation_block,omitempty" yaml:"eip_activation_block"`
 }
 
 func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
@@ -689,108 +695,110 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1608 bytes of a gzipped FileDescriptorProto
+	// 1642 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0x4d, 0x6f, 0xe3, 0xb8,
-	0x19, 0x4e, 0x62, 0x25, 0x91, 0x69, 0xc7, 0xd6, 0x30, 0x4e, 0xd6, 0x3b, 0x83, 0x46, 0xa9, 0x4e,
-	0x29, 0xb0, 0x9b, 0x4c, 0x32, 0x4d, 0x77, 0xb0, 0x8b, 0xb6, 0x18, 0xcf, 0xcc, 0xb6, 0x49, 0xa7,
-	0xdb, 0x01, 0x93, 0x45, 0xd1, 0xa2, 0x85, 0x40, 0x4b, 0x5c, 0x59, 0x13, 0x49, 0x34, 0x48, 0xca,
-	0x63, 0xf7, 0x17, 0xb4, 0xe8, 0xa5, 0x3f, 0x61, 0x7f, 0xce, 0xa2, 0xa7, 0x3d, 0x16, 0x3d, 0x08,
-	0x45, 0xe6, 0x96, 0xa3, 0xef, 0x05, 0x0a, 0x7e, 0x58, 0xfe, 0x48, 0xea, 0xf5, 0xc9, 0x7c, 0xde,
-	0x8f, 0xe7, 0x21, 0x5f, 0xbe, 0x32, 0x49, 0xf0, 0x98, 0x88, 0x1e, 0x61, 0x69, 0x9c, 0x89, 0x13,
-	0x32, 0x48, 0x4f, 0x06, 0xa7, 0xf2, 0xe7, 0xb8, 0xcf, 0xa8, 0xa0, 0xd0, 0x29, 0x7d, 0xc7, 0xd2,
-	0x38, 0x38, 0x7d, 0xdc, 0x8a, 0x68, 0x44, 0x95, 0xf3, 0x44, 0x8e, 0x74, 0x9c, 0xf7, 0xb7, 0x0a,
-	0xd8, 0x7a, 0x8b, 0x19, 0x4e, 0x39, 0x3c, 0x05, 0x55, 0x32, 0x48, 0xfd, 0x90, 0x64, 0x34, 0x6d,
-	0xaf, 0x1f, 0xae, 0x1f, 0x55, 0x3b, 0xad, 0x71, 0xe1, 0x3a, 0x23, 0x9c, 0x26, 0x9f, 0x7b, 0xa5,
-	0xcb, 0x43, 0x36, 0x19, 0xa4, 0xaf, 0xe4, 0x10, 0xfe, 0x1c, 0xec, 0x90, 0x0c, 0x77, 0x13, 0xe2,
-	0x07, 0x8c, 0x60, 0x41, 0xda, 0x1b, 0x87, 0xeb, 0x47, 0x76, 0xa7, 0x3d, 0x2e, 0xdc, 0x96, 0x49,
-	0x9b, 0x75, 0x7b, 0xa8, 0xae, 0xf1, 0x4b, 0x05, 0xe1, 0x67, 0xa0, 0x36, 0xf1, 0xe3, 0x24, 0x69,
-	0x57, 0x54, 0xf2, 0xfe, 0xb8, 0x70, 0xe1, 0x7c, 0x32, 0x4e, 0x12, 0x0f, 0x01, 0x93, 0x8a, 0x93,
-	0x04, 0xbe, 0x00, 0x80, 0x0c, 0x05, 0xc3, 0x3e, 0x89, 0xfb, 0xbc, 0x6d, 0x1d, 0x56, 0x8e, 0x2a,
-	0x1d, 0xef, 0xb6, 0x70, 0xab, 0xaf, 0xa5, 0xf5, 0xf5, 0xc5, 0x5b, 0x3e, 0x2e, 0xdc, 0x47, 0x86,
-	0xa4, 0x0c, 0xf4, 0x50, 0x55, 0x81, 0xd7, 0x71, 0x9f, 0xc3, 0x3f, 0x83, 0x7a, 0xd0, 0xc3, 0x71,
-	0xe6, 0x07, 0x34, 0xfb, 0x26, 0x8e, 0xda, 0x9b, 0x87, 0xeb, 0x47, 0xb5, 0xb3, 0x1f, 0x1d, 0x2f,
-	0xd6, 0xed, 0xf8, 0xa5, 0x8c, 0x7a, 0xa9, 0x82, 0x3a, 0x4f, 0xbe, 0x2b, 0xdc, 0xb5, 0x71, 0xe1,
-	0xee, 0x6a, 0xea, 0x59, 0x02, 0x0f, 0xd5, 0x82, 0x69, 0x24, 0x3c, 0x03, 0x7b, 0x38, 0x49, 0xe8,
-	0x7b, 0x3f, 0xcf, 0x64, 0xa1, 0x49, 0x20, 0x48, 0xe8, 0x8b, 0x21, 0x6f, 0x6f, 0xc9, 0x45, 0xa2,
-	0x5d, 0xe5, 0xfc, 0x7a, 0xea, 0xbb, 0x1e, 0x72, 0xef, 0xbf, 0x0d, 0x50, 0x9b, 0x51, 0x83, 0x7f,
-	0x02, 0xcd, 0x1e, 0x4d, 0x09, 0x17, 0x04, 0x87, 0x7e, 0x37, 0xa1, 0xc1, 0x8d, 0xd9, 0x96, 0x67,
-	0xff, 0x2e, 0xdc, 0xbd, 0x80, 0xf2, 0x94, 0x72, 0x1e, 0xde, 0x1c, 0xc7, 0xf4, 0x24, 0xc5, 0xa2,
-	0x77, 0x7c, 0x91, 0x89, 0x71, 0xe1, 0xee, 0xeb, 0xb9, 0x2d, 0x64, 0x7a, 0xa8, 0x51, 0x5a, 0x3a,
-	0xd2, 0x00, 0x7b, 0xa0, 0x11, 0x62, 0xea, 0x7f, 0x43, 0xd9, 0x8d, 0x21, 0xdf, 0x50, 0xe4, 0x9d,
-	0xff, 0x4b, 0x7e, 0x5b, 0xb8, 0xf5, 0x57, 0x2f, 0x7e, 0xf7, 0x25, 0x65, 0x37, 0x8a, 0x62, 0x5c,
-	0xb8, 0x7b, 0x5a, 0x6c, 0x9e, 0xc8, 0x43, 0xf5, 0x10, 0xd3, 0x32, 0x0c, 0xfe, 0x1e, 0x38, 0x65,
-	0x00, 0xcf, 0xfb, 0x7d, 0xca, 0x84, 0xd9, 0xeb, 0x4f, 0x6f, 0x0b, 0xb7, 0x61, 0x28, 0xaf, 0xb4,
-	0x67, 0x5c, 0xb8, 0x1f, 0x2d, 0x90, 0x9a, 0x1c, 0x0f, 0x35, 0x0c, 0xad, 0x09, 0x85, 0x5d, 0x50,
-	0x27, 0x71, 0xff, 0xf4, 0xfc, 0xa9, 0x59, 0x80, 0xa5, 0x16, 0xf0, 0xcb, 0x65, 0x0b, 0xa8, 0xbd,
-	0xbe, 0x78, 0x7b, 0x7a, 0xfe, 0x74, 0x32, 0x7f, 0xb3, 0x91, 0xb3, 0x2c, 0x1e, 0xaa, 0x69, 0xa8,
-	0x27, 0x7f, 0x01, 0x0c, 0xf4, 0x7b, 0x98, 0xf7, 0x54, 0x9b, 0x54, 0x3b, 0x47, 0xb7, 0x85, 0x0b,
-	0x34, 0xd3, 0xaf, 0x31, 0xef, 0x4d, 0xab, 0xde, 0x1d, 0xfd, 0x05, 0x67, 0x22, 0xce, 0xd3, 0x09,
-	0x17, 0xd0, 0xc9, 0x32, 0xaa, 0x9c, 0xee, 0xb9, 0x99, 0xee, 0xd6, 0xaa, 0xd3, 0x3d, 0x7f, 0x68,
-	0xba, 0xe7, 0xf3, 0xd3, 0xd5, 0x31, 0xa5, 0xc6, 0x73, 0xa3, 0xb1, 0xbd, 0xaa, 0xc6, 0xf3, 0x87,
-	0x34, 0x9e, 0xcf, 0x6b, 0xe8, 0x18, 0xd9, 0x97, 0x0b, 0xeb, 0x6c, 0xdb, 0x2b, 0xf7, 0xe5, 0xbd,
-	0x0a, 0x35, 0x4a, 0x8b, 0x66, 0xbf, 0x01, 0xad, 0x80, 0x66, 0x5c, 0x48, 0x5b, 0x46, 0xfb, 0x09,
-	0x31, 0x12, 0x55, 0x25, 0xf1, 0x7c, 0x99, 0xc4, 0x13, 0xf3, 0x59, 0x3e, 0x90, 0xee, 0xa1, 0xdd,
-	0x79, 0xb3, 0x16, 0xf3, 0x81, 0xd3, 0x27, 0x82, 0x30, 0xde, 0xcd, 0x59, 0x64, 0x84, 0x80, 0x12,
-	0xfa, 0xe9, 0x32, 0x21, 0xd3, 0xa1, 0x8b, 0xa9, 0x1e, 0x6a, 0x4e, 0x4d, 0x5a, 0xe0, 0x0f, 0xa0,
-	0x11, 0x4b, 0xd5, 0x6e, 0x9e, 0x18, 0xfa, 0x9a, 0xa2, 0x3f, 0x5b, 0x46, 0x6f, 0xbe, 0xaa, 0xf9,
-	0x44, 0x0f, 0xed, 0x4c, 0x0c, 0x9a, 0x3a, 0x04, 0x30, 0xcd, 0x63, 0xe6, 0x47, 0x09, 0x0e, 0x62,
-	0xc2, 0x0c, 0x7d, 0x5d, 0xd1, 0xff, 0x6c, 0x19, 0xfd, 0xc7, 0x9a, 0xfe, 0x7e, 0xb2, 0x87, 0x1c,
-	0x69, 0xfc, 0x95, 0xb6, 0x69, 0x95, 0x2b, 0x50, 0xef, 0x12, 0x96, 0xc4, 0x99, 0xe1, 0xdf, 0x51,
-	0xfc, 0x4f, 0x97, 0xf1, 0x9b, 0x0e, 0x9a, 0x4d, 0xf3, 0x50, 0x4d, 0xc3, 0x92, 0x34, 0xa1, 0x59,
-	0x48, 0x27, 0xa4, 0x8f, 0x56, 0x26, 0x9d, 0x4d, 0xf3, 0x50, 0x4d, 0x43, 0x4d, 0x1a, 0x81, 0x5d,
-	0xcc, 0x18, 0x7d, 0xbf, 0x50, 0x10, 0xa8, 0xb8, 0x3f, 0x5b, 0xc6, 0xfd, 0x58, 0x73, 0x3f, 0x90,
-	0xed, 0xa1, 0x47, 0xca, 0x3a, 0x57, 0x92, 0x10, 0xc0, 0x88, 0xe1, 0xd1, 0x82, 0x4e, 0x6b, 0xe5,
-	0xc2, 0xdf, 0x4f, 0xf6, 0x90, 0x23, 0x8d, 0x73, 0x2a, 0xef, 0x40, 0x2b, 0x25, 0x2c, 0x22, 0x7e,
-	0x46, 0x04, 0xef, 0x27, 0xb1, 0x30, 0x3a, 0x7b, 0x2b, 0x7f, 0x07, 0x0f, 0xa5, 0x7b, 0x08, 0x2a,
-	0xf3, 0x57, 0xc6, 0x5a, 0x76, 0x29, 0xef, 0xe1, 0x2c, 0xea, 0xe1, 0xd8, 0xa8, 0xec, 0xaf, 0xdc,
-	0xa5, 0xf3, 0x89, 0x1e, 0xda, 0x99, 0x18, 0xca, 0xad, 0x0e, 0x70, 0x16, 0xe4, 0x93, 0xad, 0xfe,
-	0x68, 0xe5, 0xad, 0x9e, 0x4d, 0x93, 0xa7, 0xab, 0x82, 0x8a, 0xf4, 0xd2, 0xb2, 0x1b, 0x4e, 0xf3,
-	0xd2, 0xb2, 0x9b, 0x8e, 0x73, 0x69, 0xd9, 0x8e, 0xf3, 0xe8, 0xd2, 0xb2, 0x77, 0x9d, 0x16, 0xda,
-	0x19, 0xd1, 0x84, 0xfa, 0x83, 0x67, 0x3a, 0x09, 0xd5, 0xc8, 0x7b, 0xcc, 0xcd, 0x1f, 0x0d, 0x6a,
-	0x04, 0x58, 0xe0, 0x64, 0xc4, 0x4d, 0x21, 0x90, 0xa3, 0xcb, 0x33, 0x73, 0x6c, 0x9d, 0x80, 0xcd,
-	0x2b, 0x21, 0xef, 0x25, 0x0e, 0xa8, 0xdc, 0x90, 0x91, 0x3e, 0x6c, 0x91, 0x1c, 0xc2, 0x16, 0xd8,
-	0x1c, 0xe0, 0x24, 0xd7, 0x17, 0x9c, 0x2a, 0xd2, 0xc0, 0x7b, 0x0b, 0x9a, 0xd7, 0x0c, 0x67, 0x1c,
-	0x07, 0x22, 0xa6, 0xd9, 0x1b, 0x1a, 0x71, 0x08, 0x81, 0xa5, 0xce, 0x09, 0x9d, 0xab, 0xc6, 0xf0,
-	0x27, 0xc0, 0x4a, 0x68, 0xc4, 0xdb, 0x1b, 0x87, 0x95, 0xa3, 0xda, 0xd9, 0xde, 0xfd, 0x2b, 0xc6,
-	0x1b, 0x1a, 0x21, 0x15, 0xe2, 0xfd, 0x73, 0x03, 0x54, 0xde, 0xd0, 0x08, 0xb6, 0xc1, 0x36, 0x0e,
-	0x43, 0x46, 0x38, 0x37, 0x4c, 0x13, 0x08, 0xf7, 0xc1, 0x96, 0xa0, 0xfd, 0x38, 0xd0, 0x74, 0x55,
-	0x64, 0x90, 0x14, 0x0e, 0xb1, 0xc0, 0xea, 0x60, 0xad, 0x23, 0x35, 0x86, 0x67, 0xa0, 0xae, 0x56,
-	0xe6, 0x67, 0x79, 0xda, 0x25, 0x4c, 0x9d, 0x8f, 0x56, 0xa7, 0x79, 0x57, 0xb8, 0x35, 0x65, 0xff,
-	0x4a, 0x99, 0xd1, 0x2c, 0x80, 0x9f, 0x80, 0x6d, 0x31, 0x9c, 0x3d, 0xeb, 0x76, 0xef, 0x0a, 0xb7,
-	0x29, 0xa6, 0xcb, 0x94, 0x47, 0x19, 0xda, 0x12, 0x43, 0x75, 0xa4, 0x9d, 0x00, 0x5b, 0x0c, 0xfd,
-	0x38, 0x0b, 0xc9, 0x50, 0x1d, 0x67, 0x56, 0xa7, 0x75, 0x57, 0xb8, 0xce, 0x4c, 0xf8, 0x85, 0xf4,
-	0xa1, 0x6d, 0x31, 0x54, 0x03, 0xf8, 0x09, 0x00, 0x7a, 0x4a, 0x4a, 0x41, 0x9f, 0x4e, 0x3b, 0x77,
-	0x85, 0x5b, 0x55, 0x56, 0xc5, 0x3d, 0x1d, 0x42, 0x0f, 0x6c, 0x6a, 0x6e, 0x5b, 0x71, 0xd7, 0xef,
-	0x0a, 0xd7, 0x4e, 0x68, 0xa4, 0x39, 0xb5, 0x4b, 0x96, 0x8a, 0x91, 0x94, 0x0e, 0x48, 0xa8, 0x8e,
-	0x08, 0x1b, 0x4d, 0xa0, 0xf7, 0xf7, 0x0d, 0x60, 0x5f, 0x0f, 0x11, 0xe1, 0x79, 0x22, 0xe0, 0x97,
-	0xc0, 0x09, 0x68, 0x26, 0x18, 0x0e, 0x84, 0x3f, 0x57, 0xda, 0xce, 0x93, 0xe9, 0x1f, 0xfa, 0x62,
-	0x84, 0x87, 0x9a, 0x13, 0xd3, 0x0b, 0x53, 0xff, 0x16, 0xd8, 0xec, 0x26, 0x94, 0xa6, 0xaa, 0x13,
-	0xea, 0x48, 0x03, 0x88, 0x54, 0xd5, 0xd4, 0x2e, 0x57, 0xd4, 0x45, 0xf2, 0xc7, 0xf7, 0x77, 0x79,
-	0xa1, 0x55, 0x3a, 0xfb, 0xe6, 0x32, 0xd9, 0xd0, 0xda, 0x26, 0xdf, 0x93, 0xb5, 0x55, 0xad, 0xe4,
-	0x80, 0x0a, 0x23, 0x42, 0x6d, 0x5a, 0x1d, 0xc9, 0x21, 0x7c, 0x0c, 0x6c, 0x46, 0x06, 0x84, 0x09,
-	0x12, 0xaa, 0xcd, 0xb1, 0x51, 0x89, 0xe1, 0xc7, 0xc0, 0x8e, 0x30, 0xf7, 0x73, 0x4e, 0x42, 0xbd,
-	0x13, 0x68, 0x3b, 0xc2, 0xfc, 0x6b, 0x4e, 0xc2, 0xcf, 0xad, 0xbf, 0x7e, 0xeb, 0xae, 0x79, 0x18,
-	0xd4, 0x5e, 0x04, 0x01, 0xe1, 0xfc, 0x3a, 0xef, 0x27, 0x64, 0x49, 0x87, 0x9d, 0x81, 0x3a, 0x17,
-	0x94, 0xe1, 0x88, 0xf8, 0x37, 0x64, 0x64, 0xfa, 0x4c, 0x77, 0x8d, 0xb1, 0xff, 0x86, 0x8c, 0x38,
-	0x9a, 0x05, 0x46, 0xe2, 0x5b, 0x0b, 0xd4, 0xae, 0x19, 0x0e, 0x88, 0xb9, 0xc0, 0xca, 0x5e, 0x95,
-	0x90, 0x19, 0x09, 0x83, 0xa4, 0xb6, 0x88, 0x53, 0x42, 0x73, 0x61, 0xbe, 0xa7, 0x09, 0x94, 0x19,
-	0x8c, 0x90, 0x21, 0x09, 0x54, 0x19, 0x2d, 0x64, 0x10, 0x3c, 0x07, 0x3b, 0x61, 0xcc, 0xd5, 0x6b,
-	0x80, 0x0b, 0x1c, 0xdc, 0xe8, 0xe5, 0x77, 0x9c, 0xbb, 0xc2, 0xad, 0x1b, 0xc7, 0x95, 0xb4, 0xa3,
-	0x39, 0x04, 0xbf, 0x00, 0xcd, 0x69, 0x9a, 0x9a, 0xad, 0xbe, 0x7f, 0x77, 0xe0, 0x5d, 0xe1, 0x36,
-	0xca, 0x50, 0xe5, 0x41, 0x0b, 0x58, 0xee, 0x74, 0x48, 0xba, 0x79, 0xa4, 0x9a, 0xcf, 0x46, 0x1a,
-	0x48, 0x6b, 0x12, 0xa7, 0xb1, 0x50, 0xcd, 0xb6, 0x89, 0x34, 0x80, 0x5f, 0x80, 0x2a, 0x1d, 0x10,
-	0xc6, 0xe2, 0x90, 0x70, 0x75, 0x81, 0xf8, 0xa1, 0xa7, 0x04, 0x9a, 0xc6, 0xcb, 0xc5, 0x99, 0x97,
-	0x4e, 0x4a, 0x52, 0xca, 0x46, 0xea, 0x8a, 0x60, 0x16, 0xa7, 0x1d, 0xbf, 0x55, 0x76, 0x34, 0x87,
-	0x60, 0x07, 0x40, 0x93, 0xc6, 0x88, 0xc8, 0x59, 0xe6, 0xab, 0xef, 0xbf, 0xae, 0x72, 0xd5, 0x57,
-	0xa8, 0xbd, 0x48, 0x39, 0x5f, 0x61, 0x81, 0xd1, 0x3d, 0x0b, 0xfc, 0x05, 0x80, 0x7a, 0x4f, 0xfc,
-	0x77, 0x9c, 0x96, 0x6f, 0x21, 0x7d, 0xc6, 0x2b, 0x7d, 0xed, 0x35, 0x73, 0x76, 0x34, 0xba, 0xe4,
-	0xd4, 0xac, 0xe2, 0xd2, 0xb2, 0x2d, 0x67, 0xf3, 0xd2, 0xb2, 0xb7, 0x1d, 0xbb, 0xac, 0x9f, 0x59,
-	0x05, 0xda, 0x9d, 0xe0, 0x99, 0xe9, 0x75, 0x2e, 0xbe, 0xbb, 0x3d, 0x58, 0xff, 0xfe, 0xf6, 0x60,
-	0xfd, 0x3f, 0xb7, 0x07, 0xeb, 0xff, 0xf8, 0x70, 0xb0, 0xf6, 0xfd, 0x87, 0x83, 0xb5, 0x7f, 0x7d,
-	0x38, 0x58, 0xfb, 0xe3, 0x49, 0x14, 0x8b, 0x5e, 0xde, 0x3d, 0x0e, 0x68, 0x7a, 0xd2, 0x23, 0x22,
-	0xff, 0xb4, 0xcf, 0xe8, 0x3b, 0x12, 0x08, 0x0d, 0x7a, 0x79, 0x57, 0x3e, 0x70, 0x87, 0xea, 0xa5,
-	0x2b, 0x46, 0x7d, 0xc2, 0xbb, 0x5b, 0xea, 0x05, 0xfb, 0xec, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x69, 0xbb, 0xb1, 0xae, 0x07, 0x0f, 0x00, 0x00,
+	0x19, 0x4e, 0x62, 0x27, 0x91, 0x69, 0xc7, 0xd6, 0x30, 0x4e, 0xd6, 0x3b, 0x83, 0x46, 0xa9, 0x4e,
+	0x29, 0xba, 0x9b, 0x4c, 0x32, 0x4d, 0x77, 0xb0, 0x8b, 0xb6, 0x88, 0x67, 0xb2, 0x6d, 0xd2, 0xe9,
+	0x34, 0x60, 0xb2, 0x28, 0x5a, 0xb4, 0x10, 0x68, 0x89, 0x2b, 0x6b, 0x22, 0x89, 0x06, 0x49, 0x79,
+	0xec, 0x9e, 0xf6, 0xd8, 0xa2, 0x97, 0xfe, 0x84, 0xfd, 0x39, 0x8b, 0x9e, 0xe6, 0x58, 0xf4, 0x20,
+	0x14, 0x99, 0x5b, 0x8e, 0xfe, 0x05, 0x05, 0x3f, 0x2c, 0x7f, 0x24, 0x35, 0x7c, 0x32, 0x9f, 0xf7,
+	0xe3, 0x79, 0x48, 0xbe, 0xaf, 0x4c, 0x12, 0x3c, 0x25, 0xa2, 0x4b, 0x58, 0x12, 0xa5, 0xe2, 0x88,
+	0xf4, 0x93, 0xa3, 0xfe, 0xb1, 0xfc, 0x39, 0xec, 0x31, 0x2a, 0x28, 0xb4, 0x0b, 0xdf, 0xa1, 0x34,
+	0xf6, 0x8f, 0x9f, 0x36, 0x43, 0x1a, 0x52, 0xe5, 0x3c, 0x92, 0x23, 0x1d, 0xe7, 0xfe, 0xbd, 0x04,
+	0x36, 0xae, 0x30, 0xc3, 0x09, 0x87, 0xc7, 0xa0, 0x42, 0xfa, 0x89, 0x17, 0x90, 0x94, 0x26, 0xad,
+	0xd5, 0xfd, 0xd5, 0x83, 0x4a, 0xbb, 0x39, 0xca, 0x1d, 0x7b, 0x88, 0x93, 0xf8, 0x4b, 0xb7, 0x70,
+	0xb9, 0xc8, 0x22, 0xfd, 0xe4, 0xb5, 0x1c, 0xc2, 0x5f, 0x80, 0x2d, 0x92, 0xe2, 0x4e, 0x4c, 0x3c,
+	0x9f, 0x11, 0x2c, 0x48, 0x6b, 0x6d, 0x7f, 0xf5, 0xc0, 0x6a, 0xb7, 0x46, 0xb9, 0xd3, 0x34, 0x69,
+	0xd3, 0x6e, 0x17, 0xd5, 0x34, 0x7e, 0xa5, 0x20, 0xfc, 0x02, 0x54, 0xc7, 0x7e, 0x1c, 0xc7, 0xad,
+	0x92, 0x4a, 0xde, 0x1d, 0xe5, 0x0e, 0x9c, 0x4d, 0xc6, 0x71, 0xec, 0x22, 0x60, 0x52, 0x71, 0x1c,
+	0xc3, 0x33, 0x00, 0xc8, 0x40, 0x30, 0xec, 0x91, 0xa8, 0xc7, 0x5b, 0xe5, 0xfd, 0xd2, 0x41, 0xa9,
+	0xed, 0xde, 0xe5, 0x4e, 0xe5, 0x5c, 0x5a, 0xcf, 0x2f, 0xae, 0xf8, 0x28, 0x77, 0x9e, 0x18, 0x92,
+	0x22, 0xd0, 0x45, 0x15, 0x05, 0xce, 0xa3, 0x1e, 0x87, 0x7f, 0x01, 0x35, 0xbf, 0x8b, 0xa3, 0xd4,
+	0xf3, 0x69, 0xfa, 0x6d, 0x14, 0xb6, 0xd6, 0xf7, 0x57, 0x0f, 0xaa, 0x27, 0x3f, 0x3a, 0x9c, 0xdf,
+	0xb7, 0xc3, 0x57, 0x32, 0xea, 0x95, 0x0a, 0x6a, 0x3f, 0xfb, 0x21, 0x77, 0x56, 0x46, 0xb9, 0xb3,
+	0xad, 0xa9, 0xa7, 0x09, 0x5c, 0x54, 0xf5, 0x27, 0x91, 0xf0, 0x04, 0xec, 0xe0, 0x38, 0xa6, 0xef,
+	0xbd, 0x2c, 0x95, 0x1b, 0x4d, 0x7c, 0x41, 0x02, 0x4f, 0x0c, 0x78, 0x6b, 0x43, 0x2e, 0x12, 0x6d,
+	0x2b, 0xe7, 0x37, 0x13, 0xdf, 0xcd, 0x80, 0xbb, 0xdf, 0xd9, 0xa0, 0x3a, 0xa5, 0x06, 0xff, 0x0c,
+	0x1a, 0x5d, 0x9a, 0x10, 0x2e, 0x08, 0x0e, 0xbc, 0x4e, 0x4c, 0xfd, 0x5b, 0x53, 0x96, 0x17, 0xff,
+	0xc9, 0x9d, 0x1d, 0x9f, 0xf2, 0x84, 0x72, 0x1e, 0xdc, 0x1e, 0x46, 0xf4, 0x28, 0xc1, 0xa2, 0x7b,
+	0x78, 0x91, 0x8a, 0x51, 0xee, 0xec, 0xea, 0xb9, 0xcd, 0x65, 0xba, 0xa8, 0x5e, 0x58, 0xda, 0xd2,
+	0x00, 0xbb, 0xa0, 0x1e, 0x60, 0xea, 0x7d, 0x4b, 0xd9, 0xad, 0x21, 0x5f, 0x53, 0xe4, 0xed, 0xff,
+	0x4b, 0x7e, 0x97, 0x3b, 0xb5, 0xd7, 0x67, 0xbf, 0xff, 0x9a, 0xb2, 0x5b, 0x45, 0x31, 0xca, 0x9d,
+	0x1d, 0x2d, 0x36, 0x4b, 0xe4, 0xa2, 0x5a, 0x80, 0x69, 0x11, 0x06, 0xff, 0x00, 0xec, 0x22, 0x80,
+	0x67, 0xbd, 0x1e, 0x65, 0xc2, 0xd4, 0xfa, 0xf3, 0xbb, 0xdc, 0xa9, 0x1b, 0xca, 0x6b, 0xed, 0x19,
+	0xe5, 0xce, 0x27, 0x73, 0xa4, 0x26, 0xc7, 0x45, 0x75, 0x43, 0x6b, 0x42, 0x61, 0x07, 0xd4, 0x48,
+	0xd4, 0x3b, 0x3e, 0x7d, 0x6e, 0x16, 0x50, 0x56, 0x0b, 0xf8, 0xd5, 0xa2, 0x05, 0x54, 0xcf, 0x2f,
+	0xae, 0x8e, 0x4f, 0x9f, 0x8f, 0xe7, 0x6f, 0x0a, 0x39, 0xcd, 0xe2, 0xa2, 0xaa, 0x86, 0x7a, 0xf2,
+	0x17, 0xc0, 0x40, 0xaf, 0x8b, 0x79, 0x57, 0xb5, 0x49, 0xa5, 0x7d, 0x70, 0x97, 0x3b, 0x40, 0x33,
+	0xfd, 0x06, 0xf3, 0xee, 0x64, 0xd7, 0x3b, 0xc3, 0xbf, 0xe2, 0x54, 0x44, 0x59, 0x32, 0xe6, 0x02,
+	0x3a, 0x59, 0x46, 0x15, 0xd3, 0x3d, 0x35, 0xd3, 0xdd, 0x58, 0x76, 0xba, 0xa7, 0x8f, 0x4d, 0xf7,
+	0x74, 0x76, 0xba, 0x3a, 0xa6, 0xd0, 0x78, 0x69, 0x34, 0x36, 0x97, 0xd5, 0x78, 0xf9, 0x98, 0xc6,
+	0xcb, 0x59, 0x0d, 0x1d, 0x23, 0xfb, 0x72, 0x6e, 0x9d, 0x2d, 0x6b, 0xe9, 0xbe, 0x7c, 0xb0, 0x43,
+	0xf5, 0xc2, 0xa2, 0xd9, 0x6f, 0x41, 0xd3, 0xa7, 0x29, 0x17, 0xd2, 0x96, 0xd2, 0x5e, 0x4c, 0x8c,
+	0x44, 0x45, 0x49, 0xbc, 0x5c, 0x24, 0xf1, 0xcc, 0x7c, 0x96, 0x8f, 0xa4, 0xbb, 0x68, 0x7b, 0xd6,
+	0xac, 0xc5, 0x3c, 0x60, 0xf7, 0x88, 0x20, 0x8c, 0x77, 0x32, 0x16, 0x1a, 0x21, 0xa0, 0x84, 0x7e,
+	0xb6, 0x48, 0xc8, 0x74, 0xe8, 0x7c, 0xaa, 0x8b, 0x1a, 0x13, 0x93, 0x16, 0xf8, 0x23, 0xa8, 0x47,
+	0x52, 0xb5, 0x93, 0xc5, 0x86, 0xbe, 0xaa, 0xe8, 0x4f, 0x16, 0xd1, 0x9b, 0xaf, 0x6a, 0x36, 0xd1,
+	0x45, 0x5b, 0x63, 0x83, 0xa6, 0x0e, 0x00, 0x4c, 0xb2, 0x88, 0x79, 0x61, 0x8c, 0xfd, 0x88, 0x30,
+	0x43, 0x5f, 0x53, 0xf4, 0x3f, 0x5f, 0x44, 0xff, 0xa9, 0xa6, 0x7f, 0x98, 0xec, 0x22, 0x5b, 0x1a,
+	0x7f, 0xad, 0x6d, 0x5a, 0xe5, 0x1a, 0xd4, 0x3a, 0x84, 0xc5, 0x51, 0x6a, 0xf8, 0xb7, 0x14, 0xff,
+	0xf3, 0x45, 0xfc, 0xa6, 0x83, 0xa6, 0xd3, 0x5c, 0x54, 0xd5, 0xb0, 0x20, 0x8d, 0x69, 0x1a, 0xd0,
+	0x31, 0xe9, 0x93, 0xa5, 0x49, 0xa7, 0xd3, 0x5c, 0x54, 0xd5, 0x50, 0x93, 0x86, 0x60, 0x1b, 0x33,
+	0x46, 0xdf, 0xcf, 0x6d, 0x08, 0x54, 0xdc, 0x5f, 0x2c, 0xe2, 0x7e, 0xaa, 0xb9, 0x1f, 0xc9, 0x76,
+	0xd1, 0x13, 0x65, 0x9d, 0xd9, 0x92, 0x00, 0xc0, 0x90, 0xe1, 0xe1, 0x9c, 0x4e, 0x73, 0xe9, 0x8d,
+	0x7f, 0x98, 0xec, 0x22, 0x5b, 0x1a, 0x67, 0x54, 0xde, 0x81, 0x66, 0x42, 0x58, 0x48, 0xbc, 0x94,
+	0x08, 0xde, 0x8b, 0x23, 0x61, 0x74, 0x76, 0x96, 0xfe, 0x0e, 0x1e, 0x4b, 0x77, 0x11, 0x54, 0xe6,
+	0xb7, 0xc6, 0x5a, 0x74, 0x29, 0xef, 0xe2, 0x34, 0xec, 0xe2, 0xc8, 0xa8, 0xec, 0x2e, 0xdd, 0xa5,
+	0xb3, 0x89, 0x2e, 0xda, 0x1a, 0x1b, 0x8a, 0x52, 0xfb, 0x38, 0xf5, 0xb3, 0x71, 0xa9, 0x3f, 0x59,
+	0xba, 0xd4, 0xd3, 0x69, 0xf2, 0x74, 0x55, 0x50, 0x93, 0x7e, 0xb7, 0x0a, 0x9a, 0x24, 0xea, 0x79,
+	0xd8, 0x17, 0x51, 0x1f, 0x8b, 0xa8, 0x68, 0xa4, 0x96, 0x62, 0x7f, 0xbb, 0xe8, 0xef, 0x0e, 0x9e,
+	0x5f, 0x5c, 0x9d, 0x15, 0x79, 0xe3, 0x7f, 0xbd, 0x67, 0xc5, 0xbf, 0xde, 0x03, 0x52, 0x17, 0x41,
+	0x12, 0xf5, 0xe6, 0x52, 0x2e, 0xcb, 0x56, 0xdd, 0x6e, 0x5c, 0x96, 0xad, 0x86, 0x6d, 0x5f, 0x96,
+	0x2d, 0xdb, 0x7e, 0x72, 0x59, 0xb6, 0xb6, 0xed, 0x26, 0xda, 0x1a, 0xd2, 0x98, 0x7a, 0xfd, 0x17,
+	0x3a, 0x17, 0x55, 0xc9, 0x7b, 0xcc, 0xcd, 0x7f, 0x1d, 0xaa, 0xfb, 0x58, 0xe0, 0x78, 0xc8, 0x4d,
+	0x2d, 0x90, 0xad, 0x2b, 0x34, 0x75, 0x72, 0x1e, 0x81, 0xf5, 0x6b, 0x21, 0xaf, 0x46, 0x36, 0x28,
+	0xdd, 0x92, 0xa1, 0x3e, 0xef, 0x91, 0x1c, 0xc2, 0x26, 0x58, 0xef, 0xe3, 0x38, 0xd3, 0x77, 0xac,
+	0x0a, 0xd2, 0xc0, 0xbd, 0x02, 0x8d, 0x1b, 0x86, 0x53, 0x2e, 0x27, 0x4d, 0xd3, 0x37, 0x34, 0xe4,
+	0x10, 0x82, 0xb2, 0x3a, 0xaa, 0x74, 0xae, 0x1a, 0xc3, 0x9f, 0x80, 0x72, 0x4c, 0x43, 0xde, 0x5a,
+	0xdb, 0x2f, 0x1d, 0x54, 0x4f, 0x76, 0x1e, 0xde, 0x72, 0xde, 0xd0, 0x10, 0xa9, 0x10, 0xf7, 0x5f,
+	0x6b, 0xa0, 0xf4, 0x86, 0x86, 0xb0, 0x05, 0x36, 0x71, 0x10, 0x30, 0xc2, 0xb9, 0x61, 0x1a, 0x43,
+	0xb8, 0x0b, 0x36, 0x04, 0xed, 0x45, 0xbe, 0xa6, 0xab, 0x20, 0x83, 0xa4, 0x70, 0x80, 0x05, 0x56,
+	0x67, 0x7b, 0x0d, 0xa9, 0x31, 0x3c, 0x01, 0x35, 0xb5, 0x32, 0x2f, 0xcd, 0x92, 0x0e, 0x61, 0xea,
+	0x88, 0x2e, 0xb7, 0x1b, 0xf7, 0xb9, 0x53, 0x55, 0xf6, 0xb7, 0xca, 0x8c, 0xa6, 0x01, 0xfc, 0x0c,
+	0x6c, 0x8a, 0xc1, 0xf4, 0x71, 0xbb, 0x7d, 0x9f, 0x3b, 0x0d, 0x31, 0x59, 0xa6, 0x3c, 0x4d, 0xd1,
+	0x86, 0x18, 0xc8, 0x5f, 0x78, 0x04, 0x2c, 0x31, 0xf0, 0xa2, 0x34, 0x20, 0x03, 0x75, 0xa2, 0x96,
+	0xdb, 0xcd, 0xfb, 0xdc, 0xb1, 0xa7, 0xc2, 0x2f, 0xa4, 0x0f, 0x6d, 0x8a, 0x81, 0x1a, 0xc0, 0xcf,
+	0x00, 0xd0, 0x53, 0x52, 0x0a, 0xfa, 0x80, 0xdc, 0xba, 0xcf, 0x9d, 0x8a, 0xb2, 0x2a, 0xee, 0xc9,
+	0x10, 0xba, 0x60, 0x5d, 0x73, 0x5b, 0x8a, 0xbb, 0x76, 0x9f, 0x3b, 0x56, 0x4c, 0x43, 0xcd, 0xa9,
+	0x5d, 0x72, 0xab, 0x18, 0x49, 0x68, 0x9f, 0x04, 0xea, 0x94, 0xb2, 0xd0, 0x18, 0xba, 0xff, 0x58,
+	0x03, 0xd6, 0xcd, 0x00, 0x11, 0x9e, 0xc5, 0x02, 0x7e, 0x0d, 0x6c, 0x9f, 0xa6, 0x82, 0x61, 0x5f,
+	0x78, 0x33, 0x5b, 0xdb, 0x7e, 0x36, 0x39, 0x53, 0xe6, 0x23, 0x5c, 0xd4, 0x18, 0x9b, 0xce, 0xcc,
+	0xfe, 0x37, 0xc1, 0x7a, 0x27, 0xa6, 0x34, 0x51, 0x9d, 0x50, 0x43, 0x1a, 0x40, 0xa4, 0x76, 0x4d,
+	0x55, 0xb9, 0xa4, 0xee, 0xb2, 0x3f, 0x7e, 0x58, 0xe5, 0xb9, 0x56, 0x69, 0xef, 0x9a, 0xfb, 0x6c,
+	0x5d, 0x6b, 0x9b, 0x7c, 0x57, 0xee, 0xad, 0x6a, 0x25, 0x1b, 0x94, 0x18, 0x11, 0xaa, 0x68, 0x35,
+	0x24, 0x87, 0xf0, 0x29, 0xb0, 0x18, 0xe9, 0x13, 0x26, 0x48, 0xa0, 0x8a, 0x63, 0xa1, 0x02, 0xc3,
+	0x4f, 0x81, 0x15, 0x62, 0xee, 0x65, 0x9c, 0x04, 0xba, 0x12, 0x68, 0x33, 0xc4, 0xfc, 0x1b, 0x4e,
+	0x82, 0x2f, 0xcb, 0x7f, 0xfb, 0xde, 0x59, 0x71, 0x31, 0xa8, 0x9e, 0xf9, 0x3e, 0xe1, 0xfc, 0x26,
+	0xeb, 0xc5, 0x64, 0x41, 0x87, 0x9d, 0x80, 0x1a, 0x17, 0x94, 0xe1, 0x90, 0x78, 0xb7, 0x64, 0x68,
+	0xfa, 0x4c, 0x77, 0x8d, 0xb1, 0xff, 0x96, 0x0c, 0x39, 0x9a, 0x06, 0x46, 0xe2, 0xfb, 0x32, 0xa8,
+	0xde, 0x30, 0xec, 0x13, 0x73, 0x87, 0x96, 0xbd, 0x2a, 0x21, 0x33, 0x12, 0x06, 0x49, 0x6d, 0x11,
+	0x25, 0x84, 0x66, 0xc2, 0x7c, 0x4f, 0x63, 0x28, 0x33, 0x18, 0x21, 0x03, 0xe2, 0xab, 0x6d, 0x2c,
+	0x23, 0x83, 0xe0, 0x29, 0xd8, 0x0a, 0x22, 0xae, 0x1e, 0x24, 0x5c, 0x60, 0xff, 0x56, 0x2f, 0xbf,
+	0x6d, 0xdf, 0xe7, 0x4e, 0xcd, 0x38, 0xae, 0xa5, 0x1d, 0xcd, 0x20, 0xf8, 0x15, 0x68, 0x4c, 0xd2,
+	0xd4, 0x6c, 0xf5, 0x13, 0xa0, 0x0d, 0xef, 0x73, 0xa7, 0x5e, 0x84, 0x2a, 0x0f, 0x9a, 0xc3, 0xb2,
+	0xd2, 0x01, 0xe9, 0x64, 0xa1, 0x6a, 0x3e, 0x0b, 0x69, 0x20, 0xad, 0x71, 0x94, 0x44, 0x42, 0x35,
+	0xdb, 0x3a, 0xd2, 0x00, 0x7e, 0x05, 0x2a, 0xb4, 0x4f, 0x18, 0x8b, 0x02, 0xc2, 0x5b, 0x60, 0x89,
+	0xd7, 0x0c, 0x9a, 0xc4, 0xcb, 0xc5, 0x99, 0xc7, 0x56, 0x42, 0x12, 0xca, 0x86, 0xad, 0xea, 0x64,
+	0x71, 0xda, 0xf1, 0x3b, 0x65, 0x47, 0x33, 0x08, 0xb6, 0x01, 0x34, 0x69, 0x8c, 0x88, 0x8c, 0xa5,
+	0x9e, 0xfa, 0xfe, 0x6b, 0x2a, 0x57, 0x7d, 0x85, 0xda, 0x8b, 0x94, 0xf3, 0x35, 0x16, 0x18, 0x3d,
+	0xb0, 0xc0, 0x5f, 0x02, 0xa8, 0x6b, 0xe2, 0xbd, 0xe3, 0xb4, 0x78, 0x8e, 0xe9, 0x6b, 0x86, 0xd2,
+	0xd7, 0x5e, 0x33, 0x67, 0x5b, 0xa3, 0x4b, 0x4e, 0xcd, 0x2a, 0x2e, 0xcb, 0x56, 0xd9, 0x5e, 0xbf,
+	0x2c, 0x5b, 0x9b, 0xb6, 0x55, 0xec, 0x9f, 0x59, 0x05, 0xda, 0x1e, 0xe3, 0xa9, 0xe9, 0xb5, 0xcf,
+	0x7f, 0xb8, 0xdb, 0x5b, 0xfd, 0x70, 0xb7, 0xb7, 0xfa, 0xdf, 0xbb, 0xbd, 0xd5, 0x7f, 0x7e, 0xdc,
+	0x5b, 0xf9, 0xf0, 0x71, 0x6f, 0xe5, 0xdf, 0x1f, 0xf7, 0x56, 0xfe, 0xf4, 0xd3, 0x30, 0x12, 0xdd,
+	0xac, 0x73, 0xe8, 0xd3, 0xe4, 0xa8, 0x4b, 0x44, 0xf6, 0x79, 0x8f, 0xd1, 0x77, 0xc4, 0x17, 0x0a,
+	0xc8, 0xf7, 0xf5, 0x40, 0x3d, 0xb4, 0xc5, 0xb0, 0x47, 0x78, 0x67, 0x43, 0x3d, 0xa0, 0x5f, 0xfc,
+	0x6f, 0x00, 0x9a, 0x45, 0x6a, 0x5c, 0x86, 0x0f, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -902,6 +910,20 @@ func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EIPActivationBlock != nil {
+		{
+			size := m.EIPActivationBlock.Size()
+			i -= size
+			if _, err := m.EIPActivationBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
 	if m.CancunBlock != nil {
 		{
 			size := m.CancunBlock.Size()
@@ -1651,6 +1673,10 @@ func (m *ChainConfig) Size() (n int) {
 		l = m.CancunBlock.Size()
 		n += 2 + l + sovEvm(uint64(l))
 	}
+	if m.EIPActivationBlock != nil {
+		l = m.EIPActivationBlock.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
@@ -2777,6 +2803,42 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EIPActivationBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.EIPActivationBlock = &v
+			if err := m.EIPActivationBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// Ethereum transaction types that are recognized but not executed by the EVM module.
+const (
+	// BlobTxType is the EIP-4844 blob transaction type. Blob transactions are
+	// never accepted since the chain does not provide data availability for blobs.
+	BlobTxType = 0x03
+	// SetCodeTxType is the EIP-7702 set code transaction type. Set code
+	// transactions are not accepted since the interpreter of the go-ethereum
+	// fork cannot apply their authorization list.
+	SetCodeTxType = 0x04
+)
+
+// Fork schedule
+//
+// The hard forks are activated by height through the ChainConfig params, so they
+// can be scheduled by governance with a params update. On top of the rules
+// already applied by the go-ethereum interpreter, the EVM module implements:
+//
+//   - Shanghai: EIP-3855 (PUSH0) and EIP-3651 (warm coinbase).
+//   - Cancun: EIP-6780 (SELFDESTRUCT only in the same transaction).
+//
+// These EIPs only apply from the EIPActivationBlock, which chains that ran
+// before they were implemented set in an upgrade, so that past blocks replay
+// with the rules they were produced with.
+//
+// The StateDB and its journal implement the EIP-1153 transient storage, but the
+// interpreter of the go-ethereum fork has no TLOAD/TSTORE nor EIP-5656 MCOPY
+// opcodes, so contracts cannot use them yet.
+
+// ShanghaiEIPs are the extra EIPs enabled on the interpreter from the Shanghai block.
+var ShanghaiEIPs = []int{3855}
+
+// ForkEIPs returns the extra EIPs that must be enabled on the interpreter for the
+// forks active at the given height.
+func (cc ChainConfig) ForkEIPs(height *big.Int) []int {
+	var eips []int
+	if cc.IsShanghai(height) {
+		eips = append(eips, ShanghaiEIPs...)
+	}
+	return eips
+}
+
+// IsShanghai returns whether the Shanghai EIPs implemented by the EVM module
+// apply at the given height.
+func (cc ChainConfig) IsShanghai(height *big.Int) bool {
+	return isForked(cc.ShanghaiBlock, height) && isForked(cc.EIPActivationBlock, height)
+}
+
+// IsCancun returns whether the Cancun EIPs implemented by the EVM module apply
+// at the given height.
+func (cc ChainConfig) IsCancun(height *big.Int) bool {
+	return isForked(cc.CancunBlock, height) && isForked(cc.EIPActivationBlock, height)
+}
+
+// ValidateTxType checks that an Ethereum transaction of the given type can be
+// accepted. Blob and set code transactions are always rejected.
+func ValidateTxType(txType byte) error {
+	switch txType {
+	case BlobTxType:
+		return errorsmod.Wrapf(ErrBlobTxNotSupported, "tx type %d", txType)
+	case SetCodeTxType:
+		return errorsmod.Wrapf(ErrSetCodeTxNotSupported, "tx type %d: authorization lists are not supported by the EVM interpreter", txType)
+	}
+	return nil
+}
+
+// isForked returns whether a fork scheduled at block is active at the given
+// height. A nil or negative block means the fork is not scheduled.
+func isForked(block *sdkmath.Int, height *big.Int) bool {
+	value := getBlockValue(block)
+	if value == nil || height == nil {
+		return false
+	}
+	return value.Cmp(height) <= 0
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkEIPs(t *testing.T) {
+	config := DefaultChainConfig()
+	config.ShanghaiBlock = newIntPtr(10)
+	config.CancunBlock = newIntPtr(20)
+
+	require.Empty(t, config.ForkEIPs(big.NewInt(9)))
+	require.Equal(t, []int{3855}, config.ForkEIPs(big.NewInt(10)))
+	require.Equal(t, []int{3855}, config.ForkEIPs(big.NewInt(20)))
+	require.False(t, config.IsCancun(big.NewInt(19)))
+	require.True(t, config.IsCancun(big.NewInt(20)))
+
+	params := DefaultParams()
+	params.ChainConfig = config
+	params.ExtraEIPs = []int64{2200, 3855}
+	require.Equal(t, []int{2200, 3855}, params.ActiveEIPs(big.NewInt(9)))
+	// fork EIPs come first and are not enabled twice
+	require.Equal(t, []int{3855, 2200}, params.ActiveEIPs(big.NewInt(10)))
+}
+
+func TestEIPActivationBlock(t *testing.T) {
+	// a live chain already on Shanghai and Cancun activates the EIPs of the
+	// module in an upgrade
+	config := DefaultChainConfig()
+	config.EIPActivationBlock = nil
+	require.NoError(t, config.Validate())
+	require.Empty(t, config.ForkEIPs(big.NewInt(100)))
+	require.False(t, config.IsShanghai(big.NewInt(100)))
+	require.False(t, config.IsCancun(big.NewInt(100)))
+
+	config.EIPActivationBlock = newIntPtr(50)
+	require.NoError(t, config.Validate())
+	require.Empty(t, config.ForkEIPs(big.NewInt(49)))
+	require.False(t, config.IsCancun(big.NewInt(49)))
+	require.Equal(t, []int{3855}, config.ForkEIPs(big.NewInt(50)))
+	require.True(t, config.IsShanghai(big.NewInt(50)))
+	require.True(t, config.IsCancun(big.NewInt(50)))
+}
+
+func TestValidateTxType(t *testing.T) {
+	require.NoError(t, ValidateTxType(2))
+	require.ErrorIs(t, ValidateTxType(BlobTxType), ErrBlobTxNotSupported)
+	require.ErrorIs(t, ValidateTxType(SetCodeTxType), ErrSetCodeTxNotSupported)
+}
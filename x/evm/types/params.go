@@ -18,6 +18,7 @@ package types
 import (
 	"fmt"
 	"math/big"
+	"slices"
 
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
@@ -43,7 +44,7 @@ var (
 // instruction sets from the latest hard fork enabled by the ChainConfig. For
 // more info check:
 // https://github.com/ethereum/go-ethereum/blob/master/core/vm/interpreter.go#L97
-var AvailableExtraEIPs = []int64{1344, 1884, 2200, 2929, 3198, 3529}
+var AvailableExtraEIPs = []int64{1344, 1884, 2200, 2929, 3198, 3529, 3855}
 
 // NewParams creates a new Params instance
 func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bool, config ChainConfig, extraEIPs []int64) Params {
@@ -104,6 +105,19 @@ func (p Params) EIPs() []int {
 	return eips
 }
 
+// ActiveEIPs returns the extra EIPs enabled on the interpreter at the given
+// height: the EIPs of the fork schedule followed by the ExtraEIPs params that
+// are not already part of it.
+func (p Params) ActiveEIPs(height *big.Int) []int {
+	eips := p.ChainConfig.ForkEIPs(height)
+	for _, eip := range p.EIPs() {
+		if !slices.Contains(eips, eip) {
+			eips = append(eips, eip)
+		}
+	}
+	return eips
+}
+
 func validateEVMDenom(i interface{}) error {
 	denom, ok := i.(string)
 	if !ok {
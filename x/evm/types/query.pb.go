@@ -797,6 +797,9 @@ type EthCallRequest struct {
 	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides is the optional set of account overrides applied before the call,
+	// using the same json format as the json rpc api.
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -860,6 +863,13 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -1062,6 +1072,171 @@ func (m *QueryTraceTxResponse) GetData() []byte {
 	return nil
 }
 
+// QueryTraceCallRequest defines TraceCall request
+type QueryTraceCallRequest struct {
+	// args uses the same json format as the json rpc api.
+	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// overrides is the optional set of account overrides applied before the call,
+	// using the same json format as the json rpc api.
+	Overrides []byte `protobuf:"bytes,4,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_number of the block the call is executed on top of
+	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash (hex) of the block the call is executed on top of
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_time of the block the call is executed on top of
+	BlockTime time.Time `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// proposer_address is the proposer of the requested block
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
+func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallRequest) ProtoMessage()    {}
+func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{20}
+}
+func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallRequest.Merge(m, src)
+}
+func (m *QueryTraceCallRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallRequest proto.InternalMessageInfo
+
+func (m *QueryTraceCallRequest) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *QueryTraceCallRequest) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryTraceCallRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// QueryTraceCallResponse defines TraceCall response
+type QueryTraceCallResponse struct {
+	// data is the response serialized in bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{} }
+func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallResponse) ProtoMessage()    {}
+func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{21}
+}
+func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallResponse.Merge(m, src)
+}
+func (m *QueryTraceCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallResponse proto.InternalMessageInfo
+
+func (m *QueryTraceCallResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // QueryTraceBlockRequest defines TraceTx request
 type QueryTraceBlockRequest struct {
 	// txs is an array of messages in the block
@@ -1084,7 +1259,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{20}
+	return fileDescriptor_e15a877459347994, []int{22}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1172,7 +1347,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{21}
+	return fileDescriptor_e15a877459347994, []int{23}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1217,7 +1392,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{22}
+	return fileDescriptor_e15a877459347994, []int{24}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1256,7 +1431,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{23}
+	return fileDescriptor_e15a877459347994, []int{25}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1306,6 +1481,8 @@ func init() {
 	proto.RegisterType((*EstimateGasResponse)(nil), "ethermint.evm.v1.EstimateGasResponse")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "ethermint.evm.v1.QueryTraceTxRequest")
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "ethermint.evm.v1.QueryTraceTxResponse")
+	proto.RegisterType((*QueryTraceCallRequest)(nil), "ethermint.evm.v1.QueryTraceCallRequest")
+	proto.RegisterType((*QueryTraceCallResponse)(nil), "ethermint.evm.v1.QueryTraceCallResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "ethermint.evm.v1.QueryTraceBlockRequest")
 	proto.RegisterType((*QueryTraceBlockResponse)(nil), "ethermint.evm.v1.QueryTraceBlockResponse")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.evm.v1.QueryBaseFeeRequest")
@@ -1315,98 +1492,102 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0xcf, 0x6f, 0x13, 0x47,
-	0x1b, 0xce, 0xc6, 0x4e, 0xec, 0x8c, 0x03, 0xf8, 0x1b, 0x02, 0x38, 0x4b, 0x62, 0x87, 0xfd, 0xbe,
-	0x60, 0xc3, 0x47, 0x76, 0x49, 0xbe, 0x4f, 0x48, 0xed, 0xa5, 0xc5, 0x16, 0x50, 0x0a, 0x54, 0xd4,
-	0x8d, 0x7a, 0xa8, 0x54, 0x59, 0xe3, 0xf5, 0xb0, 0x76, 0x63, 0xef, 0x98, 0x9d, 0xb1, 0xe5, 0x80,
-	0x38, 0x14, 0x55, 0xfd, 0xa1, 0x4a, 0x15, 0x52, 0x6f, 0x3d, 0x71, 0xef, 0x1f, 0xd0, 0x63, 0xaf,
-	0x1c, 0x91, 0x7a, 0xa9, 0x7a, 0xa0, 0x08, 0x7a, 0xe8, 0xdf, 0xd0, 0x53, 0x35, 0xbf, 0xec, 0x5d,
-	0xdb, 0x1b, 0x87, 0x8a, 0x9e, 0x7a, 0xda, 0x9d, 0x99, 0x77, 0xde, 0xe7, 0x99, 0xf7, 0x7d, 0x67,
-	0xde, 0x07, 0xac, 0x61, 0xd6, 0xc4, 0x41, 0xa7, 0xe5, 0x33, 0x07, 0xf7, 0x3b, 0x4e, 0x7f, 0xdb,
-	0xb9, 0xdb, 0xc3, 0xc1, 0xbe, 0xdd, 0x0d, 0x08, 0x23, 0x30, 0x3b, 0x5c, 0xb5, 0x71, 0xbf, 0x63,
-	0xf7, 0xb7, 0xcd, 0xf3, 0x2e, 0xa1, 0x1d, 0x42, 0x9d, 0x3a, 0xa2, 0x58, 0x9a, 0x3a, 0xfd, 0xed,
-	0x3a, 0x66, 0x68, 0xdb, 0xe9, 0x22, 0xaf, 0xe5, 0x23, 0xd6, 0x22, 0xbe, 0xdc, 0x6d, 0x9a, 0x13,
-	0xbe, 0xb9, 0x13, 0xb9, 0xb6, 0x3a, 0xb1, 0xc6, 0x06, 0x6a, 0x69, 0xc5, 0x23, 0x1e, 0x11, 0xbf,
-	0x0e, 0xff, 0x53, 0xb3, 0x6b, 0x1e, 0x21, 0x5e, 0x1b, 0x3b, 0xa8, 0xdb, 0x72, 0x90, 0xef, 0x13,
-	0x26, 0x90, 0xa8, 0x5a, 0x2d, 0xa8, 0x55, 0x31, 0xaa, 0xf7, 0xee, 0x38, 0xac, 0xd5, 0xc1, 0x94,
-	0xa1, 0x4e, 0x57, 0x1a, 0x58, 0x6f, 0x80, 0xe3, 0xef, 0x73, 0xb6, 0x97, 0x5d, 0x97, 0xf4, 0x7c,
-	0x56, 0xc5, 0x77, 0x7b, 0x98, 0x32, 0x98, 0x03, 0x29, 0xd4, 0x68, 0x04, 0x98, 0xd2, 0x9c, 0xb1,
-	0x61, 0x94, 0x96, 0xaa, 0x7a, 0xf8, 0x66, 0xfa, 0xcb, 0xc7, 0x85, 0xb9, 0xdf, 0x1f, 0x17, 0xe6,
-	0x2c, 0x17, 0xac, 0x44, 0xb7, 0xd2, 0x2e, 0xf1, 0x29, 0xe6, 0x7b, 0xeb, 0xa8, 0x8d, 0x7c, 0x17,
-	0xeb, 0xbd, 0x6a, 0x08, 0x4f, 0x83, 0x25, 0x97, 0x34, 0x70, 0xad, 0x89, 0x68, 0x33, 0x37, 0x2f,
-	0xd6, 0xd2, 0x7c, 0xe2, 0x1d, 0x44, 0x9b, 0x70, 0x05, 0x2c, 0xf8, 0x84, 0x6f, 0x4a, 0x6c, 0x18,
-	0xa5, 0x64, 0x55, 0x0e, 0xac, 0xb7, 0xc0, 0xaa, 0x00, 0xa9, 0x88, 0xf0, 0xfe, 0x05, 0x96, 0x9f,
-	0x1b, 0xc0, 0x9c, 0xe6, 0x41, 0x91, 0xdd, 0x04, 0x47, 0x65, 0xe6, 0x6a, 0x51, 0x4f, 0x47, 0xe4,
-	0xec, 0x65, 0x39, 0x09, 0x4d, 0x90, 0xa6, 0x1c, 0x94, 0xf3, 0x9b, 0x17, 0xfc, 0x86, 0x63, 0xee,
-	0x02, 0x49, 0xaf, 0x35, 0xbf, 0xd7, 0xa9, 0xe3, 0x40, 0x9d, 0xe0, 0x88, 0x9a, 0x7d, 0x4f, 0x4c,
-	0x5a, 0x37, 0xc0, 0x9a, 0xe0, 0xf1, 0x21, 0x6a, 0xb7, 0x1a, 0x88, 0x91, 0x60, 0xec, 0x30, 0x67,
-	0xc0, 0xb2, 0x4b, 0xfc, 0x71, 0x1e, 0x19, 0x3e, 0x77, 0x79, 0xe2, 0x54, 0x5f, 0x1b, 0x60, 0x3d,
-	0xc6, 0x9b, 0x3a, 0x58, 0x11, 0x1c, 0xd3, 0xac, 0xa2, 0x1e, 0x35, 0xd9, 0xd7, 0x78, 0x34, 0x5d,
-	0x44, 0x65, 0x99, 0xe7, 0x57, 0x49, 0xcf, 0x45, 0x55, 0x44, 0xc3, 0xad, 0xb3, 0x8a, 0xc8, 0xba,
-	0xa1, 0xc0, 0x3e, 0x60, 0x24, 0x40, 0xde, 0x6c, 0x30, 0x98, 0x05, 0x89, 0x3d, 0xbc, 0xaf, 0xea,
-	0x8d, 0xff, 0x86, 0xe0, 0x2f, 0x28, 0xf8, 0xa1, 0x33, 0x05, 0xbf, 0x02, 0x16, 0xfa, 0xa8, 0xdd,
-	0xd3, 0xe0, 0x72, 0x60, 0x5d, 0x02, 0x59, 0x55, 0x4a, 0x8d, 0x57, 0x3a, 0x64, 0x11, 0xfc, 0x2b,
-	0xb4, 0x4f, 0x41, 0x40, 0x90, 0xe4, 0xb5, 0x2f, 0x76, 0x2d, 0x57, 0xc5, 0xbf, 0x75, 0x0f, 0x40,
-	0x61, 0xb8, 0x3b, 0xb8, 0x49, 0x3c, 0xaa, 0x21, 0x20, 0x48, 0x8a, 0x1b, 0x23, 0xfd, 0x8b, 0x7f,
-	0x78, 0x15, 0x80, 0xd1, 0xbb, 0x22, 0xce, 0x96, 0xd9, 0x39, 0x6b, 0xcb, 0xa2, 0xb5, 0xf9, 0x23,
-	0x64, 0xcb, 0xf7, 0x4a, 0x3d, 0x42, 0xf6, 0xed, 0x51, 0xa8, 0xaa, 0xa1, 0x9d, 0x21, 0x92, 0x5f,
-	0x19, 0x2a, 0xb0, 0x1a, 0x5c, 0xf1, 0x3c, 0x07, 0x92, 0x6d, 0xe2, 0xf1, 0xd3, 0x25, 0x4a, 0x99,
-	0x9d, 0x13, 0xf6, 0xf8, 0xd3, 0x67, 0xdf, 0x24, 0x5e, 0x55, 0x98, 0xc0, 0x6b, 0x53, 0x48, 0x15,
-	0x67, 0x92, 0x92, 0x38, 0x61, 0x56, 0xd6, 0x8a, 0x8a, 0xc3, 0x6d, 0x14, 0xa0, 0x8e, 0x8e, 0x83,
-	0x75, 0x4b, 0x11, 0xd4, 0xb3, 0x8a, 0xe0, 0x25, 0xb0, 0xd8, 0x15, 0x33, 0x22, 0x40, 0x99, 0x9d,
-	0xdc, 0x24, 0x45, 0xb9, 0xa3, 0x9c, 0x7c, 0xf2, 0xac, 0x30, 0x57, 0x55, 0xd6, 0xd6, 0x0f, 0x06,
-	0x38, 0x7a, 0x85, 0x35, 0x2b, 0xa8, 0xdd, 0x0e, 0x45, 0x1a, 0x05, 0x1e, 0xd5, 0x39, 0xe1, 0xff,
-	0xf0, 0x14, 0x48, 0x79, 0x88, 0xd6, 0x5c, 0xd4, 0x55, 0xd7, 0x63, 0xd1, 0x43, 0xb4, 0x82, 0xba,
-	0xf0, 0x63, 0x90, 0xed, 0x06, 0xa4, 0x4b, 0x28, 0x0e, 0x86, 0x57, 0x8c, 0x5f, 0x8f, 0xe5, 0xf2,
-	0xce, 0x1f, 0xcf, 0x0a, 0xb6, 0xd7, 0x62, 0xcd, 0x5e, 0xdd, 0x76, 0x49, 0xc7, 0x51, 0xbd, 0x41,
-	0x7e, 0xb6, 0x68, 0x63, 0xcf, 0x61, 0xfb, 0x5d, 0x4c, 0xed, 0xca, 0xe8, 0x6e, 0x57, 0x8f, 0x69,
-	0x5f, 0xfa, 0x5e, 0xae, 0x82, 0xb4, 0xdb, 0x44, 0x2d, 0xbf, 0xd6, 0x6a, 0xe4, 0x92, 0x1b, 0x46,
-	0x29, 0x51, 0x4d, 0x89, 0xf1, 0xf5, 0x86, 0x55, 0x04, 0xc7, 0xaf, 0x50, 0xd6, 0xea, 0x20, 0x86,
-	0xaf, 0xa1, 0x51, 0x20, 0xb2, 0x20, 0xe1, 0x21, 0x49, 0x3e, 0x59, 0xe5, 0xbf, 0xd6, 0xf3, 0x84,
-	0xce, 0x69, 0x80, 0x5c, 0xbc, 0x3b, 0xd0, 0xe7, 0xdc, 0x06, 0x89, 0x0e, 0xf5, 0x54, 0xbc, 0x0a,
-	0x93, 0xf1, 0xba, 0x45, 0xbd, 0x2b, 0x7c, 0x0e, 0xf7, 0x3a, 0xbb, 0x83, 0x2a, 0xb7, 0x85, 0x6f,
-	0x83, 0x65, 0xc6, 0x9d, 0xd4, 0x5c, 0xe2, 0xdf, 0x69, 0x79, 0xe2, 0xa4, 0x99, 0x9d, 0xf5, 0xc9,
-	0xbd, 0x02, 0xaa, 0x22, 0x8c, 0xaa, 0x19, 0x36, 0x1a, 0xc0, 0x0a, 0x58, 0xee, 0x06, 0xb8, 0x81,
-	0x5d, 0x4c, 0x29, 0x09, 0x68, 0x2e, 0x29, 0x0a, 0x6a, 0x26, 0x7a, 0x64, 0x13, 0x7f, 0x25, 0xeb,
-	0x6d, 0xe2, 0xee, 0xe9, 0xf7, 0x68, 0x41, 0x44, 0x26, 0x23, 0xe6, 0xe4, 0x6b, 0x04, 0xd7, 0x01,
-	0x90, 0x26, 0xe2, 0xd2, 0x2c, 0x8a, 0x4b, 0xb3, 0x24, 0x66, 0x44, 0x9f, 0xa9, 0xe8, 0x65, 0xde,
-	0x0a, 0x73, 0x29, 0x71, 0x0c, 0xd3, 0x96, 0x7d, 0xd2, 0xd6, 0x7d, 0xd2, 0xde, 0xd5, 0x7d, 0xb2,
-	0x9c, 0xe6, 0x45, 0xf3, 0xe8, 0xd7, 0x82, 0xa1, 0x9c, 0xf0, 0x95, 0xa9, 0xb9, 0x4f, 0xff, 0x3d,
-	0xb9, 0x5f, 0x8a, 0xe4, 0xfe, 0xdd, 0x64, 0x7a, 0x3e, 0x9b, 0xa8, 0xa6, 0xd9, 0xa0, 0xd6, 0xf2,
-	0x1b, 0x78, 0x60, 0x9d, 0x57, 0x2f, 0xd8, 0x30, 0xc3, 0xa3, 0xe7, 0xa5, 0x81, 0x18, 0xd2, 0xa5,
-	0xcc, 0xff, 0xad, 0x6f, 0x12, 0xe0, 0xe4, 0xc8, 0xb8, 0xcc, 0x4f, 0x13, 0xaa, 0x08, 0x36, 0xd0,
-	0x97, 0x7c, 0x76, 0x45, 0xb0, 0x01, 0x7d, 0x0d, 0x15, 0xf1, 0x4f, 0x4f, 0xa6, 0xb5, 0x05, 0x4e,
-	0x4d, 0xe4, 0xe3, 0x80, 0xfc, 0x9d, 0x18, 0xf6, 0x59, 0x8a, 0xaf, 0x62, 0xfd, 0x9e, 0x5b, 0x37,
-	0x87, 0x3d, 0x54, 0x4d, 0x2b, 0x17, 0xff, 0x07, 0x69, 0xfe, 0xe8, 0xd6, 0xee, 0x60, 0xd5, 0xc7,
-	0xca, 0xab, 0xbf, 0x3c, 0x2b, 0x9c, 0x90, 0xec, 0x69, 0x63, 0xcf, 0x6e, 0x11, 0xa7, 0x83, 0x58,
-	0xd3, 0xbe, 0xee, 0x33, 0xde, 0x5f, 0xc5, 0xee, 0x9d, 0x1f, 0x97, 0xc1, 0x82, 0x70, 0x07, 0x3f,
-	0x35, 0x40, 0x4a, 0xc9, 0x0a, 0xb8, 0x39, 0x99, 0xd6, 0x29, 0xba, 0xd1, 0x3c, 0x3b, 0xcb, 0x4c,
-	0x52, 0xb3, 0x8a, 0x0f, 0x7f, 0xfa, 0xed, 0xdb, 0xf9, 0x33, 0xb0, 0xc0, 0x55, 0x2e, 0xa1, 0x5a,
-	0xeb, 0x2a, 0x59, 0xe1, 0xdc, 0x57, 0x69, 0x78, 0x00, 0xbf, 0x33, 0xc0, 0x91, 0x88, 0x72, 0x83,
-	0xff, 0x8d, 0x81, 0x98, 0xa6, 0x10, 0xcd, 0x0b, 0x87, 0x33, 0x56, 0xac, 0x6c, 0xc1, 0xaa, 0x04,
-	0xcf, 0x46, 0x59, 0x69, 0x81, 0x38, 0x41, 0xee, 0x7b, 0x03, 0x64, 0xc7, 0x05, 0x18, 0xb4, 0x63,
-	0x20, 0x63, 0x74, 0x9f, 0xe9, 0x1c, 0xda, 0x5e, 0xb1, 0xbc, 0x24, 0x58, 0x5e, 0x84, 0x76, 0x94,
-	0x65, 0x5f, 0xdb, 0x8f, 0x88, 0x86, 0xf5, 0xe4, 0x03, 0xf8, 0xd0, 0x00, 0x29, 0x25, 0xb3, 0x62,
-	0xd3, 0x19, 0x55, 0x70, 0xb1, 0xe9, 0x1c, 0x53, 0x6b, 0x56, 0x49, 0x50, 0xb2, 0xe0, 0x46, 0x94,
-	0x92, 0x92, 0x6c, 0x34, 0x14, 0xb2, 0x2f, 0x0c, 0x90, 0x52, 0x62, 0x2b, 0x96, 0x44, 0x54, 0xd9,
-	0xc5, 0x92, 0x18, 0xd3, 0x6c, 0xd6, 0x96, 0x20, 0x51, 0x84, 0x9b, 0x51, 0x12, 0x54, 0x9a, 0x8d,
-	0x38, 0x38, 0xf7, 0xf7, 0xf0, 0xfe, 0x03, 0xd8, 0x07, 0x49, 0xae, 0xc7, 0xa0, 0x15, 0x5b, 0x22,
-	0x43, 0x91, 0x67, 0xfe, 0xfb, 0x40, 0x1b, 0x85, 0xbf, 0x29, 0xf0, 0x0b, 0x70, 0x7d, 0xbc, 0x7a,
-	0x1a, 0x91, 0x08, 0x50, 0xb0, 0x28, 0xe5, 0x08, 0xfc, 0x4f, 0x8c, 0xd7, 0x88, 0xea, 0x31, 0x37,
-	0x67, 0x58, 0x29, 0xf4, 0x35, 0x81, 0x7e, 0x12, 0xae, 0x44, 0xd1, 0xa5, 0xd6, 0x81, 0x0c, 0xa4,
-	0x94, 0xd4, 0x81, 0x1b, 0x93, 0xfe, 0xa2, 0x2a, 0xc8, 0x2c, 0xce, 0x7a, 0xfe, 0x35, 0x66, 0x5e,
-	0x60, 0xe6, 0xe0, 0xc9, 0x28, 0x26, 0x66, 0xcd, 0x9a, 0xcb, 0xa1, 0xee, 0x81, 0x4c, 0x48, 0xa7,
-	0x1c, 0x02, 0x79, 0xca, 0x59, 0xa7, 0x08, 0x1d, 0xcb, 0x12, 0xb8, 0x6b, 0xd0, 0x1c, 0xc3, 0x55,
-	0xa6, 0x35, 0x0f, 0x51, 0x38, 0x00, 0x29, 0xd5, 0x12, 0x63, 0xeb, 0x2c, 0x2a, 0x8a, 0x62, 0xeb,
-	0x6c, 0xac, 0xb3, 0xc6, 0x9d, 0x5a, 0xf6, 0x42, 0x36, 0x80, 0x9f, 0x19, 0x00, 0x8c, 0x1e, 0x74,
-	0x58, 0x3a, 0xc8, 0x6d, 0xb8, 0x07, 0x9b, 0xe7, 0x0e, 0x61, 0xa9, 0x38, 0x9c, 0x11, 0x1c, 0x4e,
-	0xc3, 0xd5, 0x69, 0x1c, 0x44, 0x67, 0xe3, 0x01, 0x50, 0x0d, 0xe1, 0x80, 0xdb, 0x1e, 0xee, 0x23,
-	0x07, 0xdc, 0xf6, 0x48, 0x5f, 0x89, 0x0b, 0x80, 0xee, 0x35, 0xe5, 0xeb, 0x4f, 0x5e, 0xe4, 0x8d,
-	0xa7, 0x2f, 0xf2, 0xc6, 0xf3, 0x17, 0x79, 0xe3, 0xd1, 0xcb, 0xfc, 0xdc, 0xd3, 0x97, 0xf9, 0xb9,
-	0x9f, 0x5f, 0xe6, 0xe7, 0x3e, 0x72, 0x42, 0xbd, 0xb4, 0x89, 0x59, 0x6f, 0xab, 0x1b, 0x90, 0x4f,
-	0xb0, 0xcb, 0xe4, 0xa0, 0xd9, 0xab, 0x73, 0x3f, 0x03, 0xe1, 0x50, 0x34, 0xd6, 0xfa, 0xa2, 0xe8,
-	0xe1, 0xff, 0xfb, 0x33, 0x00, 0x00, 0xff, 0xff, 0xfd, 0xff, 0x7a, 0x8b, 0x8f, 0x11, 0x00, 0x00,
+	// 1520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcd, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0x2d, 0xd9, 0x92, 0x47, 0x76, 0xe2, 0xb7, 0x96, 0x13, 0x99, 0xb1, 0x2d, 0x87, 0xef,
+	0xd9, 0x72, 0x3e, 0x4c, 0xc6, 0x7e, 0x0f, 0x01, 0x5e, 0x2f, 0x6d, 0x6c, 0x38, 0x69, 0x9a, 0xa4,
+	0x48, 0x55, 0xa3, 0x87, 0x02, 0x85, 0xb0, 0xa2, 0x36, 0x14, 0x6b, 0x89, 0xab, 0x70, 0x57, 0x82,
+	0x9c, 0x20, 0x40, 0x1b, 0x14, 0xfd, 0x40, 0x81, 0x22, 0x40, 0x6f, 0x3d, 0xe5, 0xd2, 0x53, 0xff,
+	0x8b, 0x9e, 0x72, 0x0c, 0x50, 0x14, 0x28, 0x7a, 0x48, 0x83, 0xa4, 0x87, 0xfe, 0x0d, 0x3d, 0x15,
+	0xbb, 0x5c, 0x5a, 0xa4, 0x3e, 0x9d, 0x34, 0x3d, 0xe5, 0xc4, 0xdd, 0xd9, 0xd9, 0xf9, 0xfd, 0x76,
+	0x66, 0x76, 0x67, 0x08, 0x8b, 0x84, 0x57, 0x89, 0x5f, 0x77, 0x3d, 0x6e, 0x91, 0x56, 0xdd, 0x6a,
+	0x6d, 0x5a, 0xb7, 0x9b, 0xc4, 0x3f, 0x30, 0x1b, 0x3e, 0xe5, 0x14, 0xcd, 0x1e, 0xae, 0x9a, 0xa4,
+	0x55, 0x37, 0x5b, 0x9b, 0xfa, 0x59, 0x9b, 0xb2, 0x3a, 0x65, 0x56, 0x19, 0x33, 0x12, 0xa8, 0x5a,
+	0xad, 0xcd, 0x32, 0xe1, 0x78, 0xd3, 0x6a, 0x60, 0xc7, 0xf5, 0x30, 0x77, 0xa9, 0x17, 0xec, 0xd6,
+	0xf5, 0x1e, 0xdb, 0xc2, 0x48, 0xb0, 0xb6, 0xd0, 0xb3, 0xc6, 0xdb, 0x6a, 0x29, 0xeb, 0x50, 0x87,
+	0xca, 0xa1, 0x25, 0x46, 0x4a, 0xba, 0xe8, 0x50, 0xea, 0xd4, 0x88, 0x85, 0x1b, 0xae, 0x85, 0x3d,
+	0x8f, 0x72, 0x89, 0xc4, 0xd4, 0x6a, 0x5e, 0xad, 0xca, 0x59, 0xb9, 0x79, 0xcb, 0xe2, 0x6e, 0x9d,
+	0x30, 0x8e, 0xeb, 0x8d, 0x40, 0xc1, 0xf8, 0x3f, 0xcc, 0xbd, 0x27, 0xd8, 0x5e, 0xb2, 0x6d, 0xda,
+	0xf4, 0x78, 0x91, 0xdc, 0x6e, 0x12, 0xc6, 0x51, 0x0e, 0x52, 0xb8, 0x52, 0xf1, 0x09, 0x63, 0x39,
+	0x6d, 0x45, 0x5b, 0x9f, 0x2a, 0x86, 0xd3, 0x37, 0xd2, 0x5f, 0x3e, 0xcc, 0x8f, 0xfd, 0xf1, 0x30,
+	0x3f, 0x66, 0xd8, 0x90, 0x8d, 0x6f, 0x65, 0x0d, 0xea, 0x31, 0x22, 0xf6, 0x96, 0x71, 0x0d, 0x7b,
+	0x36, 0x09, 0xf7, 0xaa, 0x29, 0x3a, 0x05, 0x53, 0x36, 0xad, 0x90, 0x52, 0x15, 0xb3, 0x6a, 0x6e,
+	0x5c, 0xae, 0xa5, 0x85, 0xe0, 0x6d, 0xcc, 0xaa, 0x28, 0x0b, 0x13, 0x1e, 0x15, 0x9b, 0x12, 0x2b,
+	0xda, 0x7a, 0xb2, 0x18, 0x4c, 0x8c, 0x37, 0x61, 0x41, 0x82, 0xec, 0x48, 0xf7, 0xbe, 0x04, 0xcb,
+	0xcf, 0x35, 0xd0, 0xfb, 0x59, 0x50, 0x64, 0x57, 0xe1, 0x58, 0x10, 0xb9, 0x52, 0xdc, 0xd2, 0x4c,
+	0x20, 0xbd, 0x14, 0x08, 0x91, 0x0e, 0x69, 0x26, 0x40, 0x05, 0xbf, 0x71, 0xc9, 0xef, 0x70, 0x2e,
+	0x4c, 0xe0, 0xc0, 0x6a, 0xc9, 0x6b, 0xd6, 0xcb, 0xc4, 0x57, 0x27, 0x98, 0x51, 0xd2, 0x77, 0xa5,
+	0xd0, 0xb8, 0x06, 0x8b, 0x92, 0xc7, 0x07, 0xb8, 0xe6, 0x56, 0x30, 0xa7, 0x7e, 0xd7, 0x61, 0x4e,
+	0xc3, 0xb4, 0x4d, 0xbd, 0x6e, 0x1e, 0x19, 0x21, 0xbb, 0xd4, 0x73, 0xaa, 0xaf, 0x35, 0x58, 0x1a,
+	0x60, 0x4d, 0x1d, 0xac, 0x00, 0xc7, 0x43, 0x56, 0x71, 0x8b, 0x21, 0xd9, 0x57, 0x78, 0xb4, 0x30,
+	0x89, 0xb6, 0x83, 0x38, 0xbf, 0x48, 0x78, 0x2e, 0x40, 0x36, 0xbe, 0x75, 0x54, 0x12, 0x19, 0xd7,
+	0x14, 0xd8, 0xfb, 0x9c, 0xfa, 0xd8, 0x19, 0x0d, 0x86, 0x66, 0x21, 0xb1, 0x4f, 0x0e, 0x54, 0xbe,
+	0x89, 0x61, 0x04, 0xfe, 0x3c, 0x64, 0xe3, 0xc6, 0x14, 0x7c, 0x16, 0x26, 0x5a, 0xb8, 0xd6, 0x0c,
+	0xc1, 0x83, 0x89, 0x71, 0x11, 0x66, 0x55, 0x2a, 0x55, 0x5e, 0xe8, 0x90, 0x05, 0xf8, 0x57, 0x64,
+	0x9f, 0x82, 0x40, 0x90, 0x14, 0xb9, 0x2f, 0x77, 0x4d, 0x17, 0xe5, 0xd8, 0xb8, 0x03, 0x48, 0x2a,
+	0xee, 0xb5, 0xaf, 0x53, 0x87, 0x85, 0x10, 0x08, 0x92, 0xf2, 0xc6, 0x04, 0xf6, 0xe5, 0x18, 0x5d,
+	0x06, 0xe8, 0xbc, 0x2b, 0xf2, 0x6c, 0x99, 0xad, 0x35, 0x33, 0x48, 0x5a, 0x53, 0x3c, 0x42, 0x66,
+	0xf0, 0x5e, 0xa9, 0x47, 0xc8, 0xbc, 0xd9, 0x71, 0x55, 0x31, 0xb2, 0x33, 0x42, 0xf2, 0x2b, 0x0d,
+	0xe6, 0x62, 0xe0, 0x8a, 0xe7, 0x19, 0x48, 0xd6, 0xa8, 0x23, 0x4e, 0x97, 0x58, 0xcf, 0x6c, 0xcd,
+	0x9b, 0xdd, 0x4f, 0x9f, 0x79, 0x9d, 0x3a, 0x45, 0xa9, 0x82, 0xae, 0xf4, 0x21, 0x55, 0x18, 0x49,
+	0x2a, 0xc0, 0x89, 0xb2, 0x32, 0xb2, 0xca, 0x0f, 0x37, 0xb1, 0x8f, 0xeb, 0xa1, 0x1f, 0x8c, 0x1b,
+	0x30, 0x17, 0x93, 0x2a, 0x82, 0x17, 0x61, 0xb2, 0x21, 0x25, 0xd2, 0x41, 0x99, 0xad, 0x5c, 0x2f,
+	0xc5, 0x60, 0xc7, 0x76, 0xf2, 0xd1, 0x93, 0xfc, 0x58, 0x51, 0x69, 0x1b, 0x3f, 0x6b, 0x70, 0x6c,
+	0x97, 0x57, 0x77, 0x70, 0xad, 0x16, 0xf1, 0x34, 0xf6, 0x1d, 0x16, 0xc6, 0x44, 0x8c, 0xd1, 0x49,
+	0x48, 0x39, 0x98, 0x95, 0x6c, 0xdc, 0x50, 0xd7, 0x63, 0xd2, 0xc1, 0x6c, 0x07, 0x37, 0xd0, 0x47,
+	0x30, 0xdb, 0xf0, 0x69, 0x83, 0x32, 0xe2, 0x1f, 0x5e, 0x31, 0x71, 0x3d, 0xa6, 0xb7, 0xb7, 0xfe,
+	0x7c, 0x92, 0x37, 0x1d, 0x97, 0x57, 0x9b, 0x65, 0xd3, 0xa6, 0x75, 0x4b, 0xd5, 0x86, 0xe0, 0xb3,
+	0xc1, 0x2a, 0xfb, 0x16, 0x3f, 0x68, 0x10, 0x66, 0xee, 0x74, 0xee, 0x76, 0xf1, 0x78, 0x68, 0x2b,
+	0xbc, 0x97, 0x0b, 0x90, 0xb6, 0xab, 0xd8, 0xf5, 0x4a, 0x6e, 0x25, 0x97, 0x5c, 0xd1, 0xd6, 0x13,
+	0xc5, 0x94, 0x9c, 0x5f, 0xad, 0xa0, 0x45, 0x98, 0xa2, 0x2d, 0xe2, 0xfb, 0x6e, 0x85, 0xb0, 0xdc,
+	0x84, 0xe4, 0xda, 0x11, 0x18, 0x05, 0x98, 0xdb, 0x65, 0xdc, 0xad, 0x63, 0x4e, 0xae, 0xe0, 0x8e,
+	0x9b, 0x66, 0x21, 0xe1, 0xe0, 0xe0, 0x68, 0xc9, 0xa2, 0x18, 0x1a, 0x4f, 0x13, 0x61, 0xc4, 0x7d,
+	0x6c, 0x93, 0xbd, 0x76, 0xe8, 0x85, 0x4d, 0x48, 0xd4, 0x99, 0xa3, 0xbc, 0x99, 0xef, 0xf5, 0xe6,
+	0x0d, 0xe6, 0xec, 0x0a, 0x19, 0x69, 0xd6, 0xf7, 0xda, 0x45, 0xa1, 0x8b, 0xde, 0x82, 0x69, 0x2e,
+	0x8c, 0x94, 0x6c, 0xea, 0xdd, 0x72, 0x1d, 0xe9, 0x87, 0xcc, 0xd6, 0x52, 0xef, 0x5e, 0x09, 0xb5,
+	0x23, 0x95, 0x8a, 0x19, 0xde, 0x99, 0xa0, 0x1d, 0x98, 0x6e, 0xf8, 0xa4, 0x42, 0x6c, 0xc2, 0x18,
+	0xf5, 0x59, 0x2e, 0xb9, 0x92, 0x38, 0x0a, 0x7a, 0x6c, 0x93, 0x78, 0x43, 0xcb, 0x35, 0x6a, 0xef,
+	0x87, 0xaf, 0xd5, 0x84, 0xf4, 0x5b, 0x46, 0xca, 0x82, 0xb7, 0x0a, 0x2d, 0x01, 0x04, 0x2a, 0xf2,
+	0x4a, 0x4d, 0xca, 0x2b, 0x35, 0x25, 0x25, 0xb2, 0x0a, 0xed, 0x84, 0xcb, 0xa2, 0x50, 0xe6, 0x52,
+	0xf2, 0x18, 0xba, 0x19, 0x54, 0x51, 0x33, 0xac, 0xa2, 0xe6, 0x5e, 0x58, 0x45, 0xb7, 0xd3, 0x22,
+	0xa5, 0x1e, 0xfc, 0x96, 0xd7, 0x94, 0x11, 0xb1, 0xd2, 0x37, 0x33, 0xd2, 0xff, 0x4c, 0x66, 0x4c,
+	0xc5, 0x32, 0xe3, 0x9d, 0x64, 0x7a, 0x7c, 0x36, 0x51, 0x4c, 0xf3, 0x76, 0xc9, 0xf5, 0x2a, 0xa4,
+	0x6d, 0x9c, 0x55, 0xef, 0xdb, 0x61, 0x84, 0x3b, 0x8f, 0x4f, 0x05, 0x73, 0x1c, 0x26, 0xba, 0x18,
+	0x1b, 0xdf, 0x27, 0x60, 0xbe, 0xa3, 0xfc, 0xd2, 0xd7, 0xe2, 0xef, 0xa7, 0x42, 0x2c, 0xbd, 0x93,
+	0x5d, 0xe9, 0xfd, 0xda, 0xc7, 0xd8, 0x38, 0x0f, 0x27, 0xba, 0xc3, 0x34, 0x24, 0xaa, 0xdf, 0x24,
+	0xa2, 0xea, 0xdb, 0x82, 0x7f, 0xe4, 0x9e, 0xf3, 0x76, 0xf8, 0xb0, 0x8f, 0xbe, 0xe7, 0xbc, 0xcd,
+	0x5e, 0x41, 0x70, 0x5f, 0xfb, 0xf0, 0x6d, 0xc0, 0xc9, 0x9e, 0x78, 0x0c, 0x89, 0xdf, 0xfc, 0x61,
+	0x6f, 0xc5, 0xc8, 0x65, 0x12, 0xd6, 0x70, 0xe3, 0x3a, 0x64, 0xe3, 0x62, 0x65, 0xe2, 0x7f, 0x90,
+	0x16, 0x85, 0xb6, 0x74, 0x8b, 0xa8, 0xde, 0x65, 0x7b, 0xe1, 0xd7, 0x27, 0xf9, 0xf9, 0x80, 0x3d,
+	0xab, 0xec, 0x9b, 0x2e, 0xb5, 0xea, 0x98, 0x57, 0xcd, 0xab, 0x1e, 0x17, 0x3d, 0x95, 0xdc, 0xbd,
+	0xf5, 0xe3, 0x0c, 0x4c, 0x48, 0x73, 0xe8, 0x53, 0x0d, 0x52, 0xaa, 0x95, 0x44, 0xab, 0xbd, 0x61,
+	0xed, 0xf3, 0xaf, 0xa0, 0xaf, 0x8d, 0x52, 0x0b, 0xa8, 0x19, 0x85, 0xfb, 0x3f, 0xfd, 0xfe, 0xed,
+	0xf8, 0x69, 0x94, 0x17, 0x7f, 0x36, 0x94, 0x85, 0xff, 0x37, 0xaa, 0x95, 0xb4, 0xee, 0xaa, 0x30,
+	0xdc, 0x43, 0xdf, 0x69, 0x30, 0x13, 0xeb, 0xd6, 0xd1, 0xb9, 0x01, 0x10, 0xfd, 0xfe, 0x0a, 0xf4,
+	0xf3, 0x47, 0x53, 0x56, 0xac, 0x4c, 0xc9, 0x6a, 0x1d, 0xad, 0xc5, 0x59, 0x85, 0x3f, 0x05, 0x3d,
+	0xe4, 0x7e, 0xd0, 0x60, 0xb6, 0xbb, 0xe9, 0x46, 0xe6, 0x00, 0xc8, 0x01, 0xbd, 0xbe, 0x6e, 0x1d,
+	0x59, 0x5f, 0xb1, 0xbc, 0x28, 0x59, 0x5e, 0x40, 0x66, 0x9c, 0x65, 0x2b, 0xd4, 0xef, 0x10, 0x8d,
+	0xfe, 0x43, 0xdc, 0x43, 0xf7, 0x35, 0x48, 0xa9, 0xd6, 0x7a, 0x60, 0x38, 0xe3, 0x5d, 0xbb, 0xbe,
+	0x36, 0x4a, 0x4d, 0x51, 0x5a, 0x97, 0x94, 0x0c, 0xb4, 0x12, 0xa7, 0xa4, 0xda, 0x74, 0x16, 0x71,
+	0xd9, 0x17, 0x1a, 0xa4, 0x54, 0x83, 0x3d, 0x90, 0x44, 0xbc, 0x9b, 0xd7, 0xd7, 0x46, 0xa9, 0x29,
+	0x12, 0x1b, 0x92, 0x44, 0x01, 0xad, 0xc6, 0x49, 0xb0, 0x40, 0xad, 0xc3, 0xc1, 0xba, 0xbb, 0x4f,
+	0x0e, 0xee, 0xa1, 0x16, 0x24, 0x45, 0x0f, 0x8e, 0x8c, 0x81, 0x29, 0x72, 0xd8, 0xd8, 0xeb, 0xff,
+	0x1e, 0xaa, 0xa3, 0xf0, 0x57, 0x25, 0x7e, 0x1e, 0x2d, 0x75, 0x67, 0x4f, 0x25, 0xe6, 0x01, 0x06,
+	0x93, 0x41, 0x0b, 0x8a, 0xfe, 0x33, 0xc0, 0x6a, 0xac, 0xd3, 0xd5, 0x57, 0x47, 0x68, 0x29, 0xf4,
+	0x45, 0x89, 0x7e, 0x02, 0x65, 0xe3, 0xe8, 0x41, 0x7f, 0x8b, 0x38, 0xa4, 0x54, 0x7b, 0x8b, 0x56,
+	0x7a, 0xed, 0xc5, 0x3b, 0x5f, 0xbd, 0x30, 0xea, 0xf9, 0x0f, 0x31, 0x97, 0x25, 0x66, 0x0e, 0x9d,
+	0x88, 0x63, 0x12, 0x5e, 0x2d, 0xd9, 0x02, 0xea, 0x0e, 0x64, 0x22, 0xdd, 0xe7, 0x11, 0x90, 0xfb,
+	0x9c, 0xb5, 0x4f, 0xfb, 0x6a, 0x18, 0x12, 0x77, 0x11, 0xe9, 0x5d, 0xb8, 0x4a, 0xb5, 0xe4, 0x60,
+	0x86, 0xda, 0x90, 0x52, 0x8d, 0xce, 0xc0, 0x3c, 0x8b, 0xb7, 0xba, 0xfa, 0xda, 0x28, 0xb5, 0xe1,
+	0xa7, 0x0e, 0x6a, 0x21, 0x6f, 0xa3, 0x4f, 0x34, 0x98, 0x3a, 0xac, 0xc7, 0xa8, 0x30, 0xcc, 0x6a,
+	0xf4, 0xec, 0xeb, 0xa3, 0x15, 0x15, 0x81, 0x15, 0x49, 0x40, 0x47, 0xb9, 0x7e, 0x04, 0xa4, 0xe3,
+	0x3f, 0xd3, 0x00, 0x3a, 0x35, 0x05, 0x0d, 0x35, 0x1d, 0x6d, 0x03, 0xf4, 0x33, 0x47, 0xd0, 0x54,
+	0x2c, 0x4e, 0x4b, 0x16, 0xa7, 0xd0, 0x42, 0x3f, 0x16, 0xb2, 0xb8, 0x8a, 0x18, 0xa8, 0x9a, 0x34,
+	0xe4, 0xc1, 0x89, 0x96, 0x32, 0x7d, 0x6d, 0x94, 0xda, 0xf0, 0x18, 0x84, 0xe5, 0x6e, 0x7b, 0xf7,
+	0xd1, 0xb3, 0x65, 0xed, 0xf1, 0xb3, 0x65, 0xed, 0xe9, 0xb3, 0x65, 0xed, 0xc1, 0xf3, 0xe5, 0xb1,
+	0xc7, 0xcf, 0x97, 0xc7, 0x7e, 0x79, 0xbe, 0x3c, 0xf6, 0xe1, 0xb9, 0x48, 0x39, 0xaf, 0x12, 0xde,
+	0xdc, 0x68, 0xf8, 0xf4, 0x63, 0x62, 0x73, 0x39, 0x11, 0x36, 0xda, 0xd2, 0x98, 0xac, 0xeb, 0xe5,
+	0x49, 0xd9, 0x42, 0xfc, 0xf7, 0xaf, 0x01, 0x00, 0x8b, 0x6a, 0x13, 0x36, 0x02, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1443,6 +1624,8 @@ type QueryClient interface {
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
@@ -1548,6 +1731,15 @@ func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error) {
+	out := new(QueryTraceCallResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error) {
 	out := new(QueryTraceBlockResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceBlock", in, out, opts...)
@@ -1590,6 +1782,8 @@ type QueryServer interface {
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
@@ -1631,6 +1825,9 @@ func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRe
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
+func (*UnimplementedQueryServer) TraceCall(ctx context.Context, req *QueryTraceCallRequest) (*QueryTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
 func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
@@ -1822,6 +2019,24 @@ func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/TraceCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceBlockRequest)
 	if err := dec(in); err != nil {
@@ -1858,6 +2073,7 @@ func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1902,6 +2118,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
 		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _Query_TraceCall_Handler,
+		},
 		{
 			MethodName: "TraceBlock",
 			Handler:    _Query_TraceBlock_Handler,
@@ -2481,6 +2701,13 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -2659,7 +2886,7 @@ func (m *QueryTraceTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2669,12 +2896,12 @@ func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2711,6 +2938,13 @@ func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x28
 	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.TraceConfig != nil {
 		{
 			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
@@ -2723,24 +2957,22 @@ func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Txs) > 0 {
-		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceBlockResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2750,12 +2982,12 @@ func (m *QueryTraceBlockResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2770,7 +3002,7 @@ func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2780,20 +3012,78 @@ func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceBlockRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceBlockResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2803,22 +3093,75 @@ func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceBlockResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BaseFee != nil {
-		{
-			size := m.BaseFee.Size()
-			i -= size
-			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
 			}
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
@@ -3094,6 +3437,10 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3161,6 +3508,59 @@ func (m *QueryTraceTxResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTraceCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryTraceCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTraceBlockRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4888,6 +5288,40 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5355,6 +5789,400 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTraceCallRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
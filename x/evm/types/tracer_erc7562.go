@@ -0,0 +1,331 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// TracerERC7562 is the name of the tracer that checks the ERC-7562 validation
+// rules of ERC-4337 user operations.
+const TracerERC7562 = "erc7562Tracer"
+
+// associatedSlotRange is the number of slots following keccak(sender || x) that
+// are considered associated with the sender, so that structs can be stored in
+// mappings keyed by the sender.
+const associatedSlotRange = 128
+
+// depositToSelector is the selector of the EntryPoint depositTo(address) method.
+var depositToSelector = []byte{0xb7, 0x60, 0xfa, 0xf9}
+
+// bannedOpcodes are the opcodes that cannot be used during validation (OP-011)
+// as their result differs between the simulation and the on-chain execution.
+var bannedOpcodes = map[vm.OpCode]bool{
+	vm.GASPRICE:     true,
+	vm.GASLIMIT:     true,
+	vm.DIFFICULTY:   true,
+	vm.TIMESTAMP:    true,
+	vm.BASEFEE:      true,
+	vm.BLOCKHASH:    true,
+	vm.NUMBER:       true,
+	vm.SELFBALANCE:  true,
+	vm.BALANCE:      true,
+	vm.ORIGIN:       true,
+	vm.CREATE:       true,
+	vm.COINBASE:     true,
+	vm.SELFDESTRUCT: true,
+	vm.INVALID:      true,
+}
+
+// ERC7562TracerConfig defines the entities of the traced user operation.
+type ERC7562TracerConfig struct {
+	// EntryPoint is the EntryPoint contract. Its own execution is not checked.
+	EntryPoint common.Address `json:"entryPoint"`
+	// Sender is the account of the user operation.
+	Sender common.Address `json:"sender"`
+	// Factory is the account factory, if the account is not yet deployed.
+	Factory *common.Address `json:"factory,omitempty"`
+	// StakedEntities are the entities allowed to access their own storage.
+	StakedEntities []common.Address `json:"stakedEntities,omitempty"`
+}
+
+// ERC7562Violation describes a validation rule broken during the traced call.
+type ERC7562Violation struct {
+	Rule    string         `json:"rule"`
+	Address common.Address `json:"address"`
+	Opcode  string         `json:"opcode,omitempty"`
+	Depth   int            `json:"depth"`
+	Detail  string         `json:"detail,omitempty"`
+}
+
+// ERC7562StorageAccess lists the storage slots read and written on a contract.
+type ERC7562StorageAccess struct {
+	Reads  []common.Hash `json:"reads"`
+	Writes []common.Hash `json:"writes"`
+}
+
+// ERC7562Result is the result returned by the ERC-7562 tracer.
+type ERC7562Result struct {
+	Valid      bool                                     `json:"valid"`
+	Violations []ERC7562Violation                       `json:"violations"`
+	Storage    map[common.Address]*ERC7562StorageAccess `json:"storage"`
+}
+
+// ERC7562Tracer checks the opcode, storage and call rules of ERC-7562 on every
+// frame executed below the EntryPoint, and reports the rules that are broken.
+type ERC7562Tracer struct {
+	cfg ERC7562TracerConfig
+	env *vm.EVM
+
+	violations []ERC7562Violation
+	storage    map[common.Address]*ERC7562StorageAccess
+	// associated are the keccak(sender || x) slots computed during the execution
+	associated []*big.Int
+	// frames is the stack of the called addresses
+	frames []common.Address
+	// gasDepth is the depth of the last GAS opcode, zero if the previous opcode was not GAS
+	gasDepth int
+	creates  int
+
+	interrupt atomic.Bool
+	reason    error
+}
+
+var _ vm.EVMLogger = &ERC7562Tracer{}
+
+// NewERC7562Tracer creates a new ERC-7562 tracer from its json configuration.
+func NewERC7562Tracer(cfg json.RawMessage) (*ERC7562Tracer, error) {
+	t := &ERC7562Tracer{storage: make(map[common.Address]*ERC7562StorageAccess)}
+	if len(cfg) > 0 {
+		if err := json.Unmarshal(cfg, &t.cfg); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// CaptureTxStart implements the EVMLogger interface.
+func (t *ERC7562Tracer) CaptureTxStart(uint64) {}
+
+// CaptureTxEnd implements the EVMLogger interface.
+func (t *ERC7562Tracer) CaptureTxEnd(uint64) {}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *ERC7562Tracer) CaptureStart(env *vm.EVM, _ common.Address, to common.Address, _ bool, _ []byte, _ uint64, _ *big.Int) {
+	t.env = env
+	t.frames = append(t.frames, to)
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *ERC7562Tracer) CaptureEnd(_ []byte, _ uint64, _ time.Duration, err error) {
+	t.exitFrame(err)
+}
+
+// CaptureEnter is called when the EVM enters a new scope (via call, create or selfdestruct).
+func (t *ERC7562Tracer) CaptureEnter(_ vm.OpCode, _ common.Address, to common.Address, _ []byte, _ uint64, _ *big.Int) {
+	t.frames = append(t.frames, to)
+}
+
+// CaptureExit is called when the EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *ERC7562Tracer) CaptureExit(_ []byte, _ uint64, err error) {
+	t.exitFrame(err)
+}
+
+// CaptureFault implements the EVMLogger interface to trace an execution fault.
+func (t *ERC7562Tracer) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {
+}
+
+// CaptureState checks the rules that apply to the opcode being executed.
+func (t *ERC7562Tracer) CaptureState(_ uint64, op vm.OpCode, _, _ uint64, scope *vm.ScopeContext, _ []byte, depth int, _ error) {
+	if t.interrupt.Load() {
+		return
+	}
+
+	addr := scope.Contract.Address()
+	if t.isEntryPoint(addr) {
+		t.gasDepth = 0
+		return
+	}
+
+	// OP-012: GAS is only allowed when immediately followed by a call
+	if t.gasDepth == depth && !isCall(op) {
+		t.violate("OP-012", addr, vm.GAS, depth, "GAS not followed by a call")
+	}
+	t.gasDepth = 0
+
+	stack := scope.Stack
+	switch {
+	case bannedOpcodes[op]:
+		t.violate("OP-011", addr, op, depth, "")
+	case op == vm.GAS:
+		t.gasDepth = depth
+	case op == vm.CREATE2:
+		// OP-031: CREATE2 is only allowed once, to deploy the sender with the factory
+		t.creates++
+		if t.cfg.Factory == nil || t.creates > 1 {
+			t.violate("OP-031", addr, op, depth, "")
+		}
+	case op == vm.SLOAD || op == vm.SSTORE:
+		slot := common.Hash(stack.Back(0).Bytes32())
+		t.recordStorage(addr, slot, op == vm.SSTORE)
+		if !t.storageAllowed(addr, slot) {
+			t.violate("STO-021", addr, op, depth, "access to unassociated storage slot "+slot.Hex())
+		}
+	case op == vm.KECCAK256:
+		t.recordKeccak(scope)
+	case op == vm.EXTCODESIZE || op == vm.EXTCODEHASH || op == vm.EXTCODECOPY:
+		t.checkCode(common.Address(stack.Back(0).Bytes20()), addr, op, depth)
+	case isCall(op):
+		target := common.Address(stack.Back(1).Bytes20())
+		if t.isEntryPoint(target) {
+			t.checkEntryPointCall(scope, addr, op, depth)
+			return
+		}
+		t.checkCode(target, addr, op, depth)
+	}
+}
+
+// GetResult returns the violations and the storage accessed during the trace.
+func (t *ERC7562Tracer) GetResult() (json.RawMessage, error) {
+	if t.reason != nil {
+		return nil, t.reason
+	}
+
+	violations := t.violations
+	if violations == nil {
+		violations = []ERC7562Violation{}
+	}
+	return json.Marshal(ERC7562Result{
+		Valid:      len(t.violations) == 0,
+		Violations: violations,
+		Storage:    t.storage,
+	})
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *ERC7562Tracer) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
+
+// exitFrame pops the current frame and checks that it did not run out of gas (OP-020).
+func (t *ERC7562Tracer) exitFrame(err error) {
+	if len(t.frames) == 0 {
+		return
+	}
+	addr := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+
+	if errors.Is(err, vm.ErrOutOfGas) && !t.isEntryPoint(addr) {
+		t.violate("OP-020", addr, 0, len(t.frames)+1, "out of gas")
+	}
+}
+
+func (t *ERC7562Tracer) isEntryPoint(addr common.Address) bool {
+	return t.cfg.EntryPoint != (common.Address{}) && addr == t.cfg.EntryPoint
+}
+
+// checkCode checks that the accessed address has code (OP-041). The sender is
+// exempted as it may be deployed by the factory during validation.
+func (t *ERC7562Tracer) checkCode(target, addr common.Address, op vm.OpCode, depth int) {
+	if target == t.cfg.Sender {
+		return
+	}
+	if _, ok := t.env.Precompile(target); ok {
+		return
+	}
+	if t.env.StateDB.GetCodeSize(target) == 0 {
+		t.violate("OP-041", addr, op, depth, "access to address without code "+target.Hex())
+	}
+}
+
+// checkEntryPointCall checks that the EntryPoint is only called with depositTo
+// or on its fallback function (OP-052, OP-053).
+func (t *ERC7562Tracer) checkEntryPointCall(scope *vm.ScopeContext, addr common.Address, op vm.OpCode, depth int) {
+	offset, size := scope.Stack.Back(3), scope.Stack.Back(4)
+	if op == vm.DELEGATECALL || op == vm.STATICCALL {
+		offset, size = scope.Stack.Back(2), scope.Stack.Back(3)
+	}
+	if size.IsZero() {
+		return
+	}
+	if size.IsUint64() && size.Uint64() >= 4 && offset.IsUint64() &&
+		offset.Uint64()+4 <= uint64(scope.Memory.Len()) &&
+		bytes.Equal(scope.Memory.GetCopy(int64(offset.Uint64()), 4), depositToSelector) {
+		return
+	}
+	t.violate("OP-052", addr, op, depth, "call to the EntryPoint other than depositTo")
+}
+
+// recordKeccak keeps the hashes of keccak(sender || x), which are the base
+// slots of the mappings entries associated with the sender.
+func (t *ERC7562Tracer) recordKeccak(scope *vm.ScopeContext) {
+	offset, size := scope.Stack.Back(0), scope.Stack.Back(1)
+	if !offset.IsUint64() || !size.IsUint64() || size.Uint64() < common.HashLength ||
+		offset.Uint64()+size.Uint64() > uint64(scope.Memory.Len()) {
+		return
+	}
+	data := scope.Memory.GetCopy(int64(offset.Uint64()), int64(size.Uint64()))
+	if bytes.Equal(data[:common.HashLength], common.LeftPadBytes(t.cfg.Sender.Bytes(), common.HashLength)) {
+		t.associated = append(t.associated, crypto.Keccak256Hash(data).Big())
+	}
+}
+
+// storageAllowed returns true if the slot can be accessed during validation:
+// the sender storage, the storage of staked entities and the slots associated
+// with the sender on any contract (STO-010, STO-021, STO-031).
+func (t *ERC7562Tracer) storageAllowed(addr common.Address, slot common.Hash) bool {
+	if addr == t.cfg.Sender {
+		return true
+	}
+	for _, staked := range t.cfg.StakedEntities {
+		if addr == staked {
+			return true
+		}
+	}
+	if slot == common.BytesToHash(t.cfg.Sender.Bytes()) {
+		return true
+	}
+
+	value := slot.Big()
+	for _, base := range t.associated {
+		diff := new(big.Int).Sub(value, base)
+		if diff.Sign() >= 0 && diff.Cmp(big.NewInt(associatedSlotRange)) <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *ERC7562Tracer) recordStorage(addr common.Address, slot common.Hash, write bool) {
+	access, ok := t.storage[addr]
+	if !ok {
+		access = &ERC7562StorageAccess{Reads: []common.Hash{}, Writes: []common.Hash{}}
+		t.storage[addr] = access
+	}
+	if write {
+		access.Writes = append(access.Writes, slot)
+	} else {
+		access.Reads = append(access.Reads, slot)
+	}
+}
+
+func (t *ERC7562Tracer) violate(rule string, addr common.Address, op vm.OpCode, depth int, detail string) {
+	violation := ERC7562Violation{Rule: rule, Address: addr, Depth: depth, Detail: detail}
+	if op != 0 {
+		violation.Opcode = op.String()
+	}
+	t.violations = append(t.violations, violation)
+}
+
+func isCall(op vm.OpCode) bool {
+	return op == vm.CALL || op == vm.CALLCODE || op == vm.DELEGATECALL || op == vm.STATICCALL
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestERC7562Tracer(t *testing.T) {
+	// address of the code executed by runtime.Execute
+	contract := common.BytesToAddress([]byte("contract"))
+	sender := common.BigToAddress(common.Big1)
+
+	testCases := []struct {
+		name   string
+		code   []byte
+		sender common.Address
+		rules  []string
+	}{
+		{
+			"valid",
+			[]byte{byte(vm.PUSH1), 0x01, byte(vm.POP), byte(vm.STOP)},
+			sender,
+			nil,
+		},
+		{
+			"banned opcode",
+			[]byte{byte(vm.TIMESTAMP), byte(vm.POP), byte(vm.STOP)},
+			sender,
+			[]string{"OP-011"},
+		},
+		{
+			"GAS not followed by a call",
+			[]byte{byte(vm.GAS), byte(vm.POP), byte(vm.STOP)},
+			sender,
+			[]string{"OP-012"},
+		},
+		{
+			"unassociated storage",
+			[]byte{byte(vm.PUSH1), 0x02, byte(vm.SLOAD), byte(vm.STOP)},
+			sender,
+			[]string{"STO-021"},
+		},
+		{
+			"sender storage",
+			[]byte{byte(vm.PUSH1), 0x02, byte(vm.SLOAD), byte(vm.STOP)},
+			contract,
+			nil,
+		},
+		{
+			"slot equal to the sender",
+			[]byte{byte(vm.PUSH1), 0x01, byte(vm.SLOAD), byte(vm.STOP)},
+			sender,
+			nil,
+		},
+		{
+			"create2 without factory",
+			[]byte{
+				byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00,
+				byte(vm.CREATE2), byte(vm.STOP),
+			},
+			sender,
+			[]string{"OP-031"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := json.Marshal(ERC7562TracerConfig{Sender: tc.sender})
+			require.NoError(t, err)
+			tracer, err := NewERC7562Tracer(cfg)
+			require.NoError(t, err)
+
+			_, _, err = runtime.Execute(tc.code, nil, &runtime.Config{
+				EVMConfig: vm.Config{Debug: true, Tracer: tracer},
+			})
+			require.NoError(t, err)
+
+			bz, err := tracer.GetResult()
+			require.NoError(t, err)
+			var res ERC7562Result
+			require.NoError(t, json.Unmarshal(bz, &res))
+
+			var rules []string
+			for _, violation := range res.Violations {
+				rules = append(rules, violation.Rule)
+			}
+			require.Equal(t, tc.rules, rules)
+			require.Equal(t, len(tc.rules) == 0, res.Valid)
+		})
+	}
+}
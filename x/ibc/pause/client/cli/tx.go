@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hetu-project/hetu/v1/x/ibc/pause/types"
+)
+
+// FlagExpedited defines the flag submitting a proposal as expedited
+const FlagExpedited = "expedited"
+
+// GetTxCmd returns the cli transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewPauseChannelProposalCmd(),
+		NewResumeChannelProposalCmd(),
+	)
+
+	return cmd
+}
+
+// NewPauseChannelProposalCmd implements the command to submit a governance
+// proposal pausing a transfer channel.
+func NewPauseChannelProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-channel-proposal CHANNEL_ID",
+		Short: "Submit a proposal to pause the transfers of a channel",
+		Long: `Submit a governance proposal to pause a transfer channel along with an initial deposit.
+The transfers sent through a paused channel are rejected and the transfers received from it are refunded to their senders.
+Submit the proposal as expedited to pause the channel in an emergency.`,
+		Example: fmt.Sprintf(`$ %s tx %s pause-channel-proposal channel-0 --title="Pause channel-0" --summary="..." --expedited --deposit=10000000ahetu --from=<key_or_address>`,
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+			msg := types.NewMsgPauseChannel(authority.String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if proposal.Expedited, err = cmd.Flags().GetBool(FlagExpedited); err != nil {
+				return err
+			}
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().Bool(FlagExpedited, false, "Submit the proposal as expedited, with the shorter voting period")
+	govcli.AddGovPropFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewResumeChannelProposalCmd implements the command to submit a governance
+// proposal resuming a paused transfer channel.
+func NewResumeChannelProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-channel-proposal CHANNEL_ID",
+		Short: "Submit a proposal to resume the transfers of a paused channel",
+		Long:  `Submit a governance proposal to resume a paused transfer channel along with an initial deposit.`,
+		Example: fmt.Sprintf(`$ %s tx %s resume-channel-proposal channel-0 --title="Resume channel-0" --summary="..." --deposit=10000000ahetu --from=<key_or_address>`,
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+			msg := types.NewMsgResumeChannel(authority.String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
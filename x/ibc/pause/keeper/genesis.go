@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hetu-project/hetu/v1/x/ibc/pause/types"
+)
+
+// InitGenesis initializes the channelpause module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
+	for _, channel := range data.PausedChannels {
+		k.SetPausedChannel(ctx, channel)
+	}
+}
+
+// ExportGenesis returns the channelpause module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{PausedChannels: k.GetAllPausedChannels(ctx)}
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/hetu-project/hetu/v1/x/ibc/pause/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// PausedChannels returns all the paused transfer channels
+func (k Keeper) PausedChannels(goCtx context.Context, req *types.QueryPausedChannelsRequest) (*types.QueryPausedChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryPausedChannelsResponse{PausedChannels: k.GetAllPausedChannels(ctx)}, nil
+}
+
+// PausedChannel returns whether a transfer channel is paused
+func (k Keeper) PausedChannel(goCtx context.Context, req *types.QueryPausedChannelRequest) (*types.QueryPausedChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	channel, found := k.GetPausedChannel(ctx, req.ChannelId)
+	return &types.QueryPausedChannelResponse{Paused: found, PausedChannel: channel}, nil
+}
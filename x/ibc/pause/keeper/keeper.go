@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+
+	"github.com/hetu-project/hetu/v1/x/ibc/pause/types"
+)
+
+// Keeper stores the paused transfer channels. It wraps the ICS4 wrapper of
+// the transfer stack to reject the packets sent through a paused channel.
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	channelKeeper types.ChannelKeeper
+	ics4Wrapper   porttypes.ICS4Wrapper
+	// the address capable of pausing and resuming channels. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+}
+
+// NewKeeper creates a new channelpause Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	channelKeeper types.ChannelKeeper,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	authority sdk.AccAddress,
+) Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		channelKeeper: channelKeeper,
+		ics4Wrapper:   ics4Wrapper,
+		authority:     authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hetu-project/hetu/v1/x/ibc/pause/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// PauseChannel defines a method for pausing a transfer channel
+func (k Keeper) PauseChannel(goCtx context.Context, req *types.MsgPauseChannel) (*types.MsgPauseChannelResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.PauseTransferChannel(ctx, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseChannelResponse{}, nil
+}
+
+// ResumeChannel defines a method for resuming a paused transfer channel
+func (k Keeper) ResumeChannel(goCtx context.Context, req *types.MsgResumeChannel) (*types.MsgResumeChannelResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ResumePausedChannel(ctx, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResumeChannelResponse{}, nil
+}
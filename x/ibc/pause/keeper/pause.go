@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/hetu-project/hetu/v1/x/ibc/pause/types"
+)
+
+// GetPausedChannel returns the pause of a channel
+func (k Keeper) GetPausedChannel(ctx sdk.Context, channelID string) (types.PausedChannel, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedChannelPrefix)
+	bz := store.Get([]byte(channelID))
+	if bz == nil {
+		return types.PausedChannel{}, false
+	}
+
+	var channel types.PausedChannel
+	k.cdc.MustUnmarshal(bz, &channel)
+	return channel, true
+}
+
+// SetPausedChannel stores the pause of a channel
+func (k Keeper) SetPausedChannel(ctx sdk.Context, channel types.PausedChannel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedChannelPrefix)
+	store.Set([]byte(channel.ChannelId), k.cdc.MustMarshal(&channel))
+}
+
+// IsChannelPaused returns whether a channel is paused
+func (k Keeper) IsChannelPaused(ctx sdk.Context, channelID string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedChannelPrefix)
+	return store.Has([]byte(channelID))
+}
+
+// GetAllPausedChannels returns the paused channels ordered by channel identifier
+func (k Keeper) GetAllPausedChannels(ctx sdk.Context) []types.PausedChannel {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedChannelPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	channels := []types.PausedChannel{}
+	for ; iterator.Valid(); iterator.Next() {
+		var channel types.PausedChannel
+		k.cdc.MustUnmarshal(iterator.Value(), &channel)
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
+// PauseTransferChannel pauses a transfer channel: the packets sent through it are
+// rejected, and the packets received from it are acknowledged with an error.
+// The acknowledgements and timeouts of the packets in flight are still
+// processed, so that their senders are refunded.
+func (k Keeper) PauseTransferChannel(ctx sdk.Context, channelID string) error {
+	if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, channelID); !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port %s, channel %s", transfertypes.PortID, channelID)
+	}
+	if k.IsChannelPaused(ctx, channelID) {
+		return errorsmod.Wrap(types.ErrChannelPaused, channelID)
+	}
+
+	k.SetPausedChannel(ctx, types.PausedChannel{
+		ChannelId:    channelID,
+		PausedHeight: ctx.BlockHeight(),
+	})
+
+	k.Logger(ctx).Info("Transfer channel paused", "channel_id", channelID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChannelPaused,
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyPausedHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+
+	return nil
+}
+
+// ResumePausedChannel lifts the pause of a transfer channel
+func (k Keeper) ResumePausedChannel(ctx sdk.Context, channelID string) error {
+	channel, found := k.GetPausedChannel(ctx, channelID)
+	if !found {
+		return errorsmod.Wrap(types.ErrChannelNotPaused, channelID)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedChannelPrefix)
+	store.Delete([]byte(channelID))
+
+	k.Logger(ctx).Info("Transfer channel resumed",
+		"channel_id", channelID,
+		"paused_height", channel.PausedHeight,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChannelResumed,
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyPausedHeight, fmt.Sprintf("%d", channel.PausedHeight)),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrChannelPaused    = errorsmod.Register(ModuleName, 2, "channel is paused")
+	ErrChannelNotPaused = errorsmod.Register(ModuleName, 3, "channel is not paused")
+	ErrChannelNotFound  = errorsmod.Register(ModuleName, 4, "channel not found")
+)
@@ -0,0 +1,10 @@
+package types
+
+// channelpause events
+const (
+	EventTypeChannelPaused  = "ibc_channel_paused"
+	EventTypeChannelResumed = "ibc_channel_resumed"
+
+	AttributeKeyChannelID    = "channel_id"
+	AttributeKeyPausedHeight = "paused_height"
+)
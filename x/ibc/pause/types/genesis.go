@@ -0,0 +1,27 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// DefaultGenesisState returns the default genesis state, with no paused channel
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.PausedChannels))
+	for _, channel := range gs.PausedChannels {
+		if err := host.ChannelIdentifierValidator(channel.ChannelId); err != nil {
+			return err
+		}
+		if seen[channel.ChannelId] {
+			return fmt.Errorf("duplicate paused channel %s", channel.ChannelId)
+		}
+		seen[channel.ChannelId] = true
+	}
+	return nil
+}
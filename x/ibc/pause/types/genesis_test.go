@@ -0,0 +1,23 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	require.NoError(t, DefaultGenesisState().Validate())
+
+	gs := GenesisState{PausedChannels: []PausedChannel{
+		{ChannelId: "channel-0", PausedHeight: 10},
+		{ChannelId: "channel-1", PausedHeight: 12},
+	}}
+	require.NoError(t, gs.Validate())
+
+	gs.PausedChannels = append(gs.PausedChannels, PausedChannel{ChannelId: "channel-0", PausedHeight: 14})
+	require.ErrorContains(t, gs.Validate(), "duplicate paused channel")
+
+	gs.PausedChannels = []PausedChannel{{ChannelId: "channel/0"}}
+	require.Error(t, gs.Validate())
+}
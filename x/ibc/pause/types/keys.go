@@ -0,0 +1,16 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "channelpause"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
+
+// PausedChannelPrefix defines a map prefix for the paused transfer channels:
+// 0x01 | channel_id -> paused channel
+var PausedChannelPrefix = []byte{0x01}
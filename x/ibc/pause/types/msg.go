@@ -0,0 +1,69 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgPauseChannel{}
+	_ sdk.Msg = &MsgResumeChannel{}
+)
+
+// NewMsgPauseChannel creates a new MsgPauseChannel instance
+func NewMsgPauseChannel(authority, channelID string) *MsgPauseChannel {
+	return &MsgPauseChannel{
+		Authority: authority,
+		ChannelId: channelID,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgPauseChannel message.
+func (m *MsgPauseChannel) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgPauseChannel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return host.ChannelIdentifierValidator(m.ChannelId)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgPauseChannel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgResumeChannel creates a new MsgResumeChannel instance
+func NewMsgResumeChannel(authority, channelID string) *MsgResumeChannel {
+	return &MsgResumeChannel{
+		Authority: authority,
+		ChannelId: channelID,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgResumeChannel message.
+func (m *MsgResumeChannel) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgResumeChannel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return host.ChannelIdentifierValidator(m.ChannelId)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgResumeChannel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
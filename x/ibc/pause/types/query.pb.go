@@ -0,0 +1,979 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hetu/ibc/pause/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryPausedChannelsRequest is the request type for the Query/PausedChannels RPC method.
+type QueryPausedChannelsRequest struct {
+}
+
+func (m *QueryPausedChannelsRequest) Reset()         { *m = QueryPausedChannelsRequest{} }
+func (m *QueryPausedChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedChannelsRequest) ProtoMessage()    {}
+func (*QueryPausedChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26a9db7972ca3cfe, []int{0}
+}
+func (m *QueryPausedChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedChannelsRequest.Merge(m, src)
+}
+func (m *QueryPausedChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedChannelsRequest proto.InternalMessageInfo
+
+// QueryPausedChannelsResponse is the response type for the Query/PausedChannels RPC method.
+type QueryPausedChannelsResponse struct {
+	// paused_channels defines the paused transfer channels.
+	PausedChannels []PausedChannel `protobuf:"bytes,1,rep,name=paused_channels,json=pausedChannels,proto3" json:"paused_channels"`
+}
+
+func (m *QueryPausedChannelsResponse) Reset()         { *m = QueryPausedChannelsResponse{} }
+func (m *QueryPausedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedChannelsResponse) ProtoMessage()    {}
+func (*QueryPausedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26a9db7972ca3cfe, []int{1}
+}
+func (m *QueryPausedChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedChannelsResponse.Merge(m, src)
+}
+func (m *QueryPausedChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedChannelsResponse proto.InternalMessageInfo
+
+func (m *QueryPausedChannelsResponse) GetPausedChannels() []PausedChannel {
+	if m != nil {
+		return m.PausedChannels
+	}
+	return nil
+}
+
+// QueryPausedChannelRequest is the request type for the Query/PausedChannel RPC method.
+type QueryPausedChannelRequest struct {
+	// channel_id is the identifier of the channel.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryPausedChannelRequest) Reset()         { *m = QueryPausedChannelRequest{} }
+func (m *QueryPausedChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedChannelRequest) ProtoMessage()    {}
+func (*QueryPausedChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26a9db7972ca3cfe, []int{2}
+}
+func (m *QueryPausedChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedChannelRequest.Merge(m, src)
+}
+func (m *QueryPausedChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedChannelRequest proto.InternalMessageInfo
+
+func (m *QueryPausedChannelRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryPausedChannelResponse is the response type for the Query/PausedChannel RPC method.
+type QueryPausedChannelResponse struct {
+	// paused is true if the channel is paused.
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// paused_channel defines the pause of the channel, if it is paused.
+	PausedChannel PausedChannel `protobuf:"bytes,2,opt,name=paused_channel,json=pausedChannel,proto3" json:"paused_channel"`
+}
+
+func (m *QueryPausedChannelResponse) Reset()         { *m = QueryPausedChannelResponse{} }
+func (m *QueryPausedChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedChannelResponse) ProtoMessage()    {}
+func (*QueryPausedChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26a9db7972ca3cfe, []int{3}
+}
+func (m *QueryPausedChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedChannelResponse.Merge(m, src)
+}
+func (m *QueryPausedChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedChannelResponse proto.InternalMessageInfo
+
+func (m *QueryPausedChannelResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *QueryPausedChannelResponse) GetPausedChannel() PausedChannel {
+	if m != nil {
+		return m.PausedChannel
+	}
+	return PausedChannel{}
+}
+
+func init() {
+	proto.RegisterType((*QueryPausedChannelsRequest)(nil), "hetu.ibc.pause.v1.QueryPausedChannelsRequest")
+	proto.RegisterType((*QueryPausedChannelsResponse)(nil), "hetu.ibc.pause.v1.QueryPausedChannelsResponse")
+	proto.RegisterType((*QueryPausedChannelRequest)(nil), "hetu.ibc.pause.v1.QueryPausedChannelRequest")
+	proto.RegisterType((*QueryPausedChannelResponse)(nil), "hetu.ibc.pause.v1.QueryPausedChannelResponse")
+}
+
+func init() { proto.RegisterFile("hetu/ibc/pause/v1/query.proto", fileDescriptor_26a9db7972ca3cfe) }
+
+var fileDescriptor_26a9db7972ca3cfe = []byte{
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4d, 0x8b, 0xd3, 0x40,
+	0x1c, 0xc6, 0x33, 0x55, 0x8b, 0x1d, 0x69, 0xc5, 0x41, 0xa4, 0xc6, 0x36, 0x0d, 0xc1, 0x43, 0x11,
+	0x3b, 0x43, 0xea, 0x41, 0xf0, 0x58, 0x4f, 0x22, 0xa2, 0xe6, 0xe8, 0xa5, 0xe4, 0x65, 0x48, 0x23,
+	0x75, 0x26, 0xed, 0x4c, 0x8a, 0x45, 0xbc, 0xe8, 0x17, 0x10, 0x3c, 0x7a, 0xf6, 0xbb, 0xf4, 0x22,
+	0x14, 0xbc, 0xec, 0x69, 0x59, 0xda, 0xfd, 0x20, 0x4b, 0x26, 0xb3, 0x2f, 0xd9, 0x74, 0xd9, 0xee,
+	0x2d, 0xc9, 0xf3, 0xcf, 0xf3, 0xfc, 0x9e, 0xf9, 0x0f, 0xec, 0x4e, 0xa8, 0xcc, 0x48, 0x12, 0x84,
+	0x24, 0xf5, 0x33, 0x41, 0xc9, 0xc2, 0x25, 0xb3, 0x8c, 0xce, 0x97, 0x38, 0x9d, 0x73, 0xc9, 0xd1,
+	0x83, 0x5c, 0xc6, 0x49, 0x10, 0x62, 0x25, 0xe3, 0x85, 0x6b, 0x3e, 0x8c, 0x79, 0xcc, 0x95, 0x4a,
+	0xf2, 0xa7, 0x62, 0xd0, 0xec, 0xc4, 0x9c, 0xc7, 0x53, 0x4a, 0xfc, 0x34, 0x21, 0x3e, 0x63, 0x5c,
+	0xfa, 0x32, 0xe1, 0x4c, 0x68, 0xb5, 0x57, 0x4d, 0x89, 0x29, 0xa3, 0x22, 0xd1, 0x03, 0x4e, 0x07,
+	0x9a, 0x1f, 0xf3, 0xd8, 0x0f, 0xb9, 0x1c, 0xbd, 0x9e, 0xf8, 0x8c, 0xd1, 0xa9, 0xf0, 0xe8, 0x2c,
+	0xa3, 0x42, 0x3a, 0x0c, 0x3e, 0xd9, 0xa9, 0x8a, 0x94, 0x33, 0x41, 0xd1, 0x7b, 0x78, 0x5f, 0xd9,
+	0x46, 0xe3, 0x50, 0x4b, 0x6d, 0x60, 0xdf, 0xea, 0xdf, 0x1b, 0xda, 0xb8, 0x82, 0x8f, 0x4b, 0x1e,
+	0xa3, 0xdb, 0xab, 0xc3, 0x9e, 0xe1, 0xb5, 0xd2, 0x92, 0xb1, 0xf3, 0x0a, 0x3e, 0xae, 0xe6, 0x69,
+	0x18, 0xd4, 0x85, 0x50, 0xc7, 0x8c, 0x93, 0xa8, 0x0d, 0x6c, 0xd0, 0x6f, 0x78, 0x0d, 0xfd, 0xe5,
+	0x4d, 0xe4, 0xfc, 0x04, 0xbb, 0xaa, 0x9c, 0xb1, 0x3e, 0x82, 0xf5, 0x22, 0x4c, 0xfd, 0x79, 0xd7,
+	0xd3, 0x6f, 0xe8, 0x1d, 0x6c, 0x95, 0x3b, 0xb4, 0x6b, 0x36, 0xb8, 0x41, 0x85, 0x66, 0xa9, 0xc2,
+	0xf0, 0x5f, 0x0d, 0xde, 0x51, 0x14, 0xe8, 0x0f, 0x80, 0xad, 0xf2, 0xb9, 0xa1, 0xc1, 0x0e, 0xcf,
+	0xab, 0x4f, 0xdf, 0xc4, 0xfb, 0x8e, 0x17, 0x15, 0x9d, 0x67, 0x3f, 0xfe, 0x1f, 0xff, 0xae, 0x3d,
+	0x45, 0x0e, 0xa9, 0x6e, 0xfd, 0xd2, 0x9e, 0xd0, 0x5f, 0x00, 0x9b, 0x25, 0x1b, 0xf4, 0x7c, 0xaf,
+	0xb4, 0x53, 0xb6, 0xc1, 0x9e, 0xd3, 0x1a, 0xed, 0xa5, 0x42, 0x73, 0x11, 0xb9, 0x1e, 0x8d, 0x7c,
+	0x3b, 0xdf, 0xf2, 0xf7, 0xd1, 0xdb, 0xd5, 0xc6, 0x02, 0xeb, 0x8d, 0x05, 0x8e, 0x36, 0x16, 0xf8,
+	0xb5, 0xb5, 0x8c, 0xf5, 0xd6, 0x32, 0x0e, 0xb6, 0x96, 0xf1, 0xc9, 0x8d, 0x13, 0x39, 0xc9, 0x02,
+	0x1c, 0xf2, 0x2f, 0xca, 0x74, 0x90, 0xce, 0xf9, 0x67, 0x1a, 0xca, 0x22, 0x61, 0xe1, 0x92, 0xaf,
+	0x17, 0x62, 0xe4, 0x32, 0xa5, 0x22, 0xa8, 0xab, 0x3b, 0xff, 0xe2, 0x64, 0x00, 0xf6, 0xb4, 0x7f,
+	0x9c, 0x7c, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// PausedChannels returns all the paused transfer channels
+	PausedChannels(ctx context.Context, in *QueryPausedChannelsRequest, opts ...grpc.CallOption) (*QueryPausedChannelsResponse, error)
+	// PausedChannel returns whether a transfer channel is paused
+	PausedChannel(ctx context.Context, in *QueryPausedChannelRequest, opts ...grpc.CallOption) (*QueryPausedChannelResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PausedChannels(ctx context.Context, in *QueryPausedChannelsRequest, opts ...grpc.CallOption) (*QueryPausedChannelsResponse, error) {
+	out := new(QueryPausedChannelsResponse)
+	err := c.cc.Invoke(ctx, "/hetu.ibc.pause.v1.Query/PausedChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PausedChannel(ctx context.Context, in *QueryPausedChannelRequest, opts ...grpc.CallOption) (*QueryPausedChannelResponse, error) {
+	out := new(QueryPausedChannelResponse)
+	err := c.cc.Invoke(ctx, "/hetu.ibc.pause.v1.Query/PausedChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// PausedChannels returns all the paused transfer channels
+	PausedChannels(context.Context, *QueryPausedChannelsRequest) (*QueryPausedChannelsResponse, error)
+	// PausedChannel returns whether a transfer channel is paused
+	PausedChannel(context.Context, *QueryPausedChannelRequest) (*QueryPausedChannelResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) PausedChannels(ctx context.Context, req *QueryPausedChannelsRequest) (*QueryPausedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedChannels not implemented")
+}
+func (*UnimplementedQueryServer) PausedChannel(ctx context.Context, req *QueryPausedChannelRequest) (*QueryPausedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedChannel not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_PausedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hetu.ibc.pause.v1.Query/PausedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedChannels(ctx, req.(*QueryPausedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PausedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hetu.ibc.pause.v1.Query/PausedChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedChannel(ctx, req.(*QueryPausedChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hetu.ibc.pause.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PausedChannels",
+			Handler:    _Query_PausedChannels_Handler,
+		},
+		{
+			MethodName: "PausedChannel",
+			Handler:    _Query_PausedChannel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hetu/ibc/pause/v1/query.proto",
+}
+
+func (m *QueryPausedChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedChannels) > 0 {
+		for iNdEx := len(m.PausedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PausedChannel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPausedChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPausedChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PausedChannels) > 0 {
+		for _, e := range m.PausedChannels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPausedChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPausedChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	l = m.PausedChannel.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPausedChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedChannels = append(m.PausedChannels, PausedChannel{})
+			if err := m.PausedChannels[len(m.PausedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PausedChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -35,8 +35,9 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	params := k.GetParams(ctx)
 	skippedEpochs := k.GetSkippedEpochs(ctx)
 
-	// Skip inflation if it is disabled and increment number of skipped epochs
-	if !params.EnableInflation {
+	// Skip inflation if it is disabled or another module is the active minter
+	// of the supply policy and increment number of skipped epochs
+	if !params.EnableInflation || !k.isActiveMinter(ctx) {
 		// check if the epochIdentifier is "day" before incrementing.
 		if epochIdentifier != epochstypes.DayEpochID {
 			return
@@ -84,6 +85,13 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		Amount: epochMintProvision.TruncateInt(),
 	}
 
+	// Never mint past the supply cap
+	if k.supplyPolicy != nil {
+		if remaining := k.supplyPolicy.RemainingSupply(ctx); mintedCoin.Amount.GT(remaining) {
+			mintedCoin.Amount = remaining
+		}
+	}
+
 	staking, incentives, communityPool, err := k.MintAndAllocateInflation(ctx, mintedCoin, params)
 	if err != nil {
 		panic(err)
@@ -54,12 +54,21 @@ func (k Keeper) MintAndAllocateInflation(
 }
 
 // MintCoins implements an alias call to the underlying supply keeper's
-// MintCoins to be used in BeginBlocker.
+// MintCoins to be used in BeginBlocker. The coins are minted through the
+// supply policy if one is set.
 func (k Keeper) MintCoins(ctx sdk.Context, coin sdk.Coin) error {
+	if k.supplyPolicy != nil {
+		return k.supplyPolicy.MintSupply(ctx, types.ModuleName, coin)
+	}
 	coins := sdk.Coins{coin}
 	return k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 }
 
+// isActiveMinter returns whether the supply policy allows x/inflation to mint
+func (k Keeper) isActiveMinter(ctx sdk.Context) bool {
+	return k.supplyPolicy == nil || k.supplyPolicy.IsActiveMinter(ctx, types.ModuleName)
+}
+
 // AllocateExponentialInflation allocates coins from the inflation to external
 // modules according to allocation proportions:
 //   - staking rewards -> sdk `auth` module fee collector
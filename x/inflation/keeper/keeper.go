@@ -35,6 +35,7 @@ type Keeper struct {
 	bankKeeper       types.BankKeeper
 	distrKeeper      types.DistrKeeper
 	stakingKeeper    types.StakingKeeper
+	supplyPolicy     types.SupplyPolicy
 	feeCollectorName string
 }
 
@@ -75,3 +76,9 @@ func NewKeeper(
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
+
+// SetSupplyPolicy sets the supply policy that mints the inflation. Without a
+// supply policy the inflation is minted straight from the bank module.
+func (k *Keeper) SetSupplyPolicy(supplyPolicy types.SupplyPolicy) {
+	k.supplyPolicy = supplyPolicy
+}
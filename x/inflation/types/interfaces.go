@@ -58,6 +58,14 @@ type StakingKeeper interface {
 	TotalBondedTokens(ctx context.Context) (math.Int, error)
 }
 
+// SupplyPolicy defines the supply policy that all mints of new supply must
+// go through. It enforces the supply cap and the active minter.
+type SupplyPolicy interface {
+	IsActiveMinter(ctx sdk.Context, minter string) bool
+	RemainingSupply(ctx sdk.Context) math.Int
+	MintSupply(ctx sdk.Context, minter string, amount sdk.Coin) error
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
@@ -12,6 +12,8 @@ import (
 	cosmosmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/hetu-project/hetu/v1/x/stakework/types"
 )
 
@@ -266,6 +268,24 @@ func (k Keeper) getSubnetValidators(ctx sdk.Context, netuid uint16) []types.Vali
 		validatorMap[stake.Validator] = validator
 	}
 
+	// Child hotkeys without stake of their own validate with the stake weight
+	// lent by their parents
+	known := make(map[string]bool, len(validatorMap))
+	for addr := range validatorMap {
+		known[common.HexToAddress(addr).Hex()] = true
+	}
+	for _, child := range k.eventKeeper.GetChildHotkeysByNetuid(ctx, netuid) {
+		if known[child] {
+			continue
+		}
+		validatorMap[child] = types.ValidatorInfo{
+			Address: child,
+			Stake:   "0",
+			Weights: []uint64{},
+			Active:  true,
+		}
+	}
+
 	// Process weight information
 	for validatorAddr := range validatorMap {
 		weight, found := k.eventKeeper.GetValidatorWeight(ctx, netuid, validatorAddr)
@@ -319,19 +339,26 @@ func (k Keeper) getLastActiveBlock(ctx sdk.Context, netuid uint16, validator str
 	return uint64(ctx.BlockHeight())
 }
 
-// getStakeWeights gets stake weights
+// getStakeWeights gets stake weights, the effective stake of each validator
+// including the stake weight lent to it by its parent keys
 func (k Keeper) getStakeWeights(ctx sdk.Context, netuid uint16, validators []types.ValidatorInfo) []float64 {
+	effective := k.eventKeeper.GetEffectiveStakes(ctx, netuid)
+
 	stake := make([]float64, len(validators))
 	for i, validator := range validators {
-		// Parse string stake to big.Int, then convert to float64
-		bigIntStake, err := validator.GetStakeBigInt()
-		if err != nil {
-			k.Logger(ctx).Error("Failed to parse stake", "validator", validator.Address, "stake", validator.Stake, "error", err)
-			continue // Skip this validator or set to 0
+		amount, found := effective[common.HexToAddress(validator.Address).Hex()]
+		if !found {
+			// Fall back to the stake of the validator info
+			bigIntStake, err := validator.GetStakeBigInt()
+			if err != nil {
+				k.Logger(ctx).Error("Failed to parse stake", "validator", validator.Address, "stake", validator.Stake, "error", err)
+				continue // Skip this validator or set to 0
+			}
+			amount = cosmosmath.NewIntFromBigInt(bigIntStake)
 		}
 
 		// Convert to float64 (with potential precision loss, but needed for algorithm)
-		stakeFloat := new(big.Float).SetInt(bigIntStake)
+		stakeFloat := new(big.Float).SetInt(amount.BigInt())
 		stakeValue, _ := stakeFloat.Float64()
 		stake[i] = stakeValue
 	}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"sort"
+
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hetu-project/hetu/v1/x/stakework/types"
+)
+
+// RunRootEpoch runs the Yuma consensus of the root network over the given
+// subnets. Root validators are the HETU stakers on netuid 0, their weights
+// point at subnets (see types.RootWeightDest).
+//
+// Each validator's weights are normalized, the consensus weight of a subnet is
+// the stake-weighted median at kappa, and the weights are clipped to it. A
+// subnet's share is the stake-weighted sum of its clipped weights and a
+// validator's dividend share is its stake times the weight it kept in
+// consensus. Fixed point arithmetic keeps the result deterministic.
+func (k Keeper) RunRootEpoch(ctx sdk.Context, netuids []uint16, kappa cosmosmath.LegacyDec) (*types.RootEpochResult, error) {
+	logger := k.Logger(ctx)
+
+	netuids = append([]uint16{}, netuids...)
+	sort.Slice(netuids, func(i, j int) bool { return netuids[i] < netuids[j] })
+
+	// 1. Root validators and their stake, ordered by address
+	stakes := k.eventKeeper.GetAllValidatorStakesByNetuid(ctx, types.RootNetuid)
+	sort.Slice(stakes, func(i, j int) bool { return stakes[i].Validator < stakes[j].Validator })
+
+	accounts := make([]string, 0, len(stakes))
+	stake := make([]cosmosmath.LegacyDec, 0, len(stakes))
+	totalStake := cosmosmath.LegacyZeroDec()
+	for _, s := range stakes {
+		amount, ok := cosmosmath.NewIntFromString(s.Amount)
+		if !ok || !amount.IsPositive() {
+			continue
+		}
+		accounts = append(accounts, s.Validator)
+		stake = append(stake, amount.ToLegacyDec())
+		totalStake = totalStake.Add(amount.ToLegacyDec())
+	}
+
+	result := &types.RootEpochResult{
+		Netuids:   netuids,
+		Shares:    make([]cosmosmath.LegacyDec, len(netuids)),
+		Accounts:  accounts,
+		Dividends: make([]cosmosmath.LegacyDec, len(accounts)),
+	}
+	for j := range result.Shares {
+		result.Shares[j] = cosmosmath.LegacyZeroDec()
+	}
+	for i := range result.Dividends {
+		result.Dividends[i] = cosmosmath.LegacyZeroDec()
+	}
+	if totalStake.IsZero() || len(netuids) == 0 {
+		logger.Debug("No root stake or subnets, skipping root epoch")
+		return result, nil
+	}
+
+	// 2. Normalized stake and weights
+	for i := range stake {
+		stake[i] = stake[i].Quo(totalStake)
+	}
+	weights := make([][]cosmosmath.LegacyDec, len(accounts))
+	for i, account := range accounts {
+		weights[i] = make([]cosmosmath.LegacyDec, len(netuids))
+		rowSum := cosmosmath.ZeroInt()
+		raw := make([]cosmosmath.Int, len(netuids))
+		weight, found := k.eventKeeper.GetValidatorWeight(ctx, types.RootNetuid, account)
+		for j, netuid := range netuids {
+			raw[j] = cosmosmath.ZeroInt()
+			if found {
+				raw[j] = cosmosmath.NewIntFromUint64(weight.Weights[types.RootWeightDest(netuid)])
+			}
+			rowSum = rowSum.Add(raw[j])
+		}
+		for j := range netuids {
+			weights[i][j] = cosmosmath.LegacyZeroDec()
+			if rowSum.IsPositive() {
+				weights[i][j] = raw[j].ToLegacyDec().QuoInt(rowSum)
+			}
+		}
+	}
+
+	// 3. Consensus, clipping and subnet ranks
+	totalRank := cosmosmath.LegacyZeroDec()
+	clipped := make([][]cosmosmath.LegacyDec, len(accounts))
+	for i := range clipped {
+		clipped[i] = make([]cosmosmath.LegacyDec, len(netuids))
+	}
+	for j := range netuids {
+		consensus := k.rootWeightedMedian(stake, weights, j, kappa)
+		rank := cosmosmath.LegacyZeroDec()
+		for i := range accounts {
+			clipped[i][j] = cosmosmath.LegacyMinDec(weights[i][j], consensus)
+			rank = rank.Add(stake[i].Mul(clipped[i][j]))
+		}
+		result.Shares[j] = rank
+		totalRank = totalRank.Add(rank)
+	}
+	if totalRank.IsPositive() {
+		for j := range result.Shares {
+			result.Shares[j] = result.Shares[j].Quo(totalRank)
+		}
+	}
+
+	// 4. Dividends
+	totalDividends := cosmosmath.LegacyZeroDec()
+	for i := range accounts {
+		kept := cosmosmath.LegacyZeroDec()
+		for j := range netuids {
+			kept = kept.Add(clipped[i][j])
+		}
+		result.Dividends[i] = stake[i].Mul(kept)
+		totalDividends = totalDividends.Add(result.Dividends[i])
+	}
+	if totalDividends.IsPositive() {
+		for i := range result.Dividends {
+			result.Dividends[i] = result.Dividends[i].Quo(totalDividends)
+		}
+	}
+
+	logger.Debug("Root epoch calculation completed",
+		"validators_count", len(accounts),
+		"subnets_count", len(netuids),
+		"total_rank", totalRank.String())
+
+	return result, nil
+}
+
+// rootWeightedMedian returns the largest weight on subnet j that validators
+// holding at least kappa of the stake set at or above.
+func (k Keeper) rootWeightedMedian(stake []cosmosmath.LegacyDec, weights [][]cosmosmath.LegacyDec, j int, kappa cosmosmath.LegacyDec) cosmosmath.LegacyDec {
+	order := make([]int, len(stake))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return weights[order[a]][j].GT(weights[order[b]][j])
+	})
+
+	cumulative := cosmosmath.LegacyZeroDec()
+	for _, i := range order {
+		cumulative = cumulative.Add(stake[i])
+		if cumulative.GTE(kappa) {
+			return weights[i][j]
+		}
+	}
+	return cosmosmath.LegacyZeroDec()
+}
@@ -0,0 +1,73 @@
+package v2
+
+import (
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hetu-project/hetu/v1/x/stakework/types"
+)
+
+// BondsPrefix is the prefix of the bonds matrices in the store
+var BondsPrefix = []byte("bonds:")
+
+// MigrateStore migrates the x/stakework module state from the consensus
+// version 1 to version 2. Specifically, it prunes the bonds of subnets that no
+// longer exist and of accounts that no longer validate on their subnet. Bonds
+// are only ever written, so they pile up as subnets and validators come and go.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, eventKeeper types.EventKeeper) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), BondsPrefix)
+
+	// validators of each subnet seen so far, nil for subnets that do not exist
+	validators := make(map[uint16]map[string]bool)
+
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	var stale [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		// bond keys are netuid:validator_i:validator_j
+		parts := strings.Split(string(iterator.Key()), ":")
+		if len(parts) != 3 {
+			stale = append(stale, iterator.Key())
+			continue
+		}
+		netuid, err := strconv.ParseUint(parts[0], 10, 16)
+		if err != nil {
+			stale = append(stale, iterator.Key())
+			continue
+		}
+
+		subnetValidators, seen := validators[uint16(netuid)]
+		if !seen {
+			subnetValidators = getSubnetValidators(ctx, eventKeeper, uint16(netuid))
+			validators[uint16(netuid)] = subnetValidators
+		}
+		if !subnetValidators[parts[1]] || !subnetValidators[parts[2]] {
+			stale = append(stale, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range stale {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// getSubnetValidators returns the accounts validating on a subnet, those with
+// stake and the child hotkeys, or nil when the subnet does not exist.
+func getSubnetValidators(ctx sdk.Context, eventKeeper types.EventKeeper, netuid uint16) map[string]bool {
+	if _, found := eventKeeper.GetSubnet(ctx, netuid); !found {
+		return nil
+	}
+	validators := make(map[string]bool)
+	for _, stake := range eventKeeper.GetAllValidatorStakesByNetuid(ctx, netuid) {
+		validators[stake.Validator] = true
+	}
+	for _, child := range eventKeeper.GetChildHotkeysByNetuid(ctx, netuid) {
+		validators[child] = true
+	}
+	return validators
+}
@@ -0,0 +1,56 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	eventtypes "github.com/hetu-project/hetu/v1/x/event/types"
+	v2 "github.com/hetu-project/hetu/v1/x/stakework/migrations/v2"
+	"github.com/hetu-project/hetu/v1/x/stakework/types"
+)
+
+// mockEventKeeper serves subnet 1 with validators A and B, and child hotkey C.
+type mockEventKeeper struct {
+	types.EventKeeper
+}
+
+func (mockEventKeeper) GetSubnet(_ sdk.Context, netuid uint16) (eventtypes.Subnet, bool) {
+	return eventtypes.Subnet{Netuid: netuid}, netuid == 1
+}
+
+func (mockEventKeeper) GetAllValidatorStakesByNetuid(_ sdk.Context, netuid uint16) []eventtypes.ValidatorStake {
+	return []eventtypes.ValidatorStake{
+		{Netuid: netuid, Validator: "A", Amount: "100"},
+		{Netuid: netuid, Validator: "B", Amount: "100"},
+	}
+}
+
+func (mockEventKeeper) GetChildHotkeysByNetuid(_ sdk.Context, _ uint16) []string {
+	return []string{"C"}
+}
+
+func TestMigrate(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := prefix.NewStore(ctx.KVStore(storeKey), v2.BondsPrefix)
+
+	live := []string{"1:A:B", "1:B:A", "1:A:C", "1:C:C"}
+	stale := []string{"1:A:D", "1:D:B", "2:A:B", "invalid"}
+	for _, key := range append(live, stale...) {
+		store.Set([]byte(key), make([]byte, 8))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, mockEventKeeper{}))
+	for _, key := range live {
+		require.True(t, store.Has([]byte(key)), key)
+	}
+	for _, key := range stale {
+		require.False(t, store.Has([]byte(key)), key)
+	}
+}
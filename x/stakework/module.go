@@ -16,6 +16,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/hetu-project/hetu/v1/x/stakework/keeper"
+	"github.com/hetu-project/hetu/v1/x/stakework/types"
 )
 
 var (
@@ -88,7 +89,10 @@ func NewAppModule(cdc codec.Codec, keeper *keeper.Keeper) AppModule {
 
 // RegisterServices registers services
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	// Simplified module, no need to register services for now
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Errorf("failed to migrate %s to v2: %w", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers invariants
@@ -110,7 +114,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion returns the consensus version
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock executes at the beginning of each block
 func (am AppModule) BeginBlock(ctx sdk.Context, _ interface{}) {
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RootNetuid is the netuid of the root network. HETU stakers on the root
+// network set weights across subnets instead of across neurons.
+const RootNetuid uint16 = 0
+
+// RootWeightDest returns the weight destination a root validator uses for a
+// subnet: the address whose value is the netuid, in the hex form the event
+// module stores weights under.
+func RootWeightDest(netuid uint16) string {
+	return common.BigToAddress(big.NewInt(int64(netuid))).Hex()
+}
+
+// RootEpochResult root epoch calculation result
+type RootEpochResult struct {
+	// Netuids and their emission shares, the shares sum up to 1 unless no
+	// validator set a weight
+	Netuids []uint16         `json:"netuids"`
+	Shares  []math.LegacyDec `json:"shares"`
+	// Root validators and their dividend shares, the shares sum up to 1 unless
+	// no weight is in consensus
+	Accounts  []string         `json:"accounts"`
+	Dividends []math.LegacyDec `json:"dividends"`
+}
@@ -19,6 +19,8 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -39,6 +41,9 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(),
+		GetVestingAccountCmd(),
+		GetVestingAccountsByFunderCmd(),
+		GetSimulateClawbackCmd(),
 	)
 	return cmd
 }
@@ -75,3 +80,116 @@ func GetBalancesCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetVestingAccountCmd queries the schedules of a given vesting account
+func GetVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-account ADDRESS",
+		Short: "Gets the funder, schedules and next unlocking and vesting events of a vesting account",
+		Long:  "Gets the funder, the lockup and vesting schedules, the next unlocking and vesting events and the clawback eligibility of a vesting account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryVestingAccountRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.VestingAccount(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetVestingAccountsByFunderCmd queries the vesting accounts funded by a given
+// address
+func GetVestingAccountsByFunderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-accounts-by-funder FUNDER_ADDRESS",
+		Short: "Gets the vesting accounts funded by an address",
+		Long:  "Gets the vesting accounts funded by an address, with their schedules",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryVestingAccountsByFunderRequest{
+				FunderAddress: args[0],
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.VestingAccountsByFunder(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "vesting accounts")
+	return cmd
+}
+
+// GetSimulateClawbackCmd queries the outcome of a clawback of a given vesting
+// account
+func GetSimulateClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-clawback ADDRESS [TIME]",
+		Short: "Simulates a clawback of a vesting account at a unix time, by default the block time",
+		Long:  "Gets the tokens a clawback of a vesting account would leave vested, return to the funder, and fail to return because they are staked, at a unix time which defaults to the block time",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySimulateClawbackRequest{
+				Address: args[0],
+			}
+
+			if len(args) == 2 {
+				unixTime, err := strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid unix time %s: %w", args[1], err)
+				}
+				req.Time = time.Unix(unixTime, 0).UTC()
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateClawback(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -18,6 +18,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -58,6 +59,8 @@ func NewTxCmd() *cobra.Command {
 		NewMsgClawbackCmd(),
 		NewMsgUpdateVestingFunderCmd(),
 		NewMsgConvertVestingAccountCmd(),
+		NewMsgAllocateToSubnetCmd(),
+		NewMsgDeallocateFromSubnetCmd(),
 	)
 
 	return txCmd
@@ -261,3 +264,75 @@ func NewMsgConvertVestingAccountCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewMsgAllocateToSubnetCmd returns a CLI command handler for creating a
+// MsgAllocateToSubnet transaction.
+func NewMsgAllocateToSubnetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allocate-to-subnet NETUID AMOUNT",
+		Short: "Allocate locked coins of a ClawbackVestingAccount to its stake on a subnet.",
+		Long: "Allocate locked coins of a ClawbackVestingAccount (--from) to its stake on a subnet. " +
+			"The coins are escrowed by the vesting module and remain subject to clawback.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			netuid, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAllocateToSubnet(clientCtx.GetFromAddress(), uint16(netuid), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgDeallocateFromSubnetCmd returns a CLI command handler for creating a
+// MsgDeallocateFromSubnet transaction.
+func NewMsgDeallocateFromSubnetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deallocate-from-subnet NETUID AMOUNT",
+		Short: "Return coins allocated to the stake of an account (--from) on a subnet to the account.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			netuid, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeallocateFromSubnet(clientCtx.GetFromAddress(), uint16(netuid), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
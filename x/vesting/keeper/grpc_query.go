@@ -17,9 +17,12 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -73,3 +76,206 @@ func (k Keeper) Balances(
 		Vested:   vested,
 	}, nil
 }
+
+// VestingAccount returns the funder and the lockup and vesting schedules of a
+// clawback vesting account
+func (k Keeper) VestingAccount(
+	goCtx context.Context,
+	req *types.QueryVestingAccountRequest,
+) (*types.QueryVestingAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clawbackAccount, err := k.getClawbackAccount(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVestingAccountResponse{
+		Account: vestingAccountSchedule(ctx, clawbackAccount),
+	}, nil
+}
+
+// VestingAccountsByFunder returns the clawback vesting accounts funded by an
+// address, ordered by account address. The accounts are not indexed by funder,
+// so all the accounts are scanned.
+func (k Keeper) VestingAccountsByFunder(
+	goCtx context.Context,
+	req *types.QueryVestingAccountsByFunderRequest,
+) (*types.QueryVestingAccountsByFunderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	funder, err := sdk.AccAddressFromBech32(req.FunderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var (
+		key        []byte
+		offset     uint64
+		limit      = uint64(query.DefaultLimit)
+		countTotal = true
+	)
+	if req.Pagination != nil {
+		if req.Pagination.Offset > 0 && req.Pagination.Key != nil {
+			return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+		}
+		key = req.Pagination.Key
+		offset = req.Pagination.Offset
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+			countTotal = req.Pagination.CountTotal
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var (
+		accounts []types.VestingAccountSchedule
+		nextKey  []byte
+		matched  uint64
+	)
+	k.accountKeeper.IterateAccounts(ctx, func(acc sdk.AccountI) bool {
+		clawbackAccount, isClawback := acc.(*types.ClawbackVestingAccount)
+		if !isClawback || clawbackAccount.FunderAddress != funder.String() {
+			return false
+		}
+		if key != nil && bytes.Compare(acc.GetAddress(), key) < 0 {
+			return false
+		}
+
+		matched++
+		switch {
+		case matched <= offset:
+			return false
+		case uint64(len(accounts)) < limit:
+			accounts = append(accounts, vestingAccountSchedule(ctx, clawbackAccount))
+			return false
+		case nextKey == nil:
+			nextKey = acc.GetAddress()
+		}
+		// keep scanning only to count the total
+		return !countTotal
+	})
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal && key == nil {
+		pageRes.Total = matched
+	}
+
+	return &types.QueryVestingAccountsByFunderResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SimulateClawback returns the tokens a clawback of a clawback vesting account
+// would leave vested and return to the funder at a given time, which defaults
+// to the block time. The returned tokens are read from the current balance and
+// subnet allocations of the account; the unvested tokens they do not cover are
+// staked and cannot be clawed back.
+func (k Keeper) SimulateClawback(
+	goCtx context.Context,
+	req *types.QuerySimulateClawbackRequest,
+) (*types.QuerySimulateClawbackResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clawbackAccount, err := k.getClawbackAccount(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	clawbackTime := req.Time
+	if clawbackTime.IsZero() {
+		clawbackTime = ctx.BlockTime()
+	}
+
+	// ComputeClawback returns nothing before the start time, as the clawback
+	// is rejected then
+	_, unvested := clawbackAccount.ComputeClawback(clawbackTime.Unix())
+	vested := clawbackAccount.GetVestedOnly(clawbackTime)
+
+	// the subnet allocations are undone before the clawback
+	available := k.bankKeeper.GetAllBalances(ctx, clawbackAccount.GetAddress())
+	if allocated := k.subnetAllocated(ctx, clawbackAccount.GetAddress()); allocated.IsPositive() {
+		bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		available = available.Add(sdk.NewCoin(bondDenom, allocated))
+	}
+
+	returned := unvested.Min(available)
+
+	return &types.QuerySimulateClawbackResponse{
+		Time:             clawbackTime,
+		Vested:           vested,
+		UnvestedReturned: returned,
+		StakedUnvested:   unvested.Sub(returned...),
+	}, nil
+}
+
+// getClawbackAccount returns the clawback vesting account at a bech32 address.
+func (k Keeper) getClawbackAccount(ctx sdk.Context, address string) (*types.ClawbackVestingAccount, error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"account for address '%s'", address,
+		)
+	}
+
+	clawbackAccount, isClawback := acc.(*types.ClawbackVestingAccount)
+	if !isClawback {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"account at address '%s' is not a vesting account ", address,
+		)
+	}
+	return clawbackAccount, nil
+}
+
+// vestingAccountSchedule returns the schedules of a clawback vesting account
+// and its next events at the block time.
+func vestingAccountSchedule(ctx sdk.Context, va *types.ClawbackVestingAccount) types.VestingAccountSchedule {
+	blockTime := ctx.BlockTime()
+
+	schedule := types.VestingAccountSchedule{
+		Address:          va.Address,
+		FunderAddress:    va.FunderAddress,
+		StartTime:        va.StartTime,
+		EndTime:          time.Unix(va.EndTime, 0).UTC(),
+		OriginalVesting:  va.OriginalVesting,
+		LockupPeriods:    va.LockupPeriods,
+		VestingPeriods:   va.VestingPeriods,
+		DelegatedVesting: va.DelegatedVesting,
+		DelegatedFree:    va.DelegatedFree,
+		// same checks as the clawback
+		ClawbackEligible: !blockTime.Before(va.StartTime) && !va.GetUnvestedOnly(blockTime).IsZero(),
+	}
+
+	if unlockTime, unlock, found := va.GetNextUnlock(blockTime); found {
+		schedule.NextUnlockTime = &unlockTime
+		schedule.NextUnlock = unlock
+	}
+	if vestingTime, vesting, found := va.GetNextVesting(blockTime); found {
+		schedule.NextVestingTime = &vestingTime
+		schedule.NextVesting = vesting
+	}
+
+	return schedule
+}
@@ -2,12 +2,23 @@ package keeper_test
 
 import (
 	"fmt"
+	"testing"
+	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
 
+	"github.com/hetu-project/hetu/v1/app"
 	"github.com/hetu-project/hetu/v1/testutil"
 	utiltx "github.com/hetu-project/hetu/v1/testutil/tx"
+	"github.com/hetu-project/hetu/v1/utils"
+	eventtypes "github.com/hetu-project/hetu/v1/x/event/types"
 	"github.com/hetu-project/hetu/v1/x/vesting/types"
 )
 
@@ -111,3 +122,142 @@ func (suite *KeeperTestSuite) TestBalances() {
 		})
 	}
 }
+
+// TestVestingAccountQueries checks the schedule, by-funder and clawback
+// simulation queries of clawback vesting accounts.
+func TestVestingAccountQueries(t *testing.T) {
+	evmosApp := app.EthSetup(false, nil)
+	_, err := evmosApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = evmosApp.Commit()
+	require.NoError(t, err)
+	start := time.Unix(time.Now().Unix(), 0).UTC()
+	header := tmproto.Header{Height: 2, ChainID: utils.MainnetChainID + "-1", Time: start}
+	ctx := evmosApp.BaseApp.NewUncachedContext(false, header)
+
+	denom := evmosApp.EvmKeeper.GetParams(ctx).EvmDenom
+	params, err := evmosApp.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.BondDenom = denom
+	require.NoError(t, evmosApp.StakingKeeper.SetParams(ctx, params))
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+
+	// the accounts vest 1000 over four periods, locked up until the end
+	grant := coins(1000)
+	lockup := sdkvesting.Periods{{Length: 8000, Amount: grant}}
+	vesting := sdkvesting.Periods{
+		{Length: 2000, Amount: coins(250)},
+		{Length: 2000, Amount: coins(250)},
+		{Length: 2000, Amount: coins(250)},
+		{Length: 2000, Amount: coins(250)},
+	}
+	createAccount := func(funder sdk.AccAddress) sdk.AccAddress {
+		addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+		require.NoError(t, testutil.FundAccount(ctx, evmosApp.BankKeeper, funder, grant))
+		_, err := evmosApp.VestingKeeper.CreateClawbackVestingAccount(ctx, types.NewMsgCreateClawbackVestingAccount(
+			funder, addr, start, lockup, vesting, false,
+		))
+		require.NoError(t, err)
+		return addr
+	}
+
+	funder := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	addr1 := createAccount(funder)
+	addr2 := createAccount(funder)
+	createAccount(sdk.AccAddress(utiltx.GenerateAddress().Bytes()))
+
+	// schedule and next events
+	_, err = evmosApp.VestingKeeper.VestingAccount(ctx, &types.QueryVestingAccountRequest{Address: funder.String()})
+	require.Error(t, err)
+
+	res, err := evmosApp.VestingKeeper.VestingAccount(ctx, &types.QueryVestingAccountRequest{Address: addr1.String()})
+	require.NoError(t, err)
+	account := res.Account
+	require.Equal(t, addr1.String(), account.Address)
+	require.Equal(t, funder.String(), account.FunderAddress)
+	require.Equal(t, start.Add(8000*time.Second), account.EndTime)
+	require.Equal(t, grant, account.OriginalVesting)
+	require.Equal(t, vesting, account.VestingPeriods)
+	require.Equal(t, start.Add(8000*time.Second), *account.NextUnlockTime)
+	require.Equal(t, grant, account.NextUnlock)
+	require.Equal(t, start.Add(2000*time.Second), *account.NextVestingTime)
+	require.Equal(t, coins(250), account.NextVesting)
+	require.True(t, account.ClawbackEligible)
+
+	endCtx := ctx.WithBlockTime(start.Add(8000 * time.Second))
+	res, err = evmosApp.VestingKeeper.VestingAccount(endCtx, &types.QueryVestingAccountRequest{Address: addr1.String()})
+	require.NoError(t, err)
+	require.Nil(t, res.Account.NextUnlockTime)
+	require.Nil(t, res.Account.NextVestingTime)
+	require.False(t, res.Account.ClawbackEligible)
+
+	// accounts by funder, one per page
+	var (
+		key      []byte
+		accounts []string
+	)
+	for page := 0; ; page++ {
+		res, err := evmosApp.VestingKeeper.VestingAccountsByFunder(ctx, &types.QueryVestingAccountsByFunderRequest{
+			FunderAddress: funder.String(),
+			Pagination:    &query.PageRequest{Key: key, Limit: 1, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Accounts, 1)
+		if page == 0 {
+			require.Equal(t, uint64(2), res.Pagination.Total)
+		}
+		accounts = append(accounts, res.Accounts[0].Address)
+		if key = res.Pagination.NextKey; key == nil {
+			break
+		}
+	}
+	require.ElementsMatch(t, []string{addr1.String(), addr2.String()}, accounts)
+
+	byFunder, err := evmosApp.VestingKeeper.VestingAccountsByFunder(ctx, &types.QueryVestingAccountsByFunderRequest{
+		FunderAddress: funder.String(),
+		Pagination:    &query.PageRequest{Offset: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, byFunder.Accounts, 1)
+	require.Equal(t, accounts[1], byFunder.Accounts[0].Address)
+	require.Equal(t, uint64(2), byFunder.Pagination.Total)
+
+	// clawback simulation: the first account allocates locked tokens to a
+	// subnet, which the clawback returns, and the second one has tokens staked
+	evmosApp.EventKeeper.SetSubnet(ctx, eventtypes.Subnet{Netuid: 1, Owner: utiltx.GenerateAddress().Hex()})
+	_, err = evmosApp.VestingKeeper.AllocateToSubnet(ctx, types.NewMsgAllocateToSubnet(addr1, 1, sdk.NewInt64Coin(denom, 600)))
+	require.NoError(t, err)
+	require.NoError(t, evmosApp.BankKeeper.DelegateCoinsFromAccountToModule(ctx, addr2, stakingtypes.BondedPoolName, coins(700)))
+
+	simulate := func(addr sdk.AccAddress, clawbackTime time.Time) *types.QuerySimulateClawbackResponse {
+		res, err := evmosApp.VestingKeeper.SimulateClawback(ctx, &types.QuerySimulateClawbackRequest{
+			Address: addr.String(),
+			Time:    clawbackTime,
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	sim := simulate(addr1, time.Time{})
+	require.Equal(t, start, sim.Time)
+	require.Empty(t, sim.Vested)
+	require.Equal(t, grant, sim.UnvestedReturned)
+	require.Empty(t, sim.StakedUnvested)
+
+	sim = simulate(addr1, start.Add(-time.Second))
+	require.Empty(t, sim.Vested)
+	require.Empty(t, sim.UnvestedReturned)
+
+	sim = simulate(addr1, start.Add(4000*time.Second))
+	require.Equal(t, coins(500), sim.Vested)
+	require.Equal(t, coins(500), sim.UnvestedReturned)
+	require.Empty(t, sim.StakedUnvested)
+
+	sim = simulate(addr2, start.Add(4000*time.Second))
+	require.Equal(t, coins(500), sim.Vested)
+	require.Equal(t, coins(300), sim.UnvestedReturned)
+	require.Equal(t, coins(200), sim.StakedUnvested)
+}
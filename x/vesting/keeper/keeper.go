@@ -35,6 +35,7 @@ type Keeper struct {
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
+	eventKeeper   types.EventKeeper
 }
 
 // NewKeeper creates new instances of the vesting Keeper
@@ -58,3 +59,15 @@ func NewKeeper(
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// SetEventKeeper sets the event keeper counting the locked tokens allocated to
+// subnets as subnet stake. It has to be set before the keeper is copied into
+// the module.
+func (k *Keeper) SetEventKeeper(eventKeeper types.EventKeeper) *Keeper {
+	if k.eventKeeper != nil {
+		panic("cannot set vesting event keeper twice")
+	}
+
+	k.eventKeeper = eventKeeper
+	return k
+}
@@ -26,13 +26,16 @@ import (
 	"github.com/hashicorp/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/ethereum/go-ethereum/common"
 
+	eventtypes "github.com/hetu-project/hetu/v1/x/event/types"
 	"github.com/hetu-project/hetu/v1/x/vesting/types"
 )
 
@@ -212,6 +215,13 @@ func (k Keeper) Clawback(
 		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "clawback can only be executed after vesting begins: %s", va.FunderAddress)
 	}
 
+	// Undo the subnet allocations of the account first, so that the unvested
+	// tokens it allocated are clawed back along with the others
+	if err := k.undoSubnetAllocations(ctx, addr); err != nil {
+		return nil, err
+	}
+	va = ak.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+
 	// Perform clawback transfer
 	if err := k.transferClawback(ctx, *va, dest); err != nil {
 		return nil, err
@@ -325,6 +335,185 @@ func (k Keeper) ConvertVestingAccount(
 	return &types.MsgConvertVestingAccountResponse{}, nil
 }
 
+// AllocateToSubnet escrows locked tokens of a ClawbackVestingAccount in the
+// vesting module and counts them as the stake of the account on a subnet. The
+// escrowed tokens are tracked as delegated vesting coins, the same way as
+// staking delegations, so that they remain subject to clawback.
+func (k Keeper) AllocateToSubnet(
+	goCtx context.Context,
+	msg *types.MsgAllocateToSubnet,
+) (*types.MsgAllocateToSubnetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.eventKeeper == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrNotSupported, "subnet allocations are not enabled")
+	}
+
+	// NOTE: errors checked during msg validation
+	address := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+	netuid := uint16(msg.Netuid)
+
+	// Check if vesting account exists
+	account := k.accountKeeper.GetAccount(ctx, address)
+	if account == nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "account %s does not exist", msg.VestingAddress)
+	}
+
+	// Check if account is a clawback vesting account
+	va, ok := account.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account not subject to clawback: %s", msg.VestingAddress)
+	}
+
+	if _, found := k.eventKeeper.GetSubnet(ctx, netuid); !found {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "subnet %d does not exist", netuid)
+	}
+
+	denom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Amount.Denom != denom {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidCoins, "subnet stake must be allocated in %s, got %s", denom, msg.Amount.Denom)
+	}
+
+	// Only the locked coins, which cannot be staked through the GlobalStaking
+	// contract, are allocated
+	locked := va.LockedCoins(ctx.BlockTime()).AmountOf(denom)
+	if locked.LT(msg.Amount.Amount) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientLockedCoins,
+			"cannot allocate more than the locked coins to subnet stake (%s < %s)", locked, msg.Amount.Amount,
+		)
+	}
+
+	if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, address, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	hexAddress := common.BytesToAddress(address).Hex()
+	k.eventKeeper.SetVestingStake(ctx, eventtypes.VestingStake{
+		Account: hexAddress,
+		Netuid:  netuid,
+		Amount:  k.eventKeeper.GetVestingStake(ctx, netuid, hexAddress).Add(msg.Amount.Amount),
+	})
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeAllocateToSubnet,
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyNetuid, strconv.FormatUint(uint64(netuid), 10)),
+				sdk.NewAttribute(types.AttributeKeyCoins, msg.Amount.String()),
+			),
+		},
+	)
+
+	return &types.MsgAllocateToSubnetResponse{}, nil
+}
+
+// DeallocateFromSubnet returns tokens allocated to the stake of an account on
+// a subnet to the account. The account may since have been converted from a
+// ClawbackVestingAccount.
+func (k Keeper) DeallocateFromSubnet(
+	goCtx context.Context,
+	msg *types.MsgDeallocateFromSubnet,
+) (*types.MsgDeallocateFromSubnetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.eventKeeper == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrNotSupported, "subnet allocations are not enabled")
+	}
+
+	denom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Amount.Denom != denom {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidCoins, "subnet stake is allocated in %s, got %s", denom, msg.Amount.Denom)
+	}
+
+	// NOTE: errors checked during msg validation
+	address := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+	if err := k.deallocateFromSubnet(ctx, address, uint16(msg.Netuid), msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeallocateFromSubnetResponse{}, nil
+}
+
+// deallocateFromSubnet removes the given amount from the stake of an account
+// on a subnet and returns the escrowed tokens to the account.
+func (k Keeper) deallocateFromSubnet(
+	ctx sdk.Context,
+	address sdk.AccAddress,
+	netuid uint16,
+	amount sdk.Coin,
+) error {
+	hexAddress := common.BytesToAddress(address).Hex()
+	stake := k.eventKeeper.GetVestingStake(ctx, netuid, hexAddress)
+	if stake.LT(amount.Amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientSubnetStake,
+			"account %s allocated %s to subnet %d, less than %s", address, stake, netuid, amount.Amount,
+		)
+	}
+
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.ModuleName, address, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	k.eventKeeper.SetVestingStake(ctx, eventtypes.VestingStake{
+		Account: hexAddress,
+		Netuid:  netuid,
+		Amount:  stake.Sub(amount.Amount),
+	})
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeDeallocateFromSubnet,
+				sdk.NewAttribute(types.AttributeKeyAccount, address.String()),
+				sdk.NewAttribute(types.AttributeKeyNetuid, strconv.FormatUint(uint64(netuid), 10)),
+				sdk.NewAttribute(types.AttributeKeyCoins, amount.String()),
+			),
+		},
+	)
+
+	return nil
+}
+
+// undoSubnetAllocations returns all the tokens an account allocated to subnet
+// stake to the account.
+func (k Keeper) undoSubnetAllocations(ctx sdk.Context, address sdk.AccAddress) error {
+	if k.eventKeeper == nil {
+		return nil
+	}
+
+	denom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, stake := range k.eventKeeper.GetVestingStakesByAccount(ctx, common.BytesToAddress(address).Hex()) {
+		if err := k.deallocateFromSubnet(ctx, address, stake.Netuid, sdk.NewCoin(denom, stake.Amount)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// subnetAllocated returns the tokens an account allocated to subnet stake.
+func (k Keeper) subnetAllocated(ctx sdk.Context, address sdk.AccAddress) math.Int {
+	allocated := math.ZeroInt()
+	if k.eventKeeper == nil {
+		return allocated
+	}
+
+	for _, stake := range k.eventKeeper.GetVestingStakesByAccount(ctx, common.BytesToAddress(address).Hex()) {
+		allocated = allocated.Add(stake.Amount)
+	}
+	return allocated
+}
+
 // addGrant merges a new clawback vesting grant into an existing
 // ClawbackVestingAccount.
 func (k Keeper) addGrant(
@@ -343,7 +532,8 @@ func (k Keeper) addGrant(
 	if err != nil {
 		return err
 	}
-	delegatedAmt := bondedAmt.Add(unbondingAmt)
+	// the tokens allocated to subnet stake are tracked as delegated too
+	delegatedAmt := bondedAmt.Add(unbondingAmt).Add(k.subnetAllocated(ctx, va.GetAddress()))
 	denom, err := k.stakingKeeper.BondDenom(ctx)
 	delegated := sdk.NewCoins(sdk.NewCoin(denom, delegatedAmt))
 
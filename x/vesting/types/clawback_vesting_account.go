@@ -177,6 +177,26 @@ func (va ClawbackVestingAccount) GetPassedPeriodCount(blockTime time.Time) int {
 	return ReadPastPeriodCount(va.GetStartTime(), va.EndTime, va.VestingPeriods, blockTime.Unix())
 }
 
+// GetNextUnlock returns the time and the coins of the next unlocking event
+// after blockTime. It returns false once all the coins are unlocked.
+func (va ClawbackVestingAccount) GetNextUnlock(blockTime time.Time) (time.Time, sdk.Coins, bool) {
+	return nextEvent(va.GetStartTime(), va.EndTime, va.LockupPeriods, blockTime)
+}
+
+// GetNextVesting returns the time and the coins of the next vesting event
+// after blockTime. It returns false once all the coins are vested.
+func (va ClawbackVestingAccount) GetNextVesting(blockTime time.Time) (time.Time, sdk.Coins, bool) {
+	return nextEvent(va.GetStartTime(), va.EndTime, va.VestingPeriods, blockTime)
+}
+
+func nextEvent(startTime, endTime int64, periods sdkvesting.Periods, blockTime time.Time) (time.Time, sdk.Coins, bool) {
+	eventTime, coins, found := ReadNextEvent(startTime, endTime, periods, blockTime.Unix())
+	if !found {
+		return time.Time{}, coins, false
+	}
+	return time.Unix(eventTime, 0).UTC(), coins, true
+}
+
 // ComputeClawback returns an account with all future vesting events removed and
 // the clawback amount (total sum of these events). Future unlocking events are
 // preserved and update in case unlocked vested coins remain after clawback.
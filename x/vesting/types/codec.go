@@ -41,6 +41,8 @@ const (
 	createClawbackVestingAccount = "evmos/MsgCreateClawbackVestingAccount"
 	updateVestingFunder          = "evmos/MsgUpdateVestingFunder"
 	convertVestingAccount        = "evmos/MsgConvertVestingAccount"
+	allocateToSubnet             = "evmos/MsgAllocateToSubnet"
+	deallocateFromSubnet         = "evmos/MsgDeallocateFromSubnet"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -78,6 +80,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgCreateClawbackVestingAccount{},
 		&MsgUpdateVestingFunder{},
 		&MsgConvertVestingAccount{},
+		&MsgAllocateToSubnet{},
+		&MsgDeallocateFromSubnet{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -91,4 +95,6 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateClawbackVestingAccount{}, createClawbackVestingAccount, nil)
 	cdc.RegisterConcrete(&MsgUpdateVestingFunder{}, updateVestingFunder, nil)
 	cdc.RegisterConcrete(&MsgConvertVestingAccount{}, convertVestingAccount, nil)
+	cdc.RegisterConcrete(&MsgAllocateToSubnet{}, allocateToSubnet, nil)
+	cdc.RegisterConcrete(&MsgDeallocateFromSubnet{}, deallocateFromSubnet, nil)
 }
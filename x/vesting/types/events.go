@@ -21,6 +21,8 @@ const (
 	EventTypeCreateClawbackVestingAccount = "create_clawback_vesting_account"
 	EventTypeClawback                     = "clawback"
 	EventTypeUpdateVestingFunder          = "update_vesting_funder"
+	EventTypeAllocateToSubnet             = "allocate_to_subnet"
+	EventTypeDeallocateFromSubnet         = "deallocate_from_subnet"
 
 	AttributeKeyCoins       = "coins"
 	AttributeKeyStartTime   = "start_time"
@@ -29,4 +31,5 @@ const (
 	AttributeKeyFunder      = "funder"
 	AttributeKeyNewFunder   = "new_funder"
 	AttributeKeyDestination = "destination"
+	AttributeKeyNetuid      = "netuid"
 )
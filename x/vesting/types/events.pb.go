@@ -233,36 +233,172 @@ func (m *EventUpdateVestingFunder) GetNewFunder() string {
 	return ""
 }
 
+// EventAllocateToSubnet defines the event type for allocating locked tokens to
+// subnet stake
+type EventAllocateToSubnet struct {
+	// account is the address of the vesting account
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// netuid is the subnet the tokens are allocated to
+	Netuid uint32 `protobuf:"varint,2,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	// coins allocated
+	Coins string `protobuf:"bytes,3,opt,name=coins,proto3" json:"coins,omitempty"`
+}
+
+func (m *EventAllocateToSubnet) Reset()         { *m = EventAllocateToSubnet{} }
+func (m *EventAllocateToSubnet) String() string { return proto.CompactTextString(m) }
+func (*EventAllocateToSubnet) ProtoMessage()    {}
+func (*EventAllocateToSubnet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ea095c8e21d4cd, []int{3}
+}
+func (m *EventAllocateToSubnet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAllocateToSubnet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAllocateToSubnet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAllocateToSubnet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAllocateToSubnet.Merge(m, src)
+}
+func (m *EventAllocateToSubnet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAllocateToSubnet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAllocateToSubnet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAllocateToSubnet proto.InternalMessageInfo
+
+func (m *EventAllocateToSubnet) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventAllocateToSubnet) GetNetuid() uint32 {
+	if m != nil {
+		return m.Netuid
+	}
+	return 0
+}
+
+func (m *EventAllocateToSubnet) GetCoins() string {
+	if m != nil {
+		return m.Coins
+	}
+	return ""
+}
+
+// EventDeallocateFromSubnet defines the event type for deallocating tokens
+// from subnet stake
+type EventDeallocateFromSubnet struct {
+	// account is the address of the vesting account
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// netuid is the subnet the tokens are deallocated from
+	Netuid uint32 `protobuf:"varint,2,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	// coins deallocated
+	Coins string `protobuf:"bytes,3,opt,name=coins,proto3" json:"coins,omitempty"`
+}
+
+func (m *EventDeallocateFromSubnet) Reset()         { *m = EventDeallocateFromSubnet{} }
+func (m *EventDeallocateFromSubnet) String() string { return proto.CompactTextString(m) }
+func (*EventDeallocateFromSubnet) ProtoMessage()    {}
+func (*EventDeallocateFromSubnet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ea095c8e21d4cd, []int{4}
+}
+func (m *EventDeallocateFromSubnet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeallocateFromSubnet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeallocateFromSubnet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDeallocateFromSubnet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeallocateFromSubnet.Merge(m, src)
+}
+func (m *EventDeallocateFromSubnet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeallocateFromSubnet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeallocateFromSubnet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeallocateFromSubnet proto.InternalMessageInfo
+
+func (m *EventDeallocateFromSubnet) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventDeallocateFromSubnet) GetNetuid() uint32 {
+	if m != nil {
+		return m.Netuid
+	}
+	return 0
+}
+
+func (m *EventDeallocateFromSubnet) GetCoins() string {
+	if m != nil {
+		return m.Coins
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateClawbackVestingAccount)(nil), "evmos.vesting.v1.EventCreateClawbackVestingAccount")
 	proto.RegisterType((*EventClawback)(nil), "evmos.vesting.v1.EventClawback")
 	proto.RegisterType((*EventUpdateVestingFunder)(nil), "evmos.vesting.v1.EventUpdateVestingFunder")
+	proto.RegisterType((*EventAllocateToSubnet)(nil), "evmos.vesting.v1.EventAllocateToSubnet")
+	proto.RegisterType((*EventDeallocateFromSubnet)(nil), "evmos.vesting.v1.EventDeallocateFromSubnet")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v1/events.proto", fileDescriptor_48ea095c8e21d4cd) }
 
 var fileDescriptor_48ea095c8e21d4cd = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x9b, 0x42, 0x8b, 0x7a, 0x08, 0x09, 0x45, 0x08, 0x79, 0x69, 0x54, 0x3a, 0xb1, 0x90,
-	0xa8, 0xea, 0x13, 0x40, 0x05, 0x1b, 0x0b, 0x02, 0x06, 0x96, 0xca, 0x71, 0x8f, 0x36, 0x94, 0xd8,
-	0x91, 0x7d, 0x49, 0xe1, 0x2d, 0x78, 0x04, 0x1e, 0x87, 0xb1, 0x23, 0x23, 0x6a, 0x5f, 0x04, 0xc5,
-	0x36, 0x28, 0x8c, 0x8c, 0xdf, 0x7f, 0xbe, 0xff, 0xfe, 0xf3, 0x41, 0x1f, 0xab, 0x5c, 0x99, 0xa4,
-	0x42, 0x43, 0x99, 0x9c, 0x27, 0xd5, 0x28, 0xc1, 0x0a, 0x25, 0x99, 0xb8, 0xd0, 0x8a, 0x54, 0x78,
-	0x68, 0xcb, 0xb1, 0x2f, 0xc7, 0xd5, 0x68, 0xf8, 0x1e, 0xc0, 0xc9, 0x65, 0xfd, 0x64, 0xa2, 0x91,
-	0x13, 0x4e, 0x9e, 0xf9, 0x2a, 0xe5, 0x62, 0x79, 0xef, 0x5e, 0x9c, 0x0b, 0xa1, 0x4a, 0x49, 0xe1,
-	0x31, 0x74, 0x0d, 0xca, 0x19, 0x6a, 0x16, 0x0c, 0x82, 0xd3, 0xde, 0x8d, 0xa7, 0xf0, 0x08, 0x3a,
-	0x42, 0x65, 0xd2, 0xb0, 0xb6, 0x95, 0x1d, 0x84, 0x7d, 0x00, 0x43, 0x5c, 0xd3, 0x94, 0xb2, 0x1c,
-	0xd9, 0x8e, 0x2d, 0xf5, 0xac, 0x72, 0x9b, 0xe5, 0x58, 0x37, 0xe5, 0xa8, 0xe7, 0xc8, 0x76, 0x5d,
-	0x93, 0x85, 0x90, 0xc1, 0x1e, 0x77, 0xd3, 0x58, 0xc7, 0xea, 0x3f, 0x38, 0x14, 0x70, 0xe0, 0x12,
-	0xfa, 0x6c, 0x75, 0x9a, 0xc7, 0xb2, 0x99, 0xc6, 0x51, 0xd3, 0xa2, 0xfd, 0xc7, 0x22, 0x1c, 0xc0,
-	0xfe, 0xcc, 0x6e, 0xc4, 0x29, 0x53, 0xd2, 0x47, 0x6a, 0x4a, 0xc3, 0x25, 0x30, 0x3b, 0xe4, 0xae,
-	0x98, 0x71, 0x42, 0xbf, 0xfe, 0x95, 0xf3, 0xfd, 0xff, 0xbc, 0x3e, 0x80, 0xc4, 0xd5, 0xd4, 0x77,
-	0xf9, 0x1f, 0x90, 0xb8, 0x72, 0x86, 0x17, 0xd7, 0x1f, 0x9b, 0x28, 0x58, 0x6f, 0xa2, 0xe0, 0x6b,
-	0x13, 0x05, 0x6f, 0xdb, 0xa8, 0xb5, 0xde, 0x46, 0xad, 0xcf, 0x6d, 0xd4, 0x7a, 0x18, 0xcf, 0x33,
-	0x5a, 0x94, 0x69, 0x2c, 0x54, 0x9e, 0x2c, 0x90, 0xca, 0xb3, 0x42, 0xab, 0x27, 0x14, 0xe4, 0x60,
-	0x51, 0xa6, 0xf5, 0x49, 0x5f, 0x7e, 0xef, 0x4b, 0xaf, 0x05, 0x9a, 0xb4, 0x6b, 0x8f, 0x3b, 0xfe,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x91, 0xf4, 0xab, 0x1f, 0xfd, 0x01, 0x00, 0x00,
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xc1, 0x6e, 0xda, 0x40,
+	0x10, 0x86, 0x31, 0x14, 0x2a, 0xa6, 0x42, 0xaa, 0xac, 0x16, 0xb9, 0x07, 0x2c, 0xea, 0x53, 0x2f,
+	0xb5, 0x85, 0xfa, 0x04, 0x94, 0x04, 0x29, 0x57, 0x42, 0x72, 0xc8, 0x05, 0xad, 0xd7, 0x13, 0x70,
+	0xc0, 0xbb, 0x96, 0x3d, 0x36, 0xc9, 0x5b, 0xe4, 0x11, 0xf2, 0x38, 0x39, 0x72, 0xcc, 0x31, 0x82,
+	0x17, 0x89, 0xbc, 0xbb, 0x49, 0xcc, 0x31, 0x52, 0x8e, 0xff, 0xcc, 0xce, 0xff, 0xfd, 0xbb, 0x3b,
+	0x30, 0xc0, 0x32, 0x91, 0x79, 0x50, 0x62, 0x4e, 0xb1, 0x58, 0x06, 0xe5, 0x28, 0xc0, 0x12, 0x05,
+	0xe5, 0x7e, 0x9a, 0x49, 0x92, 0xf6, 0x77, 0xd5, 0xf6, 0x4d, 0xdb, 0x2f, 0x47, 0xde, 0x83, 0x05,
+	0xbf, 0x4f, 0xab, 0x23, 0x93, 0x0c, 0x19, 0xe1, 0x64, 0xc3, 0xb6, 0x21, 0xe3, 0xeb, 0x4b, 0x7d,
+	0x62, 0xcc, 0xb9, 0x2c, 0x04, 0xd9, 0x7d, 0xe8, 0xe4, 0x28, 0x22, 0xcc, 0x1c, 0x6b, 0x68, 0xfd,
+	0xe9, 0xce, 0x8c, 0xb2, 0x7f, 0x40, 0x9b, 0xcb, 0x58, 0xe4, 0x4e, 0x53, 0x95, 0xb5, 0xb0, 0x07,
+	0x00, 0x39, 0xb1, 0x8c, 0x16, 0x14, 0x27, 0xe8, 0xb4, 0x54, 0xab, 0xab, 0x2a, 0xf3, 0x38, 0xc1,
+	0x6a, 0x28, 0xc1, 0x6c, 0x89, 0xce, 0x17, 0x3d, 0xa4, 0x84, 0xed, 0xc0, 0x57, 0xa6, 0x69, 0x4e,
+	0x5b, 0xd5, 0x5f, 0xa5, 0xc7, 0xa1, 0xa7, 0x13, 0x9a, 0x6c, 0x55, 0x9a, 0xeb, 0xa2, 0x9e, 0x46,
+	0xab, 0xba, 0x45, 0xf3, 0xc8, 0xc2, 0x1e, 0xc2, 0xb7, 0x48, 0xdd, 0x88, 0x51, 0x2c, 0x85, 0x89,
+	0x54, 0x2f, 0x79, 0x6b, 0x70, 0x14, 0xe4, 0x22, 0x8d, 0x18, 0xa1, 0xb9, 0xfe, 0x54, 0xfb, 0x7e,
+	0x9c, 0x37, 0x00, 0x10, 0xb8, 0x5d, 0x98, 0x29, 0xf3, 0x02, 0x02, 0xb7, 0xda, 0xd0, 0x5b, 0xc0,
+	0x4f, 0x05, 0x1b, 0x6f, 0x36, 0x92, 0x33, 0xc2, 0xb9, 0x3c, 0x2f, 0x42, 0x81, 0x54, 0x77, 0xb4,
+	0x8e, 0x1d, 0xfb, 0xd0, 0x11, 0x48, 0x45, 0x1c, 0x29, 0x54, 0x6f, 0x66, 0xd4, 0xfb, 0x0f, 0xb4,
+	0x6a, 0x3f, 0xe0, 0x71, 0xf8, 0xa5, 0x00, 0x27, 0xc8, 0x0c, 0x62, 0x9a, 0xc9, 0xe4, 0x73, 0x21,
+	0xff, 0xcf, 0x1e, 0xf7, 0xae, 0xb5, 0xdb, 0xbb, 0xd6, 0xf3, 0xde, 0xb5, 0xee, 0x0f, 0x6e, 0x63,
+	0x77, 0x70, 0x1b, 0x4f, 0x07, 0xb7, 0x71, 0x15, 0x2c, 0x63, 0x5a, 0x15, 0xa1, 0xcf, 0x65, 0x12,
+	0xac, 0x90, 0x8a, 0xbf, 0x69, 0x26, 0x6f, 0x90, 0x93, 0x12, 0xd5, 0x52, 0xde, 0xbe, 0x6d, 0x28,
+	0xdd, 0xa5, 0x98, 0x87, 0x1d, 0xb5, 0x9e, 0xff, 0x5e, 0x06, 0x00, 0x81, 0x25, 0x8f, 0x85, 0xbf,
+	0x02, 0x00, 0x00,
 }
 
 func (m *EventCreateClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -411,6 +547,90 @@ func (m *EventUpdateVestingFunder) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *EventAllocateToSubnet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAllocateToSubnet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAllocateToSubnet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		i -= len(m.Coins)
+		copy(dAtA[i:], m.Coins)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Coins)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Netuid != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Netuid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDeallocateFromSubnet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeallocateFromSubnet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeallocateFromSubnet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		i -= len(m.Coins)
+		copy(dAtA[i:], m.Coins)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Coins)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Netuid != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Netuid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -493,6 +713,46 @@ func (m *EventUpdateVestingFunder) Size() (n int) {
 	return n
 }
 
+func (m *EventAllocateToSubnet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Netuid != 0 {
+		n += 1 + sovEvents(uint64(m.Netuid))
+	}
+	l = len(m.Coins)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDeallocateFromSubnet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Netuid != 0 {
+		n += 1 + sovEvents(uint64(m.Netuid))
+	}
+	l = len(m.Coins)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1001,6 +1261,272 @@ func (m *EventUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventAllocateToSubnet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAllocateToSubnet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAllocateToSubnet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+			}
+			m.Netuid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Netuid |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeallocateFromSubnet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeallocateFromSubnet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeallocateFromSubnet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+			}
+			m.Netuid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Netuid |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -22,6 +22,8 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	eventtypes "github.com/hetu-project/hetu/v1/x/event/types"
 )
 
 // AccountKeeper defines the expected interface contract the vesting module
@@ -43,6 +45,8 @@ type BankKeeper interface {
 	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	BlockedAddr(addr sdk.AccAddress) bool
+	DelegateCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	UndelegateCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
 // StakingKeeper defines the expected interface contract the vesting module
@@ -55,3 +59,13 @@ type StakingKeeper interface {
 	GetDelegatorBonded(ctx context.Context, delegator sdk.AccAddress) (math.Int, error)
 	IterateDelegations(ctx context.Context, delegator sdk.AccAddress, fn func(index int64, delegation stakingtypes.DelegationI) (stop bool)) error
 }
+
+// EventKeeper defines the expected interface contract the vesting module
+// requires for counting the locked tokens allocated by vesting accounts as
+// subnet stake.
+type EventKeeper interface {
+	GetSubnet(ctx sdk.Context, netuid uint16) (eventtypes.Subnet, bool)
+	GetVestingStake(ctx sdk.Context, netuid uint16, account string) math.Int
+	GetVestingStakesByAccount(ctx sdk.Context, account string) []eventtypes.VestingStake
+	SetVestingStake(ctx sdk.Context, stake eventtypes.VestingStake)
+}
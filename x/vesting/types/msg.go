@@ -30,6 +30,8 @@ var (
 	_ sdk.Msg = &MsgClawback{}
 	_ sdk.Msg = &MsgConvertVestingAccount{}
 	_ sdk.Msg = &MsgUpdateVestingFunder{}
+	_ sdk.Msg = &MsgAllocateToSubnet{}
+	_ sdk.Msg = &MsgDeallocateFromSubnet{}
 )
 
 const (
@@ -37,6 +39,8 @@ const (
 	TypeMsgClawback                     = "clawback"
 	TypeMsgUpdateVestingFunder          = "update_vesting_funder"
 	TypeMsgConvertVestingAccount        = "convert_vesting_account"
+	TypeMsgAllocateToSubnet             = "allocate_to_subnet"
+	TypeMsgDeallocateFromSubnet         = "deallocate_from_subnet"
 )
 
 // NewMsgCreateClawbackVestingAccount creates new instance of MsgCreateClawbackVestingAccount
@@ -252,3 +256,83 @@ func (msg MsgConvertVestingAccount) GetSigners() []sdk.AccAddress {
 	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
 	return []sdk.AccAddress{vesting}
 }
+
+// NewMsgAllocateToSubnet creates new instance of MsgAllocateToSubnet
+func NewMsgAllocateToSubnet(vestingAcc sdk.AccAddress, netuid uint16, amount sdk.Coin) *MsgAllocateToSubnet {
+	return &MsgAllocateToSubnet{
+		VestingAddress: vestingAcc.String(),
+		Netuid:         uint32(netuid),
+		Amount:         amount,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgAllocateToSubnet) Route() string { return RouterKey }
+
+// Type returns the action
+func (msg MsgAllocateToSubnet) Type() string { return TypeMsgAllocateToSubnet }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgAllocateToSubnet) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.GetVestingAddress()); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address")
+	}
+	return validateSubnetAmount(msg.Netuid, msg.Amount)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgAllocateToSubnet) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAllocateToSubnet) GetSigners() []sdk.AccAddress {
+	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+	return []sdk.AccAddress{vesting}
+}
+
+// NewMsgDeallocateFromSubnet creates new instance of MsgDeallocateFromSubnet
+func NewMsgDeallocateFromSubnet(vestingAcc sdk.AccAddress, netuid uint16, amount sdk.Coin) *MsgDeallocateFromSubnet {
+	return &MsgDeallocateFromSubnet{
+		VestingAddress: vestingAcc.String(),
+		Netuid:         uint32(netuid),
+		Amount:         amount,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgDeallocateFromSubnet) Route() string { return RouterKey }
+
+// Type returns the action
+func (msg MsgDeallocateFromSubnet) Type() string { return TypeMsgDeallocateFromSubnet }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDeallocateFromSubnet) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.GetVestingAddress()); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address")
+	}
+	return validateSubnetAmount(msg.Netuid, msg.Amount)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgDeallocateFromSubnet) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDeallocateFromSubnet) GetSigners() []sdk.AccAddress {
+	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+	return []sdk.AccAddress{vesting}
+}
+
+// validateSubnetAmount checks the subnet and the amount of a subnet
+// allocation. The root network, netuid 0, does not take allocations.
+func validateSubnetAmount(netuid uint32, amount sdk.Coin) error {
+	if netuid == 0 || netuid > uint32(^uint16(0)) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid netuid %d", netuid)
+	}
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errortypes.ErrInvalidCoins.Wrap(amount.String())
+	}
+	return nil
+}
@@ -410,3 +410,51 @@ func (suite *MsgsTestSuite) TestMsgConvertVestingAccount() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgAllocateToSubnet() {
+	addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	testCases := []struct {
+		name    string
+		msg     *types.MsgAllocateToSubnet
+		expPass bool
+	}{
+		{
+			"fail - not a valid vesting address",
+			&types.MsgAllocateToSubnet{VestingAddress: "invalid_address", Netuid: 1, Amount: sdk.NewInt64Coin("test", 1)},
+			false,
+		},
+		{
+			"fail - root network",
+			types.NewMsgAllocateToSubnet(addr, 0, sdk.NewInt64Coin("test", 1)),
+			false,
+		},
+		{
+			"fail - netuid out of range",
+			&types.MsgAllocateToSubnet{VestingAddress: addr.String(), Netuid: 1 << 16, Amount: sdk.NewInt64Coin("test", 1)},
+			false,
+		},
+		{
+			"fail - zero amount",
+			types.NewMsgAllocateToSubnet(addr, 1, sdk.NewInt64Coin("test", 0)),
+			false,
+		},
+		{
+			"pass - valid allocation",
+			types.NewMsgAllocateToSubnet(addr, 1, sdk.NewInt64Coin("test", 1)),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.name)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.name)
+		}
+	}
+
+	deallocate := types.NewMsgDeallocateFromSubnet(addr, 1, sdk.NewInt64Coin("test", 1))
+	suite.Require().NoError(deallocate.ValidateBasic())
+	suite.Require().Equal([]sdk.AccAddress{addr}, deallocate.GetSigners())
+}
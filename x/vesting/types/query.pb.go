@@ -8,22 +8,29 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	github_com_cosmos_cosmos_sdk_x_auth_vesting_types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -142,277 +149,2725 @@ func (m *QueryBalancesResponse) GetVested() github_com_cosmos_cosmos_sdk_types.C
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryBalancesRequest)(nil), "evmos.vesting.v1.QueryBalancesRequest")
-	proto.RegisterType((*QueryBalancesResponse)(nil), "evmos.vesting.v1.QueryBalancesResponse")
+// VestingAccountSchedule defines the schedules of a clawback vesting account,
+// along with its next unlocking and vesting events at the current block time.
+type VestingAccountSchedule struct {
+	// address of the clawback vesting account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// funder_address is the address that can claw back the unvested tokens
+	FunderAddress string `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// start_time defines the time at which the vesting period begins
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time defines the time at which the last tokens are unlocked and vested
+	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// original_vesting defines the tokens granted to the account
+	OriginalVesting github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=original_vesting,json=originalVesting,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"original_vesting"`
+	// lockup_periods defines the unlocking schedule relative to the start_time
+	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,6,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
+	// vesting_periods defines the vesting schedule relative to the start_time
+	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,7,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+	// delegated_vesting defines the vesting tokens delegated or allocated to
+	// subnet stake
+	DelegatedVesting github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=delegated_vesting,json=delegatedVesting,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegated_vesting"`
+	// delegated_free defines the vested tokens delegated or allocated to subnet
+	// stake
+	DelegatedFree github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=delegated_free,json=delegatedFree,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegated_free"`
+	// next_unlock_time is the time of the next unlocking event, unset once all
+	// the tokens are unlocked
+	NextUnlockTime *time.Time `protobuf:"bytes,10,opt,name=next_unlock_time,json=nextUnlockTime,proto3,stdtime" json:"next_unlock_time,omitempty"`
+	// next_unlock defines the tokens unlocked by the next unlocking event
+	NextUnlock github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=next_unlock,json=nextUnlock,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"next_unlock"`
+	// next_vesting_time is the time of the next vesting event, unset once all
+	// the tokens are vested
+	NextVestingTime *time.Time `protobuf:"bytes,12,opt,name=next_vesting_time,json=nextVestingTime,proto3,stdtime" json:"next_vesting_time,omitempty"`
+	// next_vesting defines the tokens vested by the next vesting event
+	NextVesting github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=next_vesting,json=nextVesting,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"next_vesting"`
+	// clawback_eligible is true if the funder can claw back unvested tokens from
+	// the account
+	ClawbackEligible bool `protobuf:"varint,14,opt,name=clawback_eligible,json=clawbackEligible,proto3" json:"clawback_eligible,omitempty"`
 }
 
-func init() { proto.RegisterFile("evmos/vesting/v1/query.proto", fileDescriptor_ff0457b141ab5d28) }
+func (m *VestingAccountSchedule) Reset()         { *m = VestingAccountSchedule{} }
+func (m *VestingAccountSchedule) String() string { return proto.CompactTextString(m) }
+func (*VestingAccountSchedule) ProtoMessage()    {}
+func (*VestingAccountSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff0457b141ab5d28, []int{2}
+}
+func (m *VestingAccountSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingAccountSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingAccountSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingAccountSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingAccountSchedule.Merge(m, src)
+}
+func (m *VestingAccountSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingAccountSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingAccountSchedule.DiscardUnknown(m)
+}
 
-var fileDescriptor_ff0457b141ab5d28 = []byte{
-	// 390 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xc1, 0xce, 0xd2, 0x40,
-	0x10, 0xee, 0x42, 0x44, 0x5c, 0x2f, 0xa6, 0xc1, 0xa4, 0x12, 0x52, 0x08, 0x31, 0xc8, 0x41, 0x76,
-	0x29, 0xbc, 0x01, 0x9e, 0x3d, 0xc8, 0xd1, 0xdb, 0xb6, 0x9d, 0x94, 0x0a, 0xec, 0x94, 0xee, 0xb6,
-	0x91, 0x18, 0x2f, 0xde, 0xbc, 0x99, 0xf0, 0x16, 0x3e, 0x09, 0xf1, 0x44, 0xe2, 0xc5, 0x93, 0x1a,
-	0xf0, 0x41, 0x4c, 0xdb, 0x85, 0x18, 0xfc, 0x93, 0xff, 0xf2, 0xff, 0xa7, 0xee, 0x74, 0xe6, 0xfb,
-	0x66, 0xbe, 0xf9, 0x86, 0x76, 0x20, 0x5f, 0xa3, 0xe2, 0x39, 0x28, 0x1d, 0xcb, 0x88, 0xe7, 0x1e,
-	0xdf, 0x64, 0x90, 0x6e, 0x59, 0x92, 0xa2, 0x46, 0xfb, 0x49, 0x99, 0x65, 0x26, 0xcb, 0x72, 0xaf,
-	0xed, 0x06, 0xa8, 0x0a, 0x80, 0x2f, 0x14, 0xf0, 0xdc, 0xf3, 0x41, 0x0b, 0x8f, 0x07, 0x18, 0xcb,
-	0x0a, 0xd1, 0x6e, 0x45, 0x18, 0x61, 0xf9, 0xe4, 0xc5, 0xcb, 0xfc, 0xed, 0x44, 0x88, 0xd1, 0x0a,
-	0xb8, 0x48, 0x62, 0x2e, 0xa4, 0x44, 0x2d, 0x74, 0x8c, 0x52, 0x55, 0xd9, 0xfe, 0x98, 0xb6, 0xde,
-	0x14, 0x4d, 0x67, 0x62, 0x25, 0x64, 0x00, 0x6a, 0x0e, 0x9b, 0x0c, 0x94, 0xb6, 0x1d, 0xfa, 0x50,
-	0x84, 0x61, 0x0a, 0x4a, 0x39, 0xa4, 0x47, 0x86, 0x8f, 0xe6, 0xe7, 0xb0, 0xff, 0xad, 0x46, 0x9f,
-	0x5e, 0x41, 0x54, 0x82, 0x52, 0x81, 0x1d, 0xd0, 0xc6, 0x0a, 0x83, 0x25, 0x84, 0x0e, 0xe9, 0xd5,
-	0x87, 0x8f, 0x27, 0xcf, 0x58, 0x35, 0x30, 0x2b, 0x06, 0x66, 0x66, 0x60, 0xf6, 0x0a, 0x63, 0x39,
-	0x1b, 0xef, 0x7f, 0x76, 0xad, 0xaf, 0xbf, 0xba, 0xc3, 0x28, 0xd6, 0x8b, 0xcc, 0x67, 0x01, 0xae,
-	0xb9, 0x51, 0x57, 0x7d, 0x46, 0x2a, 0x5c, 0x72, 0xbd, 0x4d, 0x40, 0x95, 0x00, 0x35, 0x37, 0xd4,
-	0x76, 0x44, 0x9b, 0x99, 0x2c, 0x96, 0x02, 0xa1, 0x53, 0xbb, 0xfb, 0x36, 0x17, 0xf2, 0x42, 0x8d,
-	0x69, 0x53, 0xbf, 0x07, 0x35, 0x15, 0xf5, 0x64, 0x47, 0xe8, 0x83, 0x72, 0x99, 0xf6, 0x67, 0x42,
-	0x9b, 0xe7, 0x8d, 0xda, 0x03, 0x76, 0x6d, 0x3e, 0xbb, 0xc9, 0xa5, 0xf6, 0x8b, 0x5b, 0xeb, 0x2a,
-	0x6b, 0xfa, 0x2f, 0x3f, 0x7d, 0xff, 0xb3, 0xab, 0x0d, 0xec, 0xe7, 0xfc, 0xbf, 0x9b, 0xf3, 0x4d,
-	0x2d, 0xff, 0x60, 0x1c, 0xfe, 0x38, 0x7b, 0xbd, 0x3f, 0xba, 0xe4, 0x70, 0x74, 0xc9, 0xef, 0xa3,
-	0x4b, 0xbe, 0x9c, 0x5c, 0xeb, 0x70, 0x72, 0xad, 0x1f, 0x27, 0xd7, 0x7a, 0x3b, 0xfd, 0x47, 0xe1,
-	0x02, 0x74, 0x36, 0x4a, 0x52, 0x7c, 0x07, 0x81, 0xae, 0x82, 0x45, 0xe6, 0x17, 0x8c, 0xef, 0x2f,
-	0xf4, 0xa5, 0x64, 0xbf, 0x51, 0x9e, 0xda, 0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x46,
-	0x89, 0x3c, 0xf0, 0x02, 0x00, 0x00,
+var xxx_messageInfo_VestingAccountSchedule proto.InternalMessageInfo
+
+func (m *VestingAccountSchedule) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *VestingAccountSchedule) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *VestingAccountSchedule) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Balances retrieves the unvested, vested and locked tokens for a vesting account
-	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
+func (m *VestingAccountSchedule) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *VestingAccountSchedule) GetOriginalVesting() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.OriginalVesting
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *VestingAccountSchedule) GetLockupPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.LockupPeriods
+	}
+	return nil
 }
 
-func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error) {
-	out := new(QueryBalancesResponse)
-	err := c.cc.Invoke(ctx, "/evmos.vesting.v1.Query/Balances", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VestingAccountSchedule) GetVestingPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.VestingPeriods
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Balances retrieves the unvested, vested and locked tokens for a vesting account
-	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+func (m *VestingAccountSchedule) GetDelegatedVesting() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DelegatedVesting
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *VestingAccountSchedule) GetDelegatedFree() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DelegatedFree
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Balances(ctx context.Context, req *QueryBalancesRequest) (*QueryBalancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
+func (m *VestingAccountSchedule) GetNextUnlockTime() *time.Time {
+	if m != nil {
+		return m.NextUnlockTime
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *VestingAccountSchedule) GetNextUnlock() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NextUnlock
+	}
+	return nil
 }
 
-func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBalancesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *VestingAccountSchedule) GetNextVestingTime() *time.Time {
+	if m != nil {
+		return m.NextVestingTime
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Balances(ctx, in)
+	return nil
+}
+
+func (m *VestingAccountSchedule) GetNextVesting() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NextVesting
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.vesting.v1.Query/Balances",
+	return nil
+}
+
+func (m *VestingAccountSchedule) GetClawbackEligible() bool {
+	if m != nil {
+		return m.ClawbackEligible
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Balances(ctx, req.(*QueryBalancesRequest))
+	return false
+}
+
+// QueryVestingAccountRequest is the request type for the Query/VestingAccount
+// RPC method.
+type QueryVestingAccountRequest struct {
+	// address of the clawback vesting account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryVestingAccountRequest) Reset()         { *m = QueryVestingAccountRequest{} }
+func (m *QueryVestingAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingAccountRequest) ProtoMessage()    {}
+func (*QueryVestingAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff0457b141ab5d28, []int{3}
+}
+func (m *QueryVestingAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryVestingAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingAccountRequest.Merge(m, src)
+}
+func (m *QueryVestingAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingAccountRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "evmos.vesting.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Balances",
-			Handler:    _Query_Balances_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "evmos/vesting/v1/query.proto",
+var xxx_messageInfo_QueryVestingAccountRequest proto.InternalMessageInfo
+
+func (m *QueryVestingAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *QueryBalancesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryVestingAccountResponse is the response type for the
+// Query/VestingAccount RPC method.
+type QueryVestingAccountResponse struct {
+	// account defines the schedules of the clawback vesting account
+	Account VestingAccountSchedule `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+}
+
+func (m *QueryVestingAccountResponse) Reset()         { *m = QueryVestingAccountResponse{} }
+func (m *QueryVestingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingAccountResponse) ProtoMessage()    {}
+func (*QueryVestingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff0457b141ab5d28, []int{4}
+}
+func (m *QueryVestingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryVestingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingAccountResponse.Merge(m, src)
+}
+func (m *QueryVestingAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingAccountResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryVestingAccountResponse proto.InternalMessageInfo
+
+func (m *QueryVestingAccountResponse) GetAccount() VestingAccountSchedule {
+	if m != nil {
+		return m.Account
+	}
+	return VestingAccountSchedule{}
 }
 
-func (m *QueryBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+// QueryVestingAccountsByFunderRequest is the request type for the
+// Query/VestingAccountsByFunder RPC method.
+type QueryVestingAccountsByFunderRequest struct {
+	// funder_address is the funder of the clawback vesting accounts
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVestingAccountsByFunderRequest) Reset()         { *m = QueryVestingAccountsByFunderRequest{} }
+func (m *QueryVestingAccountsByFunderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingAccountsByFunderRequest) ProtoMessage()    {}
+func (*QueryVestingAccountsByFunderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff0457b141ab5d28, []int{5}
+}
+func (m *QueryVestingAccountsByFunderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingAccountsByFunderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingAccountsByFunderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVestingAccountsByFunderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingAccountsByFunderRequest.Merge(m, src)
+}
+func (m *QueryVestingAccountsByFunderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingAccountsByFunderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingAccountsByFunderRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBalancesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVestingAccountsByFunderRequest proto.InternalMessageInfo
+
+func (m *QueryVestingAccountsByFunderRequest) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVestingAccountsByFunderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Vested) > 0 {
-		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+// QueryVestingAccountsByFunderResponse is the response type for the
+// Query/VestingAccountsByFunder RPC method.
+type QueryVestingAccountsByFunderResponse struct {
+	// accounts defines the schedules of the clawback vesting accounts
+	Accounts []VestingAccountSchedule `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVestingAccountsByFunderResponse) Reset()         { *m = QueryVestingAccountsByFunderResponse{} }
+func (m *QueryVestingAccountsByFunderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingAccountsByFunderResponse) ProtoMessage()    {}
+func (*QueryVestingAccountsByFunderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff0457b141ab5d28, []int{6}
+}
+func (m *QueryVestingAccountsByFunderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingAccountsByFunderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingAccountsByFunderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Unvested) > 0 {
-		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *QueryVestingAccountsByFunderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingAccountsByFunderResponse.Merge(m, src)
+}
+func (m *QueryVestingAccountsByFunderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingAccountsByFunderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingAccountsByFunderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingAccountsByFunderResponse proto.InternalMessageInfo
+
+func (m *QueryVestingAccountsByFunderResponse) GetAccounts() []VestingAccountSchedule {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryVestingAccountsByFunderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySimulateClawbackRequest is the request type for the
+// Query/SimulateClawback RPC method.
+type QuerySimulateClawbackRequest struct {
+	// address of the clawback vesting account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// time of the simulated clawback, defaults to the current block time
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *QuerySimulateClawbackRequest) Reset()         { *m = QuerySimulateClawbackRequest{} }
+func (m *QuerySimulateClawbackRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateClawbackRequest) ProtoMessage()    {}
+func (*QuerySimulateClawbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff0457b141ab5d28, []int{7}
+}
+func (m *QuerySimulateClawbackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateClawbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateClawbackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateClawbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateClawbackRequest.Merge(m, src)
+}
+func (m *QuerySimulateClawbackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateClawbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateClawbackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateClawbackRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateClawbackRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QuerySimulateClawbackRequest) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// QuerySimulateClawbackResponse is the response type for the
+// Query/SimulateClawback RPC method.
+type QuerySimulateClawbackResponse struct {
+	// time of the simulated clawback
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// vested defines the tokens vested at the time, which the account keeps
+	Vested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+	// unvested_returned defines the unvested tokens returned to the funder, from
+	// the account balance and its subnet allocations
+	UnvestedReturned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=unvested_returned,json=unvestedReturned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvested_returned"`
+	// staked_unvested defines the unvested tokens the account cannot return
+	// because they are staked
+	StakedUnvested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=staked_unvested,json=stakedUnvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"staked_unvested"`
+}
+
+func (m *QuerySimulateClawbackResponse) Reset()         { *m = QuerySimulateClawbackResponse{} }
+func (m *QuerySimulateClawbackResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateClawbackResponse) ProtoMessage()    {}
+func (*QuerySimulateClawbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff0457b141ab5d28, []int{8}
+}
+func (m *QuerySimulateClawbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateClawbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateClawbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateClawbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateClawbackResponse.Merge(m, src)
+}
+func (m *QuerySimulateClawbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateClawbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateClawbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateClawbackResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateClawbackResponse) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *QuerySimulateClawbackResponse) GetVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vested
+	}
+	return nil
+}
+
+func (m *QuerySimulateClawbackResponse) GetUnvestedReturned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UnvestedReturned
+	}
+	return nil
+}
+
+func (m *QuerySimulateClawbackResponse) GetStakedUnvested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StakedUnvested
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryBalancesRequest)(nil), "evmos.vesting.v1.QueryBalancesRequest")
+	proto.RegisterType((*QueryBalancesResponse)(nil), "evmos.vesting.v1.QueryBalancesResponse")
+	proto.RegisterType((*VestingAccountSchedule)(nil), "evmos.vesting.v1.VestingAccountSchedule")
+	proto.RegisterType((*QueryVestingAccountRequest)(nil), "evmos.vesting.v1.QueryVestingAccountRequest")
+	proto.RegisterType((*QueryVestingAccountResponse)(nil), "evmos.vesting.v1.QueryVestingAccountResponse")
+	proto.RegisterType((*QueryVestingAccountsByFunderRequest)(nil), "evmos.vesting.v1.QueryVestingAccountsByFunderRequest")
+	proto.RegisterType((*QueryVestingAccountsByFunderResponse)(nil), "evmos.vesting.v1.QueryVestingAccountsByFunderResponse")
+	proto.RegisterType((*QuerySimulateClawbackRequest)(nil), "evmos.vesting.v1.QuerySimulateClawbackRequest")
+	proto.RegisterType((*QuerySimulateClawbackResponse)(nil), "evmos.vesting.v1.QuerySimulateClawbackResponse")
+}
+
+func init() { proto.RegisterFile("evmos/vesting/v1/query.proto", fileDescriptor_ff0457b141ab5d28) }
+
+var fileDescriptor_ff0457b141ab5d28 = []byte{
+	// 1068 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcb, 0x6f, 0x1b, 0x45,
+	0x18, 0xcf, 0x38, 0x2f, 0xe7, 0x73, 0xe3, 0x38, 0xa3, 0x02, 0x8b, 0x09, 0x4e, 0x64, 0x42, 0x6a,
+	0x41, 0xb3, 0xdb, 0xa4, 0x4a, 0x05, 0xe2, 0x80, 0xe2, 0x88, 0x00, 0x15, 0x07, 0x70, 0x29, 0x07,
+	0x2e, 0xd6, 0x78, 0x77, 0xb2, 0x59, 0xb2, 0xd9, 0x71, 0x77, 0x66, 0x4d, 0xa2, 0xaa, 0x97, 0x9e,
+	0x80, 0x53, 0x25, 0xb8, 0x71, 0xe7, 0xd0, 0x03, 0xff, 0x00, 0x67, 0xa4, 0x8a, 0x53, 0x25, 0x2e,
+	0x9c, 0x28, 0x4a, 0xf8, 0x43, 0xd0, 0xce, 0xc3, 0x8f, 0xd8, 0xae, 0x63, 0xc9, 0xe1, 0x14, 0xef,
+	0x7c, 0x8f, 0xdf, 0xef, 0x7b, 0xcc, 0x37, 0x5f, 0x60, 0x85, 0xb6, 0x8e, 0x19, 0x77, 0x5a, 0x94,
+	0x8b, 0x20, 0xf2, 0x9d, 0xd6, 0x96, 0xf3, 0x20, 0xa1, 0xf1, 0xa9, 0xdd, 0x8c, 0x99, 0x60, 0xb8,
+	0x20, 0xa5, 0xb6, 0x96, 0xda, 0xad, 0xad, 0xe2, 0x3b, 0x2e, 0xe3, 0xa9, 0x41, 0x83, 0x70, 0xaa,
+	0x54, 0x9d, 0xd6, 0x56, 0x83, 0x0a, 0xb2, 0xe5, 0x34, 0x89, 0x1f, 0x44, 0x44, 0x04, 0x2c, 0x52,
+	0xd6, 0xc5, 0x52, 0xb7, 0xae, 0xd1, 0x72, 0x59, 0x60, 0xe4, 0xeb, 0x5a, 0xde, 0x01, 0x57, 0x2a,
+	0x06, 0x4e, 0x69, 0x5d, 0xf7, 0x99, 0xcf, 0xe4, 0x4f, 0x27, 0xfd, 0xa5, 0x4f, 0x57, 0x7c, 0xc6,
+	0xfc, 0x90, 0x3a, 0xa4, 0x19, 0x38, 0x24, 0x8a, 0x98, 0x90, 0xc0, 0x5c, 0x4b, 0x57, 0xb5, 0x54,
+	0x7e, 0x35, 0x92, 0x03, 0x47, 0x04, 0xc7, 0x94, 0x0b, 0x72, 0xdc, 0x54, 0x0a, 0xe5, 0x5b, 0x70,
+	0xfd, 0x8b, 0x94, 0x7c, 0x95, 0x84, 0x24, 0x72, 0x29, 0xaf, 0xd1, 0x07, 0x09, 0xe5, 0x02, 0x5b,
+	0x30, 0x4f, 0x3c, 0x2f, 0xa6, 0x9c, 0x5b, 0x68, 0x0d, 0x55, 0x16, 0x6a, 0xe6, 0xb3, 0xfc, 0x47,
+	0x06, 0x5e, 0xb9, 0x60, 0xc2, 0x9b, 0x2c, 0xe2, 0x14, 0xbb, 0x30, 0x17, 0x32, 0xf7, 0x88, 0x7a,
+	0x16, 0x5a, 0x9b, 0xae, 0xe4, 0xb6, 0x5f, 0xb7, 0x55, 0x5c, 0x76, 0x1a, 0xb7, 0xad, 0x83, 0xb2,
+	0xf7, 0x58, 0x10, 0x55, 0x6f, 0x3d, 0xfb, 0x7b, 0x75, 0xea, 0xe9, 0x8b, 0xd5, 0x8a, 0x1f, 0x88,
+	0xc3, 0xa4, 0x61, 0xbb, 0xec, 0xd8, 0xd1, 0x49, 0x50, 0x7f, 0x36, 0xb9, 0x77, 0xe4, 0x88, 0xd3,
+	0x26, 0xe5, 0xd2, 0x80, 0xd7, 0xb4, 0x6b, 0xec, 0x43, 0x36, 0x89, 0xd2, 0xc4, 0x50, 0xcf, 0xca,
+	0x4c, 0x1e, 0xa6, 0xed, 0x3c, 0x8d, 0x46, 0xc3, 0x4c, 0x5f, 0x41, 0x34, 0xca, 0x75, 0xf9, 0x31,
+	0xc0, 0xab, 0x5f, 0xa9, 0x2a, 0xef, 0xba, 0x2e, 0x4b, 0x22, 0x71, 0xcf, 0x3d, 0xa4, 0x5e, 0x12,
+	0xd2, 0xe1, 0x15, 0xc0, 0x6f, 0x43, 0xfe, 0x20, 0x89, 0x3c, 0x1a, 0xd7, 0x8d, 0x42, 0x46, 0x2a,
+	0x2c, 0xaa, 0xd3, 0x5d, 0xad, 0xb6, 0x07, 0xc0, 0x05, 0x89, 0x45, 0x3d, 0xad, 0xb9, 0x35, 0xbd,
+	0x86, 0x2a, 0xb9, 0xed, 0xa2, 0xad, 0x1a, 0xc2, 0x36, 0x0d, 0x61, 0x7f, 0x69, 0x1a, 0xa2, 0x9a,
+	0x4d, 0xa3, 0x78, 0xf2, 0x62, 0x15, 0xd5, 0x16, 0xa4, 0x5d, 0x2a, 0xc1, 0x1f, 0x42, 0x96, 0x46,
+	0x9e, 0x72, 0x31, 0x33, 0x86, 0x8b, 0x79, 0x1a, 0x79, 0xd2, 0x41, 0x0b, 0x0a, 0x2c, 0x0e, 0xd2,
+	0x0b, 0x11, 0xd6, 0x75, 0x3f, 0x5b, 0xb3, 0x93, 0x4f, 0xe8, 0x92, 0x01, 0xd1, 0xd9, 0xc4, 0xdf,
+	0x21, 0xc8, 0xa7, 0x2d, 0x93, 0x34, 0xeb, 0x4d, 0x1a, 0x07, 0xcc, 0xe3, 0xd6, 0x9c, 0x84, 0x2d,
+	0x19, 0xd8, 0xce, 0x65, 0x56, 0xc8, 0x9f, 0x4b, 0xb5, 0xea, 0xae, 0xc6, 0x7e, 0xff, 0xa5, 0xd8,
+	0x27, 0x0e, 0x49, 0xc4, 0x61, 0xfb, 0xc6, 0x2a, 0x2a, 0xca, 0x03, 0xaf, 0x2d, 0x2a, 0x60, 0xfd,
+	0x89, 0x7f, 0x40, 0xb0, 0xa4, 0x15, 0xdb, 0x5c, 0xe6, 0xff, 0x2f, 0x2e, 0x79, 0x7d, 0x6c, 0xc8,
+	0x9c, 0xc0, 0xb2, 0x47, 0x43, 0xea, 0x13, 0x41, 0xbd, 0x76, 0x41, 0xb2, 0x93, 0x2f, 0x48, 0xa1,
+	0x8d, 0x62, 0x2a, 0x12, 0x43, 0xbe, 0x83, 0x7c, 0x10, 0x53, 0x6a, 0x2d, 0x4c, 0x1e, 0x76, 0xb1,
+	0x0d, 0xb1, 0x1f, 0x53, 0x8a, 0xef, 0x42, 0x21, 0xa2, 0x27, 0xa2, 0x9e, 0x44, 0x69, 0x49, 0x54,
+	0x1b, 0xc3, 0xc8, 0x36, 0x9e, 0x91, 0x2d, 0x9c, 0x4f, 0x2d, 0xef, 0x4b, 0x43, 0xd9, 0xc9, 0x21,
+	0xe4, 0xba, 0x7c, 0x59, 0xb9, 0xc9, 0x93, 0x87, 0x0e, 0x22, 0xfe, 0x0c, 0x96, 0x25, 0x9a, 0x69,
+	0x1c, 0x49, 0xfd, 0xda, 0x25, 0xa9, 0x2f, 0xa5, 0xa6, 0x3a, 0xef, 0x92, 0x7b, 0x04, 0xd7, 0xba,
+	0xbd, 0x59, 0x8b, 0x93, 0x27, 0x9f, 0xeb, 0xc2, 0xc4, 0xef, 0xc2, 0xb2, 0x1b, 0x92, 0x6f, 0x1b,
+	0xc4, 0x3d, 0xaa, 0xd3, 0x30, 0xf0, 0x83, 0x46, 0x48, 0xad, 0xfc, 0x1a, 0xaa, 0x64, 0x6b, 0x05,
+	0x23, 0xf8, 0x48, 0x9f, 0x97, 0xef, 0x40, 0x51, 0x3e, 0x28, 0xbd, 0x83, 0x70, 0xf4, 0x4b, 0xe4,
+	0xc3, 0x1b, 0x03, 0xed, 0xf4, 0x73, 0xf4, 0x09, 0xcc, 0x13, 0x75, 0x24, 0x0d, 0x73, 0xdb, 0x15,
+	0xfb, 0xe2, 0x2b, 0x6e, 0x0f, 0x9e, 0xbd, 0xd5, 0x99, 0x34, 0xfa, 0x9a, 0x31, 0x2f, 0xff, 0x84,
+	0xe0, 0xad, 0x01, 0x48, 0xbc, 0x7a, 0xba, 0x2f, 0x27, 0xae, 0xa1, 0xda, 0x3f, 0x98, 0xd1, 0xa0,
+	0xc1, 0xbc, 0x0f, 0xd0, 0x59, 0x11, 0xe4, 0xec, 0xce, 0x6d, 0x6f, 0xf4, 0x94, 0x42, 0xad, 0x1e,
+	0xed, 0x61, 0x40, 0x7c, 0xaa, 0x21, 0x6a, 0x5d, 0x96, 0xe5, 0xdf, 0x10, 0xac, 0xbf, 0x9c, 0x96,
+	0xce, 0xc4, 0x5d, 0xc8, 0xea, 0x50, 0xb8, 0x7e, 0x9a, 0xc7, 0x4d, 0x45, 0xdb, 0x1e, 0x7f, 0x3c,
+	0x80, 0xfc, 0x8d, 0x91, 0xe4, 0x15, 0x91, 0x1e, 0xf6, 0x31, 0xac, 0x48, 0xf2, 0xf7, 0x82, 0xe3,
+	0x24, 0x24, 0x82, 0xee, 0xe9, 0xb6, 0x18, 0x59, 0x77, 0xfc, 0x1e, 0xcc, 0xc8, 0xdb, 0x90, 0x19,
+	0xe3, 0x3d, 0x92, 0x16, 0xe5, 0x5f, 0xa7, 0xe1, 0xcd, 0x21, 0xa0, 0x3a, 0x55, 0xc6, 0x37, 0x1a,
+	0xd7, 0x77, 0xd7, 0xbe, 0x90, 0xb9, 0xb2, 0x7d, 0x21, 0x9d, 0xde, 0x66, 0x41, 0xa9, 0xc7, 0x54,
+	0x24, 0x71, 0x74, 0x35, 0xfb, 0x49, 0xc1, 0xa0, 0xd4, 0x34, 0x08, 0x16, 0xb0, 0xc4, 0x05, 0x39,
+	0xa2, 0x5e, 0xdd, 0x88, 0xac, 0x99, 0xc9, 0xe3, 0xe6, 0x15, 0xc6, 0x7d, 0x0d, 0xb1, 0xfd, 0xcb,
+	0x2c, 0xcc, 0xca, 0x82, 0xe1, 0xef, 0x11, 0x64, 0xcd, 0xc6, 0x89, 0x37, 0xfa, 0xdb, 0x77, 0xd0,
+	0x16, 0x5b, 0xbc, 0x31, 0x52, 0x4f, 0x95, 0xbd, 0x7c, 0xf3, 0xf1, 0x9f, 0xff, 0xfe, 0x98, 0xd9,
+	0xc0, 0xeb, 0x4e, 0xdf, 0xbf, 0x01, 0x0d, 0xad, 0xeb, 0x3c, 0xd4, 0xfd, 0xf7, 0x08, 0xff, 0x8c,
+	0x20, 0xdf, 0x7b, 0x5d, 0xf0, 0xcd, 0x21, 0x48, 0x03, 0x67, 0x5a, 0x71, 0xf3, 0x92, 0xda, 0xa3,
+	0xd9, 0x99, 0x7b, 0xd9, 0xc5, 0xee, 0x77, 0x04, 0xaf, 0x0d, 0x99, 0x08, 0x78, 0xe7, 0x52, 0xc0,
+	0x17, 0x07, 0x5b, 0xf1, 0xce, 0xb8, 0x66, 0x9a, 0xf8, 0x07, 0x92, 0xf8, 0x0e, 0xbe, 0xdd, 0x4f,
+	0x5c, 0x8d, 0x44, 0xee, 0x3c, 0xec, 0x9d, 0x98, 0x8f, 0xda, 0x11, 0xe1, 0xa7, 0x08, 0x0a, 0x17,
+	0xef, 0x29, 0xb6, 0x87, 0x30, 0x19, 0x32, 0x45, 0x8a, 0xce, 0xa5, 0xf5, 0x35, 0xe5, 0x1d, 0x49,
+	0xd9, 0xc1, 0x9b, 0xfd, 0x94, 0xb9, 0xb6, 0xa9, 0x9b, 0x17, 0xac, 0x93, 0xf4, 0xea, 0xa7, 0xcf,
+	0xce, 0x4a, 0xe8, 0xf9, 0x59, 0x09, 0xfd, 0x73, 0x56, 0x42, 0x4f, 0xce, 0x4b, 0x53, 0xcf, 0xcf,
+	0x4b, 0x53, 0x7f, 0x9d, 0x97, 0xa6, 0xbe, 0x76, 0xba, 0x9a, 0xff, 0x90, 0x8a, 0x64, 0xb3, 0x19,
+	0xb3, 0x6f, 0xa8, 0x2b, 0xe4, 0x47, 0xea, 0xf6, 0xa4, 0x77, 0x73, 0x6b, 0xcc, 0xc9, 0x61, 0x73,
+	0xfb, 0xbf, 0x01, 0x00, 0x70, 0x17, 0xd8, 0x02, 0x92, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Balances retrieves the unvested, vested and locked tokens for a vesting account
+	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
+	// VestingAccount retrieves the funder and the lockup and vesting schedules of
+	// a clawback vesting account
+	VestingAccount(ctx context.Context, in *QueryVestingAccountRequest, opts ...grpc.CallOption) (*QueryVestingAccountResponse, error)
+	// VestingAccountsByFunder retrieves the clawback vesting accounts funded by an
+	// address
+	VestingAccountsByFunder(ctx context.Context, in *QueryVestingAccountsByFunderRequest, opts ...grpc.CallOption) (*QueryVestingAccountsByFunderResponse, error)
+	// SimulateClawback retrieves the tokens a clawback of a clawback vesting
+	// account would leave vested and return to the funder at a given time
+	SimulateClawback(ctx context.Context, in *QuerySimulateClawbackRequest, opts ...grpc.CallOption) (*QuerySimulateClawbackResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error) {
+	out := new(QueryBalancesResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v1.Query/Balances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingAccount(ctx context.Context, in *QueryVestingAccountRequest, opts ...grpc.CallOption) (*QueryVestingAccountResponse, error) {
+	out := new(QueryVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v1.Query/VestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingAccountsByFunder(ctx context.Context, in *QueryVestingAccountsByFunderRequest, opts ...grpc.CallOption) (*QueryVestingAccountsByFunderResponse, error) {
+	out := new(QueryVestingAccountsByFunderResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v1.Query/VestingAccountsByFunder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateClawback(ctx context.Context, in *QuerySimulateClawbackRequest, opts ...grpc.CallOption) (*QuerySimulateClawbackResponse, error) {
+	out := new(QuerySimulateClawbackResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v1.Query/SimulateClawback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Balances retrieves the unvested, vested and locked tokens for a vesting account
+	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+	// VestingAccount retrieves the funder and the lockup and vesting schedules of
+	// a clawback vesting account
+	VestingAccount(context.Context, *QueryVestingAccountRequest) (*QueryVestingAccountResponse, error)
+	// VestingAccountsByFunder retrieves the clawback vesting accounts funded by an
+	// address
+	VestingAccountsByFunder(context.Context, *QueryVestingAccountsByFunderRequest) (*QueryVestingAccountsByFunderResponse, error)
+	// SimulateClawback retrieves the tokens a clawback of a clawback vesting
+	// account would leave vested and return to the funder at a given time
+	SimulateClawback(context.Context, *QuerySimulateClawbackRequest) (*QuerySimulateClawbackResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Balances(ctx context.Context, req *QueryBalancesRequest) (*QueryBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
+}
+func (*UnimplementedQueryServer) VestingAccount(ctx context.Context, req *QueryVestingAccountRequest) (*QueryVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingAccount not implemented")
+}
+func (*UnimplementedQueryServer) VestingAccountsByFunder(ctx context.Context, req *QueryVestingAccountsByFunderRequest) (*QueryVestingAccountsByFunderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingAccountsByFunder not implemented")
+}
+func (*UnimplementedQueryServer) SimulateClawback(ctx context.Context, req *QuerySimulateClawbackRequest) (*QuerySimulateClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateClawback not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Balances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v1.Query/Balances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Balances(ctx, req.(*QueryBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v1.Query/VestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingAccount(ctx, req.(*QueryVestingAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingAccountsByFunder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingAccountsByFunderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingAccountsByFunder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v1.Query/VestingAccountsByFunder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingAccountsByFunder(ctx, req.(*QueryVestingAccountsByFunderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateClawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateClawbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateClawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v1.Query/SimulateClawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateClawback(ctx, req.(*QuerySimulateClawbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evmos.vesting.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Balances",
+			Handler:    _Query_Balances_Handler,
+		},
+		{
+			MethodName: "VestingAccount",
+			Handler:    _Query_VestingAccount_Handler,
+		},
+		{
+			MethodName: "VestingAccountsByFunder",
+			Handler:    _Query_VestingAccountsByFunder_Handler,
+		},
+		{
+			MethodName: "SimulateClawback",
+			Handler:    _Query_SimulateClawback_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evmos/vesting/v1/query.proto",
+}
+
+func (m *QueryBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingAccountSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingAccountSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingAccountSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClawbackEligible {
+		i--
+		if m.ClawbackEligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.NextVesting) > 0 {
+		for iNdEx := len(m.NextVesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NextVesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.NextVestingTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NextVestingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextVestingTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintQuery(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.NextUnlock) > 0 {
+		for iNdEx := len(m.NextUnlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NextUnlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.NextUnlockTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NextUnlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextUnlockTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.DelegatedFree) > 0 {
+		for iNdEx := len(m.DelegatedFree) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedFree[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DelegatedVesting) > 0 {
+		for iNdEx := len(m.DelegatedVesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedVesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.OriginalVesting) > 0 {
+		for iNdEx := len(m.OriginalVesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OriginalVesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingAccountsByFunderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingAccountsByFunderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingAccountsByFunderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingAccountsByFunderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingAccountsByFunderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingAccountsByFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateClawbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateClawbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateClawbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakedUnvested) > 0 {
+		for iNdEx := len(m.StakedUnvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakedUnvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UnvestedReturned) > 0 {
+		for iNdEx := len(m.UnvestedReturned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnvestedReturned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VestingAccountSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.OriginalVesting) > 0 {
+		for _, e := range m.OriginalVesting {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DelegatedVesting) > 0 {
+		for _, e := range m.DelegatedVesting {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DelegatedFree) > 0 {
+		for _, e := range m.DelegatedFree {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextUnlockTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextUnlockTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.NextUnlock) > 0 {
+		for _, e := range m.NextUnlock {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextVestingTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextVestingTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.NextVesting) > 0 {
+		for _, e := range m.NextVesting {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ClawbackEligible {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryVestingAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Account.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVestingAccountsByFunderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingAccountsByFunderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateClawbackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulateClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnvestedReturned) > 0 {
+		for _, e := range m.UnvestedReturned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.StakedUnvested) > 0 {
+		for _, e := range m.StakedUnvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, types.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingAccountSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingAccountSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingAccountSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalVesting = append(m.OriginalVesting, types.Coin{})
+			if err := m.OriginalVesting[len(m.OriginalVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types1.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types1.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedVesting = append(m.DelegatedVesting, types.Coin{})
+			if err := m.DelegatedVesting[len(m.DelegatedVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedFree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedFree = append(m.DelegatedFree, types.Coin{})
+			if err := m.DelegatedFree[len(m.DelegatedFree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextUnlockTime == nil {
+				m.NextUnlockTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextUnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUnlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextUnlock = append(m.NextUnlock, types.Coin{})
+			if err := m.NextUnlock[len(m.NextUnlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVestingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextVestingTime == nil {
+				m.NextVestingTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextVestingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextVesting = append(m.NextVesting, types.Coin{})
+			if err := m.NextVesting[len(m.NextVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackEligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawbackEligible = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingAccountsByFunderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingAccountsByFunderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingAccountsByFunderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Locked) > 0 {
-		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			i--
-			dAtA[i] = 0xa
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Locked) > 0 {
-		for _, e := range m.Locked {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryVestingAccountsByFunderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.Unvested) > 0 {
-		for _, e := range m.Unvested {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingAccountsByFunderResponse: wiretype end group for non-group")
 		}
-	}
-	if len(m.Vested) > 0 {
-		for _, e := range m.Vested {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingAccountsByFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, VestingAccountSchedule{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateClawbackRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -435,10 +2890,10 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateClawbackRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateClawbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -473,6 +2928,39 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -494,7 +2982,7 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateClawbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -517,15 +3005,15 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateClawbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -552,14 +3040,13 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locked = append(m.Locked, types.Coin{})
-			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -586,14 +3073,14 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unvested = append(m.Unvested, types.Coin{})
-			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vested = append(m.Vested, types.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnvestedReturned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -620,8 +3107,42 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vested = append(m.Vested, types.Coin{})
-			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnvestedReturned = append(m.UnvestedReturned, types.Coin{})
+			if err := m.UnvestedReturned[len(m.UnvestedReturned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedUnvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakedUnvested = append(m.StakedUnvested, types.Coin{})
+			if err := m.StakedUnvested[len(m.StakedUnvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -94,6 +94,41 @@ func ReadPastPeriodCount(
 	return passedPeriods
 }
 
+// ReadNextEvent returns the time and the amount of the first event of a
+// schedule that ReadSchedule does not count at readTime. Simultaneous events
+// are combined. It returns false if ReadSchedule already counts all events.
+func ReadNextEvent(
+	startTime, endTime int64,
+	periods sdkvesting.Periods,
+	readTime int64,
+) (int64, sdk.Coins, bool) {
+	// all the events are counted when the read time is equal or after
+	// end time
+	if readTime >= endTime {
+		return 0, sdk.NewCoins(), false
+	}
+
+	elapsedTime := startTime
+	for i, period := range periods {
+		elapsedTime += period.Length
+		// events at start time are only counted after the start time
+		if readTime >= elapsedTime && readTime > startTime {
+			continue
+		}
+
+		coins := period.Amount
+		for _, next := range periods[i+1:] {
+			if next.Length != 0 {
+				break
+			}
+			coins = coins.Add(next.Amount...)
+		}
+		return Max64(elapsedTime, startTime+1), coins, true
+	}
+
+	return 0, sdk.NewCoins(), false
+}
+
 // DisjunctPeriods returns the union of two vesting period schedules. The
 // returned schedule is the union of the vesting events, with simultaneous
 // events combined into a single event. Input schedules P and Q are defined by
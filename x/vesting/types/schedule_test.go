@@ -206,6 +206,92 @@ func (suite *ScheduleTestSuite) TestReadPastPeriodCount() {
 	}
 }
 
+func (suite *ScheduleTestSuite) TestReadNextEvent() {
+	testCases := []struct {
+		name      string
+		startTime int64
+		endTime   int64
+		readTime  int64
+		periods   sdkvesting.Periods
+		expFound  bool
+		expTime   int64
+		expAmount sdk.Coins
+	}{
+		{
+			name:      "empty",
+			startTime: 0,
+			endTime:   0,
+			readTime:  0,
+			periods:   sdkvesting.Periods{},
+			expFound:  false,
+		},
+		{
+			name:      "before start time",
+			startTime: 100,
+			endTime:   200,
+			readTime:  0,
+			periods:   sdkvesting.Periods{period(25, 10), period(50, 20), period(25, 40)},
+			expFound:  true,
+			expTime:   125,
+			expAmount: period(0, 10).Amount,
+		},
+		{
+			name:      "at start time, event at start time",
+			startTime: 100,
+			endTime:   200,
+			readTime:  100,
+			periods:   sdkvesting.Periods{period(0, 10), period(100, 20)},
+			expFound:  true,
+			expTime:   101,
+			expAmount: period(0, 10).Amount,
+		},
+		{
+			name:      "at end of first period",
+			startTime: 100,
+			endTime:   200,
+			readTime:  125,
+			periods:   sdkvesting.Periods{period(25, 10), period(50, 20), period(25, 40)},
+			expFound:  true,
+			expTime:   175,
+			expAmount: period(0, 20).Amount,
+		},
+		{
+			name:      "simultaneous events combined",
+			startTime: 100,
+			endTime:   200,
+			readTime:  130,
+			periods:   sdkvesting.Periods{period(25, 10), period(50, 20), period(0, 5), period(25, 40)},
+			expFound:  true,
+			expTime:   175,
+			expAmount: period(0, 25).Amount,
+		},
+		{
+			name:      "at end time, all periods passed",
+			startTime: 100,
+			endTime:   200,
+			readTime:  200,
+			periods:   sdkvesting.Periods{period(25, 10), period(50, 20), period(25, 40)},
+			expFound:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			eventTime, amount, found := ReadNextEvent(tc.startTime, tc.endTime, tc.periods, tc.readTime)
+			suite.Require().Equal(tc.expFound, found)
+			if !tc.expFound {
+				return
+			}
+			suite.Require().Equal(tc.expTime, eventTime)
+			suite.Require().Equal(tc.expAmount, amount)
+			// ReadSchedule counts the event at its time only
+			suite.Require().True(ReadSchedule(tc.startTime, tc.endTime, tc.periods, sdk.Coins{}, eventTime).IsAllGT(
+				ReadSchedule(tc.startTime, tc.endTime, tc.periods, sdk.Coins{}, eventTime-1),
+			))
+		})
+	}
+}
+
 func (suite *ScheduleTestSuite) TestDisjunctPeriods() {
 	testCases := []struct {
 		name         string
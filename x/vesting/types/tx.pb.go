@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	github_com_cosmos_cosmos_sdk_x_auth_vesting_types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -464,6 +465,205 @@ func (m *MsgConvertVestingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertVestingAccountResponse proto.InternalMessageInfo
 
+type MsgAllocateToSubnet struct {
+	// vesting_address is the address of the ClawbackVestingAccount allocating
+	// its locked tokens
+	VestingAddress string `protobuf:"bytes,1,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// netuid is the subnet the tokens are allocated to
+	Netuid uint32 `protobuf:"varint,2,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	// amount is the amount of locked tokens allocated, in the bond denom
+	Amount types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgAllocateToSubnet) Reset()         { *m = MsgAllocateToSubnet{} }
+func (m *MsgAllocateToSubnet) String() string { return proto.CompactTextString(m) }
+func (*MsgAllocateToSubnet) ProtoMessage()    {}
+func (*MsgAllocateToSubnet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5db113bc0c7240c, []int{8}
+}
+func (m *MsgAllocateToSubnet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAllocateToSubnet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAllocateToSubnet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAllocateToSubnet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAllocateToSubnet.Merge(m, src)
+}
+func (m *MsgAllocateToSubnet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAllocateToSubnet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAllocateToSubnet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAllocateToSubnet proto.InternalMessageInfo
+
+func (m *MsgAllocateToSubnet) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *MsgAllocateToSubnet) GetNetuid() uint32 {
+	if m != nil {
+		return m.Netuid
+	}
+	return 0
+}
+
+func (m *MsgAllocateToSubnet) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
+type MsgAllocateToSubnetResponse struct {
+}
+
+func (m *MsgAllocateToSubnetResponse) Reset()         { *m = MsgAllocateToSubnetResponse{} }
+func (m *MsgAllocateToSubnetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAllocateToSubnetResponse) ProtoMessage()    {}
+func (*MsgAllocateToSubnetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5db113bc0c7240c, []int{9}
+}
+func (m *MsgAllocateToSubnetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAllocateToSubnetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAllocateToSubnetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAllocateToSubnetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAllocateToSubnetResponse.Merge(m, src)
+}
+func (m *MsgAllocateToSubnetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAllocateToSubnetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAllocateToSubnetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAllocateToSubnetResponse proto.InternalMessageInfo
+
+type MsgDeallocateFromSubnet struct {
+	// vesting_address is the address of the account the tokens were allocated by
+	VestingAddress string `protobuf:"bytes,1,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// netuid is the subnet the tokens are deallocated from
+	Netuid uint32 `protobuf:"varint,2,opt,name=netuid,proto3" json:"netuid,omitempty"`
+	// amount is the amount of allocated tokens returned to the account
+	Amount types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgDeallocateFromSubnet) Reset()         { *m = MsgDeallocateFromSubnet{} }
+func (m *MsgDeallocateFromSubnet) String() string { return proto.CompactTextString(m) }
+func (*MsgDeallocateFromSubnet) ProtoMessage()    {}
+func (*MsgDeallocateFromSubnet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5db113bc0c7240c, []int{10}
+}
+func (m *MsgDeallocateFromSubnet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeallocateFromSubnet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeallocateFromSubnet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeallocateFromSubnet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeallocateFromSubnet.Merge(m, src)
+}
+func (m *MsgDeallocateFromSubnet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeallocateFromSubnet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeallocateFromSubnet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeallocateFromSubnet proto.InternalMessageInfo
+
+func (m *MsgDeallocateFromSubnet) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *MsgDeallocateFromSubnet) GetNetuid() uint32 {
+	if m != nil {
+		return m.Netuid
+	}
+	return 0
+}
+
+func (m *MsgDeallocateFromSubnet) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
+type MsgDeallocateFromSubnetResponse struct {
+}
+
+func (m *MsgDeallocateFromSubnetResponse) Reset()         { *m = MsgDeallocateFromSubnetResponse{} }
+func (m *MsgDeallocateFromSubnetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeallocateFromSubnetResponse) ProtoMessage()    {}
+func (*MsgDeallocateFromSubnetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5db113bc0c7240c, []int{11}
+}
+func (m *MsgDeallocateFromSubnetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeallocateFromSubnetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeallocateFromSubnetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeallocateFromSubnetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeallocateFromSubnetResponse.Merge(m, src)
+}
+func (m *MsgDeallocateFromSubnetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeallocateFromSubnetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeallocateFromSubnetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeallocateFromSubnetResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateClawbackVestingAccount)(nil), "evmos.vesting.v1.MsgCreateClawbackVestingAccount")
 	proto.RegisterType((*MsgCreateClawbackVestingAccountResponse)(nil), "evmos.vesting.v1.MsgCreateClawbackVestingAccountResponse")
@@ -473,60 +673,75 @@ func init() {
 	proto.RegisterType((*MsgUpdateVestingFunderResponse)(nil), "evmos.vesting.v1.MsgUpdateVestingFunderResponse")
 	proto.RegisterType((*MsgConvertVestingAccount)(nil), "evmos.vesting.v1.MsgConvertVestingAccount")
 	proto.RegisterType((*MsgConvertVestingAccountResponse)(nil), "evmos.vesting.v1.MsgConvertVestingAccountResponse")
+	proto.RegisterType((*MsgAllocateToSubnet)(nil), "evmos.vesting.v1.MsgAllocateToSubnet")
+	proto.RegisterType((*MsgAllocateToSubnetResponse)(nil), "evmos.vesting.v1.MsgAllocateToSubnetResponse")
+	proto.RegisterType((*MsgDeallocateFromSubnet)(nil), "evmos.vesting.v1.MsgDeallocateFromSubnet")
+	proto.RegisterType((*MsgDeallocateFromSubnetResponse)(nil), "evmos.vesting.v1.MsgDeallocateFromSubnetResponse")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v1/tx.proto", fileDescriptor_d5db113bc0c7240c) }
 
 var fileDescriptor_d5db113bc0c7240c = []byte{
-	// 769 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcf, 0x4b, 0x1b, 0x4d,
-	0x18, 0xc7, 0x33, 0xe6, 0x8d, 0xe8, 0x44, 0xa3, 0xac, 0xfa, 0xbe, 0x79, 0x17, 0xdd, 0xc4, 0x50,
-	0x31, 0x0d, 0xba, 0xdb, 0x44, 0x29, 0x28, 0xbd, 0xa8, 0xe0, 0x2d, 0x50, 0x42, 0x5b, 0x68, 0x2f,
-	0x61, 0xb3, 0x19, 0x37, 0xa9, 0xee, 0xce, 0xb2, 0x33, 0x9b, 0xd8, 0x5b, 0xf1, 0x54, 0x7a, 0x12,
-	0x4a, 0xef, 0xbd, 0xf4, 0x62, 0x29, 0xf8, 0x67, 0x48, 0x4f, 0x42, 0x2f, 0x3d, 0xd5, 0xa2, 0x05,
-	0x7b, 0xe9, 0xff, 0x50, 0x76, 0x66, 0x76, 0xd5, 0x65, 0xaa, 0xf6, 0xd2, 0x93, 0x3b, 0xcf, 0xf3,
-	0x9d, 0xe7, 0xf9, 0x3c, 0x3f, 0x9c, 0xc0, 0xff, 0x51, 0xcf, 0xc1, 0xc4, 0xe8, 0x21, 0x42, 0xbb,
-	0xae, 0x6d, 0xf4, 0xaa, 0x06, 0xdd, 0xd5, 0x3d, 0x1f, 0x53, 0xac, 0x8c, 0x33, 0x97, 0x2e, 0x5c,
-	0x7a, 0xaf, 0xaa, 0xfe, 0x67, 0x61, 0x12, 0xaa, 0x1d, 0xc2, 0x94, 0x0e, 0xb1, 0xb9, 0x54, 0xbd,
-	0x23, 0x1c, 0x17, 0x61, 0x5a, 0x88, 0x9a, 0xd5, 0xe8, 0x2c, 0x54, 0x93, 0x36, 0xb6, 0x31, 0xfb,
-	0x34, 0xc2, 0x2f, 0x61, 0x9d, 0xb6, 0x31, 0xb6, 0x77, 0x90, 0x61, 0x7a, 0x5d, 0xc3, 0x74, 0x5d,
-	0x4c, 0x4d, 0xda, 0xc5, 0x2e, 0x11, 0xde, 0x82, 0xf0, 0xb2, 0x53, 0x2b, 0xd8, 0x32, 0x68, 0xd7,
-	0x41, 0x84, 0x9a, 0x8e, 0xc7, 0x05, 0xa5, 0x9f, 0x69, 0x58, 0xa8, 0x13, 0x7b, 0xc3, 0x47, 0x26,
-	0x45, 0x1b, 0x3b, 0x66, 0xbf, 0x65, 0x5a, 0xdb, 0x4f, 0x78, 0xde, 0x35, 0xcb, 0xc2, 0x81, 0x4b,
-	0x95, 0x59, 0x38, 0xb2, 0xe5, 0x63, 0xa7, 0x69, 0xb6, 0xdb, 0x3e, 0x22, 0x24, 0x0f, 0x8a, 0xa0,
-	0x3c, 0xdc, 0xc8, 0x86, 0xb6, 0x35, 0x6e, 0x52, 0x66, 0x20, 0xa4, 0x38, 0x16, 0x0c, 0x30, 0xc1,
-	0x30, 0xc5, 0x91, 0x7b, 0x03, 0x42, 0x42, 0x4d, 0x9f, 0x36, 0xc3, 0xf4, 0xf9, 0x74, 0x11, 0x94,
-	0xb3, 0x35, 0x55, 0xe7, 0x6c, 0x7a, 0xc4, 0xa6, 0x3f, 0x8a, 0xd8, 0xd6, 0x87, 0x8e, 0xbe, 0x16,
-	0x52, 0xfb, 0x27, 0x05, 0xd0, 0x18, 0x66, 0xf7, 0x42, 0x8f, 0xf2, 0x0a, 0xc0, 0xdc, 0x0e, 0xb6,
-	0xb6, 0x03, 0xaf, 0xe9, 0x21, 0xbf, 0x8b, 0xdb, 0x24, 0xff, 0x4f, 0x31, 0x5d, 0xce, 0xd6, 0x34,
-	0x9d, 0xf7, 0xef, 0x52, 0xaf, 0x59, 0xff, 0xf4, 0x87, 0x4c, 0xb6, 0xbe, 0x16, 0x46, 0x3b, 0x38,
-	0x29, 0xac, 0xd8, 0x5d, 0xda, 0x09, 0x5a, 0xba, 0x85, 0x1d, 0x43, 0x74, 0x9c, 0xff, 0x59, 0x24,
-	0xed, 0x6d, 0x63, 0xd7, 0x30, 0x03, 0xda, 0x89, 0x67, 0x40, 0x5f, 0x78, 0x88, 0x88, 0x08, 0xa4,
-	0x31, 0xca, 0x13, 0x8b, 0xa3, 0xf2, 0x1a, 0xc0, 0x31, 0x21, 0x8c, 0x59, 0x32, 0x7f, 0x8b, 0x25,
-	0x27, 0xcc, 0x11, 0xcc, 0x24, 0xcc, 0x38, 0xc8, 0xb7, 0x51, 0x7e, 0xb0, 0x08, 0xca, 0x43, 0x0d,
-	0x7e, 0x58, 0x9d, 0xfa, 0xf1, 0xae, 0x90, 0xda, 0x3b, 0x3f, 0xac, 0x5c, 0x99, 0x5d, 0xe9, 0x2e,
-	0x9c, 0xbf, 0x61, 0xdc, 0x0d, 0x44, 0x3c, 0xec, 0x12, 0x54, 0x7a, 0x0b, 0x60, 0x36, 0xd4, 0x0a,
-	0x95, 0x32, 0x07, 0x73, 0x5b, 0x81, 0xdb, 0x46, 0x7e, 0x62, 0x11, 0x46, 0xb9, 0x35, 0x9a, 0xf5,
-	0x3c, 0x1c, 0x33, 0x79, 0xa4, 0xc4, 0x3e, 0xe4, 0x84, 0x39, 0x12, 0xce, 0xc2, 0x91, 0x36, 0x22,
-	0x17, 0xaa, 0x34, 0x5f, 0xab, 0xd0, 0x26, 0x24, 0xab, 0x13, 0x61, 0x01, 0x89, 0xac, 0xa5, 0x29,
-	0x38, 0x71, 0x09, 0x2b, 0xc6, 0xfd, 0x00, 0xe0, 0xbf, 0x75, 0x62, 0x3f, 0xf6, 0xda, 0x26, 0x45,
-	0xa2, 0xa4, 0x4d, 0x76, 0xf3, 0xb6, 0xe4, 0x0b, 0x50, 0x71, 0x51, 0xbf, 0x99, 0x90, 0x72, 0xf8,
-	0x71, 0x17, 0xf5, 0x37, 0x93, 0x75, 0x46, 0x2b, 0x70, 0xb5, 0x82, 0x68, 0x3e, 0xd7, 0x16, 0x51,
-	0x84, 0x9a, 0x1c, 0x36, 0xae, 0xe7, 0x29, 0xcc, 0x87, 0x65, 0x62, 0xb7, 0x87, 0x7c, 0x9a, 0xf8,
-	0x8f, 0x94, 0xe4, 0x06, 0xd2, 0xdc, 0x93, 0x61, 0xee, 0xa4, 0xb6, 0x54, 0x82, 0xc5, 0xdf, 0x85,
-	0x8e, 0xd2, 0xd7, 0x0e, 0x32, 0x30, 0x5d, 0x27, 0xb6, 0xf2, 0x09, 0xc0, 0xe9, 0x6b, 0x5f, 0x87,
-	0xaa, 0x9e, 0x7c, 0xe8, 0xf4, 0x1b, 0x36, 0x4c, 0x5d, 0xf9, 0xe3, 0x2b, 0x71, 0x57, 0x1e, 0xec,
-	0x7d, 0xfe, 0xfe, 0x66, 0xe0, 0xbe, 0xb2, 0x6c, 0x48, 0x5e, 0x5e, 0xc3, 0x62, 0x21, 0x9a, 0x96,
-	0x88, 0xd1, 0x8c, 0x0b, 0x17, 0xac, 0x7d, 0x38, 0x14, 0xaf, 0xf3, 0x8c, 0x1c, 0x42, 0xb8, 0xd5,
-	0xb9, 0x6b, 0xdd, 0x31, 0xcf, 0x1c, 0xe3, 0x29, 0x28, 0x33, 0x72, 0x9e, 0x28, 0xd9, 0x7b, 0x00,
-	0x27, 0x64, 0x9b, 0x59, 0x96, 0x66, 0x91, 0x28, 0xd5, 0x7b, 0xb7, 0x55, 0xc6, 0x68, 0x35, 0x86,
-	0xb6, 0xa0, 0x54, 0xa4, 0x68, 0x01, 0xbb, 0x19, 0x77, 0x88, 0xaf, 0xa7, 0xf2, 0x11, 0xc0, 0x29,
-	0xf9, 0xca, 0x55, 0xe4, 0xfd, 0x90, 0x69, 0xd5, 0xda, 0xed, 0xb5, 0x31, 0xed, 0x32, 0xa3, 0xd5,
-	0x95, 0x05, 0x79, 0x23, 0xf9, 0xdd, 0xe4, 0x40, 0xd5, 0xcc, 0xcb, 0xf3, 0xc3, 0x0a, 0x58, 0xaf,
-	0x1f, 0x9d, 0x6a, 0xe0, 0xf8, 0x54, 0x03, 0xdf, 0x4e, 0x35, 0xb0, 0x7f, 0xa6, 0xa5, 0x8e, 0xcf,
-	0xb4, 0xd4, 0x97, 0x33, 0x2d, 0xf5, 0x6c, 0xe9, 0xd2, 0x3b, 0xdb, 0x41, 0x34, 0x58, 0xf4, 0x7c,
-	0xfc, 0x1c, 0x59, 0x94, 0x1f, 0x3a, 0x41, 0x2b, 0x4c, 0xb0, 0x7b, 0xf5, 0xa5, 0x6d, 0x0d, 0xb2,
-	0x9f, 0xa4, 0xa5, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbe, 0xb5, 0x47, 0xbb, 0xde, 0x07, 0x00,
+	// 945 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0x33, 0xdd, 0x26, 0x24, 0x93, 0x26, 0x8d, 0xa6, 0x49, 0xbb, 0x35, 0x8d, 0x77, 0xb3,
+	0x22, 0xca, 0x26, 0x24, 0x36, 0xbb, 0xad, 0x40, 0xad, 0xb8, 0x24, 0x41, 0x91, 0x38, 0x44, 0x42,
+	0x4b, 0x41, 0x82, 0x8b, 0x35, 0x6b, 0x4f, 0x1c, 0x93, 0xb5, 0xc7, 0xf2, 0x8c, 0x37, 0xe1, 0x86,
+	0x7a, 0x42, 0x9c, 0x2a, 0x21, 0x0e, 0xdc, 0xb8, 0x70, 0x28, 0x08, 0xa9, 0x1f, 0xa3, 0xe2, 0x54,
+	0x89, 0x0b, 0x27, 0x8a, 0x12, 0xa4, 0x72, 0xe1, 0xc2, 0x27, 0x40, 0x9e, 0x19, 0x4f, 0x13, 0x77,
+	0x92, 0x2e, 0x17, 0xc4, 0x69, 0xd7, 0xf3, 0xfe, 0xf3, 0xde, 0xef, 0xbd, 0x37, 0xf3, 0x6c, 0x78,
+	0x93, 0x0c, 0x63, 0xca, 0xdc, 0x21, 0x61, 0x3c, 0x4a, 0x42, 0x77, 0xd8, 0x71, 0xf9, 0x91, 0x93,
+	0x66, 0x94, 0x53, 0x34, 0x27, 0x4c, 0x8e, 0x32, 0x39, 0xc3, 0x8e, 0x65, 0xfb, 0x94, 0x15, 0xea,
+	0x3e, 0x66, 0xc4, 0x1d, 0x76, 0xfa, 0x84, 0xe3, 0x8e, 0xeb, 0xd3, 0x28, 0x91, 0x3b, 0xac, 0x1b,
+	0xca, 0x1e, 0x33, 0xe1, 0x29, 0x66, 0xa1, 0x32, 0xbc, 0xa1, 0x0c, 0x2f, 0xc2, 0xc8, 0xbd, 0xa5,
+	0x6f, 0xa9, 0x9a, 0x0f, 0x69, 0x48, 0xc5, 0x5f, 0xb7, 0xf8, 0xa7, 0x56, 0x6f, 0x85, 0x94, 0x86,
+	0x03, 0xe2, 0xe2, 0x34, 0x72, 0x71, 0x92, 0x50, 0x8e, 0x79, 0x44, 0x13, 0xa6, 0xac, 0x0d, 0x65,
+	0x15, 0x4f, 0xfd, 0x7c, 0xcf, 0xe5, 0x51, 0x4c, 0x18, 0xc7, 0x71, 0x2a, 0x05, 0xad, 0xbf, 0x6a,
+	0xb0, 0xb1, 0xcb, 0xc2, 0xed, 0x8c, 0x60, 0x4e, 0xb6, 0x07, 0xf8, 0xb0, 0x8f, 0xfd, 0x83, 0x8f,
+	0x65, 0xdc, 0x4d, 0xdf, 0xa7, 0x79, 0xc2, 0xd1, 0x12, 0xbc, 0xb2, 0x97, 0xd1, 0xd8, 0xc3, 0x41,
+	0x90, 0x11, 0xc6, 0xea, 0xa0, 0x09, 0xda, 0x53, 0xbd, 0xe9, 0x62, 0x6d, 0x53, 0x2e, 0xa1, 0x45,
+	0x08, 0x39, 0xd5, 0x82, 0x4b, 0x42, 0x30, 0xc5, 0x69, 0x69, 0xde, 0x86, 0x90, 0x71, 0x9c, 0x71,
+	0xaf, 0x08, 0x5f, 0xaf, 0x35, 0x41, 0x7b, 0xba, 0x6b, 0x39, 0x92, 0xcd, 0x29, 0xd9, 0x9c, 0xfb,
+	0x25, 0xdb, 0xd6, 0xe4, 0x93, 0xdf, 0x1a, 0x63, 0x0f, 0x9f, 0x35, 0x40, 0x6f, 0x4a, 0xec, 0x2b,
+	0x2c, 0xe8, 0x4b, 0x00, 0x67, 0x07, 0xd4, 0x3f, 0xc8, 0x53, 0x2f, 0x25, 0x59, 0x44, 0x03, 0x56,
+	0xbf, 0xdc, 0xac, 0xb5, 0xa7, 0xbb, 0xb6, 0x23, 0xeb, 0x77, 0xaa, 0x17, 0xa2, 0x7e, 0xce, 0x07,
+	0x42, 0xb6, 0xb5, 0x59, 0x78, 0xfb, 0xe1, 0x59, 0xe3, 0x6e, 0x18, 0xf1, 0xfd, 0xbc, 0xef, 0xf8,
+	0x34, 0x76, 0x55, 0xc5, 0xe5, 0xcf, 0x06, 0x0b, 0x0e, 0xdc, 0x23, 0x17, 0xe7, 0x7c, 0x5f, 0xf7,
+	0x80, 0x7f, 0x9e, 0x12, 0xa6, 0x3c, 0xb0, 0xde, 0x8c, 0x0c, 0xac, 0x1e, 0xd1, 0x57, 0x00, 0x5e,
+	0x55, 0x42, 0xcd, 0x32, 0xfe, 0x5f, 0xb1, 0xcc, 0xaa, 0xe5, 0x12, 0x66, 0x1e, 0x8e, 0xc7, 0x24,
+	0x0b, 0x49, 0x7d, 0xa2, 0x09, 0xda, 0x93, 0x3d, 0xf9, 0x70, 0x6f, 0xe1, 0xcf, 0xef, 0x1a, 0x63,
+	0x0f, 0x9e, 0x3f, 0x5e, 0x3b, 0xd3, 0xbb, 0xd6, 0x2a, 0x5c, 0x79, 0x45, 0xbb, 0x7b, 0x84, 0xa5,
+	0x34, 0x61, 0xa4, 0xf5, 0x0d, 0x80, 0xd3, 0x85, 0x56, 0xa9, 0xd0, 0x32, 0x9c, 0xdd, 0xcb, 0x93,
+	0x80, 0x64, 0x95, 0x83, 0x30, 0x23, 0x57, 0xcb, 0x5e, 0xaf, 0xc0, 0xab, 0x58, 0x7a, 0xaa, 0x9c,
+	0x87, 0x59, 0xb5, 0x5c, 0x0a, 0x97, 0xe0, 0x95, 0x80, 0xb0, 0x17, 0xaa, 0x9a, 0x3c, 0x56, 0xc5,
+	0x9a, 0x92, 0xdc, 0xbb, 0x56, 0x24, 0x50, 0x89, 0xda, 0x5a, 0x80, 0xd7, 0x4e, 0x61, 0x69, 0xdc,
+	0x1f, 0x01, 0xbc, 0xbe, 0xcb, 0xc2, 0x8f, 0xd2, 0x00, 0x73, 0xa2, 0x52, 0xda, 0x11, 0x3b, 0x47,
+	0x25, 0x5f, 0x87, 0x28, 0x21, 0x87, 0x5e, 0x45, 0x2a, 0xe1, 0xe7, 0x12, 0x72, 0xb8, 0x53, 0xcd,
+	0xb3, 0x3c, 0x02, 0x67, 0x33, 0x28, 0xfb, 0x73, 0x61, 0x12, 0x4d, 0x68, 0x9b, 0x61, 0x75, 0x3e,
+	0x9f, 0xc0, 0x7a, 0x91, 0x26, 0x4d, 0x86, 0x24, 0xe3, 0x95, 0x1b, 0x69, 0x88, 0x0d, 0x8c, 0xb1,
+	0xe7, 0x8b, 0xd8, 0x55, 0x6d, 0xab, 0x05, 0x9b, 0xe7, 0xb9, 0xd6, 0xe1, 0xbf, 0x07, 0xa2, 0xcc,
+	0x9b, 0x83, 0x01, 0xf5, 0x31, 0x27, 0xf7, 0xe9, 0x87, 0x79, 0x3f, 0x21, 0xa3, 0x87, 0x46, 0xd7,
+	0xe1, 0x44, 0x42, 0x78, 0x1e, 0x05, 0xa2, 0x82, 0x33, 0x3d, 0xf5, 0x84, 0xde, 0x81, 0x13, 0x38,
+	0x2e, 0x42, 0xa9, 0x39, 0x70, 0xb3, 0xbc, 0x31, 0xc5, 0xd8, 0xd4, 0xd7, 0x65, 0x9b, 0x46, 0xc9,
+	0xd6, 0xe5, 0xe2, 0xb2, 0xf4, 0x94, 0xfc, 0x9c, 0x5c, 0x16, 0xe1, 0xeb, 0x06, 0x4c, 0x9d, 0xc6,
+	0x23, 0x00, 0x6f, 0xec, 0xb2, 0xf0, 0x3d, 0x82, 0x95, 0x62, 0x27, 0xa3, 0xf1, 0xff, 0x34, 0x95,
+	0x25, 0xd8, 0x38, 0x07, 0xb5, 0x4c, 0xa7, 0xfb, 0xf7, 0x6b, 0xb0, 0xb6, 0xcb, 0x42, 0xf4, 0x33,
+	0x80, 0xb7, 0x2e, 0x9c, 0xd9, 0x1d, 0xa7, 0xfa, 0x7a, 0x72, 0x5e, 0x71, 0xef, 0xad, 0xbb, 0xff,
+	0x7a, 0x8b, 0xae, 0xf2, 0xbb, 0x0f, 0x7e, 0xf9, 0xe3, 0xeb, 0x4b, 0x6f, 0xa3, 0x3b, 0xae, 0xe1,
+	0x7d, 0xe9, 0xfa, 0xc2, 0x85, 0xe7, 0x2b, 0x1f, 0x9e, 0xce, 0x5b, 0xb1, 0x1e, 0xc2, 0x49, 0x3d,
+	0x64, 0x16, 0xcd, 0x10, 0xca, 0x6c, 0x2d, 0x5f, 0x68, 0xd6, 0x3c, 0xcb, 0x82, 0xa7, 0x81, 0x16,
+	0xcd, 0x3c, 0x65, 0xb0, 0xe2, 0x8c, 0x9b, 0xe6, 0x45, 0xdb, 0x18, 0xc5, 0xa0, 0xb4, 0xde, 0x1a,
+	0x55, 0xa9, 0xd1, 0xba, 0x02, 0x6d, 0x1d, 0xad, 0x19, 0xd1, 0x72, 0xb1, 0x53, 0x57, 0x48, 0x0e,
+	0x0d, 0xf4, 0x13, 0x80, 0x0b, 0xe6, 0x41, 0xb0, 0x66, 0xae, 0x87, 0x49, 0x6b, 0x75, 0x47, 0xd7,
+	0x6a, 0xda, 0x3b, 0x82, 0xd6, 0x41, 0xeb, 0xe6, 0x42, 0xca, 0xbd, 0x2f, 0x35, 0xf4, 0x5b, 0x00,
+	0xe7, 0x5e, 0x1a, 0x1c, 0xe6, 0xd6, 0x55, 0x65, 0xd6, 0xc6, 0x48, 0x32, 0x0d, 0xe8, 0x0a, 0xc0,
+	0x55, 0xb4, 0x62, 0x04, 0x2c, 0x6f, 0x92, 0xc7, 0xa9, 0xc7, 0x24, 0xc6, 0x23, 0x00, 0xe7, 0x8d,
+	0xd3, 0x60, 0xd5, 0x18, 0xd8, 0x24, 0xb5, 0x3a, 0x23, 0x4b, 0x35, 0xe7, 0x6d, 0xc1, 0xb9, 0x81,
+	0xde, 0x34, 0x72, 0x06, 0x7a, 0xab, 0x27, 0xde, 0xd6, 0x92, 0xd5, 0x1a, 0xff, 0xe2, 0xf9, 0xe3,
+	0x35, 0xb0, 0xf5, 0xfe, 0x93, 0x63, 0x1b, 0x3c, 0x3d, 0xb6, 0xc1, 0xef, 0xc7, 0x36, 0x78, 0x78,
+	0x62, 0x8f, 0x3d, 0x3d, 0xb1, 0xc7, 0x7e, 0x3d, 0xb1, 0xc7, 0x3e, 0x75, 0x4f, 0x7d, 0x45, 0xec,
+	0x13, 0x9e, 0x6f, 0xa4, 0x19, 0xfd, 0x8c, 0xf8, 0x5c, 0x3c, 0x14, 0xbe, 0x8f, 0xce, 0x7e, 0x43,
+	0xf4, 0x27, 0xc4, 0xc7, 0xd6, 0xed, 0x7f, 0x06, 0x00, 0x2e, 0x59, 0x1a, 0x5c, 0xd8, 0x0a, 0x00,
 	0x00,
 }
 
@@ -552,6 +767,12 @@ type MsgClient interface {
 	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to a Eth account
 	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
+	// AllocateToSubnet allocates locked tokens of a ClawbackVestingAccount to
+	// its stake on a subnet.
+	AllocateToSubnet(ctx context.Context, in *MsgAllocateToSubnet, opts ...grpc.CallOption) (*MsgAllocateToSubnetResponse, error)
+	// DeallocateFromSubnet returns tokens allocated to the stake of a vesting
+	// account on a subnet to the account.
+	DeallocateFromSubnet(ctx context.Context, in *MsgDeallocateFromSubnet, opts ...grpc.CallOption) (*MsgDeallocateFromSubnetResponse, error)
 }
 
 type msgClient struct {
@@ -598,6 +819,24 @@ func (c *msgClient) ConvertVestingAccount(ctx context.Context, in *MsgConvertVes
 	return out, nil
 }
 
+func (c *msgClient) AllocateToSubnet(ctx context.Context, in *MsgAllocateToSubnet, opts ...grpc.CallOption) (*MsgAllocateToSubnetResponse, error) {
+	out := new(MsgAllocateToSubnetResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v1.Msg/AllocateToSubnet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeallocateFromSubnet(ctx context.Context, in *MsgDeallocateFromSubnet, opts ...grpc.CallOption) (*MsgDeallocateFromSubnetResponse, error) {
+	out := new(MsgDeallocateFromSubnetResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v1.Msg/DeallocateFromSubnet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateClawbackVestingAccount creats a vesting account that is subject to
@@ -610,6 +849,12 @@ type MsgServer interface {
 	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to a Eth account
 	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
+	// AllocateToSubnet allocates locked tokens of a ClawbackVestingAccount to
+	// its stake on a subnet.
+	AllocateToSubnet(context.Context, *MsgAllocateToSubnet) (*MsgAllocateToSubnetResponse, error)
+	// DeallocateFromSubnet returns tokens allocated to the stake of a vesting
+	// account on a subnet to the account.
+	DeallocateFromSubnet(context.Context, *MsgDeallocateFromSubnet) (*MsgDeallocateFromSubnetResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -628,6 +873,12 @@ func (*UnimplementedMsgServer) UpdateVestingFunder(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) ConvertVestingAccount(ctx context.Context, req *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestingAccount not implemented")
 }
+func (*UnimplementedMsgServer) AllocateToSubnet(ctx context.Context, req *MsgAllocateToSubnet) (*MsgAllocateToSubnetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocateToSubnet not implemented")
+}
+func (*UnimplementedMsgServer) DeallocateFromSubnet(ctx context.Context, req *MsgDeallocateFromSubnet) (*MsgDeallocateFromSubnetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeallocateFromSubnet not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -705,6 +956,43 @@ func _Msg_ConvertVestingAccount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AllocateToSubnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAllocateToSubnet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AllocateToSubnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v1.Msg/AllocateToSubnet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AllocateToSubnet(ctx, req.(*MsgAllocateToSubnet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeallocateFromSubnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeallocateFromSubnet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeallocateFromSubnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v1.Msg/DeallocateFromSubnet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeallocateFromSubnet(ctx, req.(*MsgDeallocateFromSubnet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.vesting.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -725,6 +1013,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertVestingAccount",
 			Handler:    _Msg_ConvertVestingAccount_Handler,
 		},
+		{
+			MethodName: "AllocateToSubnet",
+			Handler:    _Msg_AllocateToSubnet_Handler,
+		},
+		{
+			MethodName: "DeallocateFromSubnet",
+			Handler:    _Msg_DeallocateFromSubnet_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v1/tx.proto",
@@ -1023,6 +1319,142 @@ func (m *MsgConvertVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAllocateToSubnet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAllocateToSubnet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAllocateToSubnet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Netuid != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Netuid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAllocateToSubnetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAllocateToSubnetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAllocateToSubnetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeallocateFromSubnet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeallocateFromSubnet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeallocateFromSubnet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Netuid != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Netuid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeallocateFromSubnetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeallocateFromSubnetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeallocateFromSubnetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1159,6 +1591,60 @@ func (m *MsgConvertVestingAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAllocateToSubnet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Netuid != 0 {
+		n += 1 + sovTx(uint64(m.Netuid))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAllocateToSubnetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeallocateFromSubnet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Netuid != 0 {
+		n += 1 + sovTx(uint64(m.Netuid))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeallocateFromSubnetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1347,23 +1833,207 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Merge = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
-			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1373,12 +2043,24 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Merge = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1400,7 +2082,7 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1423,10 +2105,10 @@ func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1450,7 +2132,7 @@ func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1473,10 +2155,10 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateVestingFunder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateVestingFunder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1513,7 +2195,7 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFunderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1541,11 +2223,11 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			m.NewFunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1573,7 +2255,7 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1596,7 +2278,7 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1619,10 +2301,10 @@ func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1646,7 +2328,7 @@ func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1669,15 +2351,15 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1705,11 +2387,111 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAllocateToSubnet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAllocateToSubnet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAllocateToSubnet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFunderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1737,13 +2519,32 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewFunderAddress = string(dAtA[iNdEx:postIndex])
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+			}
+			m.Netuid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Netuid |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1753,23 +2554,24 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1792,7 +2594,7 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAllocateToSubnetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1815,10 +2617,10 @@ func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAllocateToSubnetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAllocateToSubnetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1842,7 +2644,7 @@ func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgDeallocateFromSubnet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1865,10 +2667,10 @@ func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeallocateFromSubnet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeallocateFromSubnet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1903,6 +2705,58 @@ func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
 			}
 			m.VestingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Netuid", wireType)
+			}
+			m.Netuid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Netuid |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1924,7 +2778,7 @@ func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeallocateFromSubnetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1947,10 +2801,10 @@ func (m *MsgConvertVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeallocateFromSubnetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeallocateFromSubnetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: